@@ -21,6 +21,10 @@ import (
 
 // @host      ichibuy-auth.vercel.app
 
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
+
 // @externalDocs.description  OpenAPI
 // @externalDocs.url          https://swagger.io/resources/open-api/
 func main() {
@@ -17,6 +17,7 @@ type Config struct {
 	JWTPrivateKey      string `env:"JWT_PRIVATE_KEY"`
 	GoogleClientID     string `env:"GOOGLE_CLIENT_ID"`
 	GoogleClientSecret string `env:"GOOGLE_CLIENT_SECRET"`
+	EventsAPIToken     string `env:"EVENTS_API_TOKEN"`
 }
 
 func Load() Config {
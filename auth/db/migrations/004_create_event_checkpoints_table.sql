@@ -0,0 +1,6 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS event_checkpoints (
+    consumer VARCHAR(100) PRIMARY KEY,
+    last_event_id VARCHAR(255) NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"context"
+	"ichibuy/auth/internal/domain"
+)
+
+type EventCheckpoint = domain.EventCheckpoint
+
+type EventCheckpointDAO interface {
+	// Create creates a new EventCheckpoint
+	Create(ctx context.Context, m *EventCheckpoint) error
+
+	// Update updates an existing EventCheckpoint
+	Update(ctx context.Context, m *EventCheckpoint) error
+
+	// PartialUpdate updates specific fields of a EventCheckpoint
+	PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error
+
+	// DeleteByPk deletes a EventCheckpoint by primary key
+	DeleteByPk(ctx context.Context, pk string) error
+
+	// FindByPk finds a EventCheckpoint by primary key
+	FindByPk(ctx context.Context, pk string) (*EventCheckpoint, error)
+
+	// CreateMany creates multiple EventCheckpoint records
+	CreateMany(ctx context.Context, models []*EventCheckpoint) error
+
+	// UpdateMany updates multiple EventCheckpoint records
+	UpdateMany(ctx context.Context, models []*EventCheckpoint) error
+
+	// DeleteManyByPks deletes multiple EventCheckpoint records by primary keys
+	DeleteManyByPks(ctx context.Context, pks []string) error
+
+	// FindOne finds a single EventCheckpoint with optional where clause and sort expression
+	FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*EventCheckpoint, error)
+
+	// FindAll finds all EventCheckpoint records with optional where clause and sort expression
+	FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*EventCheckpoint, error)
+
+	// FindPaginated finds EventCheckpoint records with pagination, optional where clause and sort expression
+	FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*EventCheckpoint, error)
+
+	// Count counts EventCheckpoint records with optional where clause
+	Count(ctx context.Context, where string, args ...interface{}) (int64, error)
+
+	// WithTransaction executes a function within a database transaction
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
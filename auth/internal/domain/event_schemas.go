@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+//go:embed schemas/*.json
+var schemaFiles embed.FS
+
+// eventSchemaFiles lists the data schema of every version of the published events, in version order.
+// A change that breaks the consumers of an event adds a version, new events are published with the last one.
+
+// eventSchemaFiles lists the data schema of every version of the published events, in version order.
+// A change that breaks the consumers of an event adds a version, new events are published with the last one.
+var eventSchemaFiles = map[EventType][]string{
+	UserCreated: {"user.v1.json"},
+}
+
+// EventSchemas is the registry of the schemas of the events published by the service
+var EventSchemas = mustLoadSchemaRegistry()
+
+// EventSchema is the JSON Schema of the data of a version of an event
+type EventSchema struct {
+	Type    EventType       `json:"type"`
+	Version int             `json:"version"`
+	Schema  json.RawMessage `json:"schema" swaggertype:"object"`
+
+	parsed *JSONSchema
+}
+
+type SchemaRegistry struct {
+	schemas map[EventType][]*EventSchema
+}
+
+func mustLoadSchemaRegistry() *SchemaRegistry {
+	registry, err := loadSchemaRegistry()
+	if err != nil {
+		panic(err)
+	}
+	return registry
+}
+
+func loadSchemaRegistry() (*SchemaRegistry, error) {
+	registry := &SchemaRegistry{schemas: map[EventType][]*EventSchema{}}
+	for eventType, files := range eventSchemaFiles {
+		for i, file := range files {
+			raw, err := schemaFiles.ReadFile("schemas/" + file)
+			if err != nil {
+				return nil, err
+			}
+
+			var parsed JSONSchema
+			if err := json.Unmarshal(raw, &parsed); err != nil {
+				return nil, fmt.Errorf("schema %s: %w", file, err)
+			}
+
+			registry.schemas[eventType] = append(registry.schemas[eventType], &EventSchema{
+				Type:    eventType,
+				Version: i + 1,
+				Schema:  raw,
+				parsed:  &parsed,
+			})
+		}
+	}
+	return registry, nil
+}
+
+// LatestVersion returns the version new events of the type are published with, 0 when it has no schema
+func (r *SchemaRegistry) LatestVersion(eventType EventType) int {
+	return len(r.schemas[eventType])
+}
+
+func (r *SchemaRegistry) Find(eventType EventType, version int) (*EventSchema, error) {
+	versions := r.schemas[eventType]
+	if version < 1 || version > len(versions) {
+		return nil, fmt.Errorf("schema %s v%d not found", eventType, version)
+	}
+	return versions[version-1], nil
+}
+
+// All returns every version of every schema, ordered by type and version
+func (r *SchemaRegistry) All() []*EventSchema {
+	all := []*EventSchema{}
+	for _, versions := range r.schemas {
+		all = append(all, versions...)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		if all[i].Type != all[j].Type {
+			return all[i].Type < all[j].Type
+		}
+		return all[i].Version < all[j].Version
+	})
+	return all
+}
+
+// Validate checks the envelope of the event and its data against the schema of its version
+func (r *SchemaRegistry) Validate(event Event) error {
+	if event.ID == "" || event.AggregateType == "" || event.AggregateID == "" {
+		return fmt.Errorf("event %s must have an id and an aggregate", event.Type)
+	}
+
+	schema, err := r.Find(event.Type, event.SchemaVersion)
+	if err != nil {
+		return err
+	}
+
+	if err := schema.parsed.Validate(event.Data); err != nil {
+		return fmt.Errorf("event %s v%d does not match its schema: %w", event.Type, event.SchemaVersion, err)
+	}
+	return nil
+}
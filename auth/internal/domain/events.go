@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type EventType string
+
+const (
+	UserCreated EventType = "UserCreated"
+)
+
+type AggregateType string
+
+const (
+	UserAggregate AggregateType = "user"
+)
+
+// Event is the envelope of the events published by the services. AggregateVersion counts the events of
+// the aggregate from 1, SchemaVersion is the version of the data schema in the schema registry, and the
+// correlation and causation IDs link the event to the request or event that caused it.
+type Event struct {
+	ID               string          `json:"id" sql:"id,primary"`
+	Type             EventType       `json:"type" sql:"type"`
+	SchemaVersion    int             `json:"schema_version" sql:"schema_version"`
+	AggregateType    AggregateType   `json:"aggregate_type" sql:"aggregate_type"`
+	AggregateID      string          `json:"aggregate_id" sql:"aggregate_id"`
+	AggregateVersion int             `json:"aggregate_version" sql:"aggregate_version"`
+	Producer         string          `json:"producer" sql:"producer"`
+	CorrelationID    *string         `json:"correlation_id" sql:"correlation_id"`
+	CausationID      *string         `json:"causation_id" sql:"causation_id"`
+	Data             json.RawMessage `json:"data" sql:"data"`
+	Timestamp        time.Time       `json:"timestamp" sql:"timestamp"`
+}
+
+// NewEvent returns an event of the aggregate with a time ordered UUID, published with the last schema
+// version of its type. The event bus sets the aggregate version, producer and correlation on publish.
+func NewEvent(eventType EventType, aggregateType AggregateType, aggregateID string, data any, timestamp time.Time) Event {
+	raw, _ := json.Marshal(data)
+	return Event{
+		ID:            uuid.Must(uuid.NewV7()).String(),
+		Type:          eventType,
+		SchemaVersion: EventSchemas.LatestVersion(eventType),
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		Data:          raw,
+		Timestamp:     timestamp,
+	}
+}
+
+func (e *Event) TableName() string {
+	return "events"
+}
+
+type EventBus interface {
+	Publish(ctx context.Context, events ...Event) error
+}
+
+type UserEventData struct {
+	ID       string `json:"id"`
+	Email    string `json:"email"`
+	Username string `json:"username"`
+}
@@ -1,11 +1,16 @@
 package domain
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 type User struct {
 	ID       string `sql:"id,primary"`
 	Email    string `sql:"email"`
 	Username string `sql:"username"`
+
+	Entity
 }
 
 func NewUser(id string, email string, username string) (*User, error) {
@@ -17,11 +22,22 @@ func NewUser(id string, email string, username string) (*User, error) {
 		return nil, fmt.Errorf("username cannot be empty")
 	}
 
-	return &User{
+	user := &User{
 		ID:       id,
 		Email:    email,
 		Username: username,
-	}, nil
+	}
+	user.RecordCreated()
+
+	return user, nil
+}
+
+// RecordCreated adds the UserCreated event of the user. Users created before the events were published
+// get it on their next sign in, so the other services provision their records.
+func (u *User) RecordCreated() {
+	now := time.Now().UTC()
+	data := UserEventData{ID: u.ID, Email: u.Email, Username: u.Username}
+	u.events = append(u.events, NewEvent(UserCreated, UserAggregate, u.ID, data, now))
 }
 
 func (u *User) TableName() string {
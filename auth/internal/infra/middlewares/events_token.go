@@ -0,0 +1,23 @@
+package middlewares
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequireEventsToken authenticates the services reading the events feed with a shared token, the feed is
+// closed when the token is not configured
+func RequireEventsToken(token string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		received := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+		if token == "" || subtle.ConstantTimeCompare([]byte(received), []byte(token)) != 1 {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid events token"})
+			return
+		}
+
+		c.Next()
+	}
+}
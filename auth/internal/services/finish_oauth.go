@@ -8,6 +8,7 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"log/slog"
 	"time"
 
 	"github.com/golang-jwt/jwt"
@@ -28,6 +29,8 @@ type FinishOAuthResp struct {
 
 type FinishOAuth struct {
 	userDAO            dao.UserDAO
+	eventDAO           dao.EventDAO
+	eventBus           domain.EventBus
 	oauthCfg           *oauth2.Config
 	oauthInfoExtractor domain.InfoExtractor
 	nextID             domain.NextID
@@ -36,6 +39,8 @@ type FinishOAuth struct {
 
 func NewFinishOAuth(
 	userDAO dao.UserDAO,
+	eventDAO dao.EventDAO,
+	eventBus domain.EventBus,
 	oauthCfg *oauth2.Config,
 	oauthInfoExtractor domain.InfoExtractor,
 	nextID domain.NextID,
@@ -43,6 +48,8 @@ func NewFinishOAuth(
 ) *FinishOAuth {
 	return &FinishOAuth{
 		userDAO:            userDAO,
+		eventDAO:           eventDAO,
+		eventBus:           eventBus,
 		oauthCfg:           oauthCfg,
 		oauthInfoExtractor: oauthInfoExtractor,
 		nextID:             nextID,
@@ -68,7 +75,14 @@ func (s *FinishOAuth) Exec(ctx context.Context, req FinishOAuthReq) (*FinishOAut
 			return nil, err
 		}
 
-		err = s.userDAO.Create(ctx, newUser)
+		// the UserCreated event is published with the user, so other services provision their records
+		err = s.userDAO.WithTransaction(ctx, func(ctx context.Context) error {
+			if err := s.userDAO.Create(ctx, newUser); err != nil {
+				return err
+			}
+
+			return s.eventBus.Publish(ctx, newUser.PullEvents()...)
+		})
 		if err != nil {
 			return nil, err
 		}
@@ -76,6 +90,8 @@ func (s *FinishOAuth) Exec(ctx context.Context, req FinishOAuthReq) (*FinishOAut
 		user = newUser
 	} else if err != nil {
 		return nil, err
+	} else {
+		s.recordCreated(ctx, user)
 	}
 
 	privateKey, err := domain.ParseRSAPrivateKeyFromPEM(s.cfg.JWTPrivateKey)
@@ -101,6 +117,27 @@ func (s *FinishOAuth) Exec(ctx context.Context, req FinishOAuthReq) (*FinishOAut
 	}, nil
 }
 
+// recordCreated publishes the UserCreated event of a user created before the events were published. It
+// does not stop the sign in, a failed attempt is made again on the next one.
+func (s *FinishOAuth) recordCreated(ctx context.Context, user *domain.User) {
+	count, err := s.eventDAO.Count(ctx, "aggregate_id = $1 AND type = $2", user.ID, domain.UserCreated)
+	if err != nil {
+		slog.ErrorContext(ctx, "count user created events failed", "user_id", user.ID, "error", err.Error())
+		return
+	}
+	if count > 0 {
+		return
+	}
+
+	user.RecordCreated()
+	if err := s.eventBus.Publish(ctx, user.PullEvents()...); err != nil {
+		slog.ErrorContext(ctx, "publish user created failed", "user_id", user.ID, "error", err.Error())
+		return
+	}
+
+	slog.InfoContext(ctx, "user created event recorded", "user_id", user.ID)
+}
+
 func generateToken(userID string, userEmail string, privateKey *rsa.PrivateKey) (string, error) {
 	publicKeyBytes, err := domain.PublicKeyToPEM(&privateKey.PublicKey)
 	if err != nil {
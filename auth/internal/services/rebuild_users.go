@@ -0,0 +1,40 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+
+	"ichibuy/auth/internal/domain"
+	"ichibuy/auth/internal/domain/dao"
+)
+
+// RebuildUsers is the projection of the users, it creates the users of the UserCreated events, skipping
+// the events whose user or email already exists
+type RebuildUsers struct {
+	userDAO dao.UserDAO
+}
+
+func NewRebuildUsers(userDAO dao.UserDAO) *RebuildUsers {
+	return &RebuildUsers{userDAO: userDAO}
+}
+
+func (s *RebuildUsers) Types() []domain.EventType {
+	return []domain.EventType{domain.UserCreated}
+}
+
+func (s *RebuildUsers) Handle(ctx context.Context, event domain.Event) error {
+	var data domain.UserEventData
+	if err := json.Unmarshal(event.Data, &data); err != nil {
+		return err
+	}
+
+	count, err := s.userDAO.Count(ctx, "id = $1 OR email = $2", data.ID, data.Email)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+
+	return s.userDAO.Create(ctx, &domain.User{ID: data.ID, Email: data.Email, Username: data.Username})
+}
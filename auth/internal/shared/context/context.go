@@ -0,0 +1,32 @@
+package context
+
+import "context"
+
+type ContextKey = string
+
+const (
+	// CorrelationIDKey is the ID shared by a request and every event it causes, across services
+	CorrelationIDKey ContextKey = "ichibuy-correlation-id"
+	// CausationIDKey is the ID of the event being handled, set on the events it causes
+	CausationIDKey ContextKey = "ichibuy-causation-id"
+)
+
+// WithCause returns a context whose events are caused by the event with the given ID, keeping the
+// correlation of the event or starting one from it
+func WithCause(ctx context.Context, eventID string, correlationID *string) context.Context {
+	correlation := eventID
+	if correlationID != nil && *correlationID != "" {
+		correlation = *correlationID
+	}
+
+	ctx = context.WithValue(ctx, CorrelationIDKey, correlation)
+	return context.WithValue(ctx, CausationIDKey, eventID)
+}
+
+// StringValue returns the value of the key when it is a non empty string
+func StringValue(ctx context.Context, key ContextKey) *string {
+	if value, ok := ctx.Value(key).(string); ok && value != "" {
+		return &value
+	}
+	return nil
+}
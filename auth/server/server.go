@@ -12,6 +12,8 @@ import (
 	"golang.org/x/oauth2/google"
 
 	"ichibuy/auth/config"
+	"ichibuy/auth/internal/domain"
+	"ichibuy/auth/internal/infra/events"
 	"ichibuy/auth/internal/infra/handlers"
 	"ichibuy/auth/internal/infra/middlewares"
 	"ichibuy/auth/internal/infra/persistence/postgres"
@@ -21,7 +23,7 @@ import (
 
 func New(cfg config.Config, db *sql.DB) *gin.Engine {
 	router := gin.Default()
-	router.Use(middlewares.UseCORS())
+	router.Use(middlewares.UseCORS(), middlewares.CorrelationID())
 
 	googleOAuthConfig := &oauth2.Config{
 		RedirectURL:  fmt.Sprintf("%s/api/v1/auth/google/callback", cfg.APIBaseURI),
@@ -32,17 +34,24 @@ func New(cfg config.Config, db *sql.DB) *gin.Engine {
 	}
 
 	userDAO := postgres.NewUserDAO(db)
+	eventDAO := postgres.NewEventDAO(db)
+
+	eventBus := events.NewBus(eventDAO)
 
 	nextIDFunc := uuid.NewString
 
 	startOAuthServ := services.NewStartOAuth(googleOAuthConfig)
-	finishOAuthServ := services.NewFinishOAuth(userDAO, googleOAuthConfig, infraServices.GoogleInfoExtractor, nextIDFunc, cfg)
+	finishOAuthServ := services.NewFinishOAuth(userDAO, eventDAO, eventBus, googleOAuthConfig, infraServices.GoogleInfoExtractor, nextIDFunc, cfg)
+	listEventsServ := services.NewListEvents(eventDAO)
+	listEventSchemasServ := services.NewListEventSchemas(domain.EventSchemas)
 
 	api := router.Group("/api/v1")
 	{
 		api.GET("/auth/google", handlers.StartOAuth(startOAuthServ))
 		api.GET("/auth/google/callback", handlers.OAuthCallback(finishOAuthServ))
 		api.GET("/auth/.well-known/jwks.json", handlers.GetJWKS(cfg))
+		api.GET("/auth/events", middlewares.RequireEventsToken(cfg.EventsAPIToken), handlers.ListEvents(listEventsServ))
+		api.GET("/auth/events/schemas", middlewares.RequireEventsToken(cfg.EventsAPIToken), handlers.ListEventSchemas(listEventSchemasServ))
 	}
 
 	router.GET("/api/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+
+	"ichibuy/notification/config"
+	"ichibuy/notification/db"
+	"ichibuy/notification/internal/domain"
+	"ichibuy/notification/internal/infra/persistence/postgres"
+	infraServices "ichibuy/notification/internal/infra/services"
+	"ichibuy/notification/internal/services"
+)
+
+// Worker runs the background jobs of the notification service (consumption of the store and order events
+// and sending of the deliveries)
+func main() {
+	cfg := config.Load()
+	db, err := db.New(cfg.PostgresURI)
+	if err != nil {
+		panic(err)
+	}
+	defer db.Close()
+
+	interval := cfg.GetWorkerInterval()
+
+	httpClient := &http.Client{
+		Timeout: 10 * time.Second,
+	}
+
+	// DAOs
+	customerDAO := postgres.NewCustomerDAO(db)
+	storeDAO := postgres.NewStoreDAO(db)
+	preferenceDAO := postgres.NewPreferenceDAO(db)
+	deliveryDAO := postgres.NewDeliveryDAO(db)
+	eventCheckpointDAO := postgres.NewEventCheckpointDAO(db)
+
+	nextIDFunc := uuid.NewString
+
+	// Domain ports
+	storeEventsSvc := infraServices.NewEventsService(httpClient, cfg.StoreBaseURL, cfg.StoreEventsAPIToken)
+	orderEventsSvc := infraServices.NewEventsService(httpClient, cfg.OrderBaseURL, cfg.OrderEventsAPIToken)
+
+	// the channels without a sender configured are written to the file sink
+	fileSender := infraServices.NewFileSender(cfg.GetNotificationsFile())
+	senders := map[domain.Channel]domain.Sender{
+		domain.EmailChannel:   fileSender,
+		domain.WebhookChannel: fileSender,
+	}
+	if cfg.SMTPHost != "" {
+		senders[domain.EmailChannel] = infraServices.NewSMTPSender(cfg.SMTPHost, cfg.GetSMTPPort(), cfg.SMTPUsername, cfg.SMTPPassword, cfg.SMTPFrom, 30*time.Second)
+	}
+	if cfg.WebhookURL != "" {
+		senders[domain.WebhookChannel] = infraServices.NewWebhookSender(httpClient, cfg.WebhookURL, cfg.WebhookToken)
+	}
+
+	// Jobs
+	consumeStoreEventsService := services.NewConsumeStoreEvents(eventCheckpointDAO, customerDAO, storeDAO, storeEventsSvc)
+	consumeOrderEventsService := services.NewConsumeOrderEvents(eventCheckpointDAO, customerDAO, storeDAO, preferenceDAO, deliveryDAO, nextIDFunc, orderEventsSvc)
+	deliverNotificationsService := services.NewDeliverNotifications(deliveryDAO, senders)
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	slog.InfoContext(ctx, "worker started", "interval", interval.String())
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		// the recipients are updated first, so the orders of new customers and stores are notified
+		if err := consumeStoreEventsService.Exec(ctx); err != nil {
+			slog.ErrorContext(ctx, "consume store events failed", "error", err.Error())
+		}
+
+		if err := consumeOrderEventsService.Exec(ctx); err != nil {
+			slog.ErrorContext(ctx, "consume order events failed", "error", err.Error())
+		}
+
+		if err := deliverNotificationsService.Exec(ctx); err != nil {
+			slog.ErrorContext(ctx, "deliver notifications failed", "error", err.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			slog.InfoContext(ctx, "worker stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
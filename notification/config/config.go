@@ -0,0 +1,93 @@
+package config
+
+import (
+	"errors"
+	"log"
+	"os"
+	"reflect"
+	"time"
+
+	"github.com/joho/godotenv"
+)
+
+type Config struct {
+	APIPort             string `env:"API_PORT"`
+	PostgresURI         string `env:"POSTGRES_URI"`
+	AuthBaseURL         string `env:"AUTH_BASE_URL"`
+	StoreBaseURL        string `env:"STORE_BASE_URL"`
+	OrderBaseURL        string `env:"ORDER_BASE_URL"`
+	StoreEventsAPIToken string `env:"STORE_EVENTS_API_TOKEN"`
+	OrderEventsAPIToken string `env:"ORDER_EVENTS_API_TOKEN"`
+	WorkerInterval      string `env:"WORKER_INTERVAL"`
+	SMTPHost            string `env:"SMTP_HOST"`
+	SMTPPort            string `env:"SMTP_PORT"`
+	SMTPUsername        string `env:"SMTP_USERNAME"`
+	SMTPPassword        string `env:"SMTP_PASSWORD"`
+	SMTPFrom            string `env:"SMTP_FROM"`
+	WebhookURL          string `env:"NOTIFICATION_WEBHOOK_URL"`
+	WebhookToken        string `env:"NOTIFICATION_WEBHOOK_TOKEN"`
+	NotificationsFile   string `env:"NOTIFICATIONS_FILE"`
+}
+
+func Load() Config {
+	if err := godotenv.Load(); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			log.Println(".env not found, using environment variables as default")
+		} else {
+			log.Fatal("error loading .env", err)
+		}
+	}
+
+	var cfg Config
+	loadFromEnv(&cfg)
+	return cfg
+}
+
+func loadFromEnv(cfg *Config) {
+	v := reflect.ValueOf(cfg).Elem()
+	t := v.Type()
+
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		fieldType := t.Field(i)
+
+		if envTag := fieldType.Tag.Get("env"); envTag != "" {
+			if envValue := os.Getenv(envTag); envValue != "" {
+				field.SetString(envValue)
+			}
+		}
+	}
+}
+
+func (c Config) GetWorkerInterval() time.Duration {
+	return parseDuration(c.WorkerInterval, 30*time.Second)
+}
+
+func (c Config) GetSMTPPort() string {
+	if c.SMTPPort == "" {
+		return "587"
+	}
+	return c.SMTPPort
+}
+
+// GetNotificationsFile is the file sink of the channels without a sender configured
+func (c Config) GetNotificationsFile() string {
+	if c.NotificationsFile == "" {
+		return "notifications.jsonl"
+	}
+	return c.NotificationsFile
+}
+
+func parseDuration(value string, fallback time.Duration) time.Duration {
+	if value == "" {
+		return fallback
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		log.Printf("invalid duration %q, using %s", value, fallback)
+		return fallback
+	}
+
+	return duration
+}
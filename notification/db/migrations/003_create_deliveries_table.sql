@@ -0,0 +1,24 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS deliveries (
+    id UUID PRIMARY KEY,
+    event_id VARCHAR(255) NOT NULL,
+    event_type VARCHAR(100) NOT NULL,
+    user_id VARCHAR(255) NOT NULL,
+    channel VARCHAR(20) NOT NULL,
+    recipient VARCHAR(255) NOT NULL,
+    locale VARCHAR(2) NOT NULL,
+    subject TEXT NOT NULL,
+    body TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    next_attempt_at TIMESTAMP WITH TIME ZONE,
+    sent_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+-- an event notifies a user once per channel
+CREATE UNIQUE INDEX IF NOT EXISTS idx_deliveries_event_user_channel ON deliveries(event_id, user_id, channel);
+CREATE INDEX IF NOT EXISTS idx_deliveries_user_id ON deliveries(user_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_deliveries_pending ON deliveries(next_attempt_at) WHERE status = 'pending';
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+)
+
+type Channel string
+
+const (
+	EmailChannel Channel = "email"
+	// WebhookChannel posts the notifications to a gateway, e.g. for SMS or chat messages, addressed to the phone
+	WebhookChannel Channel = "webhook"
+)
+
+// Channels are the channels a notification can be sent through
+var Channels = []Channel{EmailChannel, WebhookChannel}
+
+func ParseChannel(value string) (Channel, error) {
+	for _, channel := range Channels {
+		if string(channel) == value {
+			return channel, nil
+		}
+	}
+	return "", fmt.Errorf("invalid channel %s, expected email or webhook", value)
+}
+
+// Sender sends the deliveries of a channel
+type Sender interface {
+	Send(ctx context.Context, delivery *Delivery) error
+}
@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"context"
+	"ichibuy/notification/internal/domain"
+)
+
+type Delivery = domain.Delivery
+
+type DeliveryDAO interface {
+	// Create creates a new Delivery
+	Create(ctx context.Context, m *Delivery) error
+
+	// Update updates an existing Delivery
+	Update(ctx context.Context, m *Delivery) error
+
+	// PartialUpdate updates specific fields of a Delivery
+	PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error
+
+	// DeleteByPk deletes a Delivery by primary key
+	DeleteByPk(ctx context.Context, pk string) error
+
+	// FindByPk finds a Delivery by primary key
+	FindByPk(ctx context.Context, pk string) (*Delivery, error)
+
+	// CreateMany creates multiple Delivery records
+	CreateMany(ctx context.Context, models []*Delivery) error
+
+	// UpdateMany updates multiple Delivery records
+	UpdateMany(ctx context.Context, models []*Delivery) error
+
+	// DeleteManyByPks deletes multiple Delivery records by primary keys
+	DeleteManyByPks(ctx context.Context, pks []string) error
+
+	// FindOne finds a single Delivery with optional where clause and sort expression
+	FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*Delivery, error)
+
+	// FindAll finds all Delivery records with optional where clause and sort expression
+	FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*Delivery, error)
+
+	// FindPaginated finds Delivery records with pagination, optional where clause and sort expression
+	FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*Delivery, error)
+
+	// Count counts Delivery records with optional where clause
+	Count(ctx context.Context, where string, args ...interface{}) (int64, error)
+
+	// WithTransaction executes a function within a database transaction
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"context"
+	"ichibuy/notification/internal/domain"
+)
+
+type Preference = domain.Preference
+
+type PreferenceDAO interface {
+	// Create creates a new Preference
+	Create(ctx context.Context, m *Preference) error
+
+	// Update updates an existing Preference
+	Update(ctx context.Context, m *Preference) error
+
+	// PartialUpdate updates specific fields of a Preference
+	PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error
+
+	// DeleteByPk deletes a Preference by primary key
+	DeleteByPk(ctx context.Context, pk string) error
+
+	// FindByPk finds a Preference by primary key
+	FindByPk(ctx context.Context, pk string) (*Preference, error)
+
+	// CreateMany creates multiple Preference records
+	CreateMany(ctx context.Context, models []*Preference) error
+
+	// UpdateMany updates multiple Preference records
+	UpdateMany(ctx context.Context, models []*Preference) error
+
+	// DeleteManyByPks deletes multiple Preference records by primary keys
+	DeleteManyByPks(ctx context.Context, pks []string) error
+
+	// FindOne finds a single Preference with optional where clause and sort expression
+	FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*Preference, error)
+
+	// FindAll finds all Preference records with optional where clause and sort expression
+	FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*Preference, error)
+
+	// FindPaginated finds Preference records with pagination, optional where clause and sort expression
+	FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*Preference, error)
+
+	// Count counts Preference records with optional where clause
+	Count(ctx context.Context, where string, args ...interface{}) (int64, error)
+
+	// WithTransaction executes a function within a database transaction
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,125 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+type DeliveryStatus string
+
+const (
+	PendingDeliveryStatus DeliveryStatus = "pending"
+	SentDeliveryStatus    DeliveryStatus = "sent"
+	FailedDeliveryStatus  DeliveryStatus = "failed"
+)
+
+const (
+	// MaxDeliveryAttempts is the number of sends before a delivery fails for good
+	MaxDeliveryAttempts = 5
+	// deliveryRetryDelay is the wait after the first failed send, doubled after each failure
+	deliveryRetryDelay = time.Minute
+)
+
+// Delivery is a notification sent to a user through a channel, kept as the delivery log. Pending
+// deliveries are sent once NextAttemptAt is reached and retried with exponential backoff.
+type Delivery struct {
+	ID            string         `sql:"id,primary" json:"id"`
+	EventID       string         `sql:"event_id" json:"event_id"`
+	EventType     EventType      `sql:"event_type" json:"event_type"`
+	UserID        string         `sql:"user_id" json:"user_id"`
+	Channel       Channel        `sql:"channel" json:"channel"`
+	Recipient     string         `sql:"recipient" json:"recipient"`
+	Locale        Locale         `sql:"locale" json:"locale"`
+	Subject       string         `sql:"subject" json:"subject"`
+	Body          string         `sql:"body" json:"body"`
+	Status        DeliveryStatus `sql:"status" json:"status"`
+	Attempts      int            `sql:"attempts" json:"attempts"`
+	LastError     *string        `sql:"last_error" json:"last_error"`
+	NextAttemptAt *time.Time     `sql:"next_attempt_at" json:"next_attempt_at"`
+	SentAt        *time.Time     `sql:"sent_at" json:"sent_at"`
+	CreatedAt     time.Time      `sql:"created_at" json:"created_at"`
+	UpdatedAt     time.Time      `sql:"updated_at" json:"updated_at"`
+}
+
+func NewDelivery(id string, event Event, userID string, channel Channel, recipient string, locale Locale, subject, body string) (*Delivery, error) {
+	if recipient == "" {
+		return nil, fmt.Errorf("recipient cannot be empty")
+	}
+
+	now := time.Now().UTC()
+	return &Delivery{
+		ID:            id,
+		EventID:       event.ID,
+		EventType:     event.Type,
+		UserID:        userID,
+		Channel:       channel,
+		Recipient:     recipient,
+		Locale:        locale,
+		Subject:       subject,
+		Body:          body,
+		Status:        PendingDeliveryStatus,
+		NextAttemptAt: &now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}, nil
+}
+
+func (d *Delivery) MarkSent(at time.Time) {
+	d.Attempts++
+	d.Status = SentDeliveryStatus
+	d.LastError = nil
+	d.NextAttemptAt = nil
+	d.SentAt = &at
+	d.UpdatedAt = at
+}
+
+// MarkFailed records a failed send, the delivery is retried after a delay that doubles on each attempt
+// until MaxDeliveryAttempts is reached
+func (d *Delivery) MarkFailed(reason string, at time.Time) {
+	d.Attempts++
+	d.LastError = &reason
+	d.UpdatedAt = at
+
+	if d.Attempts >= MaxDeliveryAttempts {
+		d.Status = FailedDeliveryStatus
+		d.NextAttemptAt = nil
+		return
+	}
+
+	next := at.Add(deliveryRetryDelay << (d.Attempts - 1))
+	d.NextAttemptAt = &next
+}
+
+// Retry sends a failed delivery again, with a new round of attempts
+func (d *Delivery) Retry(at time.Time) error {
+	if d.Status != FailedDeliveryStatus {
+		return fmt.Errorf("only failed deliveries can be retried")
+	}
+
+	d.Status = PendingDeliveryStatus
+	d.Attempts = 0
+	d.NextAttemptAt = &at
+	d.UpdatedAt = at
+	return nil
+}
+
+func (d *Delivery) GetID() string                { return d.ID }
+func (d *Delivery) GetEventID() string           { return d.EventID }
+func (d *Delivery) GetEventType() EventType      { return d.EventType }
+func (d *Delivery) GetUserID() string            { return d.UserID }
+func (d *Delivery) GetChannel() Channel          { return d.Channel }
+func (d *Delivery) GetRecipient() string         { return d.Recipient }
+func (d *Delivery) GetLocale() Locale            { return d.Locale }
+func (d *Delivery) GetSubject() string           { return d.Subject }
+func (d *Delivery) GetBody() string              { return d.Body }
+func (d *Delivery) GetStatus() DeliveryStatus    { return d.Status }
+func (d *Delivery) GetAttempts() int             { return d.Attempts }
+func (d *Delivery) GetLastError() *string        { return d.LastError }
+func (d *Delivery) GetNextAttemptAt() *time.Time { return d.NextAttemptAt }
+func (d *Delivery) GetSentAt() *time.Time        { return d.SentAt }
+func (d *Delivery) GetCreatedAt() time.Time      { return d.CreatedAt }
+func (d *Delivery) GetUpdatedAt() time.Time      { return d.UpdatedAt }
+
+func (d *Delivery) TableName() string {
+	return "deliveries"
+}
@@ -0,0 +1,48 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"ichibuy/notification/internal/domain"
+)
+
+func TestDelivery_MarkFailed(t *testing.T) {
+	event := domain.Event{ID: "event-1", Type: domain.OrderCreated}
+	delivery, err := domain.NewDelivery("delivery-1", event, "user-1", domain.EmailChannel, "ana@example.com", domain.SpanishLocale, "subject", "body")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	delays := []time.Duration{time.Minute, 2 * time.Minute, 4 * time.Minute, 8 * time.Minute}
+	for i, delay := range delays {
+		delivery.MarkFailed("connection refused", now)
+
+		if delivery.GetStatus() != domain.PendingDeliveryStatus {
+			t.Fatalf("attempt %d: expected pending, got %s", i+1, delivery.GetStatus())
+		}
+
+		if next := delivery.GetNextAttemptAt(); next == nil || !next.Equal(now.Add(delay)) {
+			t.Errorf("attempt %d: expected next attempt at %s, got %v", i+1, now.Add(delay), next)
+		}
+	}
+
+	delivery.MarkFailed("connection refused", now)
+	if delivery.GetStatus() != domain.FailedDeliveryStatus || delivery.GetNextAttemptAt() != nil {
+		t.Fatalf("expected failed after %d attempts, got %s", domain.MaxDeliveryAttempts, delivery.GetStatus())
+	}
+
+	if err := delivery.Retry(now); err != nil {
+		t.Fatal(err)
+	}
+
+	if delivery.GetStatus() != domain.PendingDeliveryStatus || delivery.GetAttempts() != 0 {
+		t.Errorf("expected a pending delivery without attempts, got %s with %d", delivery.GetStatus(), delivery.GetAttempts())
+	}
+
+	delivery.MarkSent(now)
+	if err := delivery.Retry(now); err == nil {
+		t.Error("expected an error retrying a sent delivery")
+	}
+}
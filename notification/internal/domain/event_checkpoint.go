@@ -0,0 +1,31 @@
+package domain
+
+import "time"
+
+// EventCheckpoint is the last event handled by a consumer of an events feed, so it resumes from there
+type EventCheckpoint struct {
+	Consumer    string    `sql:"consumer,primary"`
+	LastEventID string    `sql:"last_event_id"`
+	UpdatedAt   time.Time `sql:"updated_at"`
+}
+
+func NewEventCheckpoint(consumer string) *EventCheckpoint {
+	return &EventCheckpoint{
+		Consumer:  consumer,
+		UpdatedAt: time.Now().UTC(),
+	}
+}
+
+// Advance moves the checkpoint to the given event once it is handled
+func (c *EventCheckpoint) Advance(eventID string) {
+	c.LastEventID = eventID
+	c.UpdatedAt = time.Now().UTC()
+}
+
+func (c *EventCheckpoint) GetConsumer() string     { return c.Consumer }
+func (c *EventCheckpoint) GetLastEventID() string  { return c.LastEventID }
+func (c *EventCheckpoint) GetUpdatedAt() time.Time { return c.UpdatedAt }
+
+func (c *EventCheckpoint) TableName() string {
+	return "event_checkpoints"
+}
@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+type EventType string
+
+// Order events, read from the events feed of the order service
+const (
+	OrderCreated  EventType = "OrderCreated"
+	OrderAccepted EventType = "OrderAccepted"
+	OrderRejected EventType = "OrderRejected"
+	OrderCanceled EventType = "OrderCanceled"
+)
+
+// Store events, read from the events feed of the store service to know who the recipients are
+const (
+	StoreCreated     EventType = "StoreCreated"
+	StoreUpdated     EventType = "StoreUpdated"
+	StoreDeleted     EventType = "StoreDeleted"
+	StoreRestored    EventType = "StoreRestored"
+	CustomerCreated  EventType = "CustomerCreated"
+	CustomerUpdated  EventType = "CustomerUpdated"
+	CustomerDeleted  EventType = "CustomerDeleted"
+	CustomerRestored EventType = "CustomerRestored"
+)
+
+// Event is an event published by another service
+// Event is the envelope of the events of the feeds, SchemaVersion is the version of the data schema in the
+// schema registry of the producer
+type Event struct {
+	ID            string          `json:"id"`
+	Type          EventType       `json:"type"`
+	SchemaVersion int             `json:"schema_version"`
+	AggregateID   string          `json:"aggregate_id"`
+	Producer      string          `json:"producer"`
+	CorrelationID *string         `json:"correlation_id"`
+	Data          json.RawMessage `json:"data"`
+	Timestamp     time.Time       `json:"timestamp"`
+}
+
+// SupportedSchemaVersion is the last version of the event data schemas the service reads
+const SupportedSchemaVersion = 1
+
+// EventsService reads the events feed of a service
+type EventsService interface {
+	// FindEventsAfter returns the events of the given types after the event with the given ID, from the
+	// first one when it is empty
+	FindEventsAfter(ctx context.Context, after string, types []EventType, limit int) ([]Event, error)
+}
+
+// OrderEventData is the part of the order events used by the notifications
+type OrderEventData struct {
+	ID           string `json:"id"`
+	Code         string `json:"code"`
+	CustomerID   string `json:"customer_id"`
+	StoreID      string `json:"store_id"`
+	Total        Money  `json:"total"`
+	StatusChange *struct {
+		Reason *string `json:"reason"`
+	} `json:"status_change"`
+}
+
+// GetReason returns the reason given for the status change, empty when there is none
+func (d OrderEventData) GetReason() string {
+	if d.StatusChange == nil || d.StatusChange.Reason == nil {
+		return ""
+	}
+	return *d.StatusChange.Reason
+}
+
+type StoreEventData struct {
+	ID        string     `json:"id"`
+	Name      string     `json:"name"`
+	UserID    string     `json:"user_id"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	DeletedAt *time.Time `json:"deleted_at"`
+}
+
+type CustomerEventData struct {
+	ID        string     `json:"id"`
+	FirstName string     `json:"first_name"`
+	LastName  string     `json:"last_name"`
+	Email     *string    `json:"email"`
+	Phone     *string    `json:"phone"`
+	UserID    string     `json:"user_id"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	DeletedAt *time.Time `json:"deleted_at"`
+}
@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Money struct {
+	Amount   int    `json:"amount"` // minor units of the currency
+	Currency string `json:"currency"`
+}
+
+// minorUnits are the decimals of the currencies without two of them, as in the currency registry of the
+// order service
+var minorUnits = map[string]int{
+	"BHD": 3,
+	"CLP": 0,
+	"JPY": 0,
+	"KWD": 3,
+}
+
+// String formats the amount with the decimals of its currency, e.g. "PEN 12.50"
+func (m Money) String() string {
+	units, ok := minorUnits[strings.ToUpper(m.Currency)]
+	if !ok {
+		units = 2
+	}
+
+	if units == 0 {
+		return fmt.Sprintf("%s %d", m.Currency, m.Amount)
+	}
+
+	divisor := 1
+	for i := 0; i < units; i++ {
+		divisor *= 10
+	}
+
+	sign := ""
+	amount := m.Amount
+	if amount < 0 {
+		sign, amount = "-", -amount
+	}
+
+	return fmt.Sprintf("%s %s%d.%0*d", m.Currency, sign, amount/divisor, units, amount%divisor)
+}
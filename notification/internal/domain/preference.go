@@ -0,0 +1,150 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+)
+
+type Locale string
+
+const (
+	SpanishLocale Locale = "es"
+	EnglishLocale Locale = "en"
+	DefaultLocale        = SpanishLocale
+)
+
+func ParseLocale(value string) (Locale, error) {
+	switch Locale(value) {
+	case SpanishLocale, EnglishLocale:
+		return Locale(value), nil
+	}
+	return "", fmt.Errorf("invalid locale %s, expected es or en", value)
+}
+
+// Preference is how a user wants to be notified: the language of the messages, the channels used and the
+// events the user does not want to hear about
+type Preference struct {
+	UserID      string          `sql:"user_id,primary"`
+	Locale      Locale          `sql:"locale"`
+	Channels    json.RawMessage `sql:"channels"`
+	MutedEvents json.RawMessage `sql:"muted_events"`
+	CreatedAt   time.Time       `sql:"created_at"`
+	UpdatedAt   time.Time       `sql:"updated_at"`
+
+	channels    []Channel
+	mutedEvents []EventType
+}
+
+// DefaultPreference is used for the users who did not save their preferences: every channel in Spanish
+func DefaultPreference(userID string) *Preference {
+	preference, _ := NewPreference(userID, string(DefaultLocale), nil, nil)
+	return preference
+}
+
+// NewPreference returns the preference of the user, all the channels are enabled when channels is nil
+func NewPreference(userID, locale string, channels []string, mutedEvents []string) (*Preference, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("userID cannot be empty")
+	}
+
+	now := time.Now().UTC()
+	preference := &Preference{
+		UserID:    userID,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := preference.set(locale, channels, mutedEvents); err != nil {
+		return nil, err
+	}
+
+	return preference, nil
+}
+
+func (p *Preference) Update(locale string, channels []string, mutedEvents []string) error {
+	if err := p.set(locale, channels, mutedEvents); err != nil {
+		return err
+	}
+
+	p.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// Wants returns true when the user accepts notifications of the event through the channel
+func (p *Preference) Wants(eventType EventType, channel Channel) bool {
+	return slices.Contains(p.GetChannels(), channel) && !slices.Contains(p.GetMutedEvents(), eventType)
+}
+
+func (p *Preference) set(locale string, channels []string, mutedEvents []string) error {
+	parsedLocale, err := ParseLocale(locale)
+	if err != nil {
+		return err
+	}
+
+	parsedChannels := Channels
+	if channels != nil {
+		parsedChannels = []Channel{}
+		for _, value := range channels {
+			channel, err := ParseChannel(value)
+			if err != nil {
+				return err
+			}
+			if !slices.Contains(parsedChannels, channel) {
+				parsedChannels = append(parsedChannels, channel)
+			}
+		}
+	}
+
+	parsedEvents := []EventType{}
+	for _, value := range mutedEvents {
+		eventType := EventType(value)
+		if !IsNotifiableEvent(eventType) {
+			return fmt.Errorf("event %s has no notifications", value)
+		}
+		if !slices.Contains(parsedEvents, eventType) {
+			parsedEvents = append(parsedEvents, eventType)
+		}
+	}
+
+	rawChannels, err := json.Marshal(parsedChannels)
+	if err != nil {
+		return err
+	}
+
+	rawEvents, err := json.Marshal(parsedEvents)
+	if err != nil {
+		return err
+	}
+
+	p.Locale = parsedLocale
+	p.Channels, p.channels = rawChannels, parsedChannels
+	p.MutedEvents, p.mutedEvents = rawEvents, parsedEvents
+	return nil
+}
+
+func (p *Preference) GetUserID() string       { return p.UserID }
+func (p *Preference) GetLocale() Locale       { return p.Locale }
+func (p *Preference) GetCreatedAt() time.Time { return p.CreatedAt }
+func (p *Preference) GetUpdatedAt() time.Time { return p.UpdatedAt }
+
+// GetChannels returns the enabled channels, decoding them when the preference was loaded from storage
+func (p *Preference) GetChannels() []Channel {
+	if p.channels == nil && len(p.Channels) > 0 {
+		_ = json.Unmarshal(p.Channels, &p.channels)
+	}
+	return p.channels
+}
+
+// GetMutedEvents returns the muted events, decoding them when the preference was loaded from storage
+func (p *Preference) GetMutedEvents() []EventType {
+	if p.mutedEvents == nil && len(p.MutedEvents) > 0 {
+		_ = json.Unmarshal(p.MutedEvents, &p.mutedEvents)
+	}
+	return p.mutedEvents
+}
+
+func (p *Preference) TableName() string {
+	return "notification_preferences"
+}
@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// Customer is the contact data of a customer of the store service, kept from its events
+type Customer struct {
+	ID        string     `sql:"id,primary"`
+	UserID    string     `sql:"user_id"`
+	FirstName string     `sql:"first_name"`
+	LastName  string     `sql:"last_name"`
+	Email     *string    `sql:"email"`
+	Phone     *string    `sql:"phone"`
+	UpdatedAt time.Time  `sql:"updated_at"`
+	DeletedAt *time.Time `sql:"deleted_at"`
+}
+
+func NewCustomerFromEvent(data CustomerEventData) *Customer {
+	customer := &Customer{ID: data.ID}
+	customer.Apply(data)
+	return customer
+}
+
+// Apply copies the data of a customer event, older events than the last applied are ignored
+func (c *Customer) Apply(data CustomerEventData) {
+	if data.UpdatedAt.Before(c.UpdatedAt) {
+		return
+	}
+
+	c.UserID = data.UserID
+	c.FirstName = data.FirstName
+	c.LastName = data.LastName
+	c.Email = data.Email
+	c.Phone = data.Phone
+	c.UpdatedAt = data.UpdatedAt
+	c.DeletedAt = data.DeletedAt
+}
+
+func (c *Customer) GetID() string            { return c.ID }
+func (c *Customer) GetUserID() string        { return c.UserID }
+func (c *Customer) GetFirstName() string     { return c.FirstName }
+func (c *Customer) GetLastName() string      { return c.LastName }
+func (c *Customer) GetEmail() *string        { return c.Email }
+func (c *Customer) GetPhone() *string        { return c.Phone }
+func (c *Customer) GetUpdatedAt() time.Time  { return c.UpdatedAt }
+func (c *Customer) GetDeletedAt() *time.Time { return c.DeletedAt }
+
+// GetFullName returns the first and last name, the last name may be empty
+func (c *Customer) GetFullName() string {
+	return strings.TrimSpace(c.FirstName + " " + c.LastName)
+}
+
+// Address returns where the notifications of the channel are sent, empty when the customer has none
+func (c *Customer) Address(channel Channel) string {
+	var address *string
+	switch channel {
+	case EmailChannel:
+		address = c.Email
+	case WebhookChannel:
+		address = c.Phone
+	}
+
+	if address == nil {
+		return ""
+	}
+	return *address
+}
+
+func (c *Customer) TableName() string {
+	return "customers"
+}
+
+// Store is the name and owner of a store of the store service, kept from its events
+type Store struct {
+	ID        string     `sql:"id,primary"`
+	Name      string     `sql:"name"`
+	UserID    string     `sql:"user_id"`
+	UpdatedAt time.Time  `sql:"updated_at"`
+	DeletedAt *time.Time `sql:"deleted_at"`
+}
+
+func NewStoreFromEvent(data StoreEventData) *Store {
+	store := &Store{ID: data.ID}
+	store.Apply(data)
+	return store
+}
+
+// Apply copies the data of a store event, older events than the last applied are ignored
+func (s *Store) Apply(data StoreEventData) {
+	if data.UpdatedAt.Before(s.UpdatedAt) {
+		return
+	}
+
+	s.Name = data.Name
+	s.UserID = data.UserID
+	s.UpdatedAt = data.UpdatedAt
+	s.DeletedAt = data.DeletedAt
+}
+
+func (s *Store) GetID() string            { return s.ID }
+func (s *Store) GetName() string          { return s.Name }
+func (s *Store) GetUserID() string        { return s.UserID }
+func (s *Store) GetUpdatedAt() time.Time  { return s.UpdatedAt }
+func (s *Store) GetDeletedAt() *time.Time { return s.DeletedAt }
+
+func (s *Store) TableName() string {
+	return "stores"
+}
@@ -0,0 +1,151 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"text/template"
+)
+
+// Audience is who a notification is for, an event may notify the customer and the store owner differently
+type Audience string
+
+const (
+	CustomerAudience   Audience = "customer"
+	StoreOwnerAudience Audience = "store_owner"
+)
+
+// eventAudiences are the events that send notifications and who receives them
+var eventAudiences = map[EventType][]Audience{
+	OrderCreated:  {CustomerAudience, StoreOwnerAudience},
+	OrderAccepted: {CustomerAudience},
+	OrderRejected: {CustomerAudience},
+	OrderCanceled: {StoreOwnerAudience},
+}
+
+// NotifiableEvents returns the events that send notifications
+func NotifiableEvents() []EventType {
+	return []EventType{OrderCreated, OrderAccepted, OrderRejected, OrderCanceled}
+}
+
+func IsNotifiableEvent(eventType EventType) bool {
+	return slices.Contains(NotifiableEvents(), eventType)
+}
+
+// EventAudiences returns who is notified of the event
+func EventAudiences(eventType EventType) []Audience {
+	return eventAudiences[eventType]
+}
+
+// Template is the subject and body of a notification, written with text/template over TemplateData
+type Template struct {
+	Subject string
+	Body    string
+}
+
+// TemplateData is what the templates print
+type TemplateData struct {
+	RecipientName string
+	OrderCode     string
+	StoreName     string
+	Total         string
+	Reason        string
+}
+
+type templateKey struct {
+	eventType EventType
+	audience  Audience
+}
+
+var templates = map[templateKey]map[Locale]Template{
+	{OrderCreated, CustomerAudience}: {
+		EnglishLocale: {
+			Subject: "We received your order {{.OrderCode}}",
+			Body:    "Hi {{.RecipientName}}, {{.StoreName}} received your order {{.OrderCode}} for {{.Total}}. We will let you know when the store accepts it.",
+		},
+		SpanishLocale: {
+			Subject: "Recibimos tu pedido {{.OrderCode}}",
+			Body:    "Hola {{.RecipientName}}, {{.StoreName}} recibió tu pedido {{.OrderCode}} por {{.Total}}. Te avisaremos cuando la tienda lo acepte.",
+		},
+	},
+	{OrderCreated, StoreOwnerAudience}: {
+		EnglishLocale: {
+			Subject: "New order {{.OrderCode}} at {{.StoreName}}",
+			Body:    "Hi {{.RecipientName}}, {{.StoreName}} has a new order {{.OrderCode}} for {{.Total}} waiting to be accepted.",
+		},
+		SpanishLocale: {
+			Subject: "Nuevo pedido {{.OrderCode}} en {{.StoreName}}",
+			Body:    "Hola {{.RecipientName}}, {{.StoreName}} tiene un nuevo pedido {{.OrderCode}} por {{.Total}} esperando ser aceptado.",
+		},
+	},
+	{OrderAccepted, CustomerAudience}: {
+		EnglishLocale: {
+			Subject: "Your order {{.OrderCode}} was accepted",
+			Body:    "Hi {{.RecipientName}}, {{.StoreName}} accepted your order {{.OrderCode}} and is preparing it.",
+		},
+		SpanishLocale: {
+			Subject: "Tu pedido {{.OrderCode}} fue aceptado",
+			Body:    "Hola {{.RecipientName}}, {{.StoreName}} aceptó tu pedido {{.OrderCode}} y lo está preparando.",
+		},
+	},
+	{OrderRejected, CustomerAudience}: {
+		EnglishLocale: {
+			Subject: "Your order {{.OrderCode}} was rejected",
+			Body:    "Hi {{.RecipientName}}, {{.StoreName}} could not accept your order {{.OrderCode}}.{{if .Reason}} Reason: {{.Reason}}.{{end}} If you paid it, the payment will be refunded.",
+		},
+		SpanishLocale: {
+			Subject: "Tu pedido {{.OrderCode}} fue rechazado",
+			Body:    "Hola {{.RecipientName}}, {{.StoreName}} no pudo aceptar tu pedido {{.OrderCode}}.{{if .Reason}} Motivo: {{.Reason}}.{{end}} Si lo pagaste, el pago será reembolsado.",
+		},
+	},
+	{OrderCanceled, StoreOwnerAudience}: {
+		EnglishLocale: {
+			Subject: "Order {{.OrderCode}} was canceled",
+			Body:    "Hi {{.RecipientName}}, the customer canceled the order {{.OrderCode}} at {{.StoreName}}.{{if .Reason}} Reason: {{.Reason}}.{{end}}",
+		},
+		SpanishLocale: {
+			Subject: "El pedido {{.OrderCode}} fue cancelado",
+			Body:    "Hola {{.RecipientName}}, el cliente canceló el pedido {{.OrderCode}} en {{.StoreName}}.{{if .Reason}} Motivo: {{.Reason}}.{{end}}",
+		},
+	},
+}
+
+// RenderTemplate returns the subject and body of the notification of the event for the audience, in the
+// locale or in DefaultLocale when the template is not translated
+func RenderTemplate(eventType EventType, audience Audience, locale Locale, data TemplateData) (string, string, error) {
+	translations, ok := templates[templateKey{eventType, audience}]
+	if !ok {
+		return "", "", fmt.Errorf("no template for %s to %s", eventType, audience)
+	}
+
+	tmpl, ok := translations[locale]
+	if !ok {
+		tmpl = translations[DefaultLocale]
+	}
+
+	subject, err := render(tmpl.Subject, data)
+	if err != nil {
+		return "", "", err
+	}
+
+	body, err := render(tmpl.Body, data)
+	if err != nil {
+		return "", "", err
+	}
+
+	return subject, body, nil
+}
+
+func render(text string, data TemplateData) (string, error) {
+	tmpl, err := template.New("notification").Parse(text)
+	if err != nil {
+		return "", err
+	}
+
+	var out strings.Builder
+	if err := tmpl.Execute(&out, data); err != nil {
+		return "", err
+	}
+
+	return out.String(), nil
+}
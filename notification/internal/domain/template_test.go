@@ -0,0 +1,54 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+
+	"ichibuy/notification/internal/domain"
+)
+
+func TestRenderTemplate(t *testing.T) {
+	data := domain.TemplateData{
+		RecipientName: "Ana",
+		OrderCode:     "AB12-CD3",
+		StoreName:     "Bodega Lima",
+		Total:         domain.Money{Amount: 1250, Currency: "PEN"}.String(),
+	}
+
+	tests := []struct {
+		name      string
+		eventType domain.EventType
+		audience  domain.Audience
+		locale    domain.Locale
+		reason    string
+		subject   string
+		contains  string
+	}{
+		{name: "customer in spanish", eventType: domain.OrderCreated, audience: domain.CustomerAudience, locale: domain.SpanishLocale, subject: "Recibimos tu pedido AB12-CD3", contains: "PEN 12.50"},
+		{name: "owner in english", eventType: domain.OrderCreated, audience: domain.StoreOwnerAudience, locale: domain.EnglishLocale, subject: "New order AB12-CD3 at Bodega Lima", contains: "waiting to be accepted"},
+		{name: "rejected with reason", eventType: domain.OrderRejected, audience: domain.CustomerAudience, locale: domain.EnglishLocale, reason: "out of stock", subject: "Your order AB12-CD3 was rejected", contains: "Reason: out of stock."},
+		{name: "rejected without reason", eventType: domain.OrderRejected, audience: domain.CustomerAudience, locale: domain.SpanishLocale, subject: "Tu pedido AB12-CD3 fue rechazado", contains: "AB12-CD3. Si lo pagaste"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data.Reason = tt.reason
+			subject, body, err := domain.RenderTemplate(tt.eventType, tt.audience, tt.locale, data)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if subject != tt.subject {
+				t.Errorf("got subject %q, want %q", subject, tt.subject)
+			}
+
+			if !strings.Contains(body, tt.contains) {
+				t.Errorf("expected %q in body %q", tt.contains, body)
+			}
+		})
+	}
+
+	if _, _, err := domain.RenderTemplate(domain.OrderAccepted, domain.StoreOwnerAudience, domain.EnglishLocale, data); err == nil {
+		t.Error("expected an error for an audience without template")
+	}
+}
@@ -0,0 +1,5 @@
+package handlers
+
+type ErrorResp struct {
+	Error string `json:"error"`
+}
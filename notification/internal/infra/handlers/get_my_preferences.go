@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/notification/internal/services"
+)
+
+// GetMyPreferences godoc
+// @Summary      Get my notification preferences
+// @Description  Get the locale, enabled channels and muted events of the authenticated user, the defaults when they were not saved
+// @Tags         preferences
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  services.PreferenceDTO
+// @Failure      400  {object}  ErrorResp
+// @Failure      401  {object}  ErrorResp
+// @Router       /api/v1/notifications/preferences [get]
+// @Security     BearerAuth
+func GetMyPreferences(getMyPreferencesService *services.GetMyPreferences) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		resp, err := getMyPreferencesService.Exec(c, services.GetMyPreferencesReq{UserID: userID.(string)})
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/notification/internal/services"
+)
+
+// ListMyDeliveries godoc
+// @Summary      List my notification deliveries
+// @Description  Get the delivery log of the notifications sent to the authenticated user, newest first, with the attempts and last error of each one
+// @Tags         deliveries
+// @Accept       json
+// @Produce      json
+// @Param        status query string false "Filter by status (pending, sent, failed)"
+// @Param        channel query string false "Filter by channel (email, webhook)"
+// @Param        offset query int false "Offset" default(0)
+// @Param        limit query int false "Limit" default(10)
+// @Success      200  {object}  services.ListMyDeliveriesResp
+// @Failure      400  {object}  ErrorResp
+// @Failure      401  {object}  ErrorResp
+// @Router       /api/v1/notifications/deliveries [get]
+// @Security     BearerAuth
+func ListMyDeliveries(listMyDeliveriesService *services.ListMyDeliveries) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+		limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+		resp, err := listMyDeliveriesService.Exec(c, services.ListMyDeliveriesReq{
+			UserID:  userID.(string),
+			Status:  c.Query("status"),
+			Channel: c.Query("channel"),
+			Pagination: services.Pagination{
+				Offset: offset,
+				Limit:  limit,
+			},
+		})
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/notification/internal/services"
+)
+
+// RetryDelivery godoc
+// @Summary      Retry a failed delivery
+// @Description  Queue a failed delivery of the authenticated user to be sent again with a new round of attempts
+// @Tags         deliveries
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Delivery ID"
+// @Success      200  {object}  services.DeliveryDTO
+// @Failure      400  {object}  ErrorResp
+// @Failure      401  {object}  ErrorResp
+// @Router       /api/v1/notifications/deliveries/{id}/retry [post]
+// @Security     BearerAuth
+func RetryDelivery(retryDeliveryService *services.RetryDelivery) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		id := c.Param("id")
+		if id == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "id parameter is required"})
+			return
+		}
+
+		resp, err := retryDeliveryService.Exec(c, services.RetryDeliveryReq{ID: id, UserID: userID.(string)})
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/notification/internal/services"
+)
+
+type UpdateMyPreferencesBody struct {
+	Locale string `json:"locale" binding:"required"`
+	// Channels are the enabled channels (email, webhook), all of them when omitted
+	Channels    []string `json:"channels"`
+	MutedEvents []string `json:"muted_events"`
+}
+
+// UpdateMyPreferences godoc
+// @Summary      Update my notification preferences
+// @Description  Save the locale (es or en), enabled channels and muted events of the authenticated user
+// @Tags         preferences
+// @Accept       json
+// @Produce      json
+// @Param        preferences body UpdateMyPreferencesBody true "Notification preferences"
+// @Success      200  {object}  services.PreferenceDTO
+// @Failure      400  {object}  ErrorResp
+// @Failure      401  {object}  ErrorResp
+// @Router       /api/v1/notifications/preferences [put]
+// @Security     BearerAuth
+func UpdateMyPreferences(updateMyPreferencesService *services.UpdateMyPreferences) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		var body UpdateMyPreferencesBody
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		resp, err := updateMyPreferencesService.Exec(c, services.UpdateMyPreferencesReq{
+			UserID:      userID.(string),
+			Locale:      body.Locale,
+			Channels:    body.Channels,
+			MutedEvents: body.MutedEvents,
+		})
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,487 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"ichibuy/notification/internal/domain"
+	"strings"
+)
+
+type Delivery = domain.Delivery
+
+type DeliveryDAO struct {
+	db *sql.DB
+}
+
+func NewDeliveryDAO(db *sql.DB) *DeliveryDAO {
+	return &DeliveryDAO{db: db}
+}
+
+func (dao *DeliveryDAO) getTx(ctx context.Context) *sql.Tx {
+	if tx, ok := ctx.Value("currentTx").(*sql.Tx); ok {
+		return tx
+	}
+	return nil
+}
+
+func (dao *DeliveryDAO) execContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.ExecContext(ctx, query, args...)
+	}
+	return dao.db.ExecContext(ctx, query, args...)
+}
+
+func (dao *DeliveryDAO) queryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryRowContext(ctx, query, args...)
+	}
+	return dao.db.QueryRowContext(ctx, query, args...)
+}
+
+func (dao *DeliveryDAO) queryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryContext(ctx, query, args...)
+	}
+	return dao.db.QueryContext(ctx, query, args...)
+}
+
+func (dao *DeliveryDAO) Create(ctx context.Context, m *Delivery) error {
+	query := `
+		INSERT INTO deliveries (id, event_id, event_type, user_id, channel, recipient, locale, subject, body, status, attempts, last_error, next_attempt_at, sent_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+	`
+
+	_, err := dao.execContext(
+		ctx,
+		query,
+		m.ID,
+		m.EventID,
+		m.EventType,
+		m.UserID,
+		m.Channel,
+		m.Recipient,
+		m.Locale,
+		m.Subject,
+		m.Body,
+		m.Status,
+		m.Attempts,
+		m.LastError,
+		m.NextAttemptAt,
+		m.SentAt,
+		m.CreatedAt,
+		m.UpdatedAt,
+	)
+
+	return err
+}
+
+func (dao *DeliveryDAO) Update(ctx context.Context, m *Delivery) error {
+	query := `
+		UPDATE deliveries
+		SET event_id = $1,
+			event_type = $2,
+			user_id = $3,
+			channel = $4,
+			recipient = $5,
+			locale = $6,
+			subject = $7,
+			body = $8,
+			status = $9,
+			attempts = $10,
+			last_error = $11,
+			next_attempt_at = $12,
+			sent_at = $13,
+			created_at = $14,
+			updated_at = $15
+		WHERE id = $16
+	`
+
+	_, err := dao.execContext(ctx, query,
+		m.EventID,
+		m.EventType,
+		m.UserID,
+		m.Channel,
+		m.Recipient,
+		m.Locale,
+		m.Subject,
+		m.Body,
+		m.Status,
+		m.Attempts,
+		m.LastError,
+		m.NextAttemptAt,
+		m.SentAt,
+		m.CreatedAt,
+		m.UpdatedAt,
+		m.ID,
+	)
+	return err
+}
+
+func (dao *DeliveryDAO) PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	setClauses := make([]string, 0, len(fields))
+	args := make([]interface{}, 0, len(fields)+1)
+	i := 1
+
+	for field, value := range fields {
+		setClauses = append(setClauses, fmt.Sprintf("%s = $%d", field, i))
+		args = append(args, value)
+		i++
+	}
+
+	args = append(args, pk)
+
+	query := fmt.Sprintf(`UPDATE deliveries SET %s WHERE id = $%d`, strings.Join(setClauses, ", "), i)
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *DeliveryDAO) DeleteByPk(ctx context.Context, pk string) error {
+	query := `DELETE FROM deliveries WHERE id = $1`
+	_, err := dao.execContext(ctx, query, pk)
+	return err
+}
+
+func (dao *DeliveryDAO) FindByPk(ctx context.Context, pk string) (*Delivery, error) {
+	query := `
+		SELECT id, event_id, event_type, user_id, channel, recipient, locale, subject, body, status, attempts, last_error, next_attempt_at, sent_at, created_at, updated_at
+		FROM deliveries
+		WHERE id = $1
+	`
+	row := dao.queryRowContext(ctx, query, pk)
+
+	var m Delivery
+	err := row.Scan(
+		&m.ID,
+		&m.EventID,
+		&m.EventType,
+		&m.UserID,
+		&m.Channel,
+		&m.Recipient,
+		&m.Locale,
+		&m.Subject,
+		&m.Body,
+		&m.Status,
+		&m.Attempts,
+		&m.LastError,
+		&m.NextAttemptAt,
+		&m.SentAt,
+		&m.CreatedAt,
+		&m.UpdatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *DeliveryDAO) CreateMany(ctx context.Context, models []*Delivery) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(models))
+	args := make([]interface{}, 0, len(models)*16)
+
+	for i, model := range models {
+		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			i*16+1, i*16+2, i*16+3, i*16+4, i*16+5, i*16+6, i*16+7, i*16+8, i*16+9, i*16+10, i*16+11, i*16+12, i*16+13, i*16+14, i*16+15, i*16+16)
+
+		args = append(args,
+			model.ID,
+			model.EventID,
+			model.EventType,
+			model.UserID,
+			model.Channel,
+			model.Recipient,
+			model.Locale,
+			model.Subject,
+			model.Body,
+			model.Status,
+			model.Attempts,
+			model.LastError,
+			model.NextAttemptAt,
+			model.SentAt,
+			model.CreatedAt,
+			model.UpdatedAt,
+		)
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO deliveries (id, event_id, event_type, user_id, channel, recipient, locale, subject, body, status, attempts, last_error, next_attempt_at, sent_at, created_at, updated_at)
+		VALUES %s
+	`, strings.Join(placeholders, ", "))
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *DeliveryDAO) UpdateMany(ctx context.Context, models []*Delivery) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	query := `
+		UPDATE deliveries
+		SET event_id = $1,
+			event_type = $2,
+			user_id = $3,
+			channel = $4,
+			recipient = $5,
+			locale = $6,
+			subject = $7,
+			body = $8,
+			status = $9,
+			attempts = $10,
+			last_error = $11,
+			next_attempt_at = $12,
+			sent_at = $13,
+			created_at = $14,
+			updated_at = $15
+		WHERE id = $16
+	`
+
+	for _, model := range models {
+		_, err := dao.execContext(ctx, query,
+			model.EventID,
+			model.EventType,
+			model.UserID,
+			model.Channel,
+			model.Recipient,
+			model.Locale,
+			model.Subject,
+			model.Body,
+			model.Status,
+			model.Attempts,
+			model.LastError,
+			model.NextAttemptAt,
+			model.SentAt,
+			model.CreatedAt,
+			model.UpdatedAt,
+			model.ID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (dao *DeliveryDAO) DeleteManyByPks(ctx context.Context, pks []string) error {
+	if len(pks) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(pks))
+	args := make([]interface{}, len(pks))
+	for i, pk := range pks {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = pk
+	}
+
+	query := fmt.Sprintf(`DELETE FROM deliveries WHERE id IN (%s)`, strings.Join(placeholders, ","))
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *DeliveryDAO) FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*Delivery, error) {
+	query := `
+		SELECT id, event_id, event_type, user_id, channel, recipient, locale, subject, body, status, attempts, last_error, next_attempt_at, sent_at, created_at, updated_at
+		FROM deliveries
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var m Delivery
+	err := row.Scan(
+		&m.ID,
+		&m.EventID,
+		&m.EventType,
+		&m.UserID,
+		&m.Channel,
+		&m.Recipient,
+		&m.Locale,
+		&m.Subject,
+		&m.Body,
+		&m.Status,
+		&m.Attempts,
+		&m.LastError,
+		&m.NextAttemptAt,
+		&m.SentAt,
+		&m.CreatedAt,
+		&m.UpdatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *DeliveryDAO) FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*Delivery, error) {
+	query := `
+		SELECT id, event_id, event_type, user_id, channel, recipient, locale, subject, body, status, attempts, last_error, next_attempt_at, sent_at, created_at, updated_at
+		FROM deliveries
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*Delivery
+	for rows.Next() {
+		var m Delivery
+		err := rows.Scan(
+			&m.ID,
+			&m.EventID,
+			&m.EventType,
+			&m.UserID,
+			&m.Channel,
+			&m.Recipient,
+			&m.Locale,
+			&m.Subject,
+			&m.Body,
+			&m.Status,
+			&m.Attempts,
+			&m.LastError,
+			&m.NextAttemptAt,
+			&m.SentAt,
+			&m.CreatedAt,
+			&m.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *DeliveryDAO) FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*Delivery, error) {
+	query := `
+		SELECT id, event_id, event_type, user_id, channel, recipient, locale, subject, body, status, attempts, last_error, next_attempt_at, sent_at, created_at, updated_at
+		FROM deliveries
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	query += fmt.Sprintf(" LIMIT %d OFFSET %d", limit, offset)
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*Delivery
+	for rows.Next() {
+		var m Delivery
+		err := rows.Scan(
+			&m.ID,
+			&m.EventID,
+			&m.EventType,
+			&m.UserID,
+			&m.Channel,
+			&m.Recipient,
+			&m.Locale,
+			&m.Subject,
+			&m.Body,
+			&m.Status,
+			&m.Attempts,
+			&m.LastError,
+			&m.NextAttemptAt,
+			&m.SentAt,
+			&m.CreatedAt,
+			&m.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *DeliveryDAO) Count(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := "SELECT COUNT(*) FROM deliveries"
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var count int64
+	err := row.Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (dao *DeliveryDAO) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := dao.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	ctxWithTx := context.WithValue(ctx, "currentTx", tx)
+
+	err = fn(ctxWithTx)
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
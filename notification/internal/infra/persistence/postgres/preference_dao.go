@@ -0,0 +1,387 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"ichibuy/notification/internal/domain"
+	"strings"
+)
+
+type Preference = domain.Preference
+
+type PreferenceDAO struct {
+	db *sql.DB
+}
+
+func NewPreferenceDAO(db *sql.DB) *PreferenceDAO {
+	return &PreferenceDAO{db: db}
+}
+
+func (dao *PreferenceDAO) getTx(ctx context.Context) *sql.Tx {
+	if tx, ok := ctx.Value("currentTx").(*sql.Tx); ok {
+		return tx
+	}
+	return nil
+}
+
+func (dao *PreferenceDAO) execContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.ExecContext(ctx, query, args...)
+	}
+	return dao.db.ExecContext(ctx, query, args...)
+}
+
+func (dao *PreferenceDAO) queryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryRowContext(ctx, query, args...)
+	}
+	return dao.db.QueryRowContext(ctx, query, args...)
+}
+
+func (dao *PreferenceDAO) queryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryContext(ctx, query, args...)
+	}
+	return dao.db.QueryContext(ctx, query, args...)
+}
+
+func (dao *PreferenceDAO) Create(ctx context.Context, m *Preference) error {
+	query := `
+		INSERT INTO notification_preferences (user_id, locale, channels, muted_events, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	_, err := dao.execContext(
+		ctx,
+		query,
+		m.UserID,
+		m.Locale,
+		m.Channels,
+		m.MutedEvents,
+		m.CreatedAt,
+		m.UpdatedAt,
+	)
+
+	return err
+}
+
+func (dao *PreferenceDAO) Update(ctx context.Context, m *Preference) error {
+	query := `
+		UPDATE notification_preferences
+		SET locale = $1,
+			channels = $2,
+			muted_events = $3,
+			created_at = $4,
+			updated_at = $5
+		WHERE user_id = $6
+	`
+
+	_, err := dao.execContext(ctx, query,
+		m.Locale,
+		m.Channels,
+		m.MutedEvents,
+		m.CreatedAt,
+		m.UpdatedAt,
+		m.UserID,
+	)
+	return err
+}
+
+func (dao *PreferenceDAO) PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	setClauses := make([]string, 0, len(fields))
+	args := make([]interface{}, 0, len(fields)+1)
+	i := 1
+
+	for field, value := range fields {
+		setClauses = append(setClauses, fmt.Sprintf("%s = $%d", field, i))
+		args = append(args, value)
+		i++
+	}
+
+	args = append(args, pk)
+
+	query := fmt.Sprintf(`UPDATE notification_preferences SET %s WHERE user_id = $%d`, strings.Join(setClauses, ", "), i)
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *PreferenceDAO) DeleteByPk(ctx context.Context, pk string) error {
+	query := `DELETE FROM notification_preferences WHERE user_id = $1`
+	_, err := dao.execContext(ctx, query, pk)
+	return err
+}
+
+func (dao *PreferenceDAO) FindByPk(ctx context.Context, pk string) (*Preference, error) {
+	query := `
+		SELECT user_id, locale, channels, muted_events, created_at, updated_at
+		FROM notification_preferences
+		WHERE user_id = $1
+	`
+	row := dao.queryRowContext(ctx, query, pk)
+
+	var m Preference
+	err := row.Scan(
+		&m.UserID,
+		&m.Locale,
+		&m.Channels,
+		&m.MutedEvents,
+		&m.CreatedAt,
+		&m.UpdatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *PreferenceDAO) CreateMany(ctx context.Context, models []*Preference) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(models))
+	args := make([]interface{}, 0, len(models)*6)
+
+	for i, model := range models {
+		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d)",
+			i*6+1, i*6+2, i*6+3, i*6+4, i*6+5, i*6+6)
+
+		args = append(args,
+			model.UserID,
+			model.Locale,
+			model.Channels,
+			model.MutedEvents,
+			model.CreatedAt,
+			model.UpdatedAt,
+		)
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO notification_preferences (user_id, locale, channels, muted_events, created_at, updated_at)
+		VALUES %s
+	`, strings.Join(placeholders, ", "))
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *PreferenceDAO) UpdateMany(ctx context.Context, models []*Preference) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	query := `
+		UPDATE notification_preferences
+		SET locale = $1,
+			channels = $2,
+			muted_events = $3,
+			created_at = $4,
+			updated_at = $5
+		WHERE user_id = $6
+	`
+
+	for _, model := range models {
+		_, err := dao.execContext(ctx, query,
+			model.Locale,
+			model.Channels,
+			model.MutedEvents,
+			model.CreatedAt,
+			model.UpdatedAt,
+			model.UserID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (dao *PreferenceDAO) DeleteManyByPks(ctx context.Context, pks []string) error {
+	if len(pks) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(pks))
+	args := make([]interface{}, len(pks))
+	for i, pk := range pks {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = pk
+	}
+
+	query := fmt.Sprintf(`DELETE FROM notification_preferences WHERE user_id IN (%s)`, strings.Join(placeholders, ","))
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *PreferenceDAO) FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*Preference, error) {
+	query := `
+		SELECT user_id, locale, channels, muted_events, created_at, updated_at
+		FROM notification_preferences
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var m Preference
+	err := row.Scan(
+		&m.UserID,
+		&m.Locale,
+		&m.Channels,
+		&m.MutedEvents,
+		&m.CreatedAt,
+		&m.UpdatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *PreferenceDAO) FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*Preference, error) {
+	query := `
+		SELECT user_id, locale, channels, muted_events, created_at, updated_at
+		FROM notification_preferences
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*Preference
+	for rows.Next() {
+		var m Preference
+		err := rows.Scan(
+			&m.UserID,
+			&m.Locale,
+			&m.Channels,
+			&m.MutedEvents,
+			&m.CreatedAt,
+			&m.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *PreferenceDAO) FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*Preference, error) {
+	query := `
+		SELECT user_id, locale, channels, muted_events, created_at, updated_at
+		FROM notification_preferences
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	query += fmt.Sprintf(" LIMIT %d OFFSET %d", limit, offset)
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*Preference
+	for rows.Next() {
+		var m Preference
+		err := rows.Scan(
+			&m.UserID,
+			&m.Locale,
+			&m.Channels,
+			&m.MutedEvents,
+			&m.CreatedAt,
+			&m.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *PreferenceDAO) Count(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := "SELECT COUNT(*) FROM notification_preferences"
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var count int64
+	err := row.Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (dao *PreferenceDAO) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := dao.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	ctxWithTx := context.WithValue(ctx, "currentTx", tx)
+
+	err = fn(ctxWithTx)
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
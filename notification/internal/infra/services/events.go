@@ -0,0 +1,63 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"ichibuy/notification/internal/domain"
+)
+
+// eventsService reads the events feed of the store or order service, authenticated with its events token
+type eventsService struct {
+	client  *http.Client
+	baseURL string
+	token   string
+}
+
+func NewEventsService(client *http.Client, baseURL, token string) domain.EventsService {
+	return &eventsService{client: client, baseURL: baseURL, token: token}
+}
+
+func (s *eventsService) FindEventsAfter(ctx context.Context, after string, types []domain.EventType, limit int) ([]domain.Event, error) {
+	names := make([]string, len(types))
+	for i, eventType := range types {
+		names[i] = string(eventType)
+	}
+
+	query := url.Values{}
+	query.Set("after", after)
+	query.Set("types", strings.Join(names, ","))
+	query.Set("limit", strconv.Itoa(limit))
+
+	endpoint := fmt.Sprintf("%s/api/v1/events?%s", s.baseURL, query.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+s.token)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("find events failed with status %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Events []domain.Event `json:"events"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+
+	return body.Events, nil
+}
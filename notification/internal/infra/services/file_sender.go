@@ -0,0 +1,61 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"ichibuy/notification/internal/domain"
+)
+
+// fileSender appends the deliveries to a file as JSON lines instead of sending them, for development and
+// tests
+type fileSender struct {
+	filePath string
+	mu       sync.Mutex
+}
+
+func NewFileSender(filePath string) domain.Sender {
+	return &fileSender{filePath: filePath}
+}
+
+type fileLine struct {
+	DeliveryID string    `json:"delivery_id"`
+	EventType  string    `json:"event_type"`
+	Channel    string    `json:"channel"`
+	To         string    `json:"to"`
+	Locale     string    `json:"locale"`
+	Subject    string    `json:"subject"`
+	Body       string    `json:"body"`
+	SentAt     time.Time `json:"sent_at"`
+}
+
+func (s *fileSender) Send(ctx context.Context, delivery *domain.Delivery) error {
+	line, err := json.Marshal(fileLine{
+		DeliveryID: delivery.GetID(),
+		EventType:  string(delivery.GetEventType()),
+		Channel:    string(delivery.GetChannel()),
+		To:         delivery.GetRecipient(),
+		Locale:     string(delivery.GetLocale()),
+		Subject:    delivery.GetSubject(),
+		Body:       delivery.GetBody(),
+		SentAt:     time.Now().UTC(),
+	})
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	file, err := os.OpenFile(s.filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = file.Write(append(line, '\n'))
+	return err
+}
@@ -0,0 +1,103 @@
+package services
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"ichibuy/notification/internal/domain"
+)
+
+// smtpSender sends the email deliveries through an SMTP server, with plain auth when a username is set.
+// Each email is sent within the timeout, and is abandoned when the context is done.
+type smtpSender struct {
+	host     string
+	port     string
+	username string
+	password string
+	from     string
+	timeout  time.Duration
+}
+
+func NewSMTPSender(host, port, username, password, from string, timeout time.Duration) domain.Sender {
+	return &smtpSender{host: host, port: port, username: username, password: password, from: from, timeout: timeout}
+}
+
+func (s *smtpSender) Send(ctx context.Context, delivery *domain.Delivery) error {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	dialer := net.Dialer{Timeout: s.timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(s.host, s.port))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// the deadline bounds the whole conversation, and closing the connection unblocks it when ctx is canceled
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetDeadline(deadline); err != nil {
+		return err
+	}
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	client, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	// like smtp.SendMail, upgrade to TLS when the server offers it before authenticating
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: s.host}); err != nil {
+			return err
+		}
+	}
+
+	if s.username != "" {
+		if ok, _ := client.Extension("AUTH"); !ok {
+			return errors.New("smtp server does not support AUTH")
+		}
+		if err := client.Auth(smtp.PlainAuth("", s.username, s.password, s.host)); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(s.from); err != nil {
+		return err
+	}
+	if err := client.Rcpt(delivery.GetRecipient()); err != nil {
+		return err
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write([]byte(s.message(delivery))); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+func (s *smtpSender) message(delivery *domain.Delivery) string {
+	headers := []string{
+		fmt.Sprintf("From: %s", s.from),
+		fmt.Sprintf("To: %s", delivery.GetRecipient()),
+		fmt.Sprintf("Subject: %s", mime.QEncoding.Encode("utf-8", delivery.GetSubject())),
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+	}
+	return strings.Join(headers, "\r\n") + "\r\n\r\n" + delivery.GetBody() + "\r\n"
+}
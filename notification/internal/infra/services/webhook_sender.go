@@ -0,0 +1,68 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"ichibuy/notification/internal/domain"
+)
+
+// webhookSender posts the deliveries as JSON to a gateway, authenticated with a bearer token when set
+type webhookSender struct {
+	client *http.Client
+	url    string
+	token  string
+}
+
+func NewWebhookSender(client *http.Client, url, token string) domain.Sender {
+	return &webhookSender{client: client, url: url, token: token}
+}
+
+type webhookPayload struct {
+	DeliveryID string `json:"delivery_id"`
+	EventType  string `json:"event_type"`
+	UserID     string `json:"user_id"`
+	To         string `json:"to"`
+	Locale     string `json:"locale"`
+	Subject    string `json:"subject"`
+	Body       string `json:"body"`
+}
+
+func (s *webhookSender) Send(ctx context.Context, delivery *domain.Delivery) error {
+	payload, err := json.Marshal(webhookPayload{
+		DeliveryID: delivery.GetID(),
+		EventType:  string(delivery.GetEventType()),
+		UserID:     delivery.GetUserID(),
+		To:         delivery.GetRecipient(),
+		Locale:     string(delivery.GetLocale()),
+		Subject:    delivery.GetSubject(),
+		Body:       delivery.GetBody(),
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
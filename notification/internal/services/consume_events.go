@@ -0,0 +1,74 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"ichibuy/notification/internal/domain"
+	"ichibuy/notification/internal/domain/dao"
+)
+
+const eventsBatchSize = 100
+
+// consumeEvents hands the events of the feed after the checkpoint of the consumer to handle. Each event is
+// handled in a transaction with its checkpoint, so an event is handled once even if the job stops halfway.
+// Events of a schema version newer than the supported one stop the consumer until it is upgraded, instead
+// of being misread. It returns the number of events handled.
+func consumeEvents(
+	ctx context.Context,
+	eventCheckpointDAO dao.EventCheckpointDAO,
+	eventsSvc domain.EventsService,
+	consumer string,
+	types []domain.EventType,
+	handle func(ctx context.Context, event domain.Event) error,
+) (int, error) {
+	checkpoint, err := eventCheckpointDAO.FindByPk(ctx, consumer)
+	isNew := errors.Is(err, sql.ErrNoRows)
+	if isNew {
+		checkpoint = domain.NewEventCheckpoint(consumer)
+	} else if err != nil {
+		slog.ErrorContext(ctx, "find event checkpoint failed", "consumer", consumer, "error", err.Error())
+		return 0, err
+	}
+
+	handled := 0
+	for {
+		events, err := eventsSvc.FindEventsAfter(ctx, checkpoint.GetLastEventID(), types, eventsBatchSize)
+		if err != nil {
+			slog.ErrorContext(ctx, "find events failed", "consumer", consumer, "error", err.Error())
+			return handled, err
+		}
+
+		for _, event := range events {
+			err := eventCheckpointDAO.WithTransaction(ctx, func(ctx context.Context) error {
+				if event.SchemaVersion > domain.SupportedSchemaVersion {
+					return fmt.Errorf("event %s has unsupported schema version %d", event.Type, event.SchemaVersion)
+				}
+
+				if err := handle(ctx, event); err != nil {
+					return err
+				}
+
+				checkpoint.Advance(event.ID)
+				if isNew {
+					return eventCheckpointDAO.Create(ctx, checkpoint)
+				}
+				return eventCheckpointDAO.Update(ctx, checkpoint)
+			})
+			if err != nil {
+				slog.ErrorContext(ctx, "handle event failed", "consumer", consumer, "event_id", event.ID, "error", err.Error())
+				return handled, err
+			}
+
+			isNew = false
+			handled++
+		}
+
+		if len(events) < eventsBatchSize {
+			return handled, nil
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"log/slog"
+
+	"ichibuy/notification/internal/domain"
+	"ichibuy/notification/internal/domain/dao"
+)
+
+const orderEventsConsumer = "notification.order_events"
+
+// ConsumeOrderEvents renders the notifications of the order events and queues a delivery for each channel
+// the recipients accept, the deliveries are sent by DeliverNotifications
+type ConsumeOrderEvents struct {
+	eventCheckpointDAO dao.EventCheckpointDAO
+	customerDAO        dao.CustomerDAO
+	storeDAO           dao.StoreDAO
+	preferenceDAO      dao.PreferenceDAO
+	deliveryDAO        dao.DeliveryDAO
+	nextID             domain.NextID
+	orderEventsSvc     domain.EventsService
+}
+
+func NewConsumeOrderEvents(
+	eventCheckpointDAO dao.EventCheckpointDAO,
+	customerDAO dao.CustomerDAO,
+	storeDAO dao.StoreDAO,
+	preferenceDAO dao.PreferenceDAO,
+	deliveryDAO dao.DeliveryDAO,
+	nextID domain.NextID,
+	orderEventsSvc domain.EventsService,
+) *ConsumeOrderEvents {
+	return &ConsumeOrderEvents{
+		eventCheckpointDAO: eventCheckpointDAO,
+		customerDAO:        customerDAO,
+		storeDAO:           storeDAO,
+		preferenceDAO:      preferenceDAO,
+		deliveryDAO:        deliveryDAO,
+		nextID:             nextID,
+		orderEventsSvc:     orderEventsSvc,
+	}
+}
+
+func (s *ConsumeOrderEvents) Exec(ctx context.Context) error {
+	handled, err := consumeEvents(ctx, s.eventCheckpointDAO, s.orderEventsSvc, orderEventsConsumer, domain.NotifiableEvents(), s.handle)
+	if handled > 0 {
+		slog.InfoContext(ctx, "consume order events finished", "handled", handled)
+	}
+	return err
+}
+
+func (s *ConsumeOrderEvents) handle(ctx context.Context, event domain.Event) error {
+	var order domain.OrderEventData
+	if err := json.Unmarshal(event.Data, &order); err != nil {
+		return err
+	}
+
+	store, err := s.storeDAO.FindByPk(ctx, order.StoreID)
+	if errors.Is(err, sql.ErrNoRows) {
+		slog.WarnContext(ctx, "store of order not found, event not notified", "event_id", event.ID, "store_id", order.StoreID)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, audience := range domain.EventAudiences(event.Type) {
+		recipient, err := s.findRecipient(ctx, audience, order, store)
+		if errors.Is(err, sql.ErrNoRows) {
+			slog.WarnContext(ctx, "recipient not found, event not notified", "event_id", event.ID, "audience", audience)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := s.queue(ctx, event, audience, order, store, recipient); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findRecipient returns the customer who placed the order or the customer profile of the store owner
+func (s *ConsumeOrderEvents) findRecipient(ctx context.Context, audience domain.Audience, order domain.OrderEventData, store *domain.Store) (*domain.Customer, error) {
+	if audience == domain.StoreOwnerAudience {
+		return s.customerDAO.FindOne(ctx, "user_id = $1 AND deleted_at IS NULL", "", store.GetUserID())
+	}
+	return s.customerDAO.FindOne(ctx, "id = $1 AND deleted_at IS NULL", "", order.CustomerID)
+}
+
+func (s *ConsumeOrderEvents) queue(ctx context.Context, event domain.Event, audience domain.Audience, order domain.OrderEventData, store *domain.Store, recipient *domain.Customer) error {
+	preference, err := findPreference(ctx, s.preferenceDAO, recipient.GetUserID())
+	if err != nil {
+		return err
+	}
+
+	subject, body, err := domain.RenderTemplate(event.Type, audience, preference.GetLocale(), domain.TemplateData{
+		RecipientName: recipient.GetFirstName(),
+		OrderCode:     order.Code,
+		StoreName:     store.GetName(),
+		Total:         order.Total.String(),
+		Reason:        order.GetReason(),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, channel := range domain.Channels {
+		address := recipient.Address(channel)
+		if address == "" || !preference.Wants(event.Type, channel) {
+			continue
+		}
+
+		delivery, err := domain.NewDelivery(s.nextID(), event, recipient.GetUserID(), channel, address, preference.GetLocale(), subject, body)
+		if err != nil {
+			return err
+		}
+
+		if err := s.deliveryDAO.Create(ctx, delivery); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"log/slog"
+
+	"ichibuy/notification/internal/domain"
+	"ichibuy/notification/internal/domain/dao"
+)
+
+const storeEventsConsumer = "notification.store_events"
+
+var storeEventTypes = []domain.EventType{
+	domain.StoreCreated,
+	domain.StoreUpdated,
+	domain.StoreDeleted,
+	domain.StoreRestored,
+	domain.CustomerCreated,
+	domain.CustomerUpdated,
+	domain.CustomerDeleted,
+	domain.CustomerRestored,
+}
+
+// ConsumeStoreEvents keeps the stores and customers of the store service, which are the recipients of the
+// notifications
+type ConsumeStoreEvents struct {
+	eventCheckpointDAO dao.EventCheckpointDAO
+	customerDAO        dao.CustomerDAO
+	storeDAO           dao.StoreDAO
+	storeEventsSvc     domain.EventsService
+}
+
+func NewConsumeStoreEvents(
+	eventCheckpointDAO dao.EventCheckpointDAO,
+	customerDAO dao.CustomerDAO,
+	storeDAO dao.StoreDAO,
+	storeEventsSvc domain.EventsService,
+) *ConsumeStoreEvents {
+	return &ConsumeStoreEvents{
+		eventCheckpointDAO: eventCheckpointDAO,
+		customerDAO:        customerDAO,
+		storeDAO:           storeDAO,
+		storeEventsSvc:     storeEventsSvc,
+	}
+}
+
+func (s *ConsumeStoreEvents) Exec(ctx context.Context) error {
+	handled, err := consumeEvents(ctx, s.eventCheckpointDAO, s.storeEventsSvc, storeEventsConsumer, storeEventTypes, s.handle)
+	if handled > 0 {
+		slog.InfoContext(ctx, "consume store events finished", "handled", handled)
+	}
+	return err
+}
+
+func (s *ConsumeStoreEvents) handle(ctx context.Context, event domain.Event) error {
+	switch event.Type {
+	case domain.StoreCreated, domain.StoreUpdated, domain.StoreDeleted, domain.StoreRestored:
+		var data domain.StoreEventData
+		if err := json.Unmarshal(event.Data, &data); err != nil {
+			return err
+		}
+
+		store, err := s.storeDAO.FindByPk(ctx, data.ID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return s.storeDAO.Create(ctx, domain.NewStoreFromEvent(data))
+		}
+		if err != nil {
+			return err
+		}
+
+		store.Apply(data)
+		return s.storeDAO.Update(ctx, store)
+	case domain.CustomerCreated, domain.CustomerUpdated, domain.CustomerDeleted, domain.CustomerRestored:
+		var data domain.CustomerEventData
+		if err := json.Unmarshal(event.Data, &data); err != nil {
+			return err
+		}
+
+		customer, err := s.customerDAO.FindByPk(ctx, data.ID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return s.customerDAO.Create(ctx, domain.NewCustomerFromEvent(data))
+		}
+		if err != nil {
+			return err
+		}
+
+		customer.Apply(data)
+		return s.customerDAO.Update(ctx, customer)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"ichibuy/notification/internal/domain"
+	"ichibuy/notification/internal/domain/dao"
+)
+
+const deliveryBatchSize = 100
+
+// DeliverNotifications sends the pending deliveries that are due through the sender of their channel,
+// failed sends are retried later with exponential backoff
+type DeliverNotifications struct {
+	deliveryDAO dao.DeliveryDAO
+	senders     map[domain.Channel]domain.Sender
+}
+
+func NewDeliverNotifications(deliveryDAO dao.DeliveryDAO, senders map[domain.Channel]domain.Sender) *DeliverNotifications {
+	return &DeliverNotifications{
+		deliveryDAO: deliveryDAO,
+		senders:     senders,
+	}
+}
+
+func (s *DeliverNotifications) Exec(ctx context.Context) error {
+	deliveries, err := s.deliveryDAO.FindPaginated(ctx, deliveryBatchSize, 0, "status = $1 AND next_attempt_at <= $2", "next_attempt_at ASC", domain.PendingDeliveryStatus, time.Now().UTC())
+	if err != nil {
+		slog.ErrorContext(ctx, "find pending deliveries failed", "error", err.Error())
+		return err
+	}
+
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	sent, failed := 0, 0
+	for _, delivery := range deliveries {
+		if err := s.send(ctx, delivery); err != nil {
+			slog.WarnContext(ctx, "send delivery failed", "delivery_id", delivery.GetID(), "attempts", delivery.GetAttempts()+1, "error", err.Error())
+			delivery.MarkFailed(err.Error(), time.Now().UTC())
+			failed++
+		} else {
+			delivery.MarkSent(time.Now().UTC())
+			sent++
+		}
+
+		if err := s.deliveryDAO.Update(ctx, delivery); err != nil {
+			slog.ErrorContext(ctx, "update delivery failed", "delivery_id", delivery.GetID(), "error", err.Error())
+			return err
+		}
+	}
+
+	slog.InfoContext(ctx, "deliver notifications finished", "sent", sent, "failed", failed)
+	return nil
+}
+
+func (s *DeliverNotifications) send(ctx context.Context, delivery *domain.Delivery) error {
+	sender, ok := s.senders[delivery.GetChannel()]
+	if !ok {
+		return fmt.Errorf("no sender for channel %s", delivery.GetChannel())
+	}
+	return sender.Send(ctx, delivery)
+}
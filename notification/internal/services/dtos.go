@@ -0,0 +1,81 @@
+package services
+
+import (
+	"time"
+
+	"ichibuy/notification/internal/domain"
+)
+
+type Pagination struct {
+	Limit  int
+	Offset int
+}
+
+type PreferenceDTO struct {
+	Locale      string   `json:"locale"`
+	Channels    []string `json:"channels"`
+	MutedEvents []string `json:"muted_events"`
+	// Events are the events that send notifications, which can be muted
+	Events []string `json:"events"`
+}
+
+type DeliveryDTO struct {
+	ID            string     `json:"id"`
+	EventID       string     `json:"event_id"`
+	EventType     string     `json:"event_type"`
+	Channel       string     `json:"channel"`
+	Recipient     string     `json:"recipient"`
+	Locale        string     `json:"locale"`
+	Subject       string     `json:"subject"`
+	Body          string     `json:"body"`
+	Status        string     `json:"status"`
+	Attempts      int        `json:"attempts"`
+	LastError     *string    `json:"last_error"`
+	NextAttemptAt *time.Time `json:"next_attempt_at"`
+	SentAt        *time.Time `json:"sent_at"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+func mapPreferenceToDTO(preference *domain.Preference) PreferenceDTO {
+	dto := PreferenceDTO{
+		Locale:      string(preference.GetLocale()),
+		Channels:    []string{},
+		MutedEvents: []string{},
+		Events:      []string{},
+	}
+
+	for _, channel := range preference.GetChannels() {
+		dto.Channels = append(dto.Channels, string(channel))
+	}
+
+	for _, eventType := range preference.GetMutedEvents() {
+		dto.MutedEvents = append(dto.MutedEvents, string(eventType))
+	}
+
+	for _, eventType := range domain.NotifiableEvents() {
+		dto.Events = append(dto.Events, string(eventType))
+	}
+
+	return dto
+}
+
+func mapDeliveryToDTO(delivery *domain.Delivery) DeliveryDTO {
+	return DeliveryDTO{
+		ID:            delivery.GetID(),
+		EventID:       delivery.GetEventID(),
+		EventType:     string(delivery.GetEventType()),
+		Channel:       string(delivery.GetChannel()),
+		Recipient:     delivery.GetRecipient(),
+		Locale:        string(delivery.GetLocale()),
+		Subject:       delivery.GetSubject(),
+		Body:          delivery.GetBody(),
+		Status:        string(delivery.GetStatus()),
+		Attempts:      delivery.GetAttempts(),
+		LastError:     delivery.GetLastError(),
+		NextAttemptAt: delivery.GetNextAttemptAt(),
+		SentAt:        delivery.GetSentAt(),
+		CreatedAt:     delivery.GetCreatedAt(),
+		UpdatedAt:     delivery.GetUpdatedAt(),
+	}
+}
@@ -0,0 +1,52 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"ichibuy/notification/internal/domain"
+	"ichibuy/notification/internal/domain/dao"
+)
+
+type GetMyPreferencesReq struct {
+	UserID string
+}
+
+type GetMyPreferences struct {
+	preferenceDAO dao.PreferenceDAO
+}
+
+func NewGetMyPreferences(preferenceDAO dao.PreferenceDAO) *GetMyPreferences {
+	return &GetMyPreferences{
+		preferenceDAO: preferenceDAO,
+	}
+}
+
+// Exec returns the notification preferences of the user, the defaults when the user did not save them
+func (s *GetMyPreferences) Exec(ctx context.Context, req GetMyPreferencesReq) (*PreferenceDTO, error) {
+	slog.InfoContext(ctx, "get my preferences started", "req", req)
+
+	preference, err := findPreference(ctx, s.preferenceDAO, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := mapPreferenceToDTO(preference)
+	slog.InfoContext(ctx, "get my preferences finished", "user_id", req.UserID)
+	return &resp, nil
+}
+
+// findPreference returns the saved preference of the user or the default one
+func findPreference(ctx context.Context, preferenceDAO dao.PreferenceDAO, userID string) (*domain.Preference, error) {
+	preference, err := preferenceDAO.FindByPk(ctx, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.DefaultPreference(userID), nil
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "find preference failed", "error", err.Error())
+		return nil, err
+	}
+	return preference, nil
+}
@@ -0,0 +1,79 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"ichibuy/notification/internal/domain/dao"
+)
+
+type ListMyDeliveriesReq struct {
+	UserID     string
+	Status     string
+	Channel    string
+	Pagination Pagination
+}
+
+type ListMyDeliveriesResp struct {
+	Deliveries []DeliveryDTO `json:"deliveries"`
+	Total      int64         `json:"total"`
+	Limit      int           `json:"limit"`
+	Offset     int           `json:"offset"`
+}
+
+type ListMyDeliveries struct {
+	deliveryDAO dao.DeliveryDAO
+}
+
+func NewListMyDeliveries(deliveryDAO dao.DeliveryDAO) *ListMyDeliveries {
+	return &ListMyDeliveries{
+		deliveryDAO: deliveryDAO,
+	}
+}
+
+// Exec returns the delivery log of the notifications sent to the user, newest first
+func (s *ListMyDeliveries) Exec(ctx context.Context, req ListMyDeliveriesReq) (*ListMyDeliveriesResp, error) {
+	slog.InfoContext(ctx, "list my deliveries started", "req", req)
+
+	whereParts := []string{"user_id = $1"}
+	args := []any{req.UserID}
+
+	if req.Status != "" {
+		args = append(args, req.Status)
+		whereParts = append(whereParts, fmt.Sprintf("status = $%d", len(args)))
+	}
+
+	if req.Channel != "" {
+		args = append(args, req.Channel)
+		whereParts = append(whereParts, fmt.Sprintf("channel = $%d", len(args)))
+	}
+
+	where := strings.Join(whereParts, " AND ")
+
+	total, err := s.deliveryDAO.Count(ctx, where, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "count deliveries failed", "error", err.Error())
+		return nil, err
+	}
+
+	deliveries, err := s.deliveryDAO.FindPaginated(ctx, req.Pagination.Limit, req.Pagination.Offset, where, "created_at DESC", args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "find paginated deliveries failed", "error", err.Error())
+		return nil, err
+	}
+
+	items := make([]DeliveryDTO, len(deliveries))
+	for i, delivery := range deliveries {
+		items[i] = mapDeliveryToDTO(delivery)
+	}
+
+	slog.InfoContext(ctx, "list my deliveries finished", "total", total, "count", len(items))
+	return &ListMyDeliveriesResp{
+		Deliveries: items,
+		Total:      total,
+		Limit:      req.Pagination.Limit,
+		Offset:     req.Pagination.Offset,
+	}, nil
+}
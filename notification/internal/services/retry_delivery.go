@@ -0,0 +1,54 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"ichibuy/notification/internal/domain/dao"
+)
+
+type RetryDeliveryReq struct {
+	ID     string
+	UserID string
+}
+
+type RetryDelivery struct {
+	deliveryDAO dao.DeliveryDAO
+}
+
+func NewRetryDelivery(deliveryDAO dao.DeliveryDAO) *RetryDelivery {
+	return &RetryDelivery{
+		deliveryDAO: deliveryDAO,
+	}
+}
+
+// Exec queues a failed delivery of the user to be sent again by the worker
+func (s *RetryDelivery) Exec(ctx context.Context, req RetryDeliveryReq) (*DeliveryDTO, error) {
+	slog.InfoContext(ctx, "retry delivery started", "req", req)
+
+	delivery, err := s.deliveryDAO.FindByPk(ctx, req.ID)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && delivery.GetUserID() != req.UserID) {
+		return nil, fmt.Errorf("delivery %s not found", req.ID)
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "find delivery failed", "error", err.Error())
+		return nil, err
+	}
+
+	if err := delivery.Retry(time.Now().UTC()); err != nil {
+		return nil, err
+	}
+
+	if err := s.deliveryDAO.Update(ctx, delivery); err != nil {
+		slog.ErrorContext(ctx, "update delivery failed", "error", err.Error())
+		return nil, err
+	}
+
+	resp := mapDeliveryToDTO(delivery)
+	slog.InfoContext(ctx, "retry delivery finished", "delivery_id", delivery.GetID())
+	return &resp, nil
+}
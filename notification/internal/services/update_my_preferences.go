@@ -0,0 +1,65 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"ichibuy/notification/internal/domain"
+	"ichibuy/notification/internal/domain/dao"
+)
+
+type UpdateMyPreferencesReq struct {
+	UserID string
+	Locale string
+	// Channels are the enabled channels, all of them when nil
+	Channels    []string
+	MutedEvents []string
+}
+
+type UpdateMyPreferences struct {
+	preferenceDAO dao.PreferenceDAO
+}
+
+func NewUpdateMyPreferences(preferenceDAO dao.PreferenceDAO) *UpdateMyPreferences {
+	return &UpdateMyPreferences{
+		preferenceDAO: preferenceDAO,
+	}
+}
+
+// Exec saves the notification preferences of the user, replacing the previous ones
+func (s *UpdateMyPreferences) Exec(ctx context.Context, req UpdateMyPreferencesReq) (*PreferenceDTO, error) {
+	slog.InfoContext(ctx, "update my preferences started", "req", req)
+
+	preference, err := s.preferenceDAO.FindByPk(ctx, req.UserID)
+	isNew := errors.Is(err, sql.ErrNoRows)
+	if err != nil && !isNew {
+		slog.ErrorContext(ctx, "find preference failed", "error", err.Error())
+		return nil, err
+	}
+
+	if isNew {
+		preference, err = domain.NewPreference(req.UserID, req.Locale, req.Channels, req.MutedEvents)
+	} else {
+		err = preference.Update(req.Locale, req.Channels, req.MutedEvents)
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "preference domain failed", "error", err.Error())
+		return nil, err
+	}
+
+	if isNew {
+		err = s.preferenceDAO.Create(ctx, preference)
+	} else {
+		err = s.preferenceDAO.Update(ctx, preference)
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "save preference failed", "error", err.Error())
+		return nil, err
+	}
+
+	resp := mapPreferenceToDTO(preference)
+	slog.InfoContext(ctx, "update my preferences finished", "user_id", req.UserID)
+	return &resp, nil
+}
@@ -0,0 +1,62 @@
+package server
+
+import (
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	swaggerFiles "github.com/swaggo/files"
+	ginSwagger "github.com/swaggo/gin-swagger"
+
+	authHTTP "github.com/Jibaru/ichibuy/api-client/go/auth"
+
+	"ichibuy/notification/config"
+	"ichibuy/notification/internal/infra/handlers"
+	"ichibuy/notification/internal/infra/middlewares"
+	"ichibuy/notification/internal/infra/persistence/postgres"
+	"ichibuy/notification/internal/services"
+)
+
+func New(cfg config.Config, db *sql.DB) *gin.Engine {
+	router := gin.Default()
+	router.Use(middlewares.UseCORS())
+
+	httpClient := &http.Client{
+		Timeout: 10 * time.Second,
+	}
+
+	authClient := authHTTP.NewAPIClient(&authHTTP.Configuration{
+		BasePath:   cfg.AuthBaseURL,
+		HTTPClient: httpClient,
+	})
+
+	jwtMiddleware := middlewares.NewJWTAuthMiddleware(authClient)
+
+	// DAOs
+	preferenceDAO := postgres.NewPreferenceDAO(db)
+	deliveryDAO := postgres.NewDeliveryDAO(db)
+
+	// Use-Cases
+	getMyPreferencesService := services.NewGetMyPreferences(preferenceDAO)
+	updateMyPreferencesService := services.NewUpdateMyPreferences(preferenceDAO)
+	listMyDeliveriesService := services.NewListMyDeliveries(deliveryDAO)
+	retryDeliveryService := services.NewRetryDelivery(deliveryDAO)
+
+	// Routes
+	api := router.Group("/api/v1")
+	api.Use(jwtMiddleware.ValidateToken())
+	{
+		notifications := api.Group("/notifications")
+		{
+			notifications.GET("/preferences", handlers.GetMyPreferences(getMyPreferencesService))
+			notifications.PUT("/preferences", handlers.UpdateMyPreferences(updateMyPreferencesService))
+			notifications.GET("/deliveries", handlers.ListMyDeliveries(listMyDeliveriesService))
+			notifications.POST("/deliveries/:id/retry", handlers.RetryDelivery(retryDeliveryService))
+		}
+	}
+
+	router.GET("/api/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+
+	return router
+}
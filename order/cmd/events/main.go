@@ -0,0 +1,220 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"ichibuy/order/config"
+	"ichibuy/order/db"
+	"ichibuy/order/internal/domain"
+	"ichibuy/order/internal/infra/persistence/postgres"
+	"ichibuy/order/internal/services"
+)
+
+const usage = `usage: events <command> [flags]
+
+commands:
+  list         print the events matching the filters, one JSON per line
+  tail         print the new events as they are published
+  replay       hand the events to a projection, resuming from its checkpoint
+  projections  print the projections that can be replayed
+`
+
+// Events lists, tails and replays the events of the order service
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	cfg := config.Load()
+	db, err := db.New(cfg.PostgresURI)
+	if err != nil {
+		panic(err)
+	}
+	defer db.Close()
+
+	// DAOs
+	eventDAO := postgres.NewEventDAO(db)
+	eventCheckpointDAO := postgres.NewEventCheckpointDAO(db)
+	orderStatusChangeDAO := postgres.NewOrderStatusChangeDAO(db)
+
+	// Services
+	listEventsService := services.NewListEvents(eventDAO)
+	replayEventsService := services.NewReplayEvents(eventDAO, eventCheckpointDAO, map[string]services.Projection{
+		"order_status_history": services.NewRebuildOrderStatusHistory(orderStatusChangeDAO),
+	})
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	switch command, args := os.Args[1], os.Args[2:]; command {
+	case "list":
+		err = list(ctx, listEventsService, args)
+	case "tail":
+		err = tail(ctx, listEventsService, args)
+	case "replay":
+		err = replay(ctx, replayEventsService, args)
+	case "projections":
+		for _, name := range replayEventsService.Projections() {
+			fmt.Println(name)
+		}
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+}
+
+func list(ctx context.Context, listEvents *services.ListEvents, args []string) error {
+	flags := flag.NewFlagSet("list", flag.ExitOnError)
+	types := flags.String("types", "", "comma separated event types")
+	from := flags.String("from", "", "RFC3339 timestamp of the first events")
+	to := flags.String("to", "", "RFC3339 timestamp the events are before")
+	aggregateID := flags.String("aggregate", "", "ID of the aggregate of the events")
+	after := flags.String("after", "", "ID of the event to start after")
+	limit := flags.Int("limit", 0, "maximum number of events, every event when 0")
+	flags.Parse(args)
+
+	req := services.ListEventsReq{After: *after, Types: splitTypes(*types), AggregateID: *aggregateID}
+	var err error
+	if req.From, err = parseTime("from", *from); err != nil {
+		return err
+	}
+	if req.To, err = parseTime("to", *to); err != nil {
+		return err
+	}
+
+	printed := 0
+	for {
+		if *limit > 0 {
+			req.Limit = *limit - printed
+		}
+		resp, err := listEvents.Exec(ctx, req)
+		if err != nil {
+			return err
+		}
+		for _, event := range resp.Events {
+			if err := printEvent(event); err != nil {
+				return err
+			}
+			req.After = event.ID
+		}
+		printed += len(resp.Events)
+		if len(resp.Events) == 0 || (*limit > 0 && printed >= *limit) || ctx.Err() != nil {
+			return nil
+		}
+	}
+}
+
+func tail(ctx context.Context, listEvents *services.ListEvents, args []string) error {
+	flags := flag.NewFlagSet("tail", flag.ExitOnError)
+	types := flags.String("types", "", "comma separated event types")
+	after := flags.String("after", "", "ID of the event to start after, the events published from now on when empty")
+	interval := flags.Duration("interval", 2*time.Second, "polling interval")
+	flags.Parse(args)
+
+	req := services.ListEventsReq{After: *after, Types: splitTypes(*types)}
+	if req.After == "" {
+		now := time.Now()
+		req.From = &now
+	}
+
+	ticker := time.NewTicker(*interval)
+	defer ticker.Stop()
+
+	for {
+		resp, err := listEvents.Exec(ctx, req)
+		if err != nil && ctx.Err() == nil {
+			return err
+		}
+		if resp != nil {
+			for _, event := range resp.Events {
+				if err := printEvent(event); err != nil {
+					return err
+				}
+				req.After = event.ID
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func replay(ctx context.Context, replayEvents *services.ReplayEvents, args []string) error {
+	flags := flag.NewFlagSet("replay", flag.ExitOnError)
+	projection := flags.String("projection", "", "name of the projection, see the projections command")
+	types := flags.String("types", "", "comma separated event types, every type of the projection when empty")
+	from := flags.String("from", "", "RFC3339 timestamp of the first events")
+	to := flags.String("to", "", "RFC3339 timestamp the events are before")
+	dryRun := flags.Bool("dry-run", false, "print the events that would be replayed without handling them")
+	restart := flags.Bool("restart", false, "replay from the first event instead of the checkpoint of the projection")
+	flags.Parse(args)
+
+	req := services.ReplayEventsReq{
+		Projection: *projection,
+		Types:      splitTypes(*types),
+		DryRun:     *dryRun,
+		Restart:    *restart,
+	}
+	var err error
+	if req.From, err = parseTime("from", *from); err != nil {
+		return err
+	}
+	if req.To, err = parseTime("to", *to); err != nil {
+		return err
+	}
+	if *dryRun {
+		req.Visit = func(event domain.Event) {
+			fmt.Printf("%s %s %s %s/%s v%d\n", event.ID, event.Timestamp.Format(time.RFC3339), event.Type, event.AggregateType, event.AggregateID, event.AggregateVersion)
+		}
+	}
+
+	resp, err := replayEvents.Exec(ctx, req)
+	if resp != nil {
+		fmt.Fprintf(os.Stderr, "%d events replayed into %s, last event %s\n", resp.Handled, *projection, resp.LastEventID)
+	}
+	return err
+}
+
+func printEvent(event services.EventDTO) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(line))
+	return nil
+}
+
+func splitTypes(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
+
+func parseTime(name, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be a RFC3339 timestamp", name)
+	}
+	return &parsed, nil
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"ichibuy/order/config"
+	"ichibuy/order/db"
+	"ichibuy/order/internal/domain"
+	"ichibuy/order/internal/infra/events"
+	"ichibuy/order/internal/infra/persistence/postgres"
+	infraServices "ichibuy/order/internal/infra/services"
+	"ichibuy/order/internal/services"
+)
+
+// Worker runs the background jobs of the order service (purge of expired carts and idempotency keys, pending refunds, catalog
+// kept from the store events, forwarding of the events to the message broker)
+func main() {
+	cfg := config.Load()
+	db, err := db.New(cfg.PostgresURI)
+	if err != nil {
+		panic(err)
+	}
+	defer db.Close()
+
+	interval := cfg.GetWorkerInterval()
+
+	// DAOs
+	cartDAO := postgres.NewCartDAO(db)
+	idempotencyKeyDAO := postgres.NewIdempotencyKeyDAO(db)
+	eventCheckpointDAO := postgres.NewEventCheckpointDAO(db)
+	catalogStoreDAO := postgres.NewCatalogStoreDAO(db)
+	catalogProductDAO := postgres.NewCatalogProductDAO(db)
+	eventDAO := postgres.NewEventDAO(db)
+	paymentDAO := postgres.NewPaymentDAO(db)
+
+	eventBus := events.NewBus(eventDAO)
+	paymentGateway, err := infraServices.NewPaymentGateway(cfg.PaymentGateway, cfg.PaymentWebhookSecret)
+	if err != nil {
+		panic(err)
+	}
+
+	var broker domain.MessageBroker
+	if cfg.EventBroker != "" {
+		broker, err = events.NewBroker(context.Background(), cfg.EventBroker, cfg.EventBrokerURL, cfg.GetEventRedeliveryDelay())
+		if err != nil {
+			panic(err)
+		}
+		defer broker.Close()
+	}
+
+	// Jobs
+	purgeExpiredCartsService := services.NewPurgeExpiredCarts(cartDAO)
+	purgeExpiredIdempotencyKeysService := services.NewPurgeExpiredIdempotencyKeys(idempotencyKeyDAO)
+	refundPaymentsService := services.NewRefundPayments(paymentDAO, paymentGateway, eventBus)
+
+	// the catalog is kept from the message broker when there is one, and from the events feed of the store
+	// service otherwise
+	var consumeStoreEventsService *services.ConsumeStoreEvents
+	var forwardEventsService *services.ForwardEvents
+	if broker != nil {
+		consumeStoreEventsService = services.NewConsumeStoreEvents(eventCheckpointDAO, catalogStoreDAO, catalogProductDAO, nil)
+		forwardEventsService = services.NewForwardEvents(eventCheckpointDAO, eventDAO, broker)
+	} else if cfg.StoreEventsAPIToken != "" {
+		storeEventsSvc := infraServices.NewEventsService(&http.Client{Timeout: 30 * time.Second}, cfg.StoreBaseURL+"/api/v1/events", cfg.StoreEventsAPIToken)
+		consumeStoreEventsService = services.NewConsumeStoreEvents(eventCheckpointDAO, catalogStoreDAO, catalogProductDAO, storeEventsSvc)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	if broker != nil {
+		go subscribe(ctx, "store events", func(ctx context.Context) error {
+			return consumeStoreEventsService.Subscribe(ctx, broker)
+		})
+	}
+
+	slog.InfoContext(ctx, "worker started", "interval", interval.String())
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := purgeExpiredCartsService.Exec(ctx); err != nil {
+			slog.ErrorContext(ctx, "purge expired carts failed", "error", err.Error())
+		}
+
+		if err := purgeExpiredIdempotencyKeysService.Exec(ctx); err != nil {
+			slog.ErrorContext(ctx, "purge expired idempotency keys failed", "error", err.Error())
+		}
+
+		if err := refundPaymentsService.Exec(ctx); err != nil {
+			slog.ErrorContext(ctx, "refund payments failed", "error", err.Error())
+		}
+
+		if consumeStoreEventsService != nil && broker == nil {
+			if err := consumeStoreEventsService.Exec(ctx); err != nil {
+				slog.ErrorContext(ctx, "consume store events failed", "error", err.Error())
+			}
+		}
+
+		if forwardEventsService != nil {
+			if err := forwardEventsService.Exec(ctx); err != nil {
+				slog.ErrorContext(ctx, "forward events failed", "error", err.Error())
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			slog.InfoContext(ctx, "worker stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+const maxSubscribeBackoff = time.Minute
+
+// subscribe keeps the subscription running until ctx is done. A subscription that stops, e.g. the broker
+// connection dropped, is subscribed again after a backoff doubling up to maxSubscribeBackoff, reset once a
+// subscription lasted longer than it.
+func subscribe(ctx context.Context, name string, run func(ctx context.Context) error) {
+	backoff := time.Second
+	for {
+		started := time.Now()
+		err := run(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if err == nil {
+			err = errors.New("subscription stopped")
+		}
+
+		if time.Since(started) > maxSubscribeBackoff {
+			backoff = time.Second
+		}
+		slog.ErrorContext(ctx, "subscribe "+name+" failed, retrying", "error", err.Error(), "backoff", backoff.String())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, maxSubscribeBackoff)
+	}
+}
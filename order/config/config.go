@@ -5,16 +5,29 @@ import (
 	"log"
 	"os"
 	"reflect"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
 type Config struct {
-	APIPort         string `env:"API_PORT"`
-	PostgresURI     string `env:"POSTGRES_URI"`
-	AuthBaseURL     string `env:"AUTH_BASE_URL"`
-	FStorageBaseURL string `env:"FSTORAGE_BASE_URL"`
-	StoreBaseURL    string `env:"STORE_BASE_URL"`
+	APIPort              string `env:"API_PORT"`
+	PostgresURI          string `env:"POSTGRES_URI"`
+	AuthBaseURL          string `env:"AUTH_BASE_URL"`
+	FStorageBaseURL      string `env:"FSTORAGE_BASE_URL"`
+	StoreBaseURL         string `env:"STORE_BASE_URL"`
+	WorkerInterval       string `env:"WORKER_INTERVAL"`
+	CartTTL              string `env:"CART_TTL"`
+	PaymentGateway       string `env:"PAYMENT_GATEWAY"`
+	PaymentWebhookSecret string `env:"PAYMENT_WEBHOOK_SECRET"`
+	StreamPollInterval   string `env:"STREAM_POLL_INTERVAL"`
+	IdempotencyKeyTTL    string `env:"IDEMPOTENCY_KEY_TTL"`
+	IdempotencyKeyLease  string `env:"IDEMPOTENCY_KEY_LEASE"`
+	EventsAPIToken       string `env:"EVENTS_API_TOKEN"`
+	StoreEventsAPIToken  string `env:"STORE_EVENTS_API_TOKEN"`
+	EventBroker          string `env:"EVENT_BROKER"`
+	EventBrokerURL       string `env:"EVENT_BROKER_URL"`
+	EventRedelivery      string `env:"EVENT_REDELIVERY_DELAY"`
 }
 
 func Load() Config {
@@ -46,3 +59,42 @@ func loadFromEnv(cfg *Config) {
 		}
 	}
 }
+
+func (c Config) GetWorkerInterval() time.Duration {
+	return parseDuration(c.WorkerInterval, 30*time.Second)
+}
+
+func (c Config) GetCartTTL() time.Duration {
+	return parseDuration(c.CartTTL, 72*time.Hour)
+}
+
+func (c Config) GetStreamPollInterval() time.Duration {
+	return parseDuration(c.StreamPollInterval, 2*time.Second)
+}
+
+// GetIdempotencyKeyLease is how long a request holds its idempotency key before a retry can take it over
+func (c Config) GetIdempotencyKeyLease() time.Duration {
+	return parseDuration(c.IdempotencyKeyLease, time.Minute)
+}
+
+func (c Config) GetIdempotencyKeyTTL() time.Duration {
+	return parseDuration(c.IdempotencyKeyTTL, 24*time.Hour)
+}
+
+func (c Config) GetEventRedeliveryDelay() time.Duration {
+	return parseDuration(c.EventRedelivery, 30*time.Second)
+}
+
+func parseDuration(value string, fallback time.Duration) time.Duration {
+	if value == "" {
+		return fallback
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		log.Printf("invalid duration %q, using %s", value, fallback)
+		return fallback
+	}
+
+	return duration
+}
@@ -0,0 +1,32 @@
+-- +goose Up
+ALTER TABLE orders
+    ADD COLUMN subtotal JSONB,
+    ADD COLUMN discount JSONB,
+    ADD COLUMN tax_rate JSONB NOT NULL DEFAULT '{"name": "", "rate": 0, "inclusive": false}',
+    ADD COLUMN tax JSONB,
+    ADD COLUMN total JSONB;
+
+-- orders created before totals existed had neither discounts nor taxes
+UPDATE orders SET
+    subtotal = totals.subtotal,
+    discount = jsonb_build_object('amount', 0, 'currency', totals.currency),
+    tax = jsonb_build_object('amount', 0, 'currency', totals.currency),
+    total = totals.subtotal
+FROM (
+    SELECT
+        o.id,
+        o.order_lines->0->'unit_price'->>'currency' AS currency,
+        jsonb_build_object(
+            'amount', SUM((line->>'quantity')::BIGINT * (line->'unit_price'->>'amount')::BIGINT),
+            'currency', o.order_lines->0->'unit_price'->>'currency'
+        ) AS subtotal
+    FROM orders o, jsonb_array_elements(o.order_lines) AS line
+    GROUP BY o.id
+) AS totals
+WHERE orders.id = totals.id;
+
+ALTER TABLE orders
+    ALTER COLUMN subtotal SET NOT NULL,
+    ALTER COLUMN discount SET NOT NULL,
+    ALTER COLUMN tax SET NOT NULL,
+    ALTER COLUMN total SET NOT NULL;
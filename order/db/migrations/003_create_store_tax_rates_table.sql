@@ -0,0 +1,9 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS store_tax_rates (
+    store_id UUID PRIMARY KEY,
+    name VARCHAR(50) NOT NULL,
+    rate INTEGER NOT NULL,
+    inclusive BOOLEAN NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
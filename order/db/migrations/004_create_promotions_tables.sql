@@ -0,0 +1,43 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS promotions (
+    id UUID PRIMARY KEY,
+    store_id UUID NOT NULL,
+    code VARCHAR(30),
+    type VARCHAR(20) NOT NULL,
+    value INTEGER NOT NULL DEFAULT 0,
+    currency VARCHAR(3) NOT NULL DEFAULT '',
+    min_order_amount BIGINT NOT NULL DEFAULT 0,
+    product_id UUID,
+    buy_quantity INTEGER NOT NULL DEFAULT 0,
+    get_quantity INTEGER NOT NULL DEFAULT 0,
+    max_redemptions INTEGER,
+    max_redemptions_per_customer INTEGER,
+    redemptions INTEGER NOT NULL DEFAULT 0,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    starts_at TIMESTAMP WITH TIME ZONE,
+    ends_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    CONSTRAINT uq_promotions_store_code UNIQUE (store_id, code)
+);
+
+CREATE INDEX idx_promotions_store_id ON promotions(store_id);
+
+CREATE TABLE IF NOT EXISTS promotion_redemptions (
+    id UUID PRIMARY KEY,
+    promotion_id UUID NOT NULL,
+    order_id UUID NOT NULL,
+    customer_id UUID NOT NULL,
+    -- redemptions are serialized by locking the promotion, the sequences are only kept for promotions with a limit
+    sequence INTEGER,
+    customer_sequence INTEGER,
+    discount JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    CONSTRAINT fk_promotion FOREIGN KEY(promotion_id) REFERENCES promotions(id) ON DELETE CASCADE,
+    CONSTRAINT fk_order FOREIGN KEY(order_id) REFERENCES orders(id) ON DELETE CASCADE,
+    -- concurrent redemptions read the same counters, so only one of them can be stored
+    CONSTRAINT uq_promotion_redemptions_sequence UNIQUE (promotion_id, sequence),
+    CONSTRAINT uq_promotion_redemptions_customer_sequence UNIQUE (promotion_id, customer_id, customer_sequence)
+);
+
+CREATE INDEX idx_promotion_redemptions_order_id ON promotion_redemptions(order_id);
@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS carts (
+    id UUID PRIMARY KEY,
+    user_id VARCHAR(255) NOT NULL UNIQUE,
+    store_id UUID,
+    currency VARCHAR(3) NOT NULL DEFAULT '',
+    lines JSONB NOT NULL DEFAULT '[]',
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX idx_carts_expires_at ON carts(expires_at);
@@ -0,0 +1,5 @@
+-- +goose Up
+-- orders created together from a multi-store checkout share the same checkout_id
+ALTER TABLE orders ADD COLUMN checkout_id UUID;
+
+CREATE INDEX idx_orders_checkout_id ON orders(checkout_id);
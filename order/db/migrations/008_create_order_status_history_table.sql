@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS order_status_history (
+    id UUID PRIMARY KEY,
+    order_id UUID NOT NULL REFERENCES orders(id),
+    from_status VARCHAR(20),
+    to_status VARCHAR(20) NOT NULL,
+    actor_user_id VARCHAR(255),
+    reason TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX idx_order_status_history_order_id ON order_status_history(order_id, created_at);
+
+-- orders created before the history existed only know their creation and their current status
+INSERT INTO order_status_history (id, order_id, from_status, to_status, created_at)
+SELECT gen_random_uuid(), id, NULL, 'created', created_at FROM orders;
+
+INSERT INTO order_status_history (id, order_id, from_status, to_status, created_at)
+SELECT gen_random_uuid(), id, 'created', current_status, updated_at FROM orders WHERE current_status <> 'created';
@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE orders ADD COLUMN store_id UUID;
+
+-- all the lines of an order belong to the same store
+UPDATE orders SET store_id = (order_lines->0->>'product_store_id')::UUID;
+
+ALTER TABLE orders ALTER COLUMN store_id SET NOT NULL;
+
+CREATE INDEX idx_orders_store_id ON orders(store_id, current_status, created_at);
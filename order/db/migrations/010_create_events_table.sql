@@ -0,0 +1,11 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS events (
+    id VARCHAR(255) PRIMARY KEY,
+    type VARCHAR(255) NOT NULL,
+    data JSONB,
+    "timestamp" TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_events_type ON events(type);
+-- the order stream reads the events in ("timestamp", id) order to resume from the last event
+CREATE INDEX IF NOT EXISTS idx_events_timestamp_id ON events("timestamp", id);
@@ -0,0 +1,20 @@
+-- +goose Up
+ALTER TABLE orders
+    ADD COLUMN fulfillment_method VARCHAR(20) NOT NULL DEFAULT 'pickup',
+    ADD COLUMN fulfillment_status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    ADD COLUMN delivery_address JSONB,
+    ADD COLUMN delivery_fee JSONB;
+
+-- orders placed before fulfillment existed were picked up at the store without a fee
+UPDATE orders SET delivery_fee = jsonb_build_object('amount', 0, 'currency', total->>'currency');
+
+ALTER TABLE orders ALTER COLUMN delivery_fee SET NOT NULL;
+
+CREATE TABLE IF NOT EXISTS store_fulfillments (
+    store_id UUID PRIMARY KEY,
+    methods JSONB NOT NULL,
+    delivery_zones JSONB NOT NULL DEFAULT '[]',
+    shipping_fee JSONB,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
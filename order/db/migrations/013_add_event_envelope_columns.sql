@@ -0,0 +1,37 @@
+-- +goose Up
+ALTER TABLE events ADD COLUMN IF NOT EXISTS schema_version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE events ADD COLUMN IF NOT EXISTS aggregate_type VARCHAR(50);
+ALTER TABLE events ADD COLUMN IF NOT EXISTS aggregate_id VARCHAR(255);
+ALTER TABLE events ADD COLUMN IF NOT EXISTS aggregate_version INTEGER;
+ALTER TABLE events ADD COLUMN IF NOT EXISTS producer VARCHAR(50) NOT NULL DEFAULT 'order';
+ALTER TABLE events ADD COLUMN IF NOT EXISTS correlation_id VARCHAR(255);
+ALTER TABLE events ADD COLUMN IF NOT EXISTS causation_id VARCHAR(255);
+
+-- the events published before the envelope get their aggregate from the type and data, and versions
+-- in publication order
+UPDATE events SET
+    aggregate_type = CASE
+        WHEN type = 'CouponRedeemed' THEN 'promotion'
+        WHEN type = 'PaymentRefunded' THEN 'payment'
+        ELSE 'order'
+    END,
+    aggregate_id = CASE
+        WHEN type = 'CouponRedeemed' THEN data->>'promotion_id'
+        WHEN type = 'PaymentRefunded' THEN data->>'payment_id'
+        ELSE data->>'id'
+    END
+WHERE aggregate_type IS NULL;
+
+UPDATE events SET aggregate_version = versioned.version
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY aggregate_type, aggregate_id ORDER BY "timestamp", id) AS version
+    FROM events
+) AS versioned
+WHERE events.id = versioned.id AND events.aggregate_version IS NULL;
+
+ALTER TABLE events ALTER COLUMN aggregate_type SET NOT NULL;
+ALTER TABLE events ALTER COLUMN aggregate_id SET NOT NULL;
+ALTER TABLE events ALTER COLUMN aggregate_version SET NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_events_aggregate_version ON events(aggregate_type, aggregate_id, aggregate_version);
+CREATE INDEX IF NOT EXISTS idx_events_correlation_id ON events(correlation_id);
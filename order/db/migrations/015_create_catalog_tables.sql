@@ -0,0 +1,30 @@
+-- +goose Up
+-- read-only copies of the stores and products of the store service, kept from its events
+CREATE TABLE IF NOT EXISTS catalog_stores (
+    id VARCHAR(255) PRIMARY KEY,
+    user_id VARCHAR(255) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    lat DOUBLE PRECISION NOT NULL,
+    lng DOUBLE PRECISION NOT NULL,
+    -- timezone stays null until a store event with the schedule is consumed
+    timezone VARCHAR(64),
+    opening_hours JSONB NOT NULL DEFAULT '[]',
+    schedule_exceptions JSONB NOT NULL DEFAULT '[]',
+    allows_pre_orders BOOLEAN NOT NULL DEFAULT FALSE,
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    version INTEGER NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS catalog_products (
+    id VARCHAR(255) PRIMARY KEY,
+    store_id VARCHAR(255) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    active BOOLEAN NOT NULL,
+    prices JSONB NOT NULL DEFAULT '[]',
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    version INTEGER NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_catalog_products_store_id ON catalog_products(store_id);
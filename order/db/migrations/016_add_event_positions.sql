@@ -0,0 +1,26 @@
+-- +goose Up
+-- the feeds page on the transaction that inserted the event and its position in the sequence, and only read
+-- the transactions older than every running one, so an event committed late is never behind a cursor
+-- (requires PostgreSQL 13)
+CREATE SEQUENCE IF NOT EXISTS events_position_seq;
+
+ALTER TABLE events ADD COLUMN IF NOT EXISTS transaction_id xid8;
+ALTER TABLE events ADD COLUMN IF NOT EXISTS position BIGINT;
+
+-- the events published before get positions in ("timestamp", id) order in a transaction older than any other
+UPDATE events SET transaction_id = '0', position = ordered.position
+FROM (
+    SELECT id, ROW_NUMBER() OVER (ORDER BY "timestamp", id) AS position
+    FROM events
+) AS ordered
+WHERE events.id = ordered.id AND events.position IS NULL;
+
+SELECT setval('events_position_seq', COALESCE(MAX(position), 0) + 1, false) FROM events;
+
+ALTER TABLE events ALTER COLUMN transaction_id SET DEFAULT pg_current_xact_id();
+ALTER TABLE events ALTER COLUMN transaction_id SET NOT NULL;
+ALTER TABLE events ALTER COLUMN position SET DEFAULT nextval('events_position_seq');
+ALTER TABLE events ALTER COLUMN position SET NOT NULL;
+ALTER SEQUENCE events_position_seq OWNED BY events.position;
+
+CREATE INDEX IF NOT EXISTS idx_events_transaction_position ON events(transaction_id, position);
@@ -0,0 +1,259 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const maxCartLineQuantity = 1000
+
+// Cart is the basket of a user. It only holds products of a single store and currency, as orders do,
+// and expires when it is not modified within its time to live.
+type Cart struct {
+	ID        string          `sql:"id,primary"`
+	UserID    string          `sql:"user_id"`
+	StoreID   *string         `sql:"store_id"`
+	Currency  string          `sql:"currency"`
+	Lines     json.RawMessage `sql:"lines"`
+	ExpiresAt time.Time       `sql:"expires_at"`
+	CreatedAt time.Time       `sql:"created_at"`
+	UpdatedAt time.Time       `sql:"updated_at"`
+
+	// Non-storable
+	lines []CartLine
+}
+
+type CartLine struct {
+	ProductID   string `json:"product_id"`
+	ProductName string `json:"product_name"`
+	Quantity    int    `json:"quantity"`
+	UnitPrice   Money  `json:"unit_price"`
+	// Available is false when the product was deactivated, deleted or lost its price in the cart currency
+	Available bool `json:"available"`
+}
+
+func NewCart(id, userID string, ttl time.Duration) (*Cart, error) {
+	if strings.TrimSpace(userID) == "" {
+		return nil, fmt.Errorf("userID cannot be empty")
+	}
+
+	now := time.Now().UTC()
+	cart := &Cart{
+		ID:        id,
+		UserID:    userID,
+		CreatedAt: now,
+		UpdatedAt: now,
+		ExpiresAt: now.Add(ttl),
+		lines:     []CartLine{},
+	}
+	cart.Lines, _ = json.Marshal(cart.lines)
+
+	return cart, nil
+}
+
+// AddLine adds the product to the cart, or increases its quantity when it is already there
+func (c *Cart) AddLine(product *ProductDTO, quantity int, currency string) error {
+	if quantity <= 0 {
+		return fmt.Errorf("quantity must be greater than 0")
+	}
+
+	if !product.Active {
+		return fmt.Errorf("product %s is not available", product.Name)
+	}
+
+	lines := c.GetLines()
+	if len(lines) > 0 {
+		if c.StoreID != nil && *c.StoreID != product.StoreID {
+			return fmt.Errorf("cart contains products of another store")
+		}
+		currency = c.Currency
+	}
+
+	unitPrice, err := product.PriceIn(currency)
+	if err != nil {
+		return err
+	}
+
+	for i, line := range lines {
+		if line.ProductID == product.ID {
+			if line.Quantity+quantity > maxCartLineQuantity {
+				return fmt.Errorf("quantity cannot exceed %d", maxCartLineQuantity)
+			}
+			lines[i].Quantity += quantity
+			lines[i].ProductName = product.Name
+			lines[i].UnitPrice = unitPrice
+			lines[i].Available = true
+			return c.setLines(lines)
+		}
+	}
+
+	if quantity > maxCartLineQuantity {
+		return fmt.Errorf("quantity cannot exceed %d", maxCartLineQuantity)
+	}
+
+	storeID := product.StoreID
+	c.StoreID = &storeID
+	c.Currency = currency
+
+	return c.setLines(append(lines, CartLine{
+		ProductID:   product.ID,
+		ProductName: product.Name,
+		Quantity:    quantity,
+		UnitPrice:   unitPrice,
+		Available:   true,
+	}))
+}
+
+// UpdateLine sets the quantity of a product in the cart, a zero quantity removes it
+func (c *Cart) UpdateLine(productID string, quantity int) error {
+	if quantity < 0 {
+		return fmt.Errorf("quantity cannot be negative")
+	}
+
+	if quantity == 0 {
+		return c.RemoveLine(productID)
+	}
+
+	if quantity > maxCartLineQuantity {
+		return fmt.Errorf("quantity cannot exceed %d", maxCartLineQuantity)
+	}
+
+	lines := c.GetLines()
+	for i, line := range lines {
+		if line.ProductID == productID {
+			lines[i].Quantity = quantity
+			return c.setLines(lines)
+		}
+	}
+
+	return fmt.Errorf("product %s is not in the cart", productID)
+}
+
+func (c *Cart) RemoveLine(productID string) error {
+	lines := c.GetLines()
+	for i, line := range lines {
+		if line.ProductID == productID {
+			return c.setLines(append(lines[:i], lines[i+1:]...))
+		}
+	}
+
+	return fmt.Errorf("product %s is not in the cart", productID)
+}
+
+func (c *Cart) Clear() error {
+	return c.setLines([]CartLine{})
+}
+
+// Reprice refreshes the names and prices of the lines with the current products.
+// Products missing from the map are no longer available.
+func (c *Cart) Reprice(products map[string]*ProductDTO) error {
+	lines := c.GetLines()
+	for i, line := range lines {
+		product, ok := products[line.ProductID]
+		if !ok || !product.Active {
+			lines[i].Available = false
+			continue
+		}
+
+		unitPrice, err := product.PriceIn(c.Currency)
+		if err != nil {
+			lines[i].Available = false
+			continue
+		}
+
+		lines[i].ProductName = product.Name
+		lines[i].UnitPrice = unitPrice
+		lines[i].Available = true
+	}
+
+	return c.setLines(lines)
+}
+
+// CheckCheckout returns an error when the cart cannot be turned into an order
+func (c *Cart) CheckCheckout() error {
+	lines := c.GetLines()
+	if len(lines) == 0 {
+		return fmt.Errorf("cart is empty")
+	}
+
+	for _, line := range lines {
+		if !line.Available {
+			return fmt.Errorf("product %s is no longer available", line.ProductName)
+		}
+	}
+
+	return nil
+}
+
+// Total returns the sum of the lines at their current prices
+func (c *Cart) Total() (Money, error) {
+	total := Money{Amount: 0, Currency: c.Currency}
+	for _, line := range c.GetLines() {
+		if !line.Available {
+			continue
+		}
+
+		amount, err := line.UnitPrice.Multiply(line.Quantity)
+		if err != nil {
+			return Money{}, err
+		}
+
+		total, err = total.Add(amount)
+		if err != nil {
+			return Money{}, err
+		}
+	}
+	return total, nil
+}
+
+// Touch extends the cart expiration after it is used
+func (c *Cart) Touch(ttl time.Duration) {
+	c.UpdatedAt = time.Now().UTC()
+	c.ExpiresAt = c.UpdatedAt.Add(ttl)
+}
+
+func (c *Cart) IsExpired(now time.Time) bool {
+	return !now.Before(c.ExpiresAt)
+}
+
+func (c *Cart) setLines(lines []CartLine) error {
+	raw, err := json.Marshal(lines)
+	if err != nil {
+		return err
+	}
+
+	c.lines = lines
+	c.Lines = raw
+
+	if len(lines) == 0 {
+		c.StoreID = nil
+		c.Currency = ""
+	}
+	return nil
+}
+
+func (c *Cart) GetID() string           { return c.ID }
+func (c *Cart) GetUserID() string       { return c.UserID }
+func (c *Cart) GetStoreID() *string     { return c.StoreID }
+func (c *Cart) GetCurrency() string     { return c.Currency }
+func (c *Cart) GetExpiresAt() time.Time { return c.ExpiresAt }
+func (c *Cart) GetUpdatedAt() time.Time { return c.UpdatedAt }
+
+func (c *Cart) GetLines() []CartLine {
+	if c.lines == nil {
+		c.lines = []CartLine{}
+		if len(c.Lines) > 0 {
+			_ = json.Unmarshal(c.Lines, &c.lines)
+		}
+	}
+
+	lines := make([]CartLine, len(c.lines))
+	copy(lines, c.lines)
+	return lines
+}
+
+func (c *Cart) TableName() string {
+	return "carts"
+}
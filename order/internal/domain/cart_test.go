@@ -0,0 +1,84 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"ichibuy/order/internal/domain"
+)
+
+func TestCart_IsExpired(t *testing.T) {
+	ttl := 24 * time.Hour
+	cart, err := domain.NewCart("cart-1", "user-1", ttl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expiresAt := cart.GetExpiresAt()
+
+	tests := []struct {
+		name    string
+		now     time.Time
+		expired bool
+	}{
+		{name: "before the expiration", now: expiresAt.Add(-time.Second), expired: false},
+		{name: "at the expiration", now: expiresAt, expired: true},
+		{name: "after the expiration", now: expiresAt.Add(time.Hour), expired: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cart.IsExpired(tt.now); got != tt.expired {
+				t.Errorf("got %v, want %v", got, tt.expired)
+			}
+		})
+	}
+}
+
+func TestCart_Touch(t *testing.T) {
+	cart, err := domain.NewCart("cart-1", "user-1", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cart.Touch(24 * time.Hour)
+
+	if cart.IsExpired(time.Now().UTC().Add(time.Hour)) {
+		t.Errorf("expected touched cart to expire a day after its last use, expires at %v", cart.GetExpiresAt())
+	}
+	if !cart.GetExpiresAt().Equal(cart.GetUpdatedAt().Add(24 * time.Hour)) {
+		t.Errorf("expected expiration from the last update, got %v", cart.GetExpiresAt())
+	}
+}
+
+func TestCart_Total(t *testing.T) {
+	coffee := &domain.ProductDTO{ID: "product-1", Name: "Coffee", StoreID: "store-1", Active: true, Prices: []domain.Money{{Amount: 1000, Currency: "PEN"}}}
+	tea := &domain.ProductDTO{ID: "product-2", Name: "Tea", StoreID: "store-1", Active: true, Prices: []domain.Money{{Amount: 450, Currency: "PEN"}}}
+
+	cart, err := domain.NewCart("cart-1", "user-1", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cart.AddLine(coffee, 2, "PEN"); err != nil {
+		t.Fatal(err)
+	}
+	if err := cart.AddLine(tea, 1, "PEN"); err != nil {
+		t.Fatal(err)
+	}
+
+	total, err := cart.Total()
+	if err != nil || total.Amount != 2450 {
+		t.Fatalf("expected total 2450, got %v, %v", total, err)
+	}
+
+	// tea is no longer sold, so it leaves the total and blocks the checkout
+	if err := cart.Reprice(map[string]*domain.ProductDTO{coffee.ID: coffee}); err != nil {
+		t.Fatal(err)
+	}
+	total, err = cart.Total()
+	if err != nil || total.Amount != 2000 {
+		t.Fatalf("expected total 2000, got %v, %v", total, err)
+	}
+	if err := cart.CheckCheckout(); err == nil {
+		t.Errorf("expected checkout blocked by an unavailable product")
+	}
+}
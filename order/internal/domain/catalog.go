@@ -0,0 +1,280 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Events of the store service kept in the catalog of the order service
+const (
+	StoreCreated    EventType = "StoreCreated"
+	StoreUpdated    EventType = "StoreUpdated"
+	StoreDeleted    EventType = "StoreDeleted"
+	StoreRestored   EventType = "StoreRestored"
+	ProductCreated  EventType = "ProductCreated"
+	ProductUpdated  EventType = "ProductUpdated"
+	ProductDeleted  EventType = "ProductDeleted"
+	ProductRestored EventType = "ProductRestored"
+)
+
+// CatalogEventTypes are the store events consumed into the catalog
+var CatalogEventTypes = []EventType{
+	StoreCreated, StoreUpdated, StoreDeleted, StoreRestored,
+	ProductCreated, ProductUpdated, ProductDeleted, ProductRestored,
+}
+
+// CatalogStore is the read-only copy of a store of the store service, kept from its events. Version is
+// the aggregate version of the last event applied. Timezone is nil until an event with the schedule of the
+// store is applied.
+type CatalogStore struct {
+	ID                 string          `sql:"id,primary"`
+	UserID             string          `sql:"user_id"`
+	Name               string          `sql:"name"`
+	Lat                float64         `sql:"lat"`
+	Lng                float64         `sql:"lng"`
+	Timezone           *string         `sql:"timezone"`
+	OpeningHours       json.RawMessage `sql:"opening_hours"`
+	ScheduleExceptions json.RawMessage `sql:"schedule_exceptions"`
+	AllowsPreOrders    bool            `sql:"allows_pre_orders"`
+	DeletedAt          *time.Time      `sql:"deleted_at"`
+	Version            int             `sql:"version"`
+	UpdatedAt          time.Time       `sql:"updated_at"`
+}
+
+// catalogStoreEventData is the part of the data of the store events kept in the catalog
+type catalogStoreEventData struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	UserID   string `json:"user_id"`
+	Location struct {
+		Lat float64 `json:"Lat"`
+		Lng float64 `json:"Lng"`
+	} `json:"location"`
+	DeletedAt *time.Time     `json:"deleted_at"`
+	Schedule  *StoreSchedule `json:"schedule"`
+}
+
+func NewCatalogStore(id string) *CatalogStore {
+	return &CatalogStore{ID: id, OpeningHours: json.RawMessage("[]"), ScheduleExceptions: json.RawMessage("[]")}
+}
+
+// Apply updates the store from a store event. Events already applied, or older than the last one applied,
+// are ignored and Apply returns false.
+func (s *CatalogStore) Apply(event Event) (bool, error) {
+	if event.AggregateVersion <= s.Version {
+		return false, nil
+	}
+
+	var data catalogStoreEventData
+	if err := json.Unmarshal(event.Data, &data); err != nil {
+		return false, err
+	}
+	if data.ID != s.ID {
+		return false, fmt.Errorf("event %s is not of store %s", event.ID, s.ID)
+	}
+
+	s.UserID = data.UserID
+	s.Name = data.Name
+	s.Lat = data.Location.Lat
+	s.Lng = data.Location.Lng
+	s.DeletedAt = data.DeletedAt
+	if event.Type == StoreDeleted && s.DeletedAt == nil {
+		s.DeletedAt = &event.Timestamp
+	}
+	if data.Schedule != nil {
+		if err := s.setSchedule(*data.Schedule); err != nil {
+			return false, err
+		}
+	}
+	s.Version = event.AggregateVersion
+	s.UpdatedAt = time.Now().UTC()
+	return true, nil
+}
+
+func (s *CatalogStore) GetID() string            { return s.ID }
+func (s *CatalogStore) GetUserID() string        { return s.UserID }
+func (s *CatalogStore) GetName() string          { return s.Name }
+func (s *CatalogStore) GetDeletedAt() *time.Time { return s.DeletedAt }
+func (s *CatalogStore) GetVersion() int          { return s.Version }
+func (s *CatalogStore) GetUpdatedAt() time.Time  { return s.UpdatedAt }
+
+func (s *CatalogStore) IsDeleted() bool {
+	return s.DeletedAt != nil
+}
+
+// Schedule returns the schedule of the store, false when no event with the schedule was applied yet
+func (s *CatalogStore) Schedule() (StoreSchedule, bool) {
+	if s.Timezone == nil {
+		return StoreSchedule{}, false
+	}
+
+	schedule := StoreSchedule{
+		Timezone:        *s.Timezone,
+		OpeningHours:    []OpeningHours{},
+		Exceptions:      []ScheduleException{},
+		AllowsPreOrders: s.AllowsPreOrders,
+	}
+	if len(s.OpeningHours) > 0 {
+		_ = json.Unmarshal(s.OpeningHours, &schedule.OpeningHours)
+	}
+	if len(s.ScheduleExceptions) > 0 {
+		_ = json.Unmarshal(s.ScheduleExceptions, &schedule.Exceptions)
+	}
+	return schedule, true
+}
+
+// AvailabilityAt returns the availability of the store at the given instant from its schedule, false when
+// no event with the schedule was applied yet
+func (s *CatalogStore) AvailabilityAt(at time.Time) (*StoreAvailabilityDTO, bool) {
+	schedule, ok := s.Schedule()
+	if !ok {
+		return nil, false
+	}
+
+	return &StoreAvailabilityDTO{
+		StoreID:         s.ID,
+		IsOpenNow:       schedule.IsOpenAt(at),
+		AllowsPreOrders: schedule.AllowsPreOrders,
+	}, true
+}
+
+func (s *CatalogStore) setSchedule(schedule StoreSchedule) error {
+	if schedule.OpeningHours == nil {
+		schedule.OpeningHours = []OpeningHours{}
+	}
+	if schedule.Exceptions == nil {
+		schedule.Exceptions = []ScheduleException{}
+	}
+
+	openingHours, err := json.Marshal(schedule.OpeningHours)
+	if err != nil {
+		return err
+	}
+	exceptions, err := json.Marshal(schedule.Exceptions)
+	if err != nil {
+		return err
+	}
+
+	timezone := schedule.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+
+	s.Timezone = &timezone
+	s.OpeningHours = openingHours
+	s.ScheduleExceptions = exceptions
+	s.AllowsPreOrders = schedule.AllowsPreOrders
+	return nil
+}
+
+func (s *CatalogStore) ToDTO() *StoreDTO {
+	return &StoreDTO{
+		ID:       s.ID,
+		UserID:   s.UserID,
+		Location: GeoPoint{Lat: s.Lat, Lng: s.Lng},
+	}
+}
+
+func (s *CatalogStore) TableName() string {
+	return "catalog_stores"
+}
+
+// CatalogProduct is the read-only copy of a product of the store service, kept from its events. Prices
+// holds the current prices of the product, one per currency.
+type CatalogProduct struct {
+	ID        string          `sql:"id,primary"`
+	StoreID   string          `sql:"store_id"`
+	Name      string          `sql:"name"`
+	Active    bool            `sql:"active"`
+	Prices    json.RawMessage `sql:"prices"`
+	DeletedAt *time.Time      `sql:"deleted_at"`
+	Version   int             `sql:"version"`
+	UpdatedAt time.Time       `sql:"updated_at"`
+}
+
+// catalogProductEventData is the part of the data of the product events kept in the catalog
+type catalogProductEventData struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Active  bool   `json:"active"`
+	StoreID string `json:"store_id"`
+	Prices  map[string]struct {
+		Value Money `json:"value"`
+	} `json:"prices"`
+	DeletedAt *time.Time `json:"deleted_at"`
+}
+
+func NewCatalogProduct(id string) *CatalogProduct {
+	return &CatalogProduct{ID: id, Prices: json.RawMessage("[]")}
+}
+
+// Apply updates the product from a product event. Events already applied, or older than the last one
+// applied, are ignored and Apply returns false.
+func (p *CatalogProduct) Apply(event Event) (bool, error) {
+	if event.AggregateVersion <= p.Version {
+		return false, nil
+	}
+
+	var data catalogProductEventData
+	if err := json.Unmarshal(event.Data, &data); err != nil {
+		return false, err
+	}
+	if data.ID != p.ID {
+		return false, fmt.Errorf("event %s is not of product %s", event.ID, p.ID)
+	}
+
+	prices := make([]Money, 0, len(data.Prices))
+	for _, price := range data.Prices {
+		prices = append(prices, price.Value)
+	}
+	raw, err := json.Marshal(prices)
+	if err != nil {
+		return false, err
+	}
+
+	p.StoreID = data.StoreID
+	p.Name = data.Name
+	p.Active = data.Active
+	p.Prices = raw
+	p.DeletedAt = data.DeletedAt
+	if event.Type == ProductDeleted && p.DeletedAt == nil {
+		p.DeletedAt = &event.Timestamp
+	}
+	p.Version = event.AggregateVersion
+	p.UpdatedAt = time.Now().UTC()
+	return true, nil
+}
+
+func (p *CatalogProduct) GetID() string            { return p.ID }
+func (p *CatalogProduct) GetStoreID() string       { return p.StoreID }
+func (p *CatalogProduct) GetName() string          { return p.Name }
+func (p *CatalogProduct) GetActive() bool          { return p.Active }
+func (p *CatalogProduct) GetDeletedAt() *time.Time { return p.DeletedAt }
+func (p *CatalogProduct) GetVersion() int          { return p.Version }
+func (p *CatalogProduct) GetUpdatedAt() time.Time  { return p.UpdatedAt }
+
+func (p *CatalogProduct) GetPrices() []Money {
+	prices := []Money{}
+	_ = json.Unmarshal(p.Prices, &prices)
+	return prices
+}
+
+func (p *CatalogProduct) IsDeleted() bool {
+	return p.DeletedAt != nil
+}
+
+// ToDTO returns the product as the store service would, deleted products are not active
+func (p *CatalogProduct) ToDTO() *ProductDTO {
+	return &ProductDTO{
+		ID:      p.ID,
+		Name:    p.Name,
+		StoreID: p.StoreID,
+		Active:  p.Active && !p.IsDeleted(),
+		Prices:  p.GetPrices(),
+	}
+}
+
+func (p *CatalogProduct) TableName() string {
+	return "catalog_products"
+}
@@ -0,0 +1,225 @@
+package domain_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"ichibuy/order/internal/domain"
+)
+
+func productEvent(t *testing.T, eventType domain.EventType, version int, active bool, amount int) domain.Event {
+	t.Helper()
+
+	data, err := json.Marshal(map[string]any{
+		"id":       "product-1",
+		"name":     "Coffee",
+		"active":   active,
+		"store_id": "store-1",
+		"prices": map[string]any{
+			"price-1": map[string]any{"id": "price-1", "value": map[string]any{"amount": amount, "currency": "PEN"}},
+		},
+		"deleted_at": nil,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return domain.Event{
+		ID:               fmt.Sprintf("event-%d", version),
+		Type:             eventType,
+		SchemaVersion:    1,
+		AggregateType:    "product",
+		AggregateID:      "product-1",
+		AggregateVersion: version,
+		Data:             data,
+		Timestamp:        time.Now().UTC(),
+	}
+}
+
+func TestCatalogProduct_Apply(t *testing.T) {
+	product := domain.NewCatalogProduct("product-1")
+
+	applied, err := product.Apply(productEvent(t, domain.ProductCreated, 1, true, 1000))
+	if err != nil || !applied {
+		t.Fatalf("expected created event applied, got %v, %v", applied, err)
+	}
+
+	applied, err = product.Apply(productEvent(t, domain.ProductUpdated, 3, true, 1200))
+	if err != nil || !applied {
+		t.Fatalf("expected updated event applied, got %v, %v", applied, err)
+	}
+
+	// an older event read late does not overwrite the newer one
+	applied, err = product.Apply(productEvent(t, domain.ProductUpdated, 2, false, 900))
+	if err != nil || applied {
+		t.Fatalf("expected stale event ignored, got %v, %v", applied, err)
+	}
+
+	dto := product.ToDTO()
+	if !dto.Active || len(dto.Prices) != 1 || dto.Prices[0].Amount != 1200 {
+		t.Fatalf("expected active product priced 1200, got %+v", dto)
+	}
+
+	if _, err := product.Apply(productEvent(t, domain.ProductDeleted, 4, true, 1200)); err != nil {
+		t.Fatal(err)
+	}
+	if !product.IsDeleted() || product.ToDTO().Active {
+		t.Fatalf("expected deleted product not active")
+	}
+}
+
+func TestProductDTO_CheckOrderLine(t *testing.T) {
+	product := &domain.ProductDTO{
+		ID:      "product-1",
+		Name:    "Coffee",
+		StoreID: "store-1",
+		Active:  true,
+		Prices:  []domain.Money{{Amount: 1000, Currency: "PEN"}},
+	}
+
+	line := func(storeID string, amount int, currency string) domain.OrderLine {
+		orderLine, err := domain.NewOrderLine("line-1", "product-1", "Coffee", storeID, 1, domain.Money{Amount: amount, Currency: currency})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return *orderLine
+	}
+
+	tests := []struct {
+		name      string
+		active    bool
+		line      domain.OrderLine
+		expectErr bool
+	}{
+		{"matching line", true, line("store-1", 1000, "PEN"), false},
+		{"inactive product", false, line("store-1", 1000, "PEN"), true},
+		{"another store", true, line("store-2", 1000, "PEN"), true},
+		{"another price", true, line("store-1", 900, "PEN"), true},
+		{"no price in currency", true, line("store-1", 1000, "USD"), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			product.Active = tt.active
+			err := product.CheckOrderLine(tt.line)
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("expected error %v, got %v", tt.expectErr, err)
+			}
+		})
+	}
+}
+
+func storeEvent(t *testing.T, eventType domain.EventType, version int, schedule map[string]any) domain.Event {
+	t.Helper()
+
+	data := map[string]any{
+		"id":         "store-1",
+		"name":       "Bodega",
+		"user_id":    "user-1",
+		"location":   map[string]any{"Lat": -12.05, "Lng": -77.04},
+		"deleted_at": nil,
+	}
+	if schedule != nil {
+		data["schedule"] = schedule
+	}
+
+	raw, err := json.Marshal(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return domain.Event{
+		ID:               fmt.Sprintf("event-%d", version),
+		Type:             eventType,
+		SchemaVersion:    1,
+		AggregateType:    "store",
+		AggregateID:      "store-1",
+		AggregateVersion: version,
+		Data:             raw,
+		Timestamp:        time.Now().UTC(),
+	}
+}
+
+func TestCatalogStore_AvailabilityAt(t *testing.T) {
+	// 2025-07-21 is a monday
+	monday := time.Date(2025, 7, 21, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		schedule         map[string]any
+		expectSchedule   bool
+		expectOpen       bool
+		expectAcceptsErr bool
+	}{
+		{
+			name:           "event without schedule",
+			expectSchedule: false,
+		},
+		{
+			name:           "open store",
+			schedule:       map[string]any{"timezone": "UTC", "opening_hours": []map[string]any{{"day": "monday", "open": "09:00", "close": "18:00"}}},
+			expectSchedule: true,
+			expectOpen:     true,
+		},
+		{
+			name:             "closed store without pre-orders",
+			schedule:         map[string]any{"timezone": "UTC", "opening_hours": []map[string]any{{"day": "tuesday", "open": "09:00", "close": "18:00"}}},
+			expectSchedule:   true,
+			expectAcceptsErr: true,
+		},
+		{
+			name: "closed store taking pre-orders",
+			schedule: map[string]any{
+				"timezone":          "UTC",
+				"opening_hours":     []map[string]any{{"day": "monday", "open": "09:00", "close": "18:00"}},
+				"exceptions":        []map[string]any{{"date": "2025-07-21", "name": "Holiday", "hours": nil}},
+				"allows_pre_orders": true,
+			},
+			expectSchedule: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := domain.NewCatalogStore("store-1")
+			if _, err := store.Apply(storeEvent(t, domain.StoreUpdated, 1, tt.schedule)); err != nil {
+				t.Fatal(err)
+			}
+
+			availability, ok := store.AvailabilityAt(monday)
+			if ok != tt.expectSchedule {
+				t.Fatalf("expected schedule %v, got %v", tt.expectSchedule, ok)
+			}
+			if !ok {
+				return
+			}
+
+			if availability.StoreID != "store-1" || availability.IsOpenNow != tt.expectOpen {
+				t.Fatalf("expected open %v, got %+v", tt.expectOpen, availability)
+			}
+			if err := availability.CheckAcceptsOrders(); (err != nil) != tt.expectAcceptsErr {
+				t.Fatalf("expected accepts orders error %v, got %v", tt.expectAcceptsErr, err)
+			}
+		})
+	}
+}
+
+func TestCatalogStore_Apply_KeepsScheduleOfOlderEvents(t *testing.T) {
+	store := domain.NewCatalogStore("store-1")
+	schedule := map[string]any{"timezone": "America/Lima", "allows_pre_orders": true}
+
+	if _, err := store.Apply(storeEvent(t, domain.StoreCreated, 1, schedule)); err != nil {
+		t.Fatal(err)
+	}
+	// an event published before the schedule was part of the store events does not clear it
+	if _, err := store.Apply(storeEvent(t, domain.StoreUpdated, 2, nil)); err != nil {
+		t.Fatal(err)
+	}
+
+	got, ok := store.Schedule()
+	if !ok || got.Timezone != "America/Lima" || !got.AllowsPreOrders {
+		t.Fatalf("expected the schedule of the first event, got %+v, %v", got, ok)
+	}
+}
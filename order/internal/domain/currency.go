@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Currency is an ISO-4217 currency. MinorUnits is the number of decimals of its smallest unit,
+// so amounts are stored as integers of that unit (e.g. cents for USD, yen for JPY).
+type Currency struct {
+	Code       string `json:"code"`
+	MinorUnits int    `json:"minor_units"`
+	Symbol     string `json:"symbol"`
+}
+
+var currencyRegistry = map[string]Currency{
+	"ARS": {Code: "ARS", MinorUnits: 2, Symbol: "$"},
+	"BHD": {Code: "BHD", MinorUnits: 3, Symbol: ".د.ب"},
+	"BOB": {Code: "BOB", MinorUnits: 2, Symbol: "Bs"},
+	"BRL": {Code: "BRL", MinorUnits: 2, Symbol: "R$"},
+	"CAD": {Code: "CAD", MinorUnits: 2, Symbol: "$"},
+	"CLP": {Code: "CLP", MinorUnits: 0, Symbol: "$"},
+	"COP": {Code: "COP", MinorUnits: 2, Symbol: "$"},
+	"EUR": {Code: "EUR", MinorUnits: 2, Symbol: "€"},
+	"GBP": {Code: "GBP", MinorUnits: 2, Symbol: "£"},
+	"JPY": {Code: "JPY", MinorUnits: 0, Symbol: "¥"},
+	"KWD": {Code: "KWD", MinorUnits: 3, Symbol: "د.ك"},
+	"MXN": {Code: "MXN", MinorUnits: 2, Symbol: "$"},
+	"PEN": {Code: "PEN", MinorUnits: 2, Symbol: "S/"},
+	"USD": {Code: "USD", MinorUnits: 2, Symbol: "$"},
+	"UYU": {Code: "UYU", MinorUnits: 2, Symbol: "$"},
+}
+
+func FindCurrency(code string) (Currency, error) {
+	currency, ok := currencyRegistry[strings.ToUpper(code)]
+	if !ok {
+		return Currency{}, fmt.Errorf("invalid currency %s", code)
+	}
+	return currency, nil
+}
+
+func IsValidCurrency(code string) bool {
+	_, ok := currencyRegistry[code]
+	return ok
+}
+
+// Currencies returns the registered currencies sorted by code
+func Currencies() []Currency {
+	currencies := make([]Currency, 0, len(currencyRegistry))
+	for _, currency := range currencyRegistry {
+		currencies = append(currencies, currency)
+	}
+	sort.Slice(currencies, func(i, j int) bool { return currencies[i].Code < currencies[j].Code })
+	return currencies
+}
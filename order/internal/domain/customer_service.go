@@ -1,11 +1,27 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"strings"
+)
 
 type CustomerService interface {
 	FindByUserID(ctx context.Context, userID string) (*CustomerDTO, error)
 }
 
 type CustomerDTO struct {
-	ID string
+	ID        string
+	FirstName string
+	LastName  string
+	Phone     *string
+}
+
+// FullName is the recipient of the orders delivered to an address without one
+func (c CustomerDTO) FullName() string {
+	return strings.TrimSpace(c.FirstName + " " + c.LastName)
+}
+
+// CustomerAddressService reads the address book of a customer, kept by the store service
+type CustomerAddressService interface {
+	FindAddress(ctx context.Context, customerID, addressID string) (*DeliveryAddress, error)
 }
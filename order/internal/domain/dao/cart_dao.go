@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"context"
+	"ichibuy/order/internal/domain"
+)
+
+type Cart = domain.Cart
+
+type CartDAO interface {
+	// Create creates a new Cart
+	Create(ctx context.Context, m *Cart) error
+
+	// Update updates an existing Cart
+	Update(ctx context.Context, m *Cart) error
+
+	// PartialUpdate updates specific fields of a Cart
+	PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error
+
+	// DeleteByPk deletes a Cart by primary key
+	DeleteByPk(ctx context.Context, pk string) error
+
+	// FindByPk finds a Cart by primary key
+	FindByPk(ctx context.Context, pk string) (*Cart, error)
+
+	// CreateMany creates multiple Cart records
+	CreateMany(ctx context.Context, models []*Cart) error
+
+	// UpdateMany updates multiple Cart records
+	UpdateMany(ctx context.Context, models []*Cart) error
+
+	// DeleteManyByPks deletes multiple Cart records by primary keys
+	DeleteManyByPks(ctx context.Context, pks []string) error
+
+	// FindOne finds a single Cart with optional where clause and sort expression
+	FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*Cart, error)
+
+	// FindAll finds all Cart records with optional where clause and sort expression
+	FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*Cart, error)
+
+	// FindPaginated finds Cart records with pagination, optional where clause and sort expression
+	FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*Cart, error)
+
+	// Count counts Cart records with optional where clause
+	Count(ctx context.Context, where string, args ...interface{}) (int64, error)
+
+	// WithTransaction executes a function within a database transaction
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
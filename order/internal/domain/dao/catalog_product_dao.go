@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"context"
+	"ichibuy/order/internal/domain"
+)
+
+type CatalogProduct = domain.CatalogProduct
+
+type CatalogProductDAO interface {
+	// Create creates a new CatalogProduct
+	Create(ctx context.Context, m *CatalogProduct) error
+
+	// Update updates an existing CatalogProduct
+	Update(ctx context.Context, m *CatalogProduct) error
+
+	// PartialUpdate updates specific fields of a CatalogProduct
+	PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error
+
+	// DeleteByPk deletes a CatalogProduct by primary key
+	DeleteByPk(ctx context.Context, pk string) error
+
+	// FindByPk finds a CatalogProduct by primary key
+	FindByPk(ctx context.Context, pk string) (*CatalogProduct, error)
+
+	// CreateMany creates multiple CatalogProduct records
+	CreateMany(ctx context.Context, models []*CatalogProduct) error
+
+	// UpdateMany updates multiple CatalogProduct records
+	UpdateMany(ctx context.Context, models []*CatalogProduct) error
+
+	// DeleteManyByPks deletes multiple CatalogProduct records by primary keys
+	DeleteManyByPks(ctx context.Context, pks []string) error
+
+	// FindOne finds a single CatalogProduct with optional where clause and sort expression
+	FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*CatalogProduct, error)
+
+	// FindAll finds all CatalogProduct records with optional where clause and sort expression
+	FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*CatalogProduct, error)
+
+	// FindPaginated finds CatalogProduct records with pagination, optional where clause and sort expression
+	FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*CatalogProduct, error)
+
+	// Count counts CatalogProduct records with optional where clause
+	Count(ctx context.Context, where string, args ...interface{}) (int64, error)
+
+	// WithTransaction executes a function within a database transaction
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"context"
+	"ichibuy/order/internal/domain"
+)
+
+type CatalogStore = domain.CatalogStore
+
+type CatalogStoreDAO interface {
+	// Create creates a new CatalogStore
+	Create(ctx context.Context, m *CatalogStore) error
+
+	// Update updates an existing CatalogStore
+	Update(ctx context.Context, m *CatalogStore) error
+
+	// PartialUpdate updates specific fields of a CatalogStore
+	PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error
+
+	// DeleteByPk deletes a CatalogStore by primary key
+	DeleteByPk(ctx context.Context, pk string) error
+
+	// FindByPk finds a CatalogStore by primary key
+	FindByPk(ctx context.Context, pk string) (*CatalogStore, error)
+
+	// CreateMany creates multiple CatalogStore records
+	CreateMany(ctx context.Context, models []*CatalogStore) error
+
+	// UpdateMany updates multiple CatalogStore records
+	UpdateMany(ctx context.Context, models []*CatalogStore) error
+
+	// DeleteManyByPks deletes multiple CatalogStore records by primary keys
+	DeleteManyByPks(ctx context.Context, pks []string) error
+
+	// FindOne finds a single CatalogStore with optional where clause and sort expression
+	FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*CatalogStore, error)
+
+	// FindAll finds all CatalogStore records with optional where clause and sort expression
+	FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*CatalogStore, error)
+
+	// FindPaginated finds CatalogStore records with pagination, optional where clause and sort expression
+	FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*CatalogStore, error)
+
+	// Count counts CatalogStore records with optional where clause
+	Count(ctx context.Context, where string, args ...interface{}) (int64, error)
+
+	// WithTransaction executes a function within a database transaction
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"context"
+	"ichibuy/order/internal/domain"
+)
+
+type IdempotencyKey = domain.IdempotencyKey
+
+type IdempotencyKeyDAO interface {
+	// Create creates a new IdempotencyKey
+	Create(ctx context.Context, m *IdempotencyKey) error
+
+	// Update updates an existing IdempotencyKey
+	Update(ctx context.Context, m *IdempotencyKey) error
+
+	// PartialUpdate updates specific fields of a IdempotencyKey
+	PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error
+
+	// DeleteByPk deletes a IdempotencyKey by primary key
+	DeleteByPk(ctx context.Context, pk string) error
+
+	// FindByPk finds a IdempotencyKey by primary key
+	FindByPk(ctx context.Context, pk string) (*IdempotencyKey, error)
+
+	// CreateMany creates multiple IdempotencyKey records
+	CreateMany(ctx context.Context, models []*IdempotencyKey) error
+
+	// UpdateMany updates multiple IdempotencyKey records
+	UpdateMany(ctx context.Context, models []*IdempotencyKey) error
+
+	// DeleteManyByPks deletes multiple IdempotencyKey records by primary keys
+	DeleteManyByPks(ctx context.Context, pks []string) error
+
+	// FindOne finds a single IdempotencyKey with optional where clause and sort expression
+	FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*IdempotencyKey, error)
+
+	// FindAll finds all IdempotencyKey records with optional where clause and sort expression
+	FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*IdempotencyKey, error)
+
+	// FindPaginated finds IdempotencyKey records with pagination, optional where clause and sort expression
+	FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*IdempotencyKey, error)
+
+	// Count counts IdempotencyKey records with optional where clause
+	Count(ctx context.Context, where string, args ...interface{}) (int64, error)
+
+	// WithTransaction executes a function within a database transaction
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"context"
+	"ichibuy/order/internal/domain"
+)
+
+type OrderStatusChange = domain.OrderStatusChange
+
+type OrderStatusChangeDAO interface {
+	// Create creates a new OrderStatusChange
+	Create(ctx context.Context, m *OrderStatusChange) error
+
+	// Update updates an existing OrderStatusChange
+	Update(ctx context.Context, m *OrderStatusChange) error
+
+	// PartialUpdate updates specific fields of a OrderStatusChange
+	PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error
+
+	// DeleteByPk deletes a OrderStatusChange by primary key
+	DeleteByPk(ctx context.Context, pk string) error
+
+	// FindByPk finds a OrderStatusChange by primary key
+	FindByPk(ctx context.Context, pk string) (*OrderStatusChange, error)
+
+	// CreateMany creates multiple OrderStatusChange records
+	CreateMany(ctx context.Context, models []*OrderStatusChange) error
+
+	// UpdateMany updates multiple OrderStatusChange records
+	UpdateMany(ctx context.Context, models []*OrderStatusChange) error
+
+	// DeleteManyByPks deletes multiple OrderStatusChange records by primary keys
+	DeleteManyByPks(ctx context.Context, pks []string) error
+
+	// FindOne finds a single OrderStatusChange with optional where clause and sort expression
+	FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*OrderStatusChange, error)
+
+	// FindAll finds all OrderStatusChange records with optional where clause and sort expression
+	FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*OrderStatusChange, error)
+
+	// FindPaginated finds OrderStatusChange records with pagination, optional where clause and sort expression
+	FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*OrderStatusChange, error)
+
+	// Count counts OrderStatusChange records with optional where clause
+	Count(ctx context.Context, where string, args ...interface{}) (int64, error)
+
+	// WithTransaction executes a function within a database transaction
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"context"
+	"ichibuy/order/internal/domain"
+)
+
+type Payment = domain.Payment
+
+type PaymentDAO interface {
+	// Create creates a new Payment
+	Create(ctx context.Context, m *Payment) error
+
+	// Update updates an existing Payment
+	Update(ctx context.Context, m *Payment) error
+
+	// PartialUpdate updates specific fields of a Payment
+	PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error
+
+	// DeleteByPk deletes a Payment by primary key
+	DeleteByPk(ctx context.Context, pk string) error
+
+	// FindByPk finds a Payment by primary key
+	FindByPk(ctx context.Context, pk string) (*Payment, error)
+
+	// CreateMany creates multiple Payment records
+	CreateMany(ctx context.Context, models []*Payment) error
+
+	// UpdateMany updates multiple Payment records
+	UpdateMany(ctx context.Context, models []*Payment) error
+
+	// DeleteManyByPks deletes multiple Payment records by primary keys
+	DeleteManyByPks(ctx context.Context, pks []string) error
+
+	// FindOne finds a single Payment with optional where clause and sort expression
+	FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*Payment, error)
+
+	// FindAll finds all Payment records with optional where clause and sort expression
+	FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*Payment, error)
+
+	// FindPaginated finds Payment records with pagination, optional where clause and sort expression
+	FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*Payment, error)
+
+	// Count counts Payment records with optional where clause
+	Count(ctx context.Context, where string, args ...interface{}) (int64, error)
+
+	// WithTransaction executes a function within a database transaction
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"context"
+	"ichibuy/order/internal/domain"
+)
+
+type Promotion = domain.Promotion
+
+type PromotionDAO interface {
+	// Create creates a new Promotion
+	Create(ctx context.Context, m *Promotion) error
+
+	// Update updates an existing Promotion
+	Update(ctx context.Context, m *Promotion) error
+
+	// PartialUpdate updates specific fields of a Promotion
+	PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error
+
+	// DeleteByPk deletes a Promotion by primary key
+	DeleteByPk(ctx context.Context, pk string) error
+
+	// FindByPk finds a Promotion by primary key
+	FindByPk(ctx context.Context, pk string) (*Promotion, error)
+
+	// CreateMany creates multiple Promotion records
+	CreateMany(ctx context.Context, models []*Promotion) error
+
+	// UpdateMany updates multiple Promotion records
+	UpdateMany(ctx context.Context, models []*Promotion) error
+
+	// DeleteManyByPks deletes multiple Promotion records by primary keys
+	DeleteManyByPks(ctx context.Context, pks []string) error
+
+	// FindOne finds a single Promotion with optional where clause and sort expression
+	FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*Promotion, error)
+
+	// FindAll finds all Promotion records with optional where clause and sort expression
+	FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*Promotion, error)
+
+	// FindPaginated finds Promotion records with pagination, optional where clause and sort expression
+	FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*Promotion, error)
+
+	// Count counts Promotion records with optional where clause
+	Count(ctx context.Context, where string, args ...interface{}) (int64, error)
+
+	// WithTransaction executes a function within a database transaction
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"context"
+	"ichibuy/order/internal/domain"
+)
+
+type PromotionRedemption = domain.PromotionRedemption
+
+type PromotionRedemptionDAO interface {
+	// Create creates a new PromotionRedemption
+	Create(ctx context.Context, m *PromotionRedemption) error
+
+	// Update updates an existing PromotionRedemption
+	Update(ctx context.Context, m *PromotionRedemption) error
+
+	// PartialUpdate updates specific fields of a PromotionRedemption
+	PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error
+
+	// DeleteByPk deletes a PromotionRedemption by primary key
+	DeleteByPk(ctx context.Context, pk string) error
+
+	// FindByPk finds a PromotionRedemption by primary key
+	FindByPk(ctx context.Context, pk string) (*PromotionRedemption, error)
+
+	// CreateMany creates multiple PromotionRedemption records
+	CreateMany(ctx context.Context, models []*PromotionRedemption) error
+
+	// UpdateMany updates multiple PromotionRedemption records
+	UpdateMany(ctx context.Context, models []*PromotionRedemption) error
+
+	// DeleteManyByPks deletes multiple PromotionRedemption records by primary keys
+	DeleteManyByPks(ctx context.Context, pks []string) error
+
+	// FindOne finds a single PromotionRedemption with optional where clause and sort expression
+	FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*PromotionRedemption, error)
+
+	// FindAll finds all PromotionRedemption records with optional where clause and sort expression
+	FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*PromotionRedemption, error)
+
+	// FindPaginated finds PromotionRedemption records with pagination, optional where clause and sort expression
+	FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*PromotionRedemption, error)
+
+	// Count counts PromotionRedemption records with optional where clause
+	Count(ctx context.Context, where string, args ...interface{}) (int64, error)
+
+	// WithTransaction executes a function within a database transaction
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
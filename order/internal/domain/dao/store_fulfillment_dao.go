@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"context"
+	"ichibuy/order/internal/domain"
+)
+
+type StoreFulfillment = domain.StoreFulfillment
+
+type StoreFulfillmentDAO interface {
+	// Create creates a new StoreFulfillment
+	Create(ctx context.Context, m *StoreFulfillment) error
+
+	// Update updates an existing StoreFulfillment
+	Update(ctx context.Context, m *StoreFulfillment) error
+
+	// PartialUpdate updates specific fields of a StoreFulfillment
+	PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error
+
+	// DeleteByPk deletes a StoreFulfillment by primary key
+	DeleteByPk(ctx context.Context, pk string) error
+
+	// FindByPk finds a StoreFulfillment by primary key
+	FindByPk(ctx context.Context, pk string) (*StoreFulfillment, error)
+
+	// CreateMany creates multiple StoreFulfillment records
+	CreateMany(ctx context.Context, models []*StoreFulfillment) error
+
+	// UpdateMany updates multiple StoreFulfillment records
+	UpdateMany(ctx context.Context, models []*StoreFulfillment) error
+
+	// DeleteManyByPks deletes multiple StoreFulfillment records by primary keys
+	DeleteManyByPks(ctx context.Context, pks []string) error
+
+	// FindOne finds a single StoreFulfillment with optional where clause and sort expression
+	FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*StoreFulfillment, error)
+
+	// FindAll finds all StoreFulfillment records with optional where clause and sort expression
+	FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*StoreFulfillment, error)
+
+	// FindPaginated finds StoreFulfillment records with pagination, optional where clause and sort expression
+	FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*StoreFulfillment, error)
+
+	// Count counts StoreFulfillment records with optional where clause
+	Count(ctx context.Context, where string, args ...interface{}) (int64, error)
+
+	// WithTransaction executes a function within a database transaction
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
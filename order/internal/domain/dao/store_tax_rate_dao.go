@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"context"
+	"ichibuy/order/internal/domain"
+)
+
+type StoreTaxRate = domain.StoreTaxRate
+
+type StoreTaxRateDAO interface {
+	// Create creates a new StoreTaxRate
+	Create(ctx context.Context, m *StoreTaxRate) error
+
+	// Update updates an existing StoreTaxRate
+	Update(ctx context.Context, m *StoreTaxRate) error
+
+	// PartialUpdate updates specific fields of a StoreTaxRate
+	PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error
+
+	// DeleteByPk deletes a StoreTaxRate by primary key
+	DeleteByPk(ctx context.Context, pk string) error
+
+	// FindByPk finds a StoreTaxRate by primary key
+	FindByPk(ctx context.Context, pk string) (*StoreTaxRate, error)
+
+	// CreateMany creates multiple StoreTaxRate records
+	CreateMany(ctx context.Context, models []*StoreTaxRate) error
+
+	// UpdateMany updates multiple StoreTaxRate records
+	UpdateMany(ctx context.Context, models []*StoreTaxRate) error
+
+	// DeleteManyByPks deletes multiple StoreTaxRate records by primary keys
+	DeleteManyByPks(ctx context.Context, pks []string) error
+
+	// FindOne finds a single StoreTaxRate with optional where clause and sort expression
+	FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*StoreTaxRate, error)
+
+	// FindAll finds all StoreTaxRate records with optional where clause and sort expression
+	FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*StoreTaxRate, error)
+
+	// FindPaginated finds StoreTaxRate records with pagination, optional where clause and sort expression
+	FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*StoreTaxRate, error)
+
+	// Count counts StoreTaxRate records with optional where clause
+	Count(ctx context.Context, where string, args ...interface{}) (int64, error)
+
+	// WithTransaction executes a function within a database transaction
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var countryCodeRegex = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// DeliveryAddress is where an order is delivered. Location is optional so the address can be geocoded
+// later, local deliveries need it to find the delivery zone.
+type DeliveryAddress struct {
+	Recipient  string    `json:"recipient"`
+	Phone      *string   `json:"phone"`
+	Line1      string    `json:"line1"`
+	Line2      *string   `json:"line2"`
+	City       string    `json:"city"`
+	Region     *string   `json:"region"`
+	PostalCode *string   `json:"postal_code"`
+	Country    string    `json:"country"` // ISO 3166-1 alpha-2
+	Location   *GeoPoint `json:"location"`
+	Notes      *string   `json:"notes"`
+}
+
+// Validate trims and normalizes the address and returns an error when it is not complete
+func (a *DeliveryAddress) Validate() error {
+	a.Recipient = strings.TrimSpace(a.Recipient)
+	a.Line1 = strings.TrimSpace(a.Line1)
+	a.City = strings.TrimSpace(a.City)
+	a.Country = strings.ToUpper(strings.TrimSpace(a.Country))
+
+	required := map[string]string{"recipient": a.Recipient, "line1": a.Line1, "city": a.City}
+	for name, value := range required {
+		if value == "" {
+			return fmt.Errorf("delivery address %s cannot be empty", name)
+		}
+
+		if len(value) > 200 {
+			return fmt.Errorf("delivery address %s cannot exceed 200 characters", name)
+		}
+	}
+
+	if !countryCodeRegex.MatchString(a.Country) {
+		return fmt.Errorf("delivery address country must be an ISO 3166-1 alpha-2 code")
+	}
+
+	if a.Notes != nil && len(*a.Notes) > 500 {
+		return fmt.Errorf("delivery address notes cannot exceed 500 characters")
+	}
+
+	if a.Location != nil {
+		location, err := NewGeoPoint(a.Location.Lat, a.Location.Lng)
+		if err != nil {
+			return err
+		}
+		a.Location = &location
+	}
+
+	return nil
+}
+
+// GeocodingQuery returns the address in one line, as expected by geocoding providers
+func (a DeliveryAddress) GeocodingQuery() string {
+	parts := []string{a.Line1}
+	for _, part := range []*string{a.Line2, &a.City, a.Region, a.PostalCode, &a.Country} {
+		if part != nil && strings.TrimSpace(*part) != "" {
+			parts = append(parts, strings.TrimSpace(*part))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Scan reads a delivery address stored as a json column
+func (a *DeliveryAddress) Scan(src any) error {
+	switch v := src.(type) {
+	case []byte:
+		return json.Unmarshal(v, a)
+	case string:
+		return json.Unmarshal([]byte(v), a)
+	default:
+		return fmt.Errorf("cannot scan %T into delivery address", src)
+	}
+}
+
+// Value stores a delivery address as a json column
+func (a DeliveryAddress) Value() (driver.Value, error) {
+	return json.Marshal(a)
+}
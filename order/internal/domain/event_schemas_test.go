@@ -0,0 +1,52 @@
+package domain_test
+
+import (
+	"testing"
+
+	"ichibuy/order/internal/domain"
+)
+
+func TestEventSchemas_Validate(t *testing.T) {
+	order := newPayableOrder(domain.CreatedOrderStatus)
+	if err := order.Accept("change-1", "owner-1"); err != nil {
+		t.Fatal(err)
+	}
+
+	payment, err := domain.NewPayment("payment-1", newPayableOrder(domain.CreatedOrderStatus), "fake", "fake_pi_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := payment.Succeed(); err != nil {
+		t.Fatal(err)
+	}
+	if err := payment.RequestRefund(); err != nil {
+		t.Fatal(err)
+	}
+	if err := payment.Refund(); err != nil {
+		t.Fatal(err)
+	}
+
+	events := append(order.PullEvents(), payment.PullEvents()...)
+	if len(events) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(events))
+	}
+
+	if events[0].AggregateType != domain.OrderAggregate || events[0].AggregateID != "order-1" {
+		t.Errorf("unexpected aggregate %s %s", events[0].AggregateType, events[0].AggregateID)
+	}
+	if events[1].AggregateType != domain.PaymentAggregate || events[1].AggregateID != "payment-1" {
+		t.Errorf("unexpected aggregate %s %s", events[1].AggregateType, events[1].AggregateID)
+	}
+
+	for _, event := range events {
+		if err := domain.EventSchemas.Validate(event); err != nil {
+			t.Errorf("expected %s to match its schema: %v", event.Type, err)
+		}
+	}
+
+	invalid := events[0]
+	invalid.Data = []byte(`{"id": "order-1", "store_id": 10}`)
+	if err := domain.EventSchemas.Validate(invalid); err == nil {
+		t.Error("expected an error for data not matching the schema")
+	}
+}
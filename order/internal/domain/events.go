@@ -4,19 +4,63 @@ import (
 	"context"
 	"encoding/json"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 type EventType string
 
 const (
-	OrderCreated EventType = "OrderCreated"
+	OrderCreated       EventType = "OrderCreated"
+	OrderAccepted      EventType = "OrderAccepted"
+	OrderPaid          EventType = "OrderPaid"
+	OrderPaymentFailed EventType = "OrderPaymentFailed"
+	OrderCanceled      EventType = "OrderCanceled"
+	OrderRejected      EventType = "OrderRejected"
+	// OrderFulfillmentUpdated is published when the delivery or pickup of an order progresses
+	OrderFulfillmentUpdated EventType = "OrderFulfillmentUpdated"
+	CouponRedeemed          EventType = "CouponRedeemed"
+	PaymentRefunded         EventType = "PaymentRefunded"
+)
+
+type AggregateType string
+
+const (
+	OrderAggregate     AggregateType = "order"
+	PromotionAggregate AggregateType = "promotion"
+	PaymentAggregate   AggregateType = "payment"
 )
 
+// Event is the envelope of the events published by the services. AggregateVersion counts the events of
+// the aggregate from 1, SchemaVersion is the version of the data schema in the schema registry, and the
+// correlation and causation IDs link the event to the request or event that caused it.
 type Event struct {
-	ID        string          `json:"id" sql:"id,primary"`
-	Type      EventType       `json:"type" sql:"type"`
-	Data      json.RawMessage `json:"data" sql:"data"`
-	Timestamp time.Time       `json:"timestamp" sql:"timestamp"`
+	ID               string          `json:"id" sql:"id,primary"`
+	Type             EventType       `json:"type" sql:"type"`
+	SchemaVersion    int             `json:"schema_version" sql:"schema_version"`
+	AggregateType    AggregateType   `json:"aggregate_type" sql:"aggregate_type"`
+	AggregateID      string          `json:"aggregate_id" sql:"aggregate_id"`
+	AggregateVersion int             `json:"aggregate_version" sql:"aggregate_version"`
+	Producer         string          `json:"producer" sql:"producer"`
+	CorrelationID    *string         `json:"correlation_id" sql:"correlation_id"`
+	CausationID      *string         `json:"causation_id" sql:"causation_id"`
+	Data             json.RawMessage `json:"data" sql:"data"`
+	Timestamp        time.Time       `json:"timestamp" sql:"timestamp"`
+}
+
+// NewEvent returns an event of the aggregate with a time ordered UUID, published with the last schema
+// version of its type. The event bus sets the aggregate version, producer and correlation on publish.
+func NewEvent(eventType EventType, aggregateType AggregateType, aggregateID string, data any, timestamp time.Time) Event {
+	raw, _ := json.Marshal(data)
+	return Event{
+		ID:            uuid.Must(uuid.NewV7()).String(),
+		Type:          eventType,
+		SchemaVersion: EventSchemas.LatestVersion(eventType),
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		Data:          raw,
+		Timestamp:     timestamp,
+	}
 }
 
 func (e *Event) TableName() string {
@@ -26,3 +70,47 @@ func (e *Event) TableName() string {
 type EventBus interface {
 	Publish(ctx context.Context, events ...Event) error
 }
+
+// MessageBroker carries the events between the services. Every consumer group receives the events of its
+// types, shared among the subscribers of the group.
+type MessageBroker interface {
+	EventBus
+	// Subscribe hands the events of the given types to handle until the context is done. An event is
+	// acknowledged when handle returns nil, and redelivered later to the group when it returns an error,
+	// so handle must be idempotent.
+	Subscribe(ctx context.Context, group string, types []EventType, handle func(ctx context.Context, event Event) error) error
+	Close() error
+}
+
+// EventsService reads the events feed of another service
+type EventsService interface {
+	// FindEventsAfter returns the events of the given types after the event with the given ID, from the
+	// first one when it is empty
+	FindEventsAfter(ctx context.Context, after string, types []EventType, limit int) ([]Event, error)
+}
+
+// OrderEventData is the data of the order events, with the status change that produced them
+type OrderEventData struct {
+	*Order
+	StatusChange *OrderStatusChange `json:"status_change"`
+}
+
+type CouponRedeemedEventData struct {
+	PromotionID string    `json:"promotion_id"`
+	Code        string    `json:"code"`
+	StoreID     string    `json:"store_id"`
+	OrderID     string    `json:"order_id"`
+	CustomerID  string    `json:"customer_id"`
+	Discount    Money     `json:"discount"`
+	RedeemedAt  time.Time `json:"redeemed_at"`
+}
+
+type PaymentEventData struct {
+	PaymentID         string        `json:"payment_id"`
+	OrderID           string        `json:"order_id"`
+	Amount            Money         `json:"amount"`
+	Status            PaymentStatus `json:"status"`
+	Provider          string        `json:"provider"`
+	ProviderReference string        `json:"provider_reference"`
+	FailureReason     *string       `json:"failure_reason"`
+}
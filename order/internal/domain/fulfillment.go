@@ -0,0 +1,137 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+type FulfillmentMethod string
+
+const (
+	PickupFulfillmentMethod        FulfillmentMethod = "pickup"
+	LocalDeliveryFulfillmentMethod FulfillmentMethod = "local_delivery"
+	ShippingFulfillmentMethod      FulfillmentMethod = "shipping"
+)
+
+func ParseFulfillmentMethod(value string) (FulfillmentMethod, error) {
+	switch method := FulfillmentMethod(value); method {
+	case PickupFulfillmentMethod, LocalDeliveryFulfillmentMethod, ShippingFulfillmentMethod:
+		return method, nil
+	default:
+		return "", fmt.Errorf("invalid fulfillment method %s", value)
+	}
+}
+
+// IsDelivery tells if the method needs a delivery address
+func (m FulfillmentMethod) IsDelivery() bool {
+	return m == LocalDeliveryFulfillmentMethod || m == ShippingFulfillmentMethod
+}
+
+// FulfillmentStatus is the progress of the delivery or pickup of an order, tracked apart from its OrderStatus
+type FulfillmentStatus string
+
+const (
+	PendingFulfillmentStatus   FulfillmentStatus = "pending"
+	ReadyFulfillmentStatus     FulfillmentStatus = "ready"
+	InTransitFulfillmentStatus FulfillmentStatus = "in_transit"
+	DeliveredFulfillmentStatus FulfillmentStatus = "delivered"
+)
+
+// fulfillmentSteps are the statuses each method goes through in order, pickups are delivered at the store
+var fulfillmentSteps = map[FulfillmentMethod][]FulfillmentStatus{
+	PickupFulfillmentMethod:        {PendingFulfillmentStatus, ReadyFulfillmentStatus, DeliveredFulfillmentStatus},
+	LocalDeliveryFulfillmentMethod: {PendingFulfillmentStatus, ReadyFulfillmentStatus, InTransitFulfillmentStatus, DeliveredFulfillmentStatus},
+	ShippingFulfillmentMethod:      {PendingFulfillmentStatus, ReadyFulfillmentStatus, InTransitFulfillmentStatus, DeliveredFulfillmentStatus},
+}
+
+// ErrOutsideDeliveryZones is returned when the delivery address is not inside any delivery zone of the store
+var ErrOutsideDeliveryZones = errors.New("delivery address is outside the delivery zones of the store")
+
+type DeliveryZoneType string
+
+const (
+	RadiusDeliveryZoneType  DeliveryZoneType = "radius"
+	PolygonDeliveryZoneType DeliveryZoneType = "polygon"
+)
+
+// DeliveryZone is an area served by local delivery, a radius around the store or a polygon.
+// Its fee is BaseFee plus FeePerKm for every kilometer between the store and the address.
+type DeliveryZone struct {
+	Name     string           `json:"name"`
+	Type     DeliveryZoneType `json:"type"`
+	RadiusKm float64          `json:"radius_km,omitempty"`
+	Polygon  []GeoPoint       `json:"polygon,omitempty"`
+	BaseFee  Money            `json:"base_fee"`
+	FeePerKm Money            `json:"fee_per_km"`
+}
+
+func (z DeliveryZone) validate() error {
+	if z.Name == "" {
+		return fmt.Errorf("delivery zone name cannot be empty")
+	}
+
+	switch z.Type {
+	case RadiusDeliveryZoneType:
+		if z.RadiusKm <= 0 {
+			return fmt.Errorf("delivery zone %s radius must be greater than 0", z.Name)
+		}
+	case PolygonDeliveryZoneType:
+		if len(z.Polygon) < 3 {
+			return fmt.Errorf("delivery zone %s polygon needs at least 3 points", z.Name)
+		}
+
+		for _, point := range z.Polygon {
+			if _, err := NewGeoPoint(point.Lat, point.Lng); err != nil {
+				return fmt.Errorf("delivery zone %s: %w", z.Name, err)
+			}
+		}
+	default:
+		return fmt.Errorf("invalid delivery zone type %s", z.Type)
+	}
+
+	if _, err := NewMoney(z.BaseFee.GetAmount(), z.BaseFee.GetCurrency()); err != nil {
+		return fmt.Errorf("delivery zone %s base fee: %w", z.Name, err)
+	}
+
+	if z.FeePerKm.GetCurrency() != z.BaseFee.GetCurrency() {
+		return fmt.Errorf("delivery zone %s fees must have the same currency", z.Name)
+	}
+
+	if z.BaseFee.GetAmount() < 0 || z.FeePerKm.GetAmount() < 0 {
+		return fmt.Errorf("delivery zone %s fees cannot be negative", z.Name)
+	}
+
+	return nil
+}
+
+// Contains tells if the address location is inside the zone of the store
+func (z DeliveryZone) Contains(store, location GeoPoint) bool {
+	switch z.Type {
+	case RadiusDeliveryZoneType:
+		return store.DistanceKm(location) <= z.RadiusKm
+	case PolygonDeliveryZoneType:
+		return location.InPolygon(z.Polygon)
+	default:
+		return false
+	}
+}
+
+// Fee returns the delivery fee for the distance, the per kilometer part is charged by the meter
+func (z DeliveryZone) Fee(distanceKm float64) (Money, error) {
+	distanceFee, err := z.FeePerKm.Scale(int(math.Round(distanceKm*1000)), 1000)
+	if err != nil {
+		return Money{}, err
+	}
+	return z.BaseFee.Add(distanceFee)
+}
+
+// OrderFulfillment is how an order reaches the customer and what it costs
+type OrderFulfillment struct {
+	Method          FulfillmentMethod
+	DeliveryAddress *DeliveryAddress
+	DeliveryFee     Money
+}
+
+// PickupFulfillment is used for orders placed without fulfillment details
+var PickupFulfillment = OrderFulfillment{Method: PickupFulfillmentMethod}
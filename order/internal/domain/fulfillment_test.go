@@ -0,0 +1,116 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+
+	"ichibuy/order/internal/domain"
+)
+
+func TestFulfillmentOptions_Quote(t *testing.T) {
+	store := domain.GeoPoint{Lat: -12.0464, Lng: -77.0428}
+	options := domain.FulfillmentOptions{
+		Methods: []domain.FulfillmentMethod{domain.PickupFulfillmentMethod, domain.LocalDeliveryFulfillmentMethod, domain.ShippingFulfillmentMethod},
+		DeliveryZones: []domain.DeliveryZone{
+			{
+				Name:     "Downtown",
+				Type:     domain.RadiusDeliveryZoneType,
+				RadiusKm: 3,
+				BaseFee:  domain.Money{Amount: 500, Currency: "PEN"},
+				FeePerKm: domain.Money{Amount: 100, Currency: "PEN"},
+			},
+			{
+				Name:     "Airport",
+				Type:     domain.PolygonDeliveryZoneType,
+				Polygon:  []domain.GeoPoint{{Lat: -12.00, Lng: -77.13}, {Lat: -12.00, Lng: -77.10}, {Lat: -12.04, Lng: -77.10}, {Lat: -12.04, Lng: -77.13}},
+				BaseFee:  domain.Money{Amount: 1500, Currency: "PEN"},
+				FeePerKm: domain.Money{Amount: 0, Currency: "PEN"},
+			},
+		},
+		ShippingFee: &domain.Money{Amount: 2000, Currency: "PEN"},
+	}
+
+	address := func(lat, lng float64) *domain.DeliveryAddress {
+		return &domain.DeliveryAddress{
+			Recipient: "Ana",
+			Line1:     "Av. Abancay 123",
+			City:      "Lima",
+			Country:   "pe",
+			Location:  &domain.GeoPoint{Lat: lat, Lng: lng},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		method      domain.FulfillmentMethod
+		address     *domain.DeliveryAddress
+		expectedFee int
+	}{
+		{"pickup", domain.PickupFulfillmentMethod, nil, 0},
+		{"radius charges per km", domain.LocalDeliveryFulfillmentMethod, address(-12.0464, -77.0336), 600},
+		{"polygon", domain.LocalDeliveryFulfillmentMethod, address(-12.02, -77.11), 1500},
+		{"shipping", domain.ShippingFulfillmentMethod, &domain.DeliveryAddress{Recipient: "Ana", Line1: "Calle 1", City: "Cusco", Country: "PE"}, 2000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fulfillment, err := options.Quote(tt.method, tt.address, store, "PEN")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if fulfillment.DeliveryFee.Amount != tt.expectedFee || fulfillment.DeliveryFee.Currency != "PEN" {
+				t.Fatalf("expected fee %d, got %v", tt.expectedFee, fulfillment.DeliveryFee)
+			}
+		})
+	}
+
+	if _, err := options.Quote(domain.LocalDeliveryFulfillmentMethod, address(-11.5, -77.0), store, "PEN"); !errors.Is(err, domain.ErrOutsideDeliveryZones) {
+		t.Fatalf("expected outside delivery zones error, got %v", err)
+	}
+
+	if _, err := options.Quote(domain.ShippingFulfillmentMethod, nil, store, "PEN"); err == nil {
+		t.Fatal("expected missing address error")
+	}
+
+	if _, err := domain.DefaultFulfillmentOptions.Quote(domain.ShippingFulfillmentMethod, address(-12.0464, -77.0336), store, "PEN"); err == nil {
+		t.Fatal("expected method not offered error")
+	}
+}
+
+func TestOrder_UpdateFulfillment(t *testing.T) {
+	order := &domain.Order{
+		CurrentStatus:     domain.PaidOrderStatus,
+		FulfillmentMethod: domain.PickupFulfillmentMethod,
+		FulfillmentStatus: domain.PendingFulfillmentStatus,
+	}
+
+	if err := order.UpdateFulfillment(domain.InTransitFulfillmentStatus); err == nil {
+		t.Fatal("expected pickups not to go in transit")
+	}
+
+	if err := order.UpdateFulfillment(domain.ReadyFulfillmentStatus); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := order.UpdateFulfillment(domain.PendingFulfillmentStatus); err == nil {
+		t.Fatal("expected fulfillment not to move back")
+	}
+
+	if err := order.UpdateFulfillment(domain.DeliveredFulfillmentStatus); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(order.PullEvents()) != 2 {
+		t.Fatal("expected an event for every fulfillment update")
+	}
+
+	canceled := &domain.Order{
+		CurrentStatus:     domain.CanceledOrderStatus,
+		FulfillmentMethod: domain.ShippingFulfillmentMethod,
+		FulfillmentStatus: domain.PendingFulfillmentStatus,
+	}
+	if err := canceled.UpdateFulfillment(domain.ReadyFulfillmentStatus); err == nil {
+		t.Fatal("expected canceled orders not to be fulfilled")
+	}
+}
@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+)
+
+const earthRadiusKm = 6371.0
+
+// GeoPoint is a position in decimal degrees
+type GeoPoint struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+}
+
+func NewGeoPoint(lat, lng float64) (GeoPoint, error) {
+	if lat < -90 || lat > 90 {
+		return GeoPoint{}, fmt.Errorf("latitude must be between -90 and 90")
+	}
+
+	if lng < -180 || lng > 180 {
+		return GeoPoint{}, fmt.Errorf("longitude must be between -180 and 180")
+	}
+
+	return GeoPoint{Lat: lat, Lng: lng}, nil
+}
+
+// DistanceKm returns the great-circle distance between both points using the haversine formula
+func (p GeoPoint) DistanceKm(other GeoPoint) float64 {
+	lat1, lat2 := toRadians(p.Lat), toRadians(other.Lat)
+	dLat := lat2 - lat1
+	dLng := toRadians(other.Lng - p.Lng)
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// InPolygon tells if the point is inside the polygon using ray casting, which is accurate enough for
+// the size of a delivery zone
+func (p GeoPoint) InPolygon(polygon []GeoPoint) bool {
+	inside := false
+	for i, j := 0, len(polygon)-1; i < len(polygon); j, i = i, i+1 {
+		a, b := polygon[i], polygon[j]
+		if (a.Lat > p.Lat) != (b.Lat > p.Lat) &&
+			p.Lng < (b.Lng-a.Lng)*(p.Lat-a.Lat)/(b.Lat-a.Lat)+a.Lng {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+func toRadians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
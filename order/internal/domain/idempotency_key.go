@@ -0,0 +1,181 @@
+package domain
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrIdempotencyKeyReused is returned when a key is sent again with a different request
+	ErrIdempotencyKeyReused = errors.New("idempotency key already used with a different request")
+	// ErrIdempotencyKeyInProgress is returned when a key is sent again while its first request is still running
+	ErrIdempotencyKeyInProgress = errors.New("a request with the same idempotency key is in progress")
+	// ErrIdempotencyKeyAbandoned is returned when the request holding a key stopped before completing it,
+	// e.g. the server crashed, so a retry can take the key over
+	ErrIdempotencyKeyAbandoned = errors.New("the request of the idempotency key was abandoned")
+)
+
+type IdempotencyKeyStatus string
+
+const (
+	ProcessingIdempotencyKeyStatus IdempotencyKeyStatus = "processing"
+	CompletedIdempotencyKeyStatus  IdempotencyKeyStatus = "completed"
+)
+
+const maxIdempotencyKeyLength = 255
+
+// IdempotencyKey records the response of a request sent with an Idempotency-Key header, so retries
+// of the same request get the same response instead of running it again. A processing key is held by
+// its request until LockedUntil, after that a retry takes it over.
+type IdempotencyKey struct {
+	ID             string               `sql:"id,primary"`
+	UserID         string               `sql:"user_id"`
+	Key            string               `sql:"key"`
+	Method         string               `sql:"method"`
+	Path           string               `sql:"path"`
+	Fingerprint    string               `sql:"fingerprint"`
+	Status         IdempotencyKeyStatus `sql:"status"`
+	ResponseStatus int                  `sql:"response_status"`
+	ResponseBody   string               `sql:"response_body"`
+	LockedUntil    time.Time            `sql:"locked_until"`
+	ExpiresAt      time.Time            `sql:"expires_at"`
+	CreatedAt      time.Time            `sql:"created_at"`
+	UpdatedAt      time.Time            `sql:"updated_at"`
+}
+
+// NewIdempotencyKey holds the key for the request for the lease, path includes the query of the request
+// and fingerprint is the RequestFingerprint of the request
+func NewIdempotencyKey(userID, key, method, path, fingerprint string, ttl, lease time.Duration) (*IdempotencyKey, error) {
+	if strings.TrimSpace(key) == "" {
+		return nil, fmt.Errorf("idempotency key cannot be empty")
+	}
+
+	if len(key) > maxIdempotencyKeyLength {
+		return nil, fmt.Errorf("idempotency key cannot exceed %d characters", maxIdempotencyKeyLength)
+	}
+
+	now := time.Now().UTC()
+	return &IdempotencyKey{
+		ID:          IdempotencyKeyID(userID, key, method, path),
+		UserID:      userID,
+		Key:         key,
+		Method:      method,
+		Path:        path,
+		Fingerprint: fingerprint,
+		Status:      ProcessingIdempotencyKeyStatus,
+		LockedUntil: now.Add(lease),
+		ExpiresAt:   now.Add(ttl),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+// IdempotencyKeyID scopes the key to the user and the endpoint
+func IdempotencyKeyID(userID, key, method, path string) string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{userID, method, path, key}, "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+// RequestFingerprint identifies the request by its path with the query and its body. Multipart bodies
+// are identified by their fields and the hashes of their files, since the boundary changes on every retry.
+func RequestFingerprint(path, contentType string, body []byte) string {
+	content, ok := multipartFingerprint(contentType, body)
+	if !ok {
+		content = body
+	}
+
+	sum := sha256.Sum256(append([]byte(path+"\n"), content...))
+	return hex.EncodeToString(sum[:])
+}
+
+// multipartFingerprint lists the name, file name and content hash of every part of a multipart body,
+// it returns false when the body is not multipart or cannot be parsed
+func multipartFingerprint(contentType string, body []byte) ([]byte, bool) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil || !strings.HasPrefix(mediaType, "multipart/") {
+		return nil, false
+	}
+
+	reader := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+	var fingerprint bytes.Buffer
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			return fingerprint.Bytes(), true
+		}
+		if err != nil {
+			return nil, false
+		}
+
+		content, err := io.ReadAll(part)
+		if err != nil {
+			return nil, false
+		}
+
+		sum := sha256.Sum256(content)
+		fmt.Fprintf(&fingerprint, "%s\n%s\n%s\n", part.FormName(), part.FileName(), hex.EncodeToString(sum[:]))
+	}
+}
+
+// CheckReplay returns an error when the stored response cannot be replayed for the request with the
+// fingerprint. A processing key whose lease ended returns ErrIdempotencyKeyAbandoned.
+func (k *IdempotencyKey) CheckReplay(fingerprint string, now time.Time) error {
+	if k.Fingerprint != fingerprint {
+		return ErrIdempotencyKeyReused
+	}
+
+	if k.Status == CompletedIdempotencyKeyStatus {
+		return nil
+	}
+
+	if now.Before(k.LockedUntil) {
+		return ErrIdempotencyKeyInProgress
+	}
+
+	return ErrIdempotencyKeyAbandoned
+}
+
+// TakeOver holds the key abandoned by its request for the request retrying it
+func (k *IdempotencyKey) TakeOver(now time.Time, lease time.Duration) error {
+	if k.Status != ProcessingIdempotencyKeyStatus || now.Before(k.LockedUntil) {
+		return ErrIdempotencyKeyInProgress
+	}
+
+	k.LockedUntil = now.Add(lease)
+	k.UpdatedAt = now
+	return nil
+}
+
+// Renew extends the lease of the key while its request is still running
+func (k *IdempotencyKey) Renew(now time.Time, lease time.Duration) {
+	k.LockedUntil = now.Add(lease)
+	k.UpdatedAt = now
+}
+
+func (k *IdempotencyKey) Complete(responseStatus int, responseBody string) {
+	k.Status = CompletedIdempotencyKeyStatus
+	k.ResponseStatus = responseStatus
+	k.ResponseBody = responseBody
+	k.UpdatedAt = time.Now().UTC()
+}
+
+func (k *IdempotencyKey) IsExpired(now time.Time) bool {
+	return !now.Before(k.ExpiresAt)
+}
+
+func (k *IdempotencyKey) GetID() string                   { return k.ID }
+func (k *IdempotencyKey) GetStatus() IdempotencyKeyStatus { return k.Status }
+func (k *IdempotencyKey) GetResponseStatus() int          { return k.ResponseStatus }
+func (k *IdempotencyKey) GetResponseBody() string         { return k.ResponseBody }
+
+func (k *IdempotencyKey) TableName() string {
+	return "idempotency_keys"
+}
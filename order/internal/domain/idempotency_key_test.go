@@ -0,0 +1,128 @@
+package domain_test
+
+import (
+	"bytes"
+	"errors"
+	"mime/multipart"
+	"testing"
+	"time"
+
+	"ichibuy/order/internal/domain"
+)
+
+func TestIdempotencyKey_CheckReplay(t *testing.T) {
+	path := "/api/v1/orders"
+	fingerprint := domain.RequestFingerprint(path, "application/json", []byte(`{"coupon_code":"WELCOME10"}`))
+
+	key, err := domain.NewIdempotencyKey("user-1", "key-1", "POST", path, fingerprint, time.Hour, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := key.CheckReplay(fingerprint, time.Now().UTC()); !errors.Is(err, domain.ErrIdempotencyKeyInProgress) {
+		t.Fatalf("expected in progress error, got %v", err)
+	}
+
+	key.Complete(201, `{"id":"order-1"}`)
+
+	if err := key.CheckReplay(fingerprint, time.Now().UTC()); err != nil {
+		t.Fatalf("expected replay, got %v", err)
+	}
+
+	if err := key.CheckReplay(domain.RequestFingerprint(path, "application/json", []byte(`{"coupon_code":"OTHER"}`)), time.Now().UTC()); !errors.Is(err, domain.ErrIdempotencyKeyReused) {
+		t.Fatalf("expected reused error, got %v", err)
+	}
+
+	if err := key.CheckReplay(domain.RequestFingerprint(path+"?store_id=store-2", "application/json", []byte(`{"coupon_code":"WELCOME10"}`)), time.Now().UTC()); !errors.Is(err, domain.ErrIdempotencyKeyReused) {
+		t.Fatalf("expected reused error for another query, got %v", err)
+	}
+
+	if key.IsExpired(time.Now().UTC()) || !key.IsExpired(time.Now().UTC().Add(2*time.Hour)) {
+		t.Fatal("unexpected expiration")
+	}
+}
+
+func TestIdempotencyKey_TakeOver(t *testing.T) {
+	fingerprint := domain.RequestFingerprint("/api/v1/orders/order-1/payments", "application/json", []byte(`{}`))
+	now := time.Now().UTC()
+
+	key, err := domain.NewIdempotencyKey("user-1", "key-1", "POST", "/api/v1/orders/order-1/payments", fingerprint, time.Hour, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := key.TakeOver(now, time.Minute); !errors.Is(err, domain.ErrIdempotencyKeyInProgress) {
+		t.Fatalf("expected a held key not to be taken over, got %v", err)
+	}
+
+	// the request holding the key never completed it
+	later := now.Add(2 * time.Minute)
+	if err := key.CheckReplay(fingerprint, later); !errors.Is(err, domain.ErrIdempotencyKeyAbandoned) {
+		t.Fatalf("expected abandoned error, got %v", err)
+	}
+
+	if err := key.TakeOver(later, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := key.CheckReplay(fingerprint, later); !errors.Is(err, domain.ErrIdempotencyKeyInProgress) {
+		t.Fatalf("expected the key held again, got %v", err)
+	}
+
+	// the request is still running when its lease ends
+	key.Renew(later.Add(50*time.Second), time.Minute)
+	if err := key.CheckReplay(fingerprint, later.Add(90*time.Second)); !errors.Is(err, domain.ErrIdempotencyKeyInProgress) {
+		t.Fatalf("expected the renewed key held, got %v", err)
+	}
+
+	key.Complete(201, `{"id":"payment-1"}`)
+	if err := key.TakeOver(later.Add(time.Hour), time.Minute); err == nil {
+		t.Fatal("expected a completed key not to be taken over")
+	}
+}
+
+func TestRequestFingerprint_Multipart(t *testing.T) {
+	path := "/api/v1/products"
+
+	form := func(name string, image []byte) (string, []byte) {
+		var body bytes.Buffer
+		writer := multipart.NewWriter(&body)
+		_ = writer.WriteField("name", name)
+		file, _ := writer.CreateFormFile("images", "coffee.png")
+		_, _ = file.Write(image)
+		_ = writer.Close()
+		return writer.FormDataContentType(), body.Bytes()
+	}
+
+	contentType, body := form("Coffee", []byte("image-1"))
+	fingerprint := domain.RequestFingerprint(path, contentType, body)
+
+	// a retry is sent with a new boundary
+	if retried, retriedBody := form("Coffee", []byte("image-1")); domain.RequestFingerprint(path, retried, retriedBody) != fingerprint {
+		t.Fatal("expected the same fingerprint for the same form with another boundary")
+	}
+
+	if other, otherBody := form("Coffee", []byte("image-2")); domain.RequestFingerprint(path, other, otherBody) == fingerprint {
+		t.Fatal("expected another fingerprint for another file")
+	}
+
+	if other, otherBody := form("Tea", []byte("image-1")); domain.RequestFingerprint(path, other, otherBody) == fingerprint {
+		t.Fatal("expected another fingerprint for another field")
+	}
+}
+
+func TestIdempotencyKeyID_ScopedToUserAndEndpoint(t *testing.T) {
+	id := domain.IdempotencyKeyID("user-1", "key-1", "POST", "/api/v1/orders")
+
+	if id == domain.IdempotencyKeyID("user-2", "key-1", "POST", "/api/v1/orders") {
+		t.Fatal("expected different id for another user")
+	}
+
+	if id == domain.IdempotencyKeyID("user-1", "key-1", "POST", "/api/v1/checkouts") {
+		t.Fatal("expected different id for another endpoint")
+	}
+
+	if id == domain.IdempotencyKeyID("user-1", "key-1", "POST", "/api/v1/orders/order-2/cancel") {
+		t.Fatal("expected different id for another order")
+	}
+}
@@ -1,14 +1,15 @@
 package domain
 
-import "fmt"
-
-var validCurrencies = map[string]bool{
-	"USD": true,
-	"PEN": true,
-}
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+)
 
 type Money struct {
-	Amount   int    `json:"amount"` // cents
+	Amount   int    `json:"amount"` // minor units of the currency
 	Currency string `json:"currency"`
 }
 
@@ -17,7 +18,7 @@ func NewMoney(amount int, currency string) (Money, error) {
 		return Money{}, fmt.Errorf("amount cannot be negative")
 	}
 
-	if !validCurrencies[currency] {
+	if !IsValidCurrency(currency) {
 		return Money{}, fmt.Errorf("invalid currency")
 	}
 
@@ -34,3 +35,134 @@ func (m Money) GetAmount() int {
 func (m Money) GetCurrency() string {
 	return m.Currency
 }
+
+// Add returns the sum of both amounts, which must share the same currency
+func (m Money) Add(other Money) (Money, error) {
+	if m.Currency != other.Currency {
+		return Money{}, fmt.Errorf("cannot add %s to %s", other.Currency, m.Currency)
+	}
+
+	if other.Amount > 0 && m.Amount > math.MaxInt-other.Amount {
+		return Money{}, fmt.Errorf("amount overflow")
+	}
+
+	if other.Amount < 0 && m.Amount < math.MinInt-other.Amount {
+		return Money{}, fmt.Errorf("amount overflow")
+	}
+
+	return Money{Amount: m.Amount + other.Amount, Currency: m.Currency}, nil
+}
+
+// Multiply returns the amount multiplied by factor, e.g. a unit price by a quantity
+func (m Money) Multiply(factor int) (Money, error) {
+	if m.Amount == 0 || factor == 0 {
+		return Money{Amount: 0, Currency: m.Currency}, nil
+	}
+
+	result := m.Amount * factor
+	if result/factor != m.Amount || (m.Amount == -1 && factor == math.MinInt) || (factor == -1 && m.Amount == math.MinInt) {
+		return Money{}, fmt.Errorf("amount overflow")
+	}
+
+	return Money{Amount: result, Currency: m.Currency}, nil
+}
+
+// Allocate splits the amount proportionally to ratios without losing minor units.
+// The remainder is spread one unit at a time starting with the first share.
+func (m Money) Allocate(ratios ...int) ([]Money, error) {
+	if m.Amount < 0 {
+		return nil, fmt.Errorf("cannot allocate a negative amount")
+	}
+
+	if len(ratios) == 0 {
+		return nil, fmt.Errorf("at least one ratio is required")
+	}
+
+	total := 0
+	for _, ratio := range ratios {
+		if ratio < 0 {
+			return nil, fmt.Errorf("ratios cannot be negative")
+		}
+		if total > math.MaxInt-ratio {
+			return nil, fmt.Errorf("ratio overflow")
+		}
+		total += ratio
+	}
+
+	if total == 0 {
+		return nil, fmt.Errorf("ratios cannot sum zero")
+	}
+
+	shares := make([]Money, len(ratios))
+	remainder := m.Amount
+	for i, ratio := range ratios {
+		share, err := m.Multiply(ratio)
+		if err != nil {
+			return nil, err
+		}
+		shares[i] = Money{Amount: share.Amount / total, Currency: m.Currency}
+		remainder -= shares[i].Amount
+	}
+
+	for i := 0; remainder > 0; i = (i + 1) % len(shares) {
+		if ratios[i] == 0 {
+			continue
+		}
+		shares[i].Amount++
+		remainder--
+	}
+
+	return shares, nil
+}
+
+// Scale returns the amount multiplied by numerator/denominator, rounding half away from zero
+func (m Money) Scale(numerator, denominator int) (Money, error) {
+	if denominator <= 0 {
+		return Money{}, fmt.Errorf("denominator must be greater than 0")
+	}
+
+	value := new(big.Int).Mul(big.NewInt(int64(m.Amount)), big.NewInt(int64(numerator)))
+	quotient, remainder := new(big.Int).QuoRem(value, big.NewInt(int64(denominator)), new(big.Int))
+
+	if new(big.Int).Mul(new(big.Int).Abs(remainder), big.NewInt(2)).Cmp(big.NewInt(int64(denominator))) >= 0 {
+		quotient.Add(quotient, big.NewInt(int64(value.Sign())))
+	}
+
+	if !quotient.IsInt64() || quotient.Int64() > math.MaxInt || quotient.Int64() < math.MinInt {
+		return Money{}, fmt.Errorf("amount overflow")
+	}
+
+	return Money{Amount: int(quotient.Int64()), Currency: m.Currency}, nil
+}
+
+// Subtract returns the difference of both amounts, which must share the same currency
+func (m Money) Subtract(other Money) (Money, error) {
+	if other.Amount == math.MinInt {
+		return Money{}, fmt.Errorf("amount overflow")
+	}
+	return m.Add(Money{Amount: -other.Amount, Currency: other.Currency})
+}
+
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+// Scan reads money stored as a json column
+func (m *Money) Scan(src any) error {
+	switch v := src.(type) {
+	case []byte:
+		return json.Unmarshal(v, m)
+	case string:
+		return json.Unmarshal([]byte(v), m)
+	case nil:
+		*m = Money{}
+		return nil
+	default:
+		return fmt.Errorf("cannot scan %T into money", src)
+	}
+}
+
+// Value stores money as a json column
+func (m Money) Value() (driver.Value, error) {
+	return json.Marshal(m)
+}
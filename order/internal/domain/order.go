@@ -12,28 +12,230 @@ type Order struct {
 	CurrentStatus OrderStatus     `sql:"current_status" json:"current_status"`
 	OrderLines    json.RawMessage `sql:"order_lines" json:"-order_lines"`
 	CustomerID    string          `sql:"customer_id" json:"customer_id"`
-	CreatedAt     time.Time       `sql:"created_at" json:"created_at"`
-	UpdatedAt     time.Time       `sql:"updated_at" json:"updated_at"`
+	StoreID       string          `sql:"store_id" json:"store_id"`
+	CheckoutID    *string         `sql:"checkout_id" json:"checkout_id"`
+	Subtotal      Money           `sql:"subtotal" json:"subtotal"`
+	Discount      Money           `sql:"discount" json:"discount"`
+	TaxRate       TaxRate         `sql:"tax_rate" json:"tax_rate"`
+	Tax           Money           `sql:"tax" json:"tax"`
+	Total         Money           `sql:"total" json:"total"`
+	// DeliveryFee is part of the total, FulfillmentStatus is tracked apart from CurrentStatus
+	FulfillmentMethod FulfillmentMethod `sql:"fulfillment_method" json:"fulfillment_method"`
+	FulfillmentStatus FulfillmentStatus `sql:"fulfillment_status" json:"fulfillment_status"`
+	DeliveryAddress   *DeliveryAddress  `sql:"delivery_address" json:"delivery_address"`
+	DeliveryFee       Money             `sql:"delivery_fee" json:"delivery_fee"`
+	CreatedAt         time.Time         `sql:"created_at" json:"created_at"`
+	UpdatedAt         time.Time         `sql:"updated_at" json:"updated_at"`
 
-	orderLines []OrderLine
+	orderLines    []OrderLine
+	statusChanges []*OrderStatusChange
 	Entity
 }
 
 func (o *Order) GetID() string                 { return o.ID }
 func (o *Order) GetCode() OrderCode            { return o.Code }
 func (o *Order) GetCurrentStatus() OrderStatus { return o.CurrentStatus }
-func (o *Order) GetOrderLines() []OrderLine    { return o.orderLines }
 func (o *Order) GetCustomerID() string         { return o.CustomerID }
+func (o *Order) GetStoreID() string            { return o.StoreID }
+func (o *Order) GetCheckoutID() *string        { return o.CheckoutID }
+func (o *Order) GetSubtotal() Money            { return o.Subtotal }
+func (o *Order) GetDiscount() Money            { return o.Discount }
+func (o *Order) GetTaxRate() TaxRate           { return o.TaxRate }
+func (o *Order) GetTax() Money                 { return o.Tax }
+func (o *Order) GetTotal() Money               { return o.Total }
 func (o *Order) GetCreatedAt() time.Time       { return o.CreatedAt }
 
-func (o *Order) Accept(acceptedBy string) error {
+func (o *Order) GetFulfillmentMethod() FulfillmentMethod { return o.FulfillmentMethod }
+func (o *Order) GetFulfillmentStatus() FulfillmentStatus { return o.FulfillmentStatus }
+func (o *Order) GetDeliveryAddress() *DeliveryAddress    { return o.DeliveryAddress }
+func (o *Order) GetDeliveryFee() Money                   { return o.DeliveryFee }
+
+// GetOrderLines returns the order lines, decoding them when the order was loaded from storage
+func (o *Order) GetOrderLines() []OrderLine {
+	if o.orderLines == nil && len(o.OrderLines) > 0 {
+		_ = json.Unmarshal(o.OrderLines, &o.orderLines)
+	}
+	return o.orderLines
+}
+
+// Accept marks the order as accepted by the store, orders are accepted once paid or when they are paid on delivery
+func (o *Order) Accept(changeID string, acceptedBy string) error {
+	switch o.CurrentStatus {
+	case CreatedOrderStatus, PaidOrderStatus:
+		o.changeStatus(changeID, AcceptedOrderStatus, OrderAccepted, &acceptedBy, nil)
+	default:
+		return fmt.Errorf("order is not in created or paid status")
+	}
+	return nil
+}
+
+// CanBePaid returns an error when the order does not accept payments
+func (o *Order) CanBePaid() error {
 	switch o.CurrentStatus {
-	case CreatedOrderStatus:
-		o.CurrentStatus = AcceptedOrderStatus
-		o.UpdatedAt = time.Now().UTC()
+	case CreatedOrderStatus, PaymentFailedOrderStatus:
+		return nil
 	default:
-		return fmt.Errorf("order is not in created status")
+		return fmt.Errorf("order is not awaiting payment")
 	}
+}
+
+// MarkPaid is done by the payment provider, so the change has no actor
+func (o *Order) MarkPaid(changeID string) error {
+	if err := o.CanBePaid(); err != nil {
+		return err
+	}
+
+	o.changeStatus(changeID, PaidOrderStatus, OrderPaid, nil, nil)
+	return nil
+}
+
+func (o *Order) MarkPaymentFailed(changeID string, reason string) error {
+	if err := o.CanBePaid(); err != nil {
+		return err
+	}
+
+	o.changeStatus(changeID, PaymentFailedOrderStatus, OrderPaymentFailed, nil, &reason)
+	return nil
+}
+
+// Cancel cancels the order before the store accepts it, a paid order must be refunded
+func (o *Order) Cancel(changeID string, canceledBy string, reason *string) error {
+	switch o.CurrentStatus {
+	case CreatedOrderStatus, PaidOrderStatus, PaymentFailedOrderStatus:
+		o.changeStatus(changeID, CanceledOrderStatus, OrderCanceled, &canceledBy, reason)
+	default:
+		return fmt.Errorf("order cannot be canceled once accepted, finished or rejected")
+	}
+	return nil
+}
+
+// Reject is done by the store owner before accepting the order, a paid order must be refunded
+func (o *Order) Reject(changeID string, rejectedBy string, reason *string) error {
+	switch o.CurrentStatus {
+	case CreatedOrderStatus, PaidOrderStatus, PaymentFailedOrderStatus:
+		o.changeStatus(changeID, RejectedOrderStatus, OrderRejected, &rejectedBy, reason)
+	default:
+		return fmt.Errorf("order cannot be rejected once accepted, finished or canceled")
+	}
+	return nil
+}
+
+// UpdateFulfillment moves the fulfillment of the order to the next status of its method, steps can be
+// skipped but not undone
+func (o *Order) UpdateFulfillment(status FulfillmentStatus) error {
+	switch o.CurrentStatus {
+	case CanceledOrderStatus, RejectedOrderStatus, PaymentFailedOrderStatus:
+		return fmt.Errorf("order in %s status cannot be fulfilled", o.CurrentStatus)
+	}
+
+	steps := fulfillmentSteps[o.FulfillmentMethod]
+	current, next := -1, -1
+	for i, step := range steps {
+		if step == o.FulfillmentStatus {
+			current = i
+		}
+		if step == status {
+			next = i
+		}
+	}
+
+	if next == -1 {
+		return fmt.Errorf("fulfillment status %s is not valid for %s", status, o.FulfillmentMethod)
+	}
+
+	if next <= current {
+		return fmt.Errorf("fulfillment cannot move from %s to %s", o.FulfillmentStatus, status)
+	}
+
+	now := time.Now().UTC()
+	o.FulfillmentStatus = status
+	o.UpdatedAt = now
+
+	o.events = append(o.events, NewEvent(OrderFulfillmentUpdated, OrderAggregate, o.ID, OrderEventData{Order: o}, now))
+	return nil
+}
+
+// PullStatusChanges returns the status changes to record in the order status history
+func (o *Order) PullStatusChanges() []*OrderStatusChange {
+	changes := o.statusChanges
+	o.statusChanges = []*OrderStatusChange{}
+	return changes
+}
+
+// changeStatus moves the order to the status, recording the change and publishing it in the event
+func (o *Order) changeStatus(changeID string, status OrderStatus, eventType EventType, actorUserID *string, reason *string) {
+	now := time.Now().UTC()
+	change := &OrderStatusChange{
+		ID:          changeID,
+		OrderID:     o.ID,
+		ToStatus:    status,
+		ActorUserID: actorUserID,
+		Reason:      reason,
+		CreatedAt:   now,
+	}
+	if o.CurrentStatus != "" {
+		from := o.CurrentStatus
+		change.FromStatus = &from
+	}
+
+	o.CurrentStatus = status
+	o.UpdatedAt = now
+	o.statusChanges = append(o.statusChanges, change)
+
+	o.events = append(o.events, NewEvent(eventType, OrderAggregate, o.ID, OrderEventData{Order: o, StatusChange: change}, now))
+}
+
+// calculateTotals computes the subtotal from the order lines totals, then applies the order discount
+// and the tax rate, the delivery fee is added untaxed to get the grand total
+func (o *Order) calculateTotals() error {
+	if len(o.orderLines) == 0 {
+		return fmt.Errorf("orderLines cannot be empty")
+	}
+
+	currency := o.orderLines[0].Total.GetCurrency()
+	subtotal := Money{Amount: 0, Currency: currency}
+	for _, orderLine := range o.orderLines {
+		var err error
+		subtotal, err = subtotal.Add(orderLine.Total)
+		if err != nil {
+			return err
+		}
+	}
+
+	if o.Discount.GetCurrency() == "" {
+		o.Discount = Money{Amount: 0, Currency: currency}
+	}
+
+	if o.Discount.GetAmount() < 0 {
+		return fmt.Errorf("discount cannot be negative")
+	}
+
+	taxable, err := subtotal.Subtract(o.Discount)
+	if err != nil {
+		return err
+	}
+
+	if taxable.GetAmount() < 0 {
+		return fmt.Errorf("discount cannot exceed the order subtotal")
+	}
+
+	tax, total, err := o.TaxRate.Apply(taxable)
+	if err != nil {
+		return err
+	}
+
+	if o.DeliveryFee.GetCurrency() == "" {
+		o.DeliveryFee = Money{Amount: 0, Currency: currency}
+	}
+
+	total, err = total.Add(o.DeliveryFee)
+	if err != nil {
+		return err
+	}
+
+	o.Subtotal = subtotal
+	o.Tax = tax
+	o.Total = total
 	return nil
 }
 
@@ -1,21 +1,80 @@
 package domain
 
 import (
-	"math/rand"
-	"time"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
 )
 
-type OrderCode string
+// orderCodeAlphabet leaves out the characters easily mistaken for others (0/O, 1/I/L, U/V).
+// Its size must be even for the check character to detect every single mistyped character.
+const orderCodeAlphabet = "23456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// orderCodeRandomLength is the number of random characters, a check character is appended to them
+const orderCodeRandomLength = 8
 
-func generateOrderCode() OrderCode {
-	const letters = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
-	const length = 8
+// ErrOrderCodeMistyped is returned for a code whose check character does not match, so it has a mistyped character
+var ErrOrderCodeMistyped = errors.New("order code has a mistyped character")
 
-	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+// OrderCode is a human friendly order number made of random characters and a Luhn mod N check character,
+// so a mistyped character is detected before looking the order up
+type OrderCode string
 
-	b := make([]byte, length)
+// NewOrderCode returns a crypto random order code
+func NewOrderCode() (OrderCode, error) {
+	max := big.NewInt(int64(len(orderCodeAlphabet)))
+
+	b := make([]byte, orderCodeRandomLength)
 	for i := range b {
-		b[i] = letters[rng.Intn(len(letters))]
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		b[i] = orderCodeAlphabet[n.Int64()]
+	}
+
+	return OrderCode(string(b) + string(orderCodeCheckCharacter(string(b)))), nil
+}
+
+// ParseOrderCode normalizes a code typed by a person, ignoring case, spaces and hyphens, and validates it
+func ParseOrderCode(value string) (OrderCode, error) {
+	normalized := strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(value))
+	if len(normalized) != orderCodeRandomLength+1 {
+		return "", fmt.Errorf("order code must have %d characters", orderCodeRandomLength+1)
+	}
+
+	for _, r := range normalized {
+		if !strings.ContainsRune(orderCodeAlphabet, r) {
+			return "", fmt.Errorf("order code contains invalid character %q", r)
+		}
+	}
+
+	payload, check := normalized[:orderCodeRandomLength], normalized[orderCodeRandomLength]
+	if orderCodeCheckCharacter(payload) != check {
+		return "", fmt.Errorf("order code %s is not valid: %w", value, ErrOrderCodeMistyped)
 	}
-	return OrderCode(b)
+
+	return OrderCode(normalized), nil
+}
+
+// orderCodeCheckCharacter computes the Luhn mod N check character of the payload
+func orderCodeCheckCharacter(payload string) byte {
+	n := len(orderCodeAlphabet)
+	factor := 2
+	sum := 0
+	for i := len(payload) - 1; i >= 0; i-- {
+		addend := factor * strings.IndexByte(orderCodeAlphabet, payload[i])
+		addend = addend/n + addend%n
+		sum += addend
+
+		if factor == 2 {
+			factor = 1
+		} else {
+			factor = 2
+		}
+	}
+
+	return orderCodeAlphabet[(n-sum%n)%n]
 }
@@ -0,0 +1,62 @@
+package domain_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"ichibuy/order/internal/domain"
+)
+
+func TestNewOrderCode(t *testing.T) {
+	seen := map[domain.OrderCode]bool{}
+	for i := 0; i < 1000; i++ {
+		code, err := domain.NewOrderCode()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if strings.ContainsAny(string(code), "0O1ILU") {
+			t.Fatalf("code %s contains an ambiguous character", code)
+		}
+
+		parsed, err := domain.ParseOrderCode(string(code))
+		if err != nil || parsed != code {
+			t.Fatalf("expected code %s to be valid, got %s %v", code, parsed, err)
+		}
+
+		seen[code] = true
+	}
+
+	if len(seen) != 1000 {
+		t.Fatalf("expected 1000 distinct codes, got %d", len(seen))
+	}
+}
+
+func TestParseOrderCode(t *testing.T) {
+	code, err := domain.NewOrderCode()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	typed := strings.ToLower(string(code[:4]) + "-" + string(code[4:8]) + " " + string(code[8:]))
+	parsed, err := domain.ParseOrderCode(typed)
+	if err != nil || parsed != code {
+		t.Fatalf("expected %s to parse to %s, got %s %v", typed, code, parsed, err)
+	}
+
+	// any single mistyped character is detected by the check character
+	const alphabet = "23456789ABCDEFGHJKMNPQRSTVWXYZ"
+	for i := range code {
+		for _, r := range alphabet {
+			if byte(r) == code[i] {
+				continue
+			}
+
+			mistyped := string(code[:i]) + string(r) + string(code[i+1:])
+			if _, err := domain.ParseOrderCode(mistyped); !errors.Is(err, domain.ErrOrderCodeMistyped) {
+				t.Fatalf("expected mistyped code %s of %s to be rejected", mistyped, code)
+			}
+		}
+	}
+}
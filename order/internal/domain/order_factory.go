@@ -25,6 +25,10 @@ func NewOrderFactory(customerSvc CustomerService, nextID NextID) *OrderFactory {
 func (f *OrderFactory) NewOrder(
 	ctx context.Context,
 	orderLines []OrderLine,
+	discount Money,
+	taxRate TaxRate,
+	fulfillment OrderFulfillment,
+	checkoutID *string,
 	userID string,
 ) (*Order, error) {
 	if strings.TrimSpace(userID) == "" {
@@ -35,6 +39,10 @@ func (f *OrderFactory) NewOrder(
 		return nil, fmt.Errorf("orderLines cannot be empty")
 	}
 
+	if fulfillment.Method == "" {
+		fulfillment = PickupFulfillment
+	}
+
 	// all order lines must have same currency
 	currencies := map[string]bool{}
 	storeIDs := map[string]bool{}
@@ -64,27 +72,36 @@ func (f *OrderFactory) NewOrder(
 
 	now := time.Now().UTC()
 
+	code, err := NewOrderCode()
+	if err != nil {
+		return nil, err
+	}
+
 	order := &Order{
-		ID:            f.nextID(),
-		Code:          generateOrderCode(),
-		CurrentStatus: CreatedOrderStatus,
-		OrderLines:    rawOrderLines,
-		CustomerID:    customer.ID,
-		CreatedAt:     now,
-		UpdatedAt:     now,
+		ID:         f.nextID(),
+		Code:       code,
+		OrderLines: rawOrderLines,
+		CustomerID: customer.ID,
+		StoreID:    orderLines[0].ProductStoreID,
+		CheckoutID: checkoutID,
+		Discount:   discount,
+		TaxRate:    taxRate,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+
+		FulfillmentMethod: fulfillment.Method,
+		FulfillmentStatus: PendingFulfillmentStatus,
+		DeliveryAddress:   fulfillment.DeliveryAddress,
+		DeliveryFee:       fulfillment.DeliveryFee,
 
 		orderLines: orderLines,
 	}
 
-	data, _ := json.Marshal(order)
-	event := Event{
-		ID:        fmt.Sprintf("%s_%v", order.GetID(), now.Unix()),
-		Type:      OrderCreated,
-		Data:      data,
-		Timestamp: now,
+	if err := order.calculateTotals(); err != nil {
+		return nil, err
 	}
 
-	order.events = append(order.events, event)
+	order.changeStatus(f.nextID(), CreatedOrderStatus, OrderCreated, &userID, nil)
 
 	return order, nil
 }
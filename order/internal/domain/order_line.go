@@ -12,6 +12,9 @@ type OrderLine struct {
 	ProductStoreID string `json:"product_store_id"`
 	Quantity       int    `json:"quantity"`
 	UnitPrice      Money  `json:"unit_price"`
+	Subtotal       Money  `json:"subtotal"`
+	Discount       Money  `json:"discount"`
+	Total          Money  `json:"total"`
 }
 
 func NewOrderLine(
@@ -46,6 +49,11 @@ func NewOrderLine(
 		return nil, fmt.Errorf("unitPrice must be greater than 0")
 	}
 
+	subtotal, err := unitPrice.Multiply(quantity)
+	if err != nil {
+		return nil, err
+	}
+
 	return &OrderLine{
 		ID:             id,
 		ProductID:      productID,
@@ -53,5 +61,41 @@ func NewOrderLine(
 		ProductStoreID: productStoreID,
 		Quantity:       quantity,
 		UnitPrice:      unitPrice,
+		Subtotal:       subtotal,
+		Discount:       Money{Amount: 0, Currency: unitPrice.GetCurrency()},
+		Total:          subtotal,
 	}, nil
 }
+
+// ApplyDiscount sets the discount of the line, which cannot exceed its subtotal
+func (l *OrderLine) ApplyDiscount(discount Money) error {
+	if discount.GetAmount() < 0 {
+		return fmt.Errorf("discount cannot be negative")
+	}
+
+	total, err := l.Subtotal.Subtract(discount)
+	if err != nil {
+		return err
+	}
+
+	if total.GetAmount() < 0 {
+		return fmt.Errorf("discount cannot exceed the line subtotal")
+	}
+
+	l.Discount = discount
+	l.Total = total
+	return nil
+}
+
+// GroupOrderLinesByStore splits the order lines by store, returning the stores in the order they appear
+func GroupOrderLinesByStore(orderLines []OrderLine) ([]string, map[string][]OrderLine) {
+	storeIDs := []string{}
+	linesByStore := map[string][]OrderLine{}
+	for _, orderLine := range orderLines {
+		if _, ok := linesByStore[orderLine.ProductStoreID]; !ok {
+			storeIDs = append(storeIDs, orderLine.ProductStoreID)
+		}
+		linesByStore[orderLine.ProductStoreID] = append(linesByStore[orderLine.ProductStoreID], orderLine)
+	}
+	return storeIDs, linesByStore
+}
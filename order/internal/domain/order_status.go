@@ -3,9 +3,14 @@ package domain
 type OrderStatus string
 
 const (
-	CreatedOrderStatus  OrderStatus = "created"
-	AcceptedOrderStatus OrderStatus = "accepted"
-	FinishedOrderStatus OrderStatus = "finished"
-	CanceledOrderStatus OrderStatus = "canceled"
-	RejectedOrderStatus OrderStatus = "rejected"
+	CreatedOrderStatus       OrderStatus = "created"
+	PaidOrderStatus          OrderStatus = "paid"
+	PaymentFailedOrderStatus OrderStatus = "payment_failed"
+	AcceptedOrderStatus      OrderStatus = "accepted"
+	FinishedOrderStatus      OrderStatus = "finished"
+	CanceledOrderStatus      OrderStatus = "canceled"
+	RejectedOrderStatus      OrderStatus = "rejected"
 )
+
+// OpenOrderStatuses are the statuses of orders the store still has to fulfill
+var OpenOrderStatuses = []OrderStatus{CreatedOrderStatus, PaidOrderStatus, PaymentFailedOrderStatus, AcceptedOrderStatus}
@@ -0,0 +1,27 @@
+package domain
+
+import "time"
+
+// OrderStatusChange is an entry of the order status history. FromStatus is nil when the order is created,
+// ActorUserID is nil for changes made by the system, like payment confirmations.
+type OrderStatusChange struct {
+	ID          string       `sql:"id,primary" json:"id"`
+	OrderID     string       `sql:"order_id" json:"order_id"`
+	FromStatus  *OrderStatus `sql:"from_status" json:"from_status"`
+	ToStatus    OrderStatus  `sql:"to_status" json:"to_status"`
+	ActorUserID *string      `sql:"actor_user_id" json:"actor_user_id"`
+	Reason      *string      `sql:"reason" json:"reason"`
+	CreatedAt   time.Time    `sql:"created_at" json:"created_at"`
+}
+
+func (c *OrderStatusChange) GetID() string               { return c.ID }
+func (c *OrderStatusChange) GetOrderID() string          { return c.OrderID }
+func (c *OrderStatusChange) GetFromStatus() *OrderStatus { return c.FromStatus }
+func (c *OrderStatusChange) GetToStatus() OrderStatus    { return c.ToStatus }
+func (c *OrderStatusChange) GetActorUserID() *string     { return c.ActorUserID }
+func (c *OrderStatusChange) GetReason() *string          { return c.Reason }
+func (c *OrderStatusChange) GetCreatedAt() time.Time     { return c.CreatedAt }
+
+func (c *OrderStatusChange) TableName() string {
+	return "order_status_history"
+}
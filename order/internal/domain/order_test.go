@@ -0,0 +1,150 @@
+package domain_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"ichibuy/order/internal/domain"
+)
+
+type customerServiceStub struct{}
+
+func (customerServiceStub) FindByUserID(ctx context.Context, userID string) (*domain.CustomerDTO, error) {
+	return &domain.CustomerDTO{ID: "customer-" + userID}, nil
+}
+
+func newOrderFactory() *domain.OrderFactory {
+	next := 0
+	return domain.NewOrderFactory(customerServiceStub{}, func() string {
+		next++
+		return fmt.Sprintf("id-%d", next)
+	})
+}
+
+func TestGroupOrderLinesByStore(t *testing.T) {
+	line := func(id, storeID string) domain.OrderLine {
+		orderLine, err := domain.NewOrderLine(id, "product-"+id, "Product "+id, storeID, 1, domain.Money{Amount: 100, Currency: "PEN"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return *orderLine
+	}
+
+	storeIDs, linesByStore := domain.GroupOrderLinesByStore([]domain.OrderLine{
+		line("1", "store-2"),
+		line("2", "store-1"),
+		line("3", "store-2"),
+	})
+
+	if fmt.Sprint(storeIDs) != "[store-2 store-1]" {
+		t.Fatalf("expected stores in the order they appear, got %v", storeIDs)
+	}
+	if len(linesByStore["store-2"]) != 2 || len(linesByStore["store-1"]) != 1 {
+		t.Fatalf("expected 2 and 1 lines, got %d and %d", len(linesByStore["store-2"]), len(linesByStore["store-1"]))
+	}
+}
+
+func TestOrderFactory_NewOrder_Totals(t *testing.T) {
+	igv, err := domain.NewTaxRate("IGV", 1800, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	inclusiveIGV, err := domain.NewTaxRate("IGV", 1800, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	delivery := domain.OrderFulfillment{Method: domain.LocalDeliveryFulfillmentMethod, DeliveryFee: domain.Money{Amount: 500, Currency: "PEN"}}
+
+	tests := []struct {
+		name        string
+		discount    domain.Money
+		taxRate     domain.TaxRate
+		fulfillment domain.OrderFulfillment
+		subtotal    int
+		tax         int
+		total       int
+		expectErr   bool
+	}{
+		{name: "exclusive tax", taxRate: igv, subtotal: 3500, tax: 630, total: 4130},
+		{name: "inclusive tax", taxRate: inclusiveIGV, subtotal: 3500, tax: 534, total: 3500},
+		{name: "discount before tax", discount: domain.Money{Amount: 500, Currency: "PEN"}, taxRate: igv, subtotal: 3500, tax: 540, total: 3540},
+		{name: "delivery fee after tax", taxRate: igv, fulfillment: delivery, subtotal: 3500, tax: 630, total: 4630},
+		{name: "discount over the subtotal", discount: domain.Money{Amount: 4000, Currency: "PEN"}, taxRate: igv, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orderLines := []domain.OrderLine{newOrderLine(t, "1", 3, 1000), newOrderLine(t, "2", 1, 500)}
+			order, err := newOrderFactory().NewOrder(context.Background(), orderLines, tt.discount, tt.taxRate, tt.fulfillment, nil, "user-1")
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("expected error %v, got %v", tt.expectErr, err)
+			}
+			if tt.expectErr {
+				return
+			}
+
+			if order.GetSubtotal().Amount != tt.subtotal || order.GetTax().Amount != tt.tax || order.GetTotal().Amount != tt.total {
+				t.Errorf("expected %d + %d = %d, got %d + %d = %d", tt.subtotal, tt.tax, tt.total, order.GetSubtotal().Amount, order.GetTax().Amount, order.GetTotal().Amount)
+			}
+			if order.GetStoreID() != "store-1" || order.GetCurrentStatus() != domain.CreatedOrderStatus {
+				t.Errorf("expected created order of store-1, got %s order of %s", order.GetCurrentStatus(), order.GetStoreID())
+			}
+		})
+	}
+}
+
+func TestOrder_StatusTransitions(t *testing.T) {
+	reason := "out of stock"
+	transitions := map[string]func(order *domain.Order) error{
+		"accept":       func(order *domain.Order) error { return order.Accept("change-1", "user-2") },
+		"pay":          func(order *domain.Order) error { return order.MarkPaid("change-1") },
+		"fail payment": func(order *domain.Order) error { return order.MarkPaymentFailed("change-1", "card declined") },
+		"cancel":       func(order *domain.Order) error { return order.Cancel("change-1", "user-1", nil) },
+		"reject":       func(order *domain.Order) error { return order.Reject("change-1", "user-2", &reason) },
+	}
+
+	tests := []struct {
+		from       domain.OrderStatus
+		transition string
+		to         domain.OrderStatus
+	}{
+		{domain.CreatedOrderStatus, "accept", domain.AcceptedOrderStatus},
+		{domain.PaidOrderStatus, "accept", domain.AcceptedOrderStatus},
+		{domain.PaymentFailedOrderStatus, "accept", ""},
+		{domain.CreatedOrderStatus, "pay", domain.PaidOrderStatus},
+		{domain.PaymentFailedOrderStatus, "pay", domain.PaidOrderStatus},
+		{domain.PaidOrderStatus, "pay", ""},
+		{domain.CreatedOrderStatus, "fail payment", domain.PaymentFailedOrderStatus},
+		{domain.AcceptedOrderStatus, "fail payment", ""},
+		{domain.PaidOrderStatus, "cancel", domain.CanceledOrderStatus},
+		{domain.AcceptedOrderStatus, "cancel", ""},
+		{domain.PaymentFailedOrderStatus, "reject", domain.RejectedOrderStatus},
+		{domain.CanceledOrderStatus, "reject", ""},
+		{domain.FinishedOrderStatus, "reject", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s %s", tt.transition, tt.from), func(t *testing.T) {
+			order := &domain.Order{ID: "order-1", CurrentStatus: tt.from}
+
+			err := transitions[tt.transition](order)
+			if tt.to == "" {
+				if err == nil || order.GetCurrentStatus() != tt.from {
+					t.Fatalf("expected %s to be refused from %s, got %s", tt.transition, tt.from, order.GetCurrentStatus())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if order.GetCurrentStatus() != tt.to {
+				t.Errorf("expected %s, got %s", tt.to, order.GetCurrentStatus())
+			}
+			if changes := order.PullStatusChanges(); len(changes) != 1 || changes[0].ToStatus != tt.to {
+				t.Errorf("expected a status change to %s, got %v", tt.to, changes)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+type PaymentStatus string
+
+const (
+	PendingPaymentStatus   PaymentStatus = "pending"
+	SucceededPaymentStatus PaymentStatus = "succeeded"
+	FailedPaymentStatus    PaymentStatus = "failed"
+	RefundedPaymentStatus  PaymentStatus = "refunded"
+	// RefundPendingPaymentStatus is a refund recorded but not confirmed by the provider yet
+	RefundPendingPaymentStatus PaymentStatus = "refund_pending"
+)
+
+// Payment is a payment intent of an order in a payment provider
+type Payment struct {
+	ID                string        `sql:"id,primary"`
+	OrderID           string        `sql:"order_id"`
+	Amount            int           `sql:"amount"`
+	Currency          string        `sql:"currency"`
+	Status            PaymentStatus `sql:"status"`
+	Provider          string        `sql:"provider"`
+	ProviderReference string        `sql:"provider_reference"`
+	FailureReason     *string       `sql:"failure_reason"`
+	CreatedAt         time.Time     `sql:"created_at"`
+	UpdatedAt         time.Time     `sql:"updated_at"`
+
+	Entity
+}
+
+func NewPayment(id string, order *Order, provider string, providerReference string) (*Payment, error) {
+	if strings.TrimSpace(id) == "" {
+		return nil, fmt.Errorf("id cannot be empty")
+	}
+
+	if strings.TrimSpace(providerReference) == "" {
+		return nil, fmt.Errorf("providerReference cannot be empty")
+	}
+
+	if err := order.CanBePaid(); err != nil {
+		return nil, err
+	}
+
+	total := order.GetTotal()
+	if total.GetAmount() <= 0 {
+		return nil, fmt.Errorf("order total must be greater than 0")
+	}
+
+	now := time.Now().UTC()
+	return &Payment{
+		ID:                id,
+		OrderID:           order.GetID(),
+		Amount:            total.GetAmount(),
+		Currency:          total.GetCurrency(),
+		Status:            PendingPaymentStatus,
+		Provider:          provider,
+		ProviderReference: providerReference,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}, nil
+}
+
+// Succeed confirms the payment, confirming an already succeeded payment does nothing
+func (p *Payment) Succeed() error {
+	switch p.Status {
+	case SucceededPaymentStatus:
+		return nil
+	case PendingPaymentStatus, FailedPaymentStatus:
+		p.Status = SucceededPaymentStatus
+		p.FailureReason = nil
+		p.UpdatedAt = time.Now().UTC()
+		return nil
+	default:
+		return fmt.Errorf("payment is %s", p.Status)
+	}
+}
+
+// Fail marks the payment as failed, only pending payments can fail
+func (p *Payment) Fail(reason string) error {
+	switch p.Status {
+	case FailedPaymentStatus:
+		return nil
+	case PendingPaymentStatus:
+		p.Status = FailedPaymentStatus
+		p.FailureReason = &reason
+		p.UpdatedAt = time.Now().UTC()
+		return nil
+	default:
+		return fmt.Errorf("payment is %s", p.Status)
+	}
+}
+
+// RequestRefund records the refund of the succeeded payment, the provider is asked for the money once
+// the refund is stored
+func (p *Payment) RequestRefund() error {
+	switch p.Status {
+	case RefundPendingPaymentStatus:
+		return nil
+	case SucceededPaymentStatus:
+		p.Status = RefundPendingPaymentStatus
+		p.UpdatedAt = time.Now().UTC()
+		return nil
+	default:
+		return fmt.Errorf("only succeeded payments can be refunded")
+	}
+}
+
+// Refund marks the payment as refunded once the provider returned the money
+func (p *Payment) Refund() error {
+	if p.Status != RefundPendingPaymentStatus {
+		return fmt.Errorf("payment refund was not requested")
+	}
+
+	now := time.Now().UTC()
+	p.Status = RefundedPaymentStatus
+	p.UpdatedAt = now
+
+	data := PaymentEventData{
+		PaymentID:         p.ID,
+		OrderID:           p.OrderID,
+		Amount:            p.GetAmount(),
+		Status:            p.Status,
+		Provider:          p.Provider,
+		ProviderReference: p.ProviderReference,
+	}
+	p.events = append(p.events, NewEvent(PaymentRefunded, PaymentAggregate, p.ID, data, now))
+	return nil
+}
+
+func (p *Payment) GetID() string                { return p.ID }
+func (p *Payment) GetOrderID() string           { return p.OrderID }
+func (p *Payment) GetAmount() Money             { return Money{Amount: p.Amount, Currency: p.Currency} }
+func (p *Payment) GetStatus() PaymentStatus     { return p.Status }
+func (p *Payment) GetProvider() string          { return p.Provider }
+func (p *Payment) GetProviderReference() string { return p.ProviderReference }
+func (p *Payment) GetFailureReason() *string    { return p.FailureReason }
+func (p *Payment) GetCreatedAt() time.Time      { return p.CreatedAt }
+
+// RefundIdempotencyKey is sent to the provider with the refund, so retrying it returns the money only once
+func (p *Payment) RefundIdempotencyKey() string {
+	return "refund_" + p.ID
+}
+
+func (p *Payment) TableName() string {
+	return "payments"
+}
@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrInvalidPaymentSignature is returned when a payment webhook is not signed by the provider
+var ErrInvalidPaymentSignature = errors.New("invalid payment webhook signature")
+
+// PaymentGateway is the port to a payment provider
+type PaymentGateway interface {
+	// Name identifies the provider of the payments created with the gateway
+	Name() string
+	CreateIntent(ctx context.Context, params PaymentIntentParams) (*PaymentIntentDTO, error)
+	// Refund returns the amount of the payment, refunds retried with the same idempotency key are made once
+	Refund(ctx context.Context, providerReference string, amount Money, idempotencyKey string) error
+	// ParseWebhook verifies the signature of a webhook payload and returns the payment update it notifies
+	ParseWebhook(payload []byte, signature string) (*PaymentWebhookDTO, error)
+}
+
+type PaymentIntentParams struct {
+	PaymentID string
+	OrderID   string
+	Amount    Money
+}
+
+type PaymentIntentDTO struct {
+	ProviderReference string
+	// ClientSecret lets the customer complete the payment with the provider
+	ClientSecret string
+}
+
+type PaymentWebhookDTO struct {
+	ProviderReference string
+	Status            PaymentStatus
+	FailureReason     string
+}
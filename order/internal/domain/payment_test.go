@@ -0,0 +1,103 @@
+package domain_test
+
+import (
+	"testing"
+
+	"ichibuy/order/internal/domain"
+)
+
+func newPayableOrder(status domain.OrderStatus) *domain.Order {
+	return &domain.Order{
+		ID:            "order-1",
+		CurrentStatus: status,
+		Total:         domain.Money{Amount: 2500, Currency: "PEN"},
+	}
+}
+
+func TestPayment_Lifecycle(t *testing.T) {
+	order := newPayableOrder(domain.CreatedOrderStatus)
+	payment, err := domain.NewPayment("payment-1", order, "fake", "fake_pi_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if payment.GetStatus() != domain.PendingPaymentStatus || payment.GetAmount() != order.GetTotal() {
+		t.Fatalf("expected a pending payment of the order total, got %s %v", payment.GetStatus(), payment.GetAmount())
+	}
+
+	if err := payment.Fail("card declined"); err != nil {
+		t.Fatal(err)
+	}
+	if err := order.MarkPaymentFailed("change-1", "card declined"); err != nil {
+		t.Fatal(err)
+	}
+
+	// a failed payment can be retried and succeed later
+	if err := payment.Succeed(); err != nil {
+		t.Fatal(err)
+	}
+	if err := order.MarkPaid("change-2"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := payment.Fail("late failure"); err == nil {
+		t.Fatal("expected a succeeded payment not to fail")
+	}
+
+	if err := order.Cancel("change-3", "user-1", nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := payment.Refund(); err == nil {
+		t.Fatal("expected a refund not requested to fail")
+	}
+	if err := payment.RequestRefund(); err != nil {
+		t.Fatal(err)
+	}
+	if err := payment.Refund(); err != nil {
+		t.Fatal(err)
+	}
+
+	if payment.GetStatus() != domain.RefundedPaymentStatus || order.GetCurrentStatus() != domain.CanceledOrderStatus {
+		t.Fatalf("expected refunded payment and canceled order, got %s and %s", payment.GetStatus(), order.GetCurrentStatus())
+	}
+
+	if events := order.PullEvents(); len(events) != 3 {
+		t.Fatalf("expected 3 order events, got %d", len(events))
+	}
+
+	changes := order.PullStatusChanges()
+	if len(changes) != 3 {
+		t.Fatalf("expected 3 status changes, got %d", len(changes))
+	}
+
+	paid := changes[1]
+	if *paid.GetFromStatus() != domain.PaymentFailedOrderStatus || paid.GetToStatus() != domain.PaidOrderStatus || paid.GetActorUserID() != nil {
+		t.Fatalf("unexpected paid status change %+v", paid)
+	}
+}
+
+func TestNewPayment_OrderNotAwaitingPayment(t *testing.T) {
+	for _, status := range []domain.OrderStatus{domain.PaidOrderStatus, domain.AcceptedOrderStatus, domain.CanceledOrderStatus} {
+		if _, err := domain.NewPayment("payment-1", newPayableOrder(status), "fake", "fake_pi_1"); err == nil {
+			t.Fatalf("expected an error paying a %s order", status)
+		}
+	}
+}
+
+func TestOrder_Reject(t *testing.T) {
+	reason := "out of stock"
+	order := newPayableOrder(domain.PaidOrderStatus)
+	if err := order.Reject("change-1", "owner-1", &reason); err != nil {
+		t.Fatal(err)
+	}
+
+	events := order.PullEvents()
+	if order.GetCurrentStatus() != domain.RejectedOrderStatus || len(events) != 1 || events[0].Type != domain.OrderRejected {
+		t.Fatalf("expected a rejected order with an OrderRejected event, got %s and %v", order.GetCurrentStatus(), events)
+	}
+
+	accepted := newPayableOrder(domain.AcceptedOrderStatus)
+	if err := accepted.Reject("change-2", "owner-1", nil); err == nil {
+		t.Fatal("expected an error rejecting an accepted order")
+	}
+}
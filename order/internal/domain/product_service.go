@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+)
+
+type ProductService interface {
+	FindByID(ctx context.Context, id string) (*ProductDTO, error)
+}
+
+type ProductDTO struct {
+	ID      string
+	Name    string
+	StoreID string
+	Active  bool
+	Prices  []Money
+}
+
+// PriceIn returns the current price of the product in the given currency
+func (p *ProductDTO) PriceIn(currency string) (Money, error) {
+	for _, price := range p.Prices {
+		if price.GetCurrency() == currency {
+			return price, nil
+		}
+	}
+	return Money{}, fmt.Errorf("product %s has no price in %s", p.Name, currency)
+}
+
+// CheckOrderLine returns an error when the line cannot be ordered: the product is not active, is of
+// another store or has another price in the currency of the line
+func (p *ProductDTO) CheckOrderLine(line OrderLine) error {
+	if !p.Active {
+		return fmt.Errorf("product %s is not available", p.Name)
+	}
+
+	if p.StoreID != line.ProductStoreID {
+		return fmt.Errorf("product %s is not of store %s", p.Name, line.ProductStoreID)
+	}
+
+	price, err := p.PriceIn(line.UnitPrice.GetCurrency())
+	if err != nil {
+		return err
+	}
+	if price.GetAmount() != line.UnitPrice.GetAmount() {
+		return fmt.Errorf("price of product %s changed", p.Name)
+	}
+
+	return nil
+}
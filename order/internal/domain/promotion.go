@@ -0,0 +1,374 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrPromotionLimitReached is returned when the promotion was redeemed as many times as it allows
+var ErrPromotionLimitReached = errors.New("promotion usage limit reached")
+
+type PromotionType string
+
+const (
+	// PercentagePromotionType discounts Value basis points of the order subtotal
+	PercentagePromotionType PromotionType = "percentage"
+	// FixedPromotionType discounts Value minor units of Currency from the order subtotal
+	FixedPromotionType PromotionType = "fixed"
+	// BuyXGetYPromotionType gives GetQuantity free units for every BuyQuantity units bought
+	BuyXGetYPromotionType PromotionType = "buy_x_get_y"
+)
+
+func NewPromotionType(value string) (PromotionType, error) {
+	switch PromotionType(value) {
+	case PercentagePromotionType, FixedPromotionType, BuyXGetYPromotionType:
+		return PromotionType(value), nil
+	default:
+		return "", fmt.Errorf("invalid promotion type %s", value)
+	}
+}
+
+// Promotion is a discount run by a store. Promotions with a code are coupons the customer has to
+// enter, the ones without a code are applied automatically to every order of the store.
+type Promotion struct {
+	ID                        string        `sql:"id,primary"`
+	StoreID                   string        `sql:"store_id"`
+	Code                      *string       `sql:"code"`
+	Type                      PromotionType `sql:"type"`
+	Value                     int           `sql:"value"`
+	Currency                  string        `sql:"currency"`
+	MinOrderAmount            int           `sql:"min_order_amount"`
+	ProductID                 *string       `sql:"product_id"`
+	BuyQuantity               int           `sql:"buy_quantity"`
+	GetQuantity               int           `sql:"get_quantity"`
+	MaxRedemptions            *int          `sql:"max_redemptions"`
+	MaxRedemptionsPerCustomer *int          `sql:"max_redemptions_per_customer"`
+	Redemptions               int           `sql:"redemptions"`
+	Active                    bool          `sql:"active"`
+	StartsAt                  *time.Time    `sql:"starts_at"`
+	EndsAt                    *time.Time    `sql:"ends_at"`
+	CreatedAt                 time.Time     `sql:"created_at"`
+	UpdatedAt                 time.Time     `sql:"updated_at"`
+
+	Entity
+}
+
+type NewPromotionParams struct {
+	StoreID                   string
+	Code                      *string
+	Type                      PromotionType
+	Value                     int
+	Currency                  string
+	MinOrderAmount            int
+	ProductID                 *string
+	BuyQuantity               int
+	GetQuantity               int
+	MaxRedemptions            *int
+	MaxRedemptionsPerCustomer *int
+	StartsAt                  *time.Time
+	EndsAt                    *time.Time
+}
+
+func NewPromotion(id string, params NewPromotionParams) (*Promotion, error) {
+	if strings.TrimSpace(params.StoreID) == "" {
+		return nil, fmt.Errorf("storeID cannot be empty")
+	}
+
+	var code *string
+	if params.Code != nil {
+		normalized := NormalizeCouponCode(*params.Code)
+		if len(normalized) < 3 || len(normalized) > 30 {
+			return nil, fmt.Errorf("code must have between 3 and 30 characters")
+		}
+		code = &normalized
+	}
+
+	if params.Currency != "" && !IsValidCurrency(params.Currency) {
+		return nil, fmt.Errorf("invalid currency")
+	}
+
+	switch params.Type {
+	case PercentagePromotionType:
+		if params.Value <= 0 || params.Value > basisPoints {
+			return nil, fmt.Errorf("percentage must be between 1 and %d basis points", basisPoints)
+		}
+	case FixedPromotionType:
+		if params.Value <= 0 {
+			return nil, fmt.Errorf("fixed discount must be greater than 0")
+		}
+		if params.Currency == "" {
+			return nil, fmt.Errorf("currency is required for fixed discounts")
+		}
+	case BuyXGetYPromotionType:
+		if params.BuyQuantity <= 0 || params.GetQuantity <= 0 {
+			return nil, fmt.Errorf("buy and get quantities must be greater than 0")
+		}
+	default:
+		return nil, fmt.Errorf("invalid promotion type %s", params.Type)
+	}
+
+	if params.MinOrderAmount < 0 {
+		return nil, fmt.Errorf("minimum order amount cannot be negative")
+	}
+
+	if params.MinOrderAmount > 0 && params.Currency == "" {
+		return nil, fmt.Errorf("currency is required for a minimum order amount")
+	}
+
+	if params.MaxRedemptions != nil && *params.MaxRedemptions <= 0 {
+		return nil, fmt.Errorf("max redemptions must be greater than 0")
+	}
+
+	if params.MaxRedemptionsPerCustomer != nil && *params.MaxRedemptionsPerCustomer <= 0 {
+		return nil, fmt.Errorf("max redemptions per customer must be greater than 0")
+	}
+
+	if params.StartsAt != nil && params.EndsAt != nil && !params.EndsAt.After(*params.StartsAt) {
+		return nil, fmt.Errorf("ends at must be after starts at")
+	}
+
+	now := time.Now().UTC()
+	return &Promotion{
+		ID:                        id,
+		StoreID:                   params.StoreID,
+		Code:                      code,
+		Type:                      params.Type,
+		Value:                     params.Value,
+		Currency:                  params.Currency,
+		MinOrderAmount:            params.MinOrderAmount,
+		ProductID:                 params.ProductID,
+		BuyQuantity:               params.BuyQuantity,
+		GetQuantity:               params.GetQuantity,
+		MaxRedemptions:            params.MaxRedemptions,
+		MaxRedemptionsPerCustomer: params.MaxRedemptionsPerCustomer,
+		Active:                    true,
+		StartsAt:                  params.StartsAt,
+		EndsAt:                    params.EndsAt,
+		CreatedAt:                 now,
+		UpdatedAt:                 now,
+	}, nil
+}
+
+// NormalizeCouponCode makes coupon codes case insensitive
+func NormalizeCouponCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func (p *Promotion) Deactivate() {
+	p.Active = false
+	p.UpdatedAt = time.Now().UTC()
+}
+
+func (p *Promotion) IsCoupon() bool {
+	return p.Code != nil
+}
+
+// CheckAvailable returns an error when the promotion cannot be applied at the given time
+func (p *Promotion) CheckAvailable(now time.Time) error {
+	if !p.Active {
+		return fmt.Errorf("promotion is not active")
+	}
+
+	if p.StartsAt != nil && now.Before(*p.StartsAt) {
+		return fmt.Errorf("promotion has not started yet")
+	}
+
+	if p.EndsAt != nil && !now.Before(*p.EndsAt) {
+		return fmt.Errorf("promotion has expired")
+	}
+
+	if p.MaxRedemptions != nil && p.Redemptions >= *p.MaxRedemptions {
+		return ErrPromotionLimitReached
+	}
+
+	return nil
+}
+
+// PromotionDiscount is the result of applying a promotion to the order lines
+type PromotionDiscount struct {
+	LineDiscounts map[string]Money
+	OrderDiscount Money
+}
+
+// Total returns the sum of the line and order discounts
+func (d PromotionDiscount) Total() (Money, error) {
+	total := d.OrderDiscount
+	for _, discount := range d.LineDiscounts {
+		var err error
+		total, err = total.Add(discount)
+		if err != nil {
+			return Money{}, err
+		}
+	}
+	return total, nil
+}
+
+// Apply computes the discount the promotion gives to the order lines and applies the line discounts.
+// The order discount has to be passed to the order factory.
+func (p *Promotion) Apply(orderLines []OrderLine, now time.Time) (PromotionDiscount, error) {
+	if err := p.CheckAvailable(now); err != nil {
+		return PromotionDiscount{}, err
+	}
+
+	if len(orderLines) == 0 {
+		return PromotionDiscount{}, fmt.Errorf("orderLines cannot be empty")
+	}
+
+	currency := orderLines[0].Total.GetCurrency()
+	if p.Currency != "" && p.Currency != currency {
+		return PromotionDiscount{}, fmt.Errorf("promotion only applies to orders in %s", p.Currency)
+	}
+
+	subtotal := Money{Amount: 0, Currency: currency}
+	for _, orderLine := range orderLines {
+		if orderLine.ProductStoreID != p.StoreID {
+			return PromotionDiscount{}, fmt.Errorf("promotion does not belong to the order store")
+		}
+
+		var err error
+		subtotal, err = subtotal.Add(orderLine.Total)
+		if err != nil {
+			return PromotionDiscount{}, err
+		}
+	}
+
+	if subtotal.GetAmount() < p.MinOrderAmount {
+		return PromotionDiscount{}, fmt.Errorf("order does not reach the promotion minimum amount")
+	}
+
+	discount := PromotionDiscount{
+		LineDiscounts: map[string]Money{},
+		OrderDiscount: Money{Amount: 0, Currency: currency},
+	}
+
+	switch p.Type {
+	case PercentagePromotionType:
+		amount, err := subtotal.Scale(p.Value, basisPoints)
+		if err != nil {
+			return PromotionDiscount{}, err
+		}
+		discount.OrderDiscount = amount
+	case FixedPromotionType:
+		amount := p.Value
+		if amount > subtotal.GetAmount() {
+			amount = subtotal.GetAmount()
+		}
+		discount.OrderDiscount = Money{Amount: amount, Currency: currency}
+	case BuyXGetYPromotionType:
+		for i := range orderLines {
+			orderLine := &orderLines[i]
+			if p.ProductID != nil && *p.ProductID != orderLine.ProductID {
+				continue
+			}
+
+			freeUnits := orderLine.Quantity / (p.BuyQuantity + p.GetQuantity) * p.GetQuantity
+			if freeUnits == 0 {
+				continue
+			}
+
+			amount, err := orderLine.UnitPrice.Multiply(freeUnits)
+			if err != nil {
+				return PromotionDiscount{}, err
+			}
+
+			lineDiscount, err := orderLine.Discount.Add(amount)
+			if err != nil {
+				return PromotionDiscount{}, err
+			}
+
+			if err := orderLine.ApplyDiscount(lineDiscount); err != nil {
+				return PromotionDiscount{}, err
+			}
+			discount.LineDiscounts[orderLine.ID] = amount
+		}
+	}
+
+	total, err := discount.Total()
+	if err != nil {
+		return PromotionDiscount{}, err
+	}
+
+	if total.IsZero() {
+		return PromotionDiscount{}, fmt.Errorf("promotion does not apply to the order")
+	}
+
+	return discount, nil
+}
+
+// Redeem records the use of the promotion by an order. customerRedemptions is the number of times
+// the customer already used it, both counters have to be read with the promotion locked. The
+// redemption is numbered only against the limits the promotion has.
+func (p *Promotion) Redeem(id string, order *Order, discount Money, customerRedemptions int) (*PromotionRedemption, error) {
+	if p.MaxRedemptionsPerCustomer != nil && customerRedemptions >= *p.MaxRedemptionsPerCustomer {
+		return nil, fmt.Errorf("%w for the customer", ErrPromotionLimitReached)
+	}
+
+	if p.MaxRedemptions != nil && p.Redemptions >= *p.MaxRedemptions {
+		return nil, ErrPromotionLimitReached
+	}
+
+	now := time.Now().UTC()
+	p.Redemptions++
+	p.UpdatedAt = now
+
+	redemption := &PromotionRedemption{
+		ID:          id,
+		PromotionID: p.ID,
+		OrderID:     order.GetID(),
+		CustomerID:  order.GetCustomerID(),
+		Discount:    discount,
+		CreatedAt:   now,
+	}
+	if p.MaxRedemptions != nil {
+		sequence := p.Redemptions
+		redemption.Sequence = &sequence
+	}
+	if p.MaxRedemptionsPerCustomer != nil {
+		customerSequence := customerRedemptions + 1
+		redemption.CustomerSequence = &customerSequence
+	}
+
+	if p.IsCoupon() {
+		data := CouponRedeemedEventData{
+			PromotionID: p.ID,
+			Code:        *p.Code,
+			StoreID:     p.StoreID,
+			OrderID:     redemption.OrderID,
+			CustomerID:  redemption.CustomerID,
+			Discount:    discount,
+			RedeemedAt:  now,
+		}
+		p.events = append(p.events, NewEvent(CouponRedeemed, PromotionAggregate, p.ID, data, now))
+	}
+
+	return redemption, nil
+}
+
+func (p *Promotion) GetID() string          { return p.ID }
+func (p *Promotion) GetStoreID() string     { return p.StoreID }
+func (p *Promotion) GetCode() *string       { return p.Code }
+func (p *Promotion) GetType() PromotionType { return p.Type }
+
+func (p *Promotion) TableName() string {
+	return "promotions"
+}
+
+// PromotionRedemption is a promotion used by an order. Sequence and CustomerSequence are set for the
+// promotions with a limit and are unique per promotion and per promotion and customer, a last guard
+// against redemptions over the limits.
+type PromotionRedemption struct {
+	ID               string    `sql:"id,primary"`
+	PromotionID      string    `sql:"promotion_id"`
+	OrderID          string    `sql:"order_id"`
+	CustomerID       string    `sql:"customer_id"`
+	Sequence         *int      `sql:"sequence"`
+	CustomerSequence *int      `sql:"customer_sequence"`
+	Discount         Money     `sql:"discount"`
+	CreatedAt        time.Time `sql:"created_at"`
+}
+
+func (r *PromotionRedemption) TableName() string {
+	return "promotion_redemptions"
+}
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// AppliedPromotion is a promotion that gave a discount to an order
+type AppliedPromotion struct {
+	Promotion *Promotion
+	Discount  Money
+}
+
+// PromotionEngine applies the automatic promotions of a store and the coupon entered by the customer
+type PromotionEngine struct{}
+
+func NewPromotionEngine() *PromotionEngine {
+	return &PromotionEngine{}
+}
+
+// Apply applies the line discounts to the order lines and returns the applied promotions along with the
+// order discount. Automatic promotions that do not apply are skipped, while a coupon that does not apply is an error.
+// The order discount never exceeds the order subtotal.
+func (e *PromotionEngine) Apply(ctx context.Context, orderLines []OrderLine, automatic []*Promotion, coupon *Promotion, now time.Time) ([]AppliedPromotion, Money, error) {
+	applied := []AppliedPromotion{}
+	orderDiscount := Money{}
+	if len(orderLines) > 0 {
+		orderDiscount = Money{Amount: 0, Currency: orderLines[0].Total.GetCurrency()}
+	}
+
+	apply := func(promotion *Promotion) error {
+		discount, err := promotion.Apply(orderLines, now)
+		if err != nil {
+			return err
+		}
+
+		total, err := discount.Total()
+		if err != nil {
+			return err
+		}
+
+		orderDiscount, err = orderDiscount.Add(discount.OrderDiscount)
+		if err != nil {
+			return err
+		}
+
+		applied = append(applied, AppliedPromotion{Promotion: promotion, Discount: total})
+		return nil
+	}
+
+	for _, promotion := range automatic {
+		if err := apply(promotion); err != nil {
+			slog.DebugContext(ctx, "automatic promotion skipped", "promotion_id", promotion.GetID(), "reason", err.Error())
+		}
+	}
+
+	if coupon != nil {
+		if err := apply(coupon); err != nil {
+			return nil, Money{}, err
+		}
+	}
+
+	subtotal := Money{Amount: 0, Currency: orderDiscount.GetCurrency()}
+	for _, orderLine := range orderLines {
+		var err error
+		subtotal, err = subtotal.Add(orderLine.Total)
+		if err != nil {
+			return nil, Money{}, err
+		}
+	}
+
+	if orderDiscount.GetAmount() > subtotal.GetAmount() {
+		orderDiscount = subtotal
+	}
+
+	return applied, orderDiscount, nil
+}
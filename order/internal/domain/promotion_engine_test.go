@@ -0,0 +1,91 @@
+package domain_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"ichibuy/order/internal/domain"
+)
+
+func newOrderLine(t *testing.T, id string, quantity, unitPrice int) domain.OrderLine {
+	t.Helper()
+	price, err := domain.NewMoney(unitPrice, "PEN")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	orderLine, err := domain.NewOrderLine(id, "product-"+id, "Product "+id, "store-1", quantity, price)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return *orderLine
+}
+
+func TestPromotionEngine_Apply(t *testing.T) {
+	now := time.Now().UTC()
+	code := "welcome10"
+
+	buyTwoGetOne, err := domain.NewPromotion("p1", domain.NewPromotionParams{
+		StoreID:     "store-1",
+		Type:        domain.BuyXGetYPromotionType,
+		BuyQuantity: 2,
+		GetQuantity: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	coupon, err := domain.NewPromotion("p2", domain.NewPromotionParams{
+		StoreID: "store-1",
+		Code:    &code,
+		Type:    domain.PercentagePromotionType,
+		Value:   1000,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	orderLines := []domain.OrderLine{
+		newOrderLine(t, "1", 3, 1000),
+		newOrderLine(t, "2", 1, 500),
+	}
+
+	applied, orderDiscount, err := domain.NewPromotionEngine().Apply(context.Background(), orderLines, []*domain.Promotion{buyTwoGetOne}, coupon, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(applied) != 2 {
+		t.Fatalf("expected 2 applied promotions, got %d", len(applied))
+	}
+
+	if orderLines[0].Discount.Amount != 1000 || orderLines[0].Total.Amount != 2000 {
+		t.Fatalf("expected one free unit, got discount %d and total %d", orderLines[0].Discount.Amount, orderLines[0].Total.Amount)
+	}
+
+	// 10% of the 2500 left after the line discount
+	if orderDiscount.Amount != 250 {
+		t.Fatalf("expected order discount 250, got %d", orderDiscount.Amount)
+	}
+}
+
+func TestPromotionEngine_Apply_CouponBelowMinimum(t *testing.T) {
+	code := "BIGSPENDER"
+	coupon, err := domain.NewPromotion("p1", domain.NewPromotionParams{
+		StoreID:        "store-1",
+		Code:           &code,
+		Type:           domain.FixedPromotionType,
+		Value:          500,
+		Currency:       "PEN",
+		MinOrderAmount: 10000,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	orderLines := []domain.OrderLine{newOrderLine(t, "1", 1, 1000)}
+	if _, _, err := domain.NewPromotionEngine().Apply(context.Background(), orderLines, nil, coupon, time.Now().UTC()); err == nil {
+		t.Fatal("expected minimum amount error")
+	}
+}
@@ -0,0 +1,74 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+
+	"ichibuy/order/internal/domain"
+)
+
+func TestPromotion_Redeem(t *testing.T) {
+	limit := func(n int) *int { return &n }
+
+	tests := []struct {
+		name                string
+		maxRedemptions      *int
+		maxPerCustomer      *int
+		redemptions         int
+		customerRedemptions int
+		expectErr           bool
+		sequence            *int
+		customerSequence    *int
+	}{
+		{name: "unlimited", redemptions: 41, customerRedemptions: 3},
+		{name: "below the limit", maxRedemptions: limit(10), redemptions: 4, sequence: limit(5)},
+		{name: "limit reached", maxRedemptions: limit(10), redemptions: 10, expectErr: true},
+		{name: "below the customer limit", maxPerCustomer: limit(2), customerRedemptions: 1, customerSequence: limit(2)},
+		{name: "customer limit reached", maxPerCustomer: limit(2), customerRedemptions: 2, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			promotion, err := domain.NewPromotion("promotion-1", domain.NewPromotionParams{
+				StoreID:                   "store-1",
+				Type:                      domain.PercentagePromotionType,
+				Value:                     1000,
+				MaxRedemptions:            tt.maxRedemptions,
+				MaxRedemptionsPerCustomer: tt.maxPerCustomer,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			promotion.Redemptions = tt.redemptions
+
+			order := &domain.Order{ID: "order-1", CustomerID: "customer-1"}
+			redemption, err := promotion.Redeem("redemption-1", order, domain.Money{Amount: 100, Currency: "PEN"}, tt.customerRedemptions)
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("expected error %v, got %v", tt.expectErr, err)
+			}
+			if tt.expectErr {
+				if !errors.Is(err, domain.ErrPromotionLimitReached) {
+					t.Errorf("expected ErrPromotionLimitReached, got %v", err)
+				}
+				if promotion.Redemptions != tt.redemptions {
+					t.Errorf("expected redemptions unchanged, got %d", promotion.Redemptions)
+				}
+				return
+			}
+
+			if promotion.Redemptions != tt.redemptions+1 {
+				t.Errorf("expected %d redemptions, got %d", tt.redemptions+1, promotion.Redemptions)
+			}
+			if !equalIntPtr(redemption.Sequence, tt.sequence) || !equalIntPtr(redemption.CustomerSequence, tt.customerSequence) {
+				t.Errorf("expected sequences %v %v, got %v %v", tt.sequence, tt.customerSequence, redemption.Sequence, redemption.CustomerSequence)
+			}
+		})
+	}
+}
+
+func equalIntPtr(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,209 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// FulfillmentOptions are the fulfillment methods a store offers, with the zones and fees of its deliveries
+type FulfillmentOptions struct {
+	Methods       []FulfillmentMethod `json:"methods"`
+	DeliveryZones []DeliveryZone      `json:"delivery_zones"`
+	ShippingFee   *Money              `json:"shipping_fee"`
+}
+
+// DefaultFulfillmentOptions are used by stores without fulfillment options, they only offer pickup
+var DefaultFulfillmentOptions = FulfillmentOptions{
+	Methods:       []FulfillmentMethod{PickupFulfillmentMethod},
+	DeliveryZones: []DeliveryZone{},
+}
+
+func (o FulfillmentOptions) validate() error {
+	if len(o.Methods) == 0 {
+		return fmt.Errorf("at least one fulfillment method is required")
+	}
+
+	seen := map[FulfillmentMethod]bool{}
+	for _, method := range o.Methods {
+		if _, err := ParseFulfillmentMethod(string(method)); err != nil {
+			return err
+		}
+
+		if seen[method] {
+			return fmt.Errorf("fulfillment method %s is repeated", method)
+		}
+		seen[method] = true
+	}
+
+	if seen[LocalDeliveryFulfillmentMethod] && len(o.DeliveryZones) == 0 {
+		return fmt.Errorf("local delivery needs at least one delivery zone")
+	}
+
+	for _, zone := range o.DeliveryZones {
+		if err := zone.validate(); err != nil {
+			return err
+		}
+	}
+
+	if seen[ShippingFulfillmentMethod] {
+		if o.ShippingFee == nil {
+			return fmt.Errorf("shipping needs a shipping fee")
+		}
+
+		if _, err := NewMoney(o.ShippingFee.GetAmount(), o.ShippingFee.GetCurrency()); err != nil {
+			return fmt.Errorf("shipping fee: %w", err)
+		}
+
+		if o.ShippingFee.GetAmount() < 0 {
+			return fmt.Errorf("shipping fee cannot be negative")
+		}
+	}
+
+	return nil
+}
+
+func (o FulfillmentOptions) offers(method FulfillmentMethod) bool {
+	for _, m := range o.Methods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// Quote returns the fulfillment of an order of the given currency, with the delivery fee for the address.
+// Local delivery uses the first zone that contains the address.
+func (o FulfillmentOptions) Quote(method FulfillmentMethod, address *DeliveryAddress, storeLocation GeoPoint, currency string) (OrderFulfillment, error) {
+	if !o.offers(method) {
+		return OrderFulfillment{}, fmt.Errorf("store does not offer %s", method)
+	}
+
+	fulfillment := OrderFulfillment{
+		Method:      method,
+		DeliveryFee: Money{Amount: 0, Currency: currency},
+	}
+
+	if !method.IsDelivery() {
+		return fulfillment, nil
+	}
+
+	if address == nil {
+		return OrderFulfillment{}, fmt.Errorf("a delivery address is required for %s", method)
+	}
+
+	if err := address.Validate(); err != nil {
+		return OrderFulfillment{}, err
+	}
+	fulfillment.DeliveryAddress = address
+
+	switch method {
+	case LocalDeliveryFulfillmentMethod:
+		if address.Location == nil {
+			return OrderFulfillment{}, fmt.Errorf("delivery address location is required for local delivery")
+		}
+
+		zone, ok := o.findDeliveryZone(storeLocation, *address.Location)
+		if !ok {
+			return OrderFulfillment{}, ErrOutsideDeliveryZones
+		}
+
+		fee, err := zone.Fee(storeLocation.DistanceKm(*address.Location))
+		if err != nil {
+			return OrderFulfillment{}, err
+		}
+		fulfillment.DeliveryFee = fee
+	case ShippingFulfillmentMethod:
+		fulfillment.DeliveryFee = *o.ShippingFee
+	}
+
+	if fulfillment.DeliveryFee.GetCurrency() != currency {
+		return OrderFulfillment{}, fmt.Errorf("delivery fee currency %s does not match the order currency %s", fulfillment.DeliveryFee.GetCurrency(), currency)
+	}
+
+	return fulfillment, nil
+}
+
+func (o FulfillmentOptions) findDeliveryZone(storeLocation, location GeoPoint) (DeliveryZone, bool) {
+	for _, zone := range o.DeliveryZones {
+		if zone.Contains(storeLocation, location) {
+			return zone, true
+		}
+	}
+	return DeliveryZone{}, false
+}
+
+// StoreFulfillment keeps the fulfillment options of a store
+type StoreFulfillment struct {
+	StoreID       string          `sql:"store_id,primary"`
+	Methods       json.RawMessage `sql:"methods"`
+	DeliveryZones json.RawMessage `sql:"delivery_zones"`
+	ShippingFee   *Money          `sql:"shipping_fee"`
+	CreatedAt     time.Time       `sql:"created_at"`
+	UpdatedAt     time.Time       `sql:"updated_at"`
+}
+
+func NewStoreFulfillment(storeID string, options FulfillmentOptions) (*StoreFulfillment, error) {
+	if strings.TrimSpace(storeID) == "" {
+		return nil, fmt.Errorf("storeID cannot be empty")
+	}
+
+	now := time.Now().UTC()
+	fulfillment := &StoreFulfillment{
+		StoreID:   storeID,
+		CreatedAt: now,
+	}
+
+	if err := fulfillment.Update(options); err != nil {
+		return nil, err
+	}
+
+	fulfillment.UpdatedAt = now
+	return fulfillment, nil
+}
+
+func (s *StoreFulfillment) Update(options FulfillmentOptions) error {
+	if err := options.validate(); err != nil {
+		return err
+	}
+
+	if options.DeliveryZones == nil {
+		options.DeliveryZones = []DeliveryZone{}
+	}
+
+	methods, err := json.Marshal(options.Methods)
+	if err != nil {
+		return err
+	}
+
+	zones, err := json.Marshal(options.DeliveryZones)
+	if err != nil {
+		return err
+	}
+
+	s.Methods = methods
+	s.DeliveryZones = zones
+	s.ShippingFee = options.ShippingFee
+	s.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+func (s *StoreFulfillment) GetStoreID() string      { return s.StoreID }
+func (s *StoreFulfillment) GetCreatedAt() time.Time { return s.CreatedAt }
+func (s *StoreFulfillment) GetUpdatedAt() time.Time { return s.UpdatedAt }
+
+func (s *StoreFulfillment) GetOptions() FulfillmentOptions {
+	options := FulfillmentOptions{
+		Methods:       []FulfillmentMethod{},
+		DeliveryZones: []DeliveryZone{},
+		ShippingFee:   s.ShippingFee,
+	}
+	_ = json.Unmarshal(s.Methods, &options.Methods)
+	_ = json.Unmarshal(s.DeliveryZones, &options.DeliveryZones)
+	return options
+}
+
+func (s *StoreFulfillment) TableName() string {
+	return "store_fulfillments"
+}
@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+	// embeds the timezone database, so the store timezones load on hosts without one
+	_ "time/tzdata"
+)
+
+const scheduleDateLayout = "2006-01-02"
+
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// TimeRange is a range of the day in HH:MM format, Close can be "24:00" to include the end of the day
+type TimeRange struct {
+	Open  string `json:"open"`
+	Close string `json:"close"`
+}
+
+// OpeningHours are the hours a store opens every week on a day, e.g. monday
+type OpeningHours struct {
+	Day   string `json:"day"`
+	Open  string `json:"open"`
+	Close string `json:"close"`
+}
+
+// ScheduleException replaces the opening hours of a date, without hours the store is closed the whole date
+type ScheduleException struct {
+	Date  string      `json:"date"`
+	Name  string      `json:"name"`
+	Hours []TimeRange `json:"hours"`
+}
+
+// StoreSchedule is the schedule of a store as published in the store events, it is validated by the store
+// service. A schedule without opening hours keeps the store always open.
+type StoreSchedule struct {
+	Timezone        string              `json:"timezone"`
+	OpeningHours    []OpeningHours      `json:"opening_hours"`
+	Exceptions      []ScheduleException `json:"exceptions"`
+	AllowsPreOrders bool                `json:"allows_pre_orders"`
+}
+
+// IsOpenAt tells if the store is open at the given instant, in the timezone of the store. The exception of
+// the date wins over the weekly hours, and stores without weekly hours are always open on the other dates.
+func (s StoreSchedule) IsOpenAt(at time.Time) bool {
+	location, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		location = time.UTC
+	}
+
+	local := at.In(location)
+	minute := local.Hour()*60 + local.Minute()
+
+	date := local.Format(scheduleDateLayout)
+	for _, exception := range s.Exceptions {
+		if exception.Date == date {
+			return inAnyRange(exception.Hours, minute)
+		}
+	}
+
+	if len(s.OpeningHours) == 0 {
+		return true
+	}
+
+	ranges := []TimeRange{}
+	for _, hours := range s.OpeningHours {
+		if weekdays[hours.Day] == local.Weekday() {
+			ranges = append(ranges, TimeRange{Open: hours.Open, Close: hours.Close})
+		}
+	}
+
+	return inAnyRange(ranges, minute)
+}
+
+func inAnyRange(ranges []TimeRange, minute int) bool {
+	for _, r := range ranges {
+		open, err := parseClock(r.Open)
+		if err != nil {
+			continue
+		}
+		closing, err := parseClock(r.Close)
+		if err != nil {
+			continue
+		}
+		if minute >= open && minute < closing {
+			return true
+		}
+	}
+	return false
+}
+
+// parseClock returns the minutes since midnight of a HH:MM time
+func parseClock(value string) (int, error) {
+	if value == "24:00" {
+		return 24 * 60, nil
+	}
+
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %s, expected HH:MM", value)
+	}
+
+	return t.Hour()*60 + t.Minute(), nil
+}
@@ -0,0 +1,53 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"ichibuy/order/internal/domain"
+)
+
+func TestStoreSchedule_IsOpenAt(t *testing.T) {
+	schedule := domain.StoreSchedule{
+		Timezone: "America/Lima",
+		OpeningHours: []domain.OpeningHours{
+			{Day: "monday", Open: "09:00", Close: "13:00"},
+			{Day: "monday", Open: "15:00", Close: "24:00"},
+		},
+		Exceptions: []domain.ScheduleException{
+			{Date: "2025-07-28", Name: "Independence day"},
+			{Date: "2025-08-04", Name: "Inventory", Hours: []domain.TimeRange{{Open: "10:00", Close: "12:00"}}},
+		},
+	}
+
+	// Lima is UTC-5, 2025-07-21 is a monday
+	tests := []struct {
+		name     string
+		schedule domain.StoreSchedule
+		at       time.Time
+		expected bool
+	}{
+		{"open in the morning hours", schedule, time.Date(2025, 7, 21, 14, 0, 0, 0, time.UTC), true},
+		{"closed between the ranges", schedule, time.Date(2025, 7, 21, 19, 0, 0, 0, time.UTC), false},
+		{"open until midnight in the timezone of the store", schedule, time.Date(2025, 7, 22, 4, 59, 0, 0, time.UTC), true},
+		{"closed on a day without hours", schedule, time.Date(2025, 7, 22, 15, 0, 0, 0, time.UTC), false},
+		{"closed on an exception without hours", schedule, time.Date(2025, 7, 28, 15, 0, 0, 0, time.UTC), false},
+		{"open in the hours of the exception", schedule, time.Date(2025, 8, 4, 16, 0, 0, 0, time.UTC), true},
+		{"closed outside the hours of the exception", schedule, time.Date(2025, 8, 4, 14, 0, 0, 0, time.UTC), false},
+		{"always open without opening hours", domain.StoreSchedule{Timezone: "UTC"}, time.Date(2025, 7, 22, 3, 0, 0, 0, time.UTC), true},
+		{
+			"exceptions close a store without opening hours",
+			domain.StoreSchedule{Timezone: "UTC", Exceptions: []domain.ScheduleException{{Date: "2025-12-25"}}},
+			time.Date(2025, 12, 25, 12, 0, 0, 0, time.UTC),
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.schedule.IsOpenAt(tt.at); got != tt.expected {
+				t.Fatalf("expected open %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
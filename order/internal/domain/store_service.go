@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"context"
+	"errors"
+)
+
+type StoreService interface {
+	FindByID(ctx context.Context, id string) (*StoreDTO, error)
+}
+
+type StoreDTO struct {
+	ID       string
+	UserID   string
+	Location GeoPoint
+}
+
+// ErrStoreClosed is returned when ordering from a closed store that does not take pre-orders
+var ErrStoreClosed = errors.New("store is closed and does not accept pre-orders")
+
+// StoreAvailabilityService tells if a store is open, according to the schedule kept by the store service
+type StoreAvailabilityService interface {
+	FindAvailability(ctx context.Context, storeID string) (*StoreAvailabilityDTO, error)
+}
+
+type StoreAvailabilityDTO struct {
+	StoreID         string
+	IsOpenNow       bool
+	AllowsPreOrders bool
+}
+
+// CheckAcceptsOrders returns ErrStoreClosed when the store cannot take orders now
+func (a *StoreAvailabilityDTO) CheckAcceptsOrders() error {
+	if a.IsOpenNow || a.AllowsPreOrders {
+		return nil
+	}
+	return ErrStoreClosed
+}
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// StoreTaxRate is the tax rate a store applies to its orders
+type StoreTaxRate struct {
+	StoreID   string    `sql:"store_id,primary"`
+	Name      string    `sql:"name"`
+	Rate      int       `sql:"rate"`
+	Inclusive bool      `sql:"inclusive"`
+	CreatedAt time.Time `sql:"created_at"`
+	UpdatedAt time.Time `sql:"updated_at"`
+}
+
+func NewStoreTaxRate(storeID string, taxRate TaxRate) (*StoreTaxRate, error) {
+	if strings.TrimSpace(storeID) == "" {
+		return nil, fmt.Errorf("storeID cannot be empty")
+	}
+
+	now := time.Now().UTC()
+	return &StoreTaxRate{
+		StoreID:   storeID,
+		Name:      taxRate.Name,
+		Rate:      taxRate.Rate,
+		Inclusive: taxRate.Inclusive,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+func (s *StoreTaxRate) Update(taxRate TaxRate) {
+	s.Name = taxRate.Name
+	s.Rate = taxRate.Rate
+	s.Inclusive = taxRate.Inclusive
+	s.UpdatedAt = time.Now().UTC()
+}
+
+func (s *StoreTaxRate) GetStoreID() string      { return s.StoreID }
+func (s *StoreTaxRate) GetCreatedAt() time.Time { return s.CreatedAt }
+func (s *StoreTaxRate) GetUpdatedAt() time.Time { return s.UpdatedAt }
+
+func (s *StoreTaxRate) GetTaxRate() TaxRate {
+	return TaxRate{Name: s.Name, Rate: s.Rate, Inclusive: s.Inclusive}
+}
+
+func (s *StoreTaxRate) TableName() string {
+	return "store_tax_rates"
+}
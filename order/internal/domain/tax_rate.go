@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const basisPoints = 10000
+
+// TaxRate is a sales tax such as the peruvian IGV. Rate is expressed in basis points (1800 = 18%).
+// Inclusive rates are already part of the prices, exclusive rates are added on top of them.
+type TaxRate struct {
+	Name      string `json:"name"`
+	Rate      int    `json:"rate"`
+	Inclusive bool   `json:"inclusive"`
+}
+
+// NoTaxRate is used for stores without a configured tax rate
+var NoTaxRate = TaxRate{}
+
+func NewTaxRate(name string, rate int, inclusive bool) (TaxRate, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return TaxRate{}, fmt.Errorf("tax name cannot be empty")
+	}
+
+	if len(name) > 50 {
+		return TaxRate{}, fmt.Errorf("tax name cannot exceed 50 characters")
+	}
+
+	if rate < 0 || rate > basisPoints {
+		return TaxRate{}, fmt.Errorf("tax rate must be between 0 and %d basis points", basisPoints)
+	}
+
+	return TaxRate{Name: name, Rate: rate, Inclusive: inclusive}, nil
+}
+
+// Apply returns the tax contained in or added to the taxable amount, and the amount to be paid
+func (t TaxRate) Apply(taxable Money) (tax Money, total Money, err error) {
+	if t.Rate == 0 {
+		return Money{Amount: 0, Currency: taxable.Currency}, taxable, nil
+	}
+
+	if t.Inclusive {
+		net, err := taxable.Scale(basisPoints, basisPoints+t.Rate)
+		if err != nil {
+			return Money{}, Money{}, err
+		}
+
+		tax, err := taxable.Subtract(net)
+		if err != nil {
+			return Money{}, Money{}, err
+		}
+
+		return tax, taxable, nil
+	}
+
+	tax, err = taxable.Scale(t.Rate, basisPoints)
+	if err != nil {
+		return Money{}, Money{}, err
+	}
+
+	total, err = taxable.Add(tax)
+	if err != nil {
+		return Money{}, Money{}, err
+	}
+
+	return tax, total, nil
+}
+
+// Scan reads a tax rate stored as a json column
+func (t *TaxRate) Scan(src any) error {
+	switch v := src.(type) {
+	case []byte:
+		return json.Unmarshal(v, t)
+	case string:
+		return json.Unmarshal([]byte(v), t)
+	case nil:
+		*t = NoTaxRate
+		return nil
+	default:
+		return fmt.Errorf("cannot scan %T into tax rate", src)
+	}
+}
+
+// Value stores a tax rate as a json column
+func (t TaxRate) Value() (driver.Value, error) {
+	return json.Marshal(t)
+}
@@ -0,0 +1,36 @@
+package domain_test
+
+import (
+	"testing"
+
+	"ichibuy/order/internal/domain"
+)
+
+func TestTaxRate_Apply(t *testing.T) {
+	tests := []struct {
+		name          string
+		taxRate       domain.TaxRate
+		taxable       int
+		expectedTax   int
+		expectedTotal int
+	}{
+		{"exclusive", domain.TaxRate{Name: "IGV", Rate: 1800}, 10000, 1800, 11800},
+		{"inclusive", domain.TaxRate{Name: "IGV", Rate: 1800, Inclusive: true}, 11800, 1800, 11800},
+		{"inclusive rounds", domain.TaxRate{Name: "IGV", Rate: 1800, Inclusive: true}, 999, 152, 999},
+		{"exclusive rounds half up", domain.TaxRate{Name: "VAT", Rate: 500}, 10, 1, 11},
+		{"no tax", domain.NoTaxRate, 2500, 0, 2500},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tax, total, err := tt.taxRate.Apply(domain.Money{Amount: tt.taxable, Currency: "PEN"})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tax.Amount != tt.expectedTax || total.Amount != tt.expectedTotal {
+				t.Fatalf("expected tax %d and total %d, got %d and %d", tt.expectedTax, tt.expectedTotal, tax.Amount, total.Amount)
+			}
+		})
+	}
+}
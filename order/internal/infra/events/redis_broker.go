@@ -0,0 +1,154 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"ichibuy/order/internal/domain"
+)
+
+const (
+	// redisStreamPrefix names the stream of every event type, ichibuy:events:<type>
+	redisStreamPrefix = "ichibuy:events:"
+	redisStreamMaxLen = 100000
+	redisBatchSize    = 10
+	redisBlock        = 2 * time.Second
+)
+
+// RedisBroker is a message broker on Redis Streams, with a stream per event type and a consumer group per
+// group of subscribers. Events acknowledged are removed from the pending list of the group, the ones
+// nacked stay pending and are claimed again once idle for the redelivery delay.
+type RedisBroker struct {
+	client          *redis.Client
+	redeliveryDelay time.Duration
+}
+
+func NewRedisBroker(ctx context.Context, url string, redeliveryDelay time.Duration) (*RedisBroker, error) {
+	options, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, err
+	}
+
+	client := redis.NewClient(options)
+	if err := client.Ping(ctx).Err(); err != nil {
+		client.Close()
+		return nil, err
+	}
+
+	return &RedisBroker{client: client, redeliveryDelay: redeliveryDelay}, nil
+}
+
+func (b *RedisBroker) Publish(ctx context.Context, events ...domain.Event) error {
+	for _, event := range events {
+		data, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+
+		err = b.client.XAdd(ctx, &redis.XAddArgs{
+			Stream: redisStreamPrefix + string(event.Type),
+			MaxLen: redisStreamMaxLen,
+			Approx: true,
+			Values: map[string]any{"event": data},
+		}).Err()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *RedisBroker) Subscribe(ctx context.Context, group string, types []domain.EventType, handle func(ctx context.Context, event domain.Event) error) error {
+	streams := make([]string, len(types))
+	for i, eventType := range types {
+		streams[i] = redisStreamPrefix + string(eventType)
+		err := b.client.XGroupCreateMkStream(ctx, streams[i], group, "0").Err()
+		if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+			return err
+		}
+	}
+
+	hostname, _ := os.Hostname()
+	consumer := fmt.Sprintf("%s-%d", hostname, os.Getpid())
+
+	for ctx.Err() == nil {
+		for _, stream := range streams {
+			messages, _, err := b.client.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+				Stream:   stream,
+				Group:    group,
+				Consumer: consumer,
+				MinIdle:  b.redeliveryDelay,
+				Start:    "0-0",
+				Count:    redisBatchSize,
+			}).Result()
+			if err != nil {
+				return b.stopped(ctx, err)
+			}
+			b.handle(ctx, stream, group, messages, handle)
+		}
+
+		args := make([]string, 0, len(streams)*2)
+		args = append(args, streams...)
+		for range streams {
+			args = append(args, ">")
+		}
+
+		results, err := b.client.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    group,
+			Consumer: consumer,
+			Streams:  args,
+			Count:    redisBatchSize,
+			Block:    redisBlock,
+		}).Result()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			return b.stopped(ctx, err)
+		}
+
+		for _, result := range results {
+			b.handle(ctx, result.Stream, group, result.Messages, handle)
+		}
+	}
+	return nil
+}
+
+func (b *RedisBroker) Close() error {
+	return b.client.Close()
+}
+
+// handle acknowledges the messages handled, and the ones that cannot be decoded so they are not claimed forever
+func (b *RedisBroker) handle(ctx context.Context, stream, group string, messages []redis.XMessage, handle func(ctx context.Context, event domain.Event) error) {
+	for _, message := range messages {
+		var event domain.Event
+		data, _ := message.Values["event"].(string)
+		if err := json.Unmarshal([]byte(data), &event); err != nil {
+			slog.ErrorContext(ctx, "decode event failed", "group", group, "stream", stream, "message_id", message.ID, "error", err.Error())
+			b.client.XAck(ctx, stream, group, message.ID)
+			continue
+		}
+
+		if err := handle(ctx, event); err != nil {
+			slog.ErrorContext(ctx, "handle event failed", "group", group, "event_id", event.ID, "error", err.Error())
+			continue
+		}
+		b.client.XAck(ctx, stream, group, message.ID)
+	}
+}
+
+// stopped returns nil when the error comes from the context being done
+func (b *RedisBroker) stopped(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return nil
+	}
+	return err
+}
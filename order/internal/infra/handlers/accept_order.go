@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/order/internal/services"
+)
+
+// AcceptOrder godoc
+// @Summary      Accept an order of my store
+// @Description  Accept an order created or paid, only the store owner can accept it
+// @Tags         orders
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Order ID"
+// @Success      204
+// @Failure      400  {object}  ErrorResp
+// @Failure      401  {object}  ErrorResp
+// @Router       /api/v1/orders/{id}/accept [post]
+// @Security     BearerAuth
+func AcceptOrder(acceptOrderService *services.AcceptOrder) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		id := c.Param("id")
+		if id == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "id parameter is required"})
+			return
+		}
+
+		if err := acceptOrderService.Exec(c, services.AcceptOrderReq{ID: id, UserID: userID.(string)}); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusNoContent, nil)
+	}
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/order/internal/services"
+)
+
+type AddCartLineBody struct {
+	ProductID string `json:"product_id" binding:"required"`
+	Quantity  int    `json:"quantity" binding:"required"`
+	Currency  string `json:"currency"`
+}
+
+// AddCartLine godoc
+// @Summary      Add a product to my cart
+// @Description  Add a product to the cart of the authenticated user, all the products must belong to the same store and be priced in the cart currency
+// @Tags         cart
+// @Accept       json
+// @Produce      json
+// @Param        line body AddCartLineBody true "Cart line data"
+// @Success      200  {object}  services.GetCartResp
+// @Failure      400  {object}  ErrorResp
+// @Failure      401  {object}  ErrorResp
+// @Router       /api/v1/cart/lines [post]
+// @Security     BearerAuth
+func AddCartLine(addCartLineService *services.AddCartLine) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		var body AddCartLineBody
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		resp, err := addCartLineService.Exec(c, services.AddCartLineReq{
+			UserID:    userID.(string),
+			ProductID: body.ProductID,
+			Quantity:  body.Quantity,
+			Currency:  body.Currency,
+		})
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
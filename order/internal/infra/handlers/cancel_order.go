@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/order/internal/services"
+)
+
+type CancelOrderBody struct {
+	Reason *string `json:"reason"`
+}
+
+// CancelOrder godoc
+// @Summary      Cancel my order
+// @Description  Cancel an order before the store accepts it, a paid order is refunded
+// @Tags         orders
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Order ID"
+// @Param        cancel body CancelOrderBody false "Cancel data"
+// @Success      204
+// @Failure      400  {object}  ErrorResp
+// @Failure      401  {object}  ErrorResp
+// @Router       /api/v1/orders/{id}/cancel [post]
+// @Security     BearerAuth
+func CancelOrder(cancelOrderService *services.CancelOrder) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		id := c.Param("id")
+		if id == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "id parameter is required"})
+			return
+		}
+
+		var body CancelOrderBody
+		if c.Request.ContentLength > 0 {
+			if err := c.ShouldBindJSON(&body); err != nil {
+				c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+				return
+			}
+		}
+
+		if err := cancelOrderService.Exec(c, services.CancelOrderReq{ID: id, Reason: body.Reason, UserID: userID.(string)}); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusNoContent, nil)
+	}
+}
@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/order/internal/domain"
+	"ichibuy/order/internal/services"
+)
+
+type CheckoutBody struct {
+	OrderLines []OrderLineReq `json:"order_lines" binding:"required"`
+	// CouponCodes by store ID
+	CouponCodes map[string]string `json:"coupon_codes"`
+	// FulfillmentMethods by store ID, pickup for the stores without one
+	FulfillmentMethods map[string]string `json:"fulfillment_methods"`
+	// DeliveryAddress of the stores delivering their order
+	DeliveryAddress *domain.DeliveryAddress `json:"delivery_address"`
+	// AddressID of a saved address of the customer, used instead of the delivery address
+	AddressID *string `json:"address_id"`
+}
+
+// Checkout godoc
+// @Summary      Checkout a basket of several stores
+// @Description  Create one order per store from a basket with products of several stores, all the orders are created or none
+// @Tags         checkouts
+// @Accept       json
+// @Produce      json
+// @Param        checkout body CheckoutBody true "Checkout data"
+// @Success      201  {object}  services.CheckoutResp
+// @Failure      400  {object}  ErrorResp
+// @Failure      401  {object}  ErrorResp
+// @Router       /api/v1/checkouts [post]
+// @Security     BearerAuth
+func Checkout(checkoutService *services.Checkout) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		var body CheckoutBody
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		order := createOrderBodyToCreateOrderReq(CreateOrderBody{OrderLines: body.OrderLines}, userID.(string))
+		resp, err := checkoutService.Exec(c, services.CheckoutReq{
+			OrderLines:         order.OrderLines,
+			CouponCodes:        body.CouponCodes,
+			FulfillmentMethods: body.FulfillmentMethods,
+			DeliveryAddress:    body.DeliveryAddress,
+			AddressID:          body.AddressID,
+			UserID:             userID.(string),
+		})
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusCreated, resp)
+	}
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/order/internal/services"
+)
+
+type CheckoutCartBody struct {
+	CouponCode  *string          `json:"coupon_code"`
+	Fulfillment *FulfillmentBody `json:"fulfillment"`
+}
+
+// CheckoutCart godoc
+// @Summary      Checkout my cart
+// @Description  Create an order from the cart of the authenticated user at the current product prices and empty the cart
+// @Tags         cart
+// @Accept       json
+// @Produce      json
+// @Param        checkout body CheckoutCartBody false "Checkout data"
+// @Success      201  {object}  services.CreateOrderResp
+// @Failure      400  {object}  ErrorResp
+// @Failure      401  {object}  ErrorResp
+// @Router       /api/v1/cart/checkout [post]
+// @Security     BearerAuth
+func CheckoutCart(checkoutCartService *services.CheckoutCart) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		var body CheckoutCartBody
+		if c.Request.ContentLength > 0 {
+			if err := c.ShouldBindJSON(&body); err != nil {
+				c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+				return
+			}
+		}
+
+		resp, err := checkoutCartService.Exec(c, services.CheckoutCartReq{
+			UserID:      userID.(string),
+			CouponCode:  body.CouponCode,
+			Fulfillment: fulfillmentBodyToFulfillmentReq(body.Fulfillment),
+		})
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusCreated, resp)
+	}
+}
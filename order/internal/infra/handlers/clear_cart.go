@@ -0,0 +1,37 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/order/internal/services"
+)
+
+// ClearCart godoc
+// @Summary      Clear my cart
+// @Description  Remove all the products from the cart of the authenticated user
+// @Tags         cart
+// @Accept       json
+// @Produce      json
+// @Success      204
+// @Failure      401  {object}  ErrorResp
+// @Failure      500  {object}  ErrorResp
+// @Router       /api/v1/cart [delete]
+// @Security     BearerAuth
+func ClearCart(clearCartService *services.ClearCart) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		if err := clearCartService.Exec(c, services.ClearCartReq{UserID: userID.(string)}); err != nil {
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusNoContent, nil)
+	}
+}
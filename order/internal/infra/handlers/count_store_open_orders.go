@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/order/internal/services"
+)
+
+// CountStoreOpenOrders godoc
+// @Summary      Count open orders of a store
+// @Description  Count the orders of a store that are not finished, canceled or rejected yet (store owner only)
+// @Tags         orders
+// @Accept       json
+// @Produce      json
+// @Param        storeId path string true "Store ID"
+// @Success      200  {object}  services.CountStoreOpenOrdersResp
+// @Failure      400  {object}  ErrorResp
+// @Failure      401  {object}  ErrorResp
+// @Router       /api/v1/stores/{storeId}/orders/open-count [get]
+// @Security     BearerAuth
+func CountStoreOpenOrders(countStoreOpenOrdersService *services.CountStoreOpenOrders) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		storeID := c.Param("storeId")
+		if storeID == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "storeId parameter is required"})
+			return
+		}
+
+		resp, err := countStoreOpenOrdersService.Exec(c, services.CountStoreOpenOrdersReq{StoreID: storeID, UserID: userID.(string)})
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -5,11 +5,23 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"ichibuy/order/internal/domain"
 	"ichibuy/order/internal/services"
 )
 
 type CreateOrderBody struct {
-	OrderLines []OrderLineReq `json:"order_lines" binding:"required"`
+	OrderLines  []OrderLineReq   `json:"order_lines" binding:"required"`
+	CouponCode  *string          `json:"coupon_code"`
+	Fulfillment *FulfillmentBody `json:"fulfillment"`
+}
+
+type FulfillmentBody struct {
+	// Method is pickup, local_delivery or shipping
+	Method string `json:"method" binding:"required"`
+	// DeliveryAddress is required for local_delivery and shipping, local_delivery also needs its location
+	DeliveryAddress *domain.DeliveryAddress `json:"delivery_address"`
+	// AddressID of a saved address of the customer, used instead of the delivery address
+	AddressID *string `json:"address_id"`
 }
 
 type OrderLineReq struct {
@@ -23,7 +35,7 @@ type OrderLineReq struct {
 
 // CreateOrder godoc
 // @Summary      Create a new order
-// @Description  Create a new order
+// @Description  Create a new order, picked up at the store unless another fulfillment is given
 // @Tags         orders
 // @Accept       json
 // @Produce      json
@@ -70,7 +82,16 @@ func createOrderBodyToCreateOrderReq(body CreateOrderBody, userID string) servic
 		})
 	}
 	return services.CreateOrderReq{
-		OrderLines: orderLines,
-		UserID:     userID,
+		OrderLines:  orderLines,
+		CouponCode:  body.CouponCode,
+		Fulfillment: fulfillmentBodyToFulfillmentReq(body.Fulfillment),
+		UserID:      userID,
+	}
+}
+
+func fulfillmentBodyToFulfillmentReq(body *FulfillmentBody) *services.FulfillmentReq {
+	if body == nil {
+		return nil
 	}
+	return &services.FulfillmentReq{Method: body.Method, DeliveryAddress: body.DeliveryAddress, AddressID: body.AddressID}
 }
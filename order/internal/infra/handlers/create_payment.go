@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/order/internal/services"
+)
+
+// CreatePayment godoc
+// @Summary      Pay an order
+// @Description  Create a payment intent for the order total in the payment provider, the payment is confirmed by the provider webhook
+// @Tags         payments
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Order ID"
+// @Success      201  {object}  services.CreatePaymentResp
+// @Failure      400  {object}  ErrorResp
+// @Failure      401  {object}  ErrorResp
+// @Router       /api/v1/orders/{id}/payments [post]
+// @Security     BearerAuth
+func CreatePayment(createPaymentService *services.CreatePayment) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		id := c.Param("id")
+		if id == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "id parameter is required"})
+			return
+		}
+
+		resp, err := createPaymentService.Exec(c, services.CreatePaymentReq{OrderID: id, UserID: userID.(string)})
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusCreated, resp)
+	}
+}
@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/order/internal/services"
+)
+
+type CreatePromotionBody struct {
+	// Code of the coupon, automatic promotions have no code
+	Code *string `json:"code"`
+	// Type is one of percentage, fixed or buy_x_get_y
+	Type string `json:"type" binding:"required"`
+	// Value is the percentage in basis points or the fixed amount in minor units
+	Value                     int        `json:"value"`
+	Currency                  string     `json:"currency"`
+	MinOrderAmount            int        `json:"min_order_amount"`
+	ProductID                 *string    `json:"product_id"`
+	BuyQuantity               int        `json:"buy_quantity"`
+	GetQuantity               int        `json:"get_quantity"`
+	MaxRedemptions            *int       `json:"max_redemptions"`
+	MaxRedemptionsPerCustomer *int       `json:"max_redemptions_per_customer"`
+	StartsAt                  *time.Time `json:"starts_at"`
+	EndsAt                    *time.Time `json:"ends_at"`
+}
+
+// CreatePromotion godoc
+// @Summary      Create a promotion
+// @Description  Create a coupon or an automatic promotion for a store, only the store owner can create it
+// @Tags         promotions
+// @Accept       json
+// @Produce      json
+// @Param        storeId path string true "Store ID"
+// @Param        promotion body CreatePromotionBody true "Promotion data"
+// @Success      201  {object}  services.CreatePromotionResp
+// @Failure      400  {object}  ErrorResp
+// @Failure      401  {object}  ErrorResp
+// @Router       /api/v1/stores/{storeId}/promotions [post]
+// @Security     BearerAuth
+func CreatePromotion(createPromotionService *services.CreatePromotion) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		storeID := c.Param("storeId")
+		if storeID == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "storeId parameter is required"})
+			return
+		}
+
+		var req CreatePromotionBody
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		resp, err := createPromotionService.Exec(c, services.CreatePromotionReq{
+			StoreID:                   storeID,
+			UserID:                    userID.(string),
+			Code:                      req.Code,
+			Type:                      req.Type,
+			Value:                     req.Value,
+			Currency:                  req.Currency,
+			MinOrderAmount:            req.MinOrderAmount,
+			ProductID:                 req.ProductID,
+			BuyQuantity:               req.BuyQuantity,
+			GetQuantity:               req.GetQuantity,
+			MaxRedemptions:            req.MaxRedemptions,
+			MaxRedemptionsPerCustomer: req.MaxRedemptionsPerCustomer,
+			StartsAt:                  req.StartsAt,
+			EndsAt:                    req.EndsAt,
+		})
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusCreated, resp)
+	}
+}
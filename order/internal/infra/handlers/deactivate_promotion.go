@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/order/internal/services"
+)
+
+// DeactivatePromotion godoc
+// @Summary      Deactivate a promotion
+// @Description  Stop a coupon or an automatic promotion from being applied, only the store owner can deactivate it
+// @Tags         promotions
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Promotion ID"
+// @Success      204
+// @Failure      400  {object}  ErrorResp
+// @Failure      401  {object}  ErrorResp
+// @Router       /api/v1/promotions/{id}/deactivate [post]
+// @Security     BearerAuth
+func DeactivatePromotion(deactivatePromotionService *services.DeactivatePromotion) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		id := c.Param("id")
+		if id == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "id parameter is required"})
+			return
+		}
+
+		err := deactivatePromotionService.Exec(c, services.DeactivatePromotionReq{ID: id, UserID: userID.(string)})
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusNoContent, nil)
+	}
+}
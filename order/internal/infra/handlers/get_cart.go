@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/order/internal/services"
+)
+
+// GetCart godoc
+// @Summary      Get my cart
+// @Description  Get the cart of the authenticated user repriced with the current product prices
+// @Tags         cart
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  services.GetCartResp
+// @Failure      401  {object}  ErrorResp
+// @Failure      500  {object}  ErrorResp
+// @Router       /api/v1/cart [get]
+// @Security     BearerAuth
+func GetCart(getCartService *services.GetCart) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		resp, err := getCartService.Exec(c, services.GetCartReq{UserID: userID.(string)})
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
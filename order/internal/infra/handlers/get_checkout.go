@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/order/internal/services"
+)
+
+// GetCheckout godoc
+// @Summary      Get a checkout
+// @Description  Get the orders created together in a checkout of the authenticated user
+// @Tags         checkouts
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Checkout ID"
+// @Success      200  {object}  services.GetCheckoutResp
+// @Failure      400  {object}  ErrorResp
+// @Failure      401  {object}  ErrorResp
+// @Failure      404  {object}  ErrorResp
+// @Router       /api/v1/checkouts/{id} [get]
+// @Security     BearerAuth
+func GetCheckout(getCheckoutService *services.GetCheckout) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		id := c.Param("id")
+		if id == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "id parameter is required"})
+			return
+		}
+
+		resp, err := getCheckoutService.Exec(c, services.GetCheckoutReq{ID: id, UserID: userID.(string)})
+		if err != nil {
+			c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/order/internal/services"
+)
+
+// GetOrderByCode godoc
+// @Summary      Get an order by code
+// @Description  Get an order by its code, ignoring case and hyphens. Visible to the customer and the store owner
+// @Tags         orders
+// @Accept       json
+// @Produce      json
+// @Param        code path string true "Order code"
+// @Success      200  {object}  services.OrderDTO
+// @Failure      400  {object}  ErrorResp
+// @Failure      401  {object}  ErrorResp
+// @Failure      404  {object}  ErrorResp
+// @Router       /api/v1/orders/code/{code} [get]
+// @Security     BearerAuth
+func GetOrderByCode(getOrderByCodeService *services.GetOrderByCode) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		code := c.Param("code")
+		if code == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "code parameter is required"})
+			return
+		}
+
+		resp, err := getOrderByCodeService.Exec(c, services.GetOrderByCodeReq{Code: code, UserID: userID.(string)})
+		if err != nil {
+			c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
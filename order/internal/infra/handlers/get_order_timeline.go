@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/order/internal/services"
+)
+
+// GetOrderTimeline godoc
+// @Summary      Get the timeline of an order
+// @Description  Get the status history of an order, with who made each change and why. Visible to the customer and the store owner
+// @Tags         orders
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Order ID"
+// @Success      200  {object}  services.GetOrderTimelineResp
+// @Failure      400  {object}  ErrorResp
+// @Failure      401  {object}  ErrorResp
+// @Failure      404  {object}  ErrorResp
+// @Router       /api/v1/orders/{id}/timeline [get]
+// @Security     BearerAuth
+func GetOrderTimeline(getOrderTimelineService *services.GetOrderTimeline) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		id := c.Param("id")
+		if id == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "id parameter is required"})
+			return
+		}
+
+		resp, err := getOrderTimelineService.Exec(c, services.GetOrderTimelineReq{ID: id, UserID: userID.(string)})
+		if err != nil {
+			c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
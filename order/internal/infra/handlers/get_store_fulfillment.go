@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/order/internal/services"
+)
+
+// GetStoreFulfillment godoc
+// @Summary      Get the fulfillment options of a store
+// @Description  Get the fulfillment methods, delivery zones and fees of a store, stores without options only offer pickup
+// @Tags         stores
+// @Accept       json
+// @Produce      json
+// @Param        storeId path string true "Store ID"
+// @Success      200  {object}  services.GetStoreFulfillmentResp
+// @Failure      400  {object}  ErrorResp
+// @Failure      401  {object}  ErrorResp
+// @Router       /api/v1/stores/{storeId}/fulfillment [get]
+// @Security     BearerAuth
+func GetStoreFulfillment(getStoreFulfillmentService *services.GetStoreFulfillment) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		storeID := c.Param("storeId")
+		if storeID == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "storeId parameter is required"})
+			return
+		}
+
+		resp, err := getStoreFulfillmentService.Exec(c, services.GetStoreFulfillmentReq{StoreID: storeID})
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
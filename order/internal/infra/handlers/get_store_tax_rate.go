@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/order/internal/services"
+)
+
+// GetStoreTaxRate godoc
+// @Summary      Get the tax rate of a store
+// @Description  Get the tax rate applied to the orders of a store
+// @Tags         stores
+// @Accept       json
+// @Produce      json
+// @Param        storeId path string true "Store ID"
+// @Success      200  {object}  services.GetStoreTaxRateResp
+// @Failure      400  {object}  ErrorResp
+// @Failure      401  {object}  ErrorResp
+// @Failure      404  {object}  ErrorResp
+// @Router       /api/v1/stores/{storeId}/tax-rate [get]
+// @Security     BearerAuth
+func GetStoreTaxRate(getStoreTaxRateService *services.GetStoreTaxRate) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		storeID := c.Param("storeId")
+		if storeID == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "storeId parameter is required"})
+			return
+		}
+
+		resp, err := getStoreTaxRateService.Exec(c, services.GetStoreTaxRateReq{StoreID: storeID})
+		if err != nil {
+			c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/order/internal/domain"
+	"ichibuy/order/internal/services"
+)
+
+// PaymentSignatureHeader carries the signature of the payment provider webhooks
+const PaymentSignatureHeader = "X-Payment-Signature"
+
+// HandlePaymentWebhook godoc
+// @Summary      Payment provider webhook
+// @Description  Receive the payment confirmations and failures of the payment provider, the payload must be signed in the X-Payment-Signature header
+// @Tags         payments
+// @Accept       json
+// @Produce      json
+// @Param        X-Payment-Signature header string true "Payload signature"
+// @Success      204
+// @Failure      400  {object}  ErrorResp
+// @Failure      401  {object}  ErrorResp
+// @Router       /api/v1/payments/webhook [post]
+func HandlePaymentWebhook(handlePaymentWebhookService *services.HandlePaymentWebhook) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		payload, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		err = handlePaymentWebhookService.Exec(c, services.HandlePaymentWebhookReq{
+			Payload:   payload,
+			Signature: c.GetHeader(PaymentSignatureHeader),
+		})
+		if errors.Is(err, domain.ErrInvalidPaymentSignature) {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: err.Error()})
+			return
+		}
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusNoContent, nil)
+	}
+}
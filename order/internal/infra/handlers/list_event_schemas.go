@@ -0,0 +1,32 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/order/internal/services"
+)
+
+// ListEventSchemas godoc
+// @Summary      List event schemas
+// @Description  Returns the JSON Schema of the data of every version of the order events, events carry their version in schema_version
+// @Tags         events
+// @Accept       json
+// @Produce      json
+// @Param        type query string false "Event type, e.g. OrderPaid"
+// @Success      200    {object}    services.ListEventSchemasResp
+// @Failure      401    {object}    ErrorResp
+// @Router       /api/v1/events/schemas [get]
+// @Security     BearerAuth
+func ListEventSchemas(listEventSchemas *services.ListEventSchemas) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		resp, err := listEventSchemas.Exec(c, services.ListEventSchemasReq{Type: c.Query("type")})
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/order/internal/services"
+)
+
+// ListPromotions godoc
+// @Summary      List promotions of a store
+// @Description  List the coupons and automatic promotions of a store, only the store owner can list them
+// @Tags         promotions
+// @Accept       json
+// @Produce      json
+// @Param        storeId path string true "Store ID"
+// @Success      200  {object}  services.ListPromotionsResp
+// @Failure      400  {object}  ErrorResp
+// @Failure      401  {object}  ErrorResp
+// @Router       /api/v1/stores/{storeId}/promotions [get]
+// @Security     BearerAuth
+func ListPromotions(listPromotionsService *services.ListPromotions) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		storeID := c.Param("storeId")
+		if storeID == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "storeId parameter is required"})
+			return
+		}
+
+		resp, err := listPromotionsService.Exec(c, services.ListPromotionsReq{StoreID: storeID, UserID: userID.(string)})
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/order/internal/services"
+)
+
+// ListStoreOrders godoc
+// @Summary      List orders of a store
+// @Description  Get the paginated orders placed at a store, newest first, with the count of orders of each status. Only the store owner can list them
+// @Tags         orders
+// @Accept       json
+// @Produce      json
+// @Param        storeId path string true "Store ID"
+// @Param        status query string false "Filter by comma separated statuses"
+// @Param        created_from query string false "Filter by creation date from (RFC3339)"
+// @Param        created_to query string false "Filter by creation date to (RFC3339)"
+// @Param        offset query int false "Offset" default(0)
+// @Param        limit query int false "Limit" default(10)
+// @Success      200  {object}  services.ListStoreOrdersResp
+// @Failure      400  {object}  ErrorResp
+// @Failure      401  {object}  ErrorResp
+// @Router       /api/v1/stores/{storeId}/orders [get]
+// @Security     BearerAuth
+func ListStoreOrders(listStoreOrdersService *services.ListStoreOrders) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		storeID := c.Param("storeId")
+		if storeID == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "storeId parameter is required"})
+			return
+		}
+
+		filters := services.StoreOrderFilters{}
+
+		if status := c.Query("status"); status != "" {
+			filters.Statuses = strings.Split(status, ",")
+		}
+
+		if createdFrom := c.Query("created_from"); createdFrom != "" {
+			from, err := time.Parse(time.RFC3339, createdFrom)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, ErrorResp{Error: "created_from must be a RFC3339 date"})
+				return
+			}
+			filters.CreatedFrom = &from
+		}
+
+		if createdTo := c.Query("created_to"); createdTo != "" {
+			to, err := time.Parse(time.RFC3339, createdTo)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, ErrorResp{Error: "created_to must be a RFC3339 date"})
+				return
+			}
+			filters.CreatedTo = &to
+		}
+
+		offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+		limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+		resp, err := listStoreOrdersService.Exec(c, services.ListStoreOrdersReq{
+			StoreID: storeID,
+			UserID:  userID.(string),
+			Filters: filters,
+			Pagination: services.Pagination{
+				Offset: offset,
+				Limit:  limit,
+			},
+		})
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
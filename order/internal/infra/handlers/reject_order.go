@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/order/internal/services"
+)
+
+type RejectOrderBody struct {
+	Reason *string `json:"reason"`
+}
+
+// RejectOrder godoc
+// @Summary      Reject an order of my store
+// @Description  Reject an order before accepting it, a paid order is refunded. Only the store owner can reject it
+// @Tags         orders
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Order ID"
+// @Param        reject body RejectOrderBody false "Reject data"
+// @Success      204
+// @Failure      400  {object}  ErrorResp
+// @Failure      401  {object}  ErrorResp
+// @Router       /api/v1/orders/{id}/reject [post]
+// @Security     BearerAuth
+func RejectOrder(rejectOrderService *services.RejectOrder) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		id := c.Param("id")
+		if id == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "id parameter is required"})
+			return
+		}
+
+		var body RejectOrderBody
+		if c.Request.ContentLength > 0 {
+			if err := c.ShouldBindJSON(&body); err != nil {
+				c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+				return
+			}
+		}
+
+		if err := rejectOrderService.Exec(c, services.RejectOrderReq{ID: id, Reason: body.Reason, UserID: userID.(string)}); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusNoContent, nil)
+	}
+}
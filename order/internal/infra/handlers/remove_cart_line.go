@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/order/internal/services"
+)
+
+// RemoveCartLine godoc
+// @Summary      Remove a product from my cart
+// @Description  Remove a product from the cart of the authenticated user
+// @Tags         cart
+// @Accept       json
+// @Produce      json
+// @Param        productId path string true "Product ID"
+// @Success      200  {object}  services.GetCartResp
+// @Failure      400  {object}  ErrorResp
+// @Failure      401  {object}  ErrorResp
+// @Router       /api/v1/cart/lines/{productId} [delete]
+// @Security     BearerAuth
+func RemoveCartLine(removeCartLineService *services.RemoveCartLine) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		productID := c.Param("productId")
+		if productID == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "productId parameter is required"})
+			return
+		}
+
+		resp, err := removeCartLineService.Exec(c, services.RemoveCartLineReq{UserID: userID.(string), ProductID: productID})
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/order/internal/services"
+)
+
+type SetStoreFulfillmentBody struct {
+	// Methods offered by the store: pickup, local_delivery and shipping
+	Methods []string `json:"methods" binding:"required"`
+	// DeliveryZones of local delivery, the first zone containing the address sets the fee
+	DeliveryZones []services.DeliveryZoneDTO `json:"delivery_zones"`
+	// ShippingFee is required when the store offers shipping
+	ShippingFee *services.MoneyDTO `json:"shipping_fee"`
+}
+
+// SetStoreFulfillment godoc
+// @Summary      Set the fulfillment options of a store
+// @Description  Set the fulfillment methods, delivery zones and fees of a store, only the store owner can set them
+// @Tags         stores
+// @Accept       json
+// @Produce      json
+// @Param        storeId path string true "Store ID"
+// @Param        fulfillment body SetStoreFulfillmentBody true "Fulfillment options"
+// @Success      200  {object}  services.GetStoreFulfillmentResp
+// @Failure      400  {object}  ErrorResp
+// @Failure      401  {object}  ErrorResp
+// @Router       /api/v1/stores/{storeId}/fulfillment [put]
+// @Security     BearerAuth
+func SetStoreFulfillment(setStoreFulfillmentService *services.SetStoreFulfillment) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		storeID := c.Param("storeId")
+		if storeID == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "storeId parameter is required"})
+			return
+		}
+
+		var req SetStoreFulfillmentBody
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		resp, err := setStoreFulfillmentService.Exec(c, services.SetStoreFulfillmentReq{
+			StoreID:       storeID,
+			UserID:        userID.(string),
+			Methods:       req.Methods,
+			DeliveryZones: req.DeliveryZones,
+			ShippingFee:   req.ShippingFee,
+		})
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/order/internal/services"
+)
+
+type SetStoreTaxRateBody struct {
+	Name string `json:"name" binding:"required"`
+	// Rate in basis points, e.g. 1800 for 18%
+	Rate      int  `json:"rate"`
+	Inclusive bool `json:"inclusive"`
+}
+
+// SetStoreTaxRate godoc
+// @Summary      Set the tax rate of a store
+// @Description  Set the tax rate applied to the orders of a store, only the store owner can set it
+// @Tags         stores
+// @Accept       json
+// @Produce      json
+// @Param        storeId path string true "Store ID"
+// @Param        taxRate body SetStoreTaxRateBody true "Tax rate data"
+// @Success      200  {object}  services.GetStoreTaxRateResp
+// @Failure      400  {object}  ErrorResp
+// @Failure      401  {object}  ErrorResp
+// @Router       /api/v1/stores/{storeId}/tax-rate [put]
+// @Security     BearerAuth
+func SetStoreTaxRate(setStoreTaxRateService *services.SetStoreTaxRate) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		storeID := c.Param("storeId")
+		if storeID == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "storeId parameter is required"})
+			return
+		}
+
+		var req SetStoreTaxRateBody
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		resp, err := setStoreTaxRateService.Exec(c, services.SetStoreTaxRateReq{
+			StoreID:   storeID,
+			UserID:    userID.(string),
+			Name:      req.Name,
+			Rate:      req.Rate,
+			Inclusive: req.Inclusive,
+		})
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/order/internal/services"
+	sharedCtx "ichibuy/order/internal/shared/context"
+)
+
+// StreamOrderEvents godoc
+// @Summary      Stream order events
+// @Description  Push the order events of the authenticated user as Server-Sent Events: new orders of the stores they own and status changes of their orders and store orders. Send the Last-Event-ID header to resume after a disconnection
+// @Tags         orders
+// @Produce      text/event-stream
+// @Param        Last-Event-ID header string false "ID of the last event received"
+// @Success      200  {string}  string "event stream"
+// @Failure      400  {object}  ErrorResp
+// @Failure      401  {object}  ErrorResp
+// @Router       /api/v1/orders/stream [get]
+// @Security     BearerAuth
+func StreamOrderEvents(streamOrderEventsService *services.StreamOrderEvents) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		flusher, ok := c.Writer.(http.Flusher)
+		if !ok {
+			c.JSON(http.StatusInternalServerError, ErrorResp{Error: "streaming is not supported"})
+			return
+		}
+
+		c.Header("Content-Type", "text/event-stream")
+		c.Header("Cache-Control", "no-cache")
+		c.Header("Connection", "keep-alive")
+		c.Header("X-Accel-Buffering", "no")
+		c.Status(http.StatusOK)
+		flusher.Flush()
+
+		// the request context ends when the client disconnects, the token is kept to query the store service
+		ctx := context.WithValue(c.Request.Context(), sharedCtx.APITokenKey, c.GetString(sharedCtx.APITokenKey))
+
+		err := streamOrderEventsService.Exec(ctx, services.StreamOrderEventsReq{
+			UserID:      userID.(string),
+			LastEventID: c.GetHeader("Last-Event-ID"),
+		}, &sseWriter{c: c, flusher: flusher})
+		if err != nil {
+			// headers are already sent, so the error is reported as an event before closing the stream
+			fmt.Fprintf(c.Writer, "event: error\ndata: %s\n\n", err.Error())
+			flusher.Flush()
+		}
+	}
+}
+
+type sseWriter struct {
+	c       *gin.Context
+	flusher http.Flusher
+}
+
+func (w *sseWriter) Send(event services.OrderStreamEvent) error {
+	if _, err := fmt.Fprintf(w.c.Writer, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Type, event.Data); err != nil {
+		return err
+	}
+	w.flusher.Flush()
+	return nil
+}
+
+func (w *sseWriter) Heartbeat() error {
+	if _, err := fmt.Fprint(w.c.Writer, ": heartbeat\n\n"); err != nil {
+		return err
+	}
+	w.flusher.Flush()
+	return nil
+}
@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/order/internal/services"
+)
+
+type UpdateCartLineBody struct {
+	Quantity *int `json:"quantity" binding:"required"`
+}
+
+// UpdateCartLine godoc
+// @Summary      Update the quantity of a cart line
+// @Description  Set the quantity of a product in the cart of the authenticated user, a zero quantity removes it
+// @Tags         cart
+// @Accept       json
+// @Produce      json
+// @Param        productId path string true "Product ID"
+// @Param        line body UpdateCartLineBody true "Cart line data"
+// @Success      200  {object}  services.GetCartResp
+// @Failure      400  {object}  ErrorResp
+// @Failure      401  {object}  ErrorResp
+// @Router       /api/v1/cart/lines/{productId} [put]
+// @Security     BearerAuth
+func UpdateCartLine(updateCartLineService *services.UpdateCartLine) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		productID := c.Param("productId")
+		if productID == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "productId parameter is required"})
+			return
+		}
+
+		var body UpdateCartLineBody
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		resp, err := updateCartLineService.Exec(c, services.UpdateCartLineReq{
+			UserID:    userID.(string),
+			ProductID: productID,
+			Quantity:  *body.Quantity,
+		})
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/order/internal/services"
+)
+
+type UpdateOrderFulfillmentBody struct {
+	// Status is ready, in_transit (deliveries only) or delivered
+	Status string `json:"status" binding:"required"`
+}
+
+// UpdateOrderFulfillment godoc
+// @Summary      Update the fulfillment of an order
+// @Description  Move the delivery or pickup of an order forward, tracked apart from the order status (store owner only)
+// @Tags         orders
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Order ID"
+// @Param        fulfillment body UpdateOrderFulfillmentBody true "Fulfillment status"
+// @Success      200  {object}  services.FulfillmentDTO
+// @Failure      400  {object}  ErrorResp
+// @Failure      401  {object}  ErrorResp
+// @Router       /api/v1/orders/{id}/fulfillment [put]
+// @Security     BearerAuth
+func UpdateOrderFulfillment(updateOrderFulfillmentService *services.UpdateOrderFulfillment) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		id := c.Param("id")
+		if id == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "id parameter is required"})
+			return
+		}
+
+		var body UpdateOrderFulfillmentBody
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		resp, err := updateOrderFulfillmentService.Exec(c, services.UpdateOrderFulfillmentReq{
+			ID:     id,
+			Status: body.Status,
+			UserID: userID.(string),
+		})
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,29 @@
+package middlewares
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	sharedCtx "ichibuy/order/internal/shared/context"
+)
+
+const (
+	CorrelationIDHeader = "X-Correlation-ID"
+	// maxCorrelationIDLength bounds the IDs received from clients, longer ones are replaced
+	maxCorrelationIDLength = 128
+)
+
+// CorrelationID sets the correlation ID of the request, from the X-Correlation-ID header or a new one, so
+// the events published by the request carry it. It is returned in the same header.
+func CorrelationID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		correlationID := c.GetHeader(CorrelationIDHeader)
+		if correlationID == "" || len(correlationID) > maxCorrelationIDLength {
+			correlationID = uuid.NewString()
+		}
+
+		c.Set(sharedCtx.CorrelationIDKey, correlationID)
+		c.Header(CorrelationIDHeader, correlationID)
+		c.Next()
+	}
+}
@@ -0,0 +1,215 @@
+package middlewares
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/order/internal/domain"
+	"ichibuy/order/internal/domain/dao"
+)
+
+const (
+	IdempotencyKeyHeader      = "Idempotency-Key"
+	IdempotencyReplayedHeader = "Idempotency-Replayed"
+)
+
+// IdempotencyMiddleware replays the stored response of POST requests retried with the same Idempotency-Key
+// header. It runs after the JWT middleware, keys are scoped to the user and the requested path with its query.
+// A request holds its key for the lease, renewed while the handler runs, so a retry can take over the key of
+// a request that never completed.
+type IdempotencyMiddleware struct {
+	idempotencyKeyDAO dao.IdempotencyKeyDAO
+	ttl               time.Duration
+	lease             time.Duration
+}
+
+func NewIdempotencyMiddleware(idempotencyKeyDAO dao.IdempotencyKeyDAO, ttl, lease time.Duration) *IdempotencyMiddleware {
+	return &IdempotencyMiddleware{
+		idempotencyKeyDAO: idempotencyKeyDAO,
+		ttl:               ttl,
+		lease:             lease,
+	}
+}
+
+func (m *IdempotencyMiddleware) Handle() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(IdempotencyKeyHeader)
+		if c.Request.Method != http.MethodPost || key == "" {
+			c.Next()
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		userID := c.GetString("user_id")
+		path := c.Request.URL.Path
+		if c.Request.URL.RawQuery != "" {
+			path += "?" + c.Request.URL.RawQuery
+		}
+		fingerprint := domain.RequestFingerprint(path, c.GetHeader("Content-Type"), body)
+
+		stored, err := m.idempotencyKeyDAO.FindByPk(c, domain.IdempotencyKeyID(userID, key, c.Request.Method, path))
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			slog.ErrorContext(c, "find idempotency key failed", "error", err.Error())
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		if stored != nil && stored.IsExpired(time.Now().UTC()) {
+			if err := m.idempotencyKeyDAO.DeleteByPk(c, stored.GetID()); err != nil {
+				slog.ErrorContext(c, "delete expired idempotency key failed", "error", err.Error())
+			}
+			stored = nil
+		}
+
+		var idempotencyKey *domain.IdempotencyKey
+		if stored != nil {
+			err := stored.CheckReplay(fingerprint, time.Now().UTC())
+			if err == nil {
+				m.replay(c, stored)
+				return
+			}
+			if !errors.Is(err, domain.ErrIdempotencyKeyAbandoned) {
+				c.AbortWithStatusJSON(http.StatusConflict, gin.H{"error": err.Error()})
+				return
+			}
+
+			idempotencyKey, err = m.takeOver(c, stored.GetID())
+			if err != nil {
+				slog.WarnContext(c, "take over idempotency key failed", "error", err.Error())
+				c.AbortWithStatusJSON(http.StatusConflict, gin.H{"error": domain.ErrIdempotencyKeyInProgress.Error()})
+				return
+			}
+		} else {
+			idempotencyKey, err = domain.NewIdempotencyKey(userID, key, c.Request.Method, path, fingerprint, m.ttl, m.lease)
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+
+			// the primary key makes a concurrent request with the same key fail here
+			if err := m.idempotencyKeyDAO.Create(c, idempotencyKey); err != nil {
+				slog.WarnContext(c, "create idempotency key failed", "error", err.Error())
+				c.AbortWithStatusJSON(http.StatusConflict, gin.H{"error": domain.ErrIdempotencyKeyInProgress.Error()})
+				return
+			}
+		}
+
+		writer := &responseRecorder{ResponseWriter: c.Writer}
+		c.Writer = writer
+
+		stopRenewing := m.renewLease(c.Request.Context(), idempotencyKey)
+		c.Next()
+		stopRenewing()
+
+		// failed requests can be retried with the same key
+		if writer.Status() >= http.StatusInternalServerError {
+			if err := m.idempotencyKeyDAO.DeleteByPk(c, idempotencyKey.GetID()); err != nil {
+				slog.ErrorContext(c, "delete idempotency key failed", "error", err.Error())
+			}
+			return
+		}
+
+		idempotencyKey.Complete(writer.Status(), writer.body.String())
+		if err := m.idempotencyKeyDAO.Update(c, idempotencyKey); err != nil {
+			slog.ErrorContext(c, "complete idempotency key failed", "error", err.Error())
+		}
+	}
+}
+
+// takeOver holds the abandoned key for the request, the row is locked so only one retry takes it over
+func (m *IdempotencyMiddleware) takeOver(c *gin.Context, id string) (*domain.IdempotencyKey, error) {
+	var idempotencyKey *domain.IdempotencyKey
+	err := m.idempotencyKeyDAO.WithTransaction(c, func(ctx context.Context) error {
+		stored, err := m.idempotencyKeyDAO.FindOne(ctx, "id = $1", "id FOR UPDATE", id)
+		if err != nil {
+			return err
+		}
+
+		if err := stored.TakeOver(time.Now().UTC(), m.lease); err != nil {
+			return err
+		}
+
+		idempotencyKey = stored
+		return m.idempotencyKeyDAO.Update(ctx, stored)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(c, "idempotency key taken over", "idempotency_key_id", id)
+	return idempotencyKey, nil
+}
+
+// renewLease keeps the key held while the handler runs longer than the lease, e.g. a product created with
+// large images. The returned func stops renewing it, so the key is completed without a concurrent update.
+func (m *IdempotencyMiddleware) renewLease(ctx context.Context, idempotencyKey *domain.IdempotencyKey) func() {
+	stop := make(chan struct{})
+	stopped := make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+
+		ticker := time.NewTicker(m.lease / 2)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				idempotencyKey.Renew(time.Now().UTC(), m.lease)
+				err := m.idempotencyKeyDAO.PartialUpdate(ctx, idempotencyKey.GetID(), map[string]interface{}{
+					"locked_until": idempotencyKey.LockedUntil,
+					"updated_at":   idempotencyKey.UpdatedAt,
+				})
+				if err != nil {
+					slog.WarnContext(ctx, "renew idempotency key failed", "idempotency_key_id", idempotencyKey.GetID(), "error", err.Error())
+				}
+			}
+		}
+	}()
+
+	return func() {
+		close(stop)
+		<-stopped
+	}
+}
+
+func (m *IdempotencyMiddleware) replay(c *gin.Context, stored *domain.IdempotencyKey) {
+	slog.InfoContext(c, "replaying idempotent response", "idempotency_key_id", stored.GetID())
+	c.Header(IdempotencyReplayedHeader, "true")
+	c.Data(stored.GetResponseStatus(), "application/json; charset=utf-8", []byte(stored.GetResponseBody()))
+	c.Abort()
+}
+
+// responseRecorder keeps a copy of the response body written by the handlers
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *responseRecorder) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *responseRecorder) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
@@ -0,0 +1,407 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"ichibuy/order/internal/domain"
+	"strings"
+)
+
+type CatalogProduct = domain.CatalogProduct
+
+type CatalogProductDAO struct {
+	db *sql.DB
+}
+
+func NewCatalogProductDAO(db *sql.DB) *CatalogProductDAO {
+	return &CatalogProductDAO{db: db}
+}
+
+func (dao *CatalogProductDAO) getTx(ctx context.Context) *sql.Tx {
+	if tx, ok := ctx.Value("currentTx").(*sql.Tx); ok {
+		return tx
+	}
+	return nil
+}
+
+func (dao *CatalogProductDAO) execContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.ExecContext(ctx, query, args...)
+	}
+	return dao.db.ExecContext(ctx, query, args...)
+}
+
+func (dao *CatalogProductDAO) queryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryRowContext(ctx, query, args...)
+	}
+	return dao.db.QueryRowContext(ctx, query, args...)
+}
+
+func (dao *CatalogProductDAO) queryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryContext(ctx, query, args...)
+	}
+	return dao.db.QueryContext(ctx, query, args...)
+}
+
+func (dao *CatalogProductDAO) Create(ctx context.Context, m *CatalogProduct) error {
+	query := `
+		INSERT INTO catalog_products (id, store_id, name, active, prices, deleted_at, version, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	_, err := dao.execContext(
+		ctx,
+		query,
+		m.ID,
+		m.StoreID,
+		m.Name,
+		m.Active,
+		m.Prices,
+		m.DeletedAt,
+		m.Version,
+		m.UpdatedAt,
+	)
+
+	return err
+}
+
+func (dao *CatalogProductDAO) Update(ctx context.Context, m *CatalogProduct) error {
+	query := `
+		UPDATE catalog_products
+		SET store_id = $1,
+			name = $2,
+			active = $3,
+			prices = $4,
+			deleted_at = $5,
+			version = $6,
+			updated_at = $7
+		WHERE id = $8
+	`
+
+	_, err := dao.execContext(ctx, query,
+		m.StoreID,
+		m.Name,
+		m.Active,
+		m.Prices,
+		m.DeletedAt,
+		m.Version,
+		m.UpdatedAt,
+		m.ID,
+	)
+	return err
+}
+
+func (dao *CatalogProductDAO) PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	setClauses := make([]string, 0, len(fields))
+	args := make([]interface{}, 0, len(fields)+1)
+	i := 1
+
+	for field, value := range fields {
+		setClauses = append(setClauses, fmt.Sprintf("%s = $%d", field, i))
+		args = append(args, value)
+		i++
+	}
+
+	args = append(args, pk)
+
+	query := fmt.Sprintf(`UPDATE catalog_products SET %s WHERE id = $%d`, strings.Join(setClauses, ", "), i)
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *CatalogProductDAO) DeleteByPk(ctx context.Context, pk string) error {
+	query := `DELETE FROM catalog_products WHERE id = $1`
+	_, err := dao.execContext(ctx, query, pk)
+	return err
+}
+
+func (dao *CatalogProductDAO) FindByPk(ctx context.Context, pk string) (*CatalogProduct, error) {
+	query := `
+		SELECT id, store_id, name, active, prices, deleted_at, version, updated_at
+		FROM catalog_products
+		WHERE id = $1
+	`
+	row := dao.queryRowContext(ctx, query, pk)
+
+	var m CatalogProduct
+	err := row.Scan(
+		&m.ID,
+		&m.StoreID,
+		&m.Name,
+		&m.Active,
+		&m.Prices,
+		&m.DeletedAt,
+		&m.Version,
+		&m.UpdatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *CatalogProductDAO) CreateMany(ctx context.Context, models []*CatalogProduct) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(models))
+	args := make([]interface{}, 0, len(models)*8)
+
+	for i, model := range models {
+		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			i*8+1, i*8+2, i*8+3, i*8+4, i*8+5, i*8+6, i*8+7, i*8+8)
+
+		args = append(args,
+			model.ID,
+			model.StoreID,
+			model.Name,
+			model.Active,
+			model.Prices,
+			model.DeletedAt,
+			model.Version,
+			model.UpdatedAt,
+		)
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO catalog_products (id, store_id, name, active, prices, deleted_at, version, updated_at)
+		VALUES %s
+	`, strings.Join(placeholders, ", "))
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *CatalogProductDAO) UpdateMany(ctx context.Context, models []*CatalogProduct) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	query := `
+		UPDATE catalog_products
+		SET store_id = $1,
+			name = $2,
+			active = $3,
+			prices = $4,
+			deleted_at = $5,
+			version = $6,
+			updated_at = $7
+		WHERE id = $8
+	`
+
+	for _, model := range models {
+		_, err := dao.execContext(ctx, query,
+			model.StoreID,
+			model.Name,
+			model.Active,
+			model.Prices,
+			model.DeletedAt,
+			model.Version,
+			model.UpdatedAt,
+			model.ID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (dao *CatalogProductDAO) DeleteManyByPks(ctx context.Context, pks []string) error {
+	if len(pks) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(pks))
+	args := make([]interface{}, len(pks))
+	for i, pk := range pks {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = pk
+	}
+
+	query := fmt.Sprintf(`DELETE FROM catalog_products WHERE id IN (%s)`, strings.Join(placeholders, ","))
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *CatalogProductDAO) FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*CatalogProduct, error) {
+	query := `
+		SELECT id, store_id, name, active, prices, deleted_at, version, updated_at
+		FROM catalog_products
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var m CatalogProduct
+	err := row.Scan(
+		&m.ID,
+		&m.StoreID,
+		&m.Name,
+		&m.Active,
+		&m.Prices,
+		&m.DeletedAt,
+		&m.Version,
+		&m.UpdatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *CatalogProductDAO) FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*CatalogProduct, error) {
+	query := `
+		SELECT id, store_id, name, active, prices, deleted_at, version, updated_at
+		FROM catalog_products
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*CatalogProduct
+	for rows.Next() {
+		var m CatalogProduct
+		err := rows.Scan(
+			&m.ID,
+			&m.StoreID,
+			&m.Name,
+			&m.Active,
+			&m.Prices,
+			&m.DeletedAt,
+			&m.Version,
+			&m.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *CatalogProductDAO) FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*CatalogProduct, error) {
+	query := `
+		SELECT id, store_id, name, active, prices, deleted_at, version, updated_at
+		FROM catalog_products
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	query += fmt.Sprintf(" LIMIT %d OFFSET %d", limit, offset)
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*CatalogProduct
+	for rows.Next() {
+		var m CatalogProduct
+		err := rows.Scan(
+			&m.ID,
+			&m.StoreID,
+			&m.Name,
+			&m.Active,
+			&m.Prices,
+			&m.DeletedAt,
+			&m.Version,
+			&m.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *CatalogProductDAO) Count(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := "SELECT COUNT(*) FROM catalog_products"
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var count int64
+	err := row.Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (dao *CatalogProductDAO) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := dao.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	ctxWithTx := context.WithValue(ctx, "currentTx", tx)
+
+	err = fn(ctxWithTx)
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
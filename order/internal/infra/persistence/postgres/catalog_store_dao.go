@@ -0,0 +1,447 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"ichibuy/order/internal/domain"
+	"strings"
+)
+
+type CatalogStore = domain.CatalogStore
+
+type CatalogStoreDAO struct {
+	db *sql.DB
+}
+
+func NewCatalogStoreDAO(db *sql.DB) *CatalogStoreDAO {
+	return &CatalogStoreDAO{db: db}
+}
+
+func (dao *CatalogStoreDAO) getTx(ctx context.Context) *sql.Tx {
+	if tx, ok := ctx.Value("currentTx").(*sql.Tx); ok {
+		return tx
+	}
+	return nil
+}
+
+func (dao *CatalogStoreDAO) execContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.ExecContext(ctx, query, args...)
+	}
+	return dao.db.ExecContext(ctx, query, args...)
+}
+
+func (dao *CatalogStoreDAO) queryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryRowContext(ctx, query, args...)
+	}
+	return dao.db.QueryRowContext(ctx, query, args...)
+}
+
+func (dao *CatalogStoreDAO) queryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryContext(ctx, query, args...)
+	}
+	return dao.db.QueryContext(ctx, query, args...)
+}
+
+func (dao *CatalogStoreDAO) Create(ctx context.Context, m *CatalogStore) error {
+	query := `
+		INSERT INTO catalog_stores (id, user_id, name, lat, lng, timezone, opening_hours, schedule_exceptions, allows_pre_orders, deleted_at, version, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`
+
+	_, err := dao.execContext(
+		ctx,
+		query,
+		m.ID,
+		m.UserID,
+		m.Name,
+		m.Lat,
+		m.Lng,
+		m.Timezone,
+		m.OpeningHours,
+		m.ScheduleExceptions,
+		m.AllowsPreOrders,
+		m.DeletedAt,
+		m.Version,
+		m.UpdatedAt,
+	)
+
+	return err
+}
+
+func (dao *CatalogStoreDAO) Update(ctx context.Context, m *CatalogStore) error {
+	query := `
+		UPDATE catalog_stores
+		SET user_id = $1,
+			name = $2,
+			lat = $3,
+			lng = $4,
+			timezone = $5,
+			opening_hours = $6,
+			schedule_exceptions = $7,
+			allows_pre_orders = $8,
+			deleted_at = $9,
+			version = $10,
+			updated_at = $11
+		WHERE id = $12
+	`
+
+	_, err := dao.execContext(ctx, query,
+		m.UserID,
+		m.Name,
+		m.Lat,
+		m.Lng,
+		m.Timezone,
+		m.OpeningHours,
+		m.ScheduleExceptions,
+		m.AllowsPreOrders,
+		m.DeletedAt,
+		m.Version,
+		m.UpdatedAt,
+		m.ID,
+	)
+	return err
+}
+
+func (dao *CatalogStoreDAO) PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	setClauses := make([]string, 0, len(fields))
+	args := make([]interface{}, 0, len(fields)+1)
+	i := 1
+
+	for field, value := range fields {
+		setClauses = append(setClauses, fmt.Sprintf("%s = $%d", field, i))
+		args = append(args, value)
+		i++
+	}
+
+	args = append(args, pk)
+
+	query := fmt.Sprintf(`UPDATE catalog_stores SET %s WHERE id = $%d`, strings.Join(setClauses, ", "), i)
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *CatalogStoreDAO) DeleteByPk(ctx context.Context, pk string) error {
+	query := `DELETE FROM catalog_stores WHERE id = $1`
+	_, err := dao.execContext(ctx, query, pk)
+	return err
+}
+
+func (dao *CatalogStoreDAO) FindByPk(ctx context.Context, pk string) (*CatalogStore, error) {
+	query := `
+		SELECT id, user_id, name, lat, lng, timezone, opening_hours, schedule_exceptions, allows_pre_orders, deleted_at, version, updated_at
+		FROM catalog_stores
+		WHERE id = $1
+	`
+	row := dao.queryRowContext(ctx, query, pk)
+
+	var m CatalogStore
+	err := row.Scan(
+		&m.ID,
+		&m.UserID,
+		&m.Name,
+		&m.Lat,
+		&m.Lng,
+		&m.Timezone,
+		&m.OpeningHours,
+		&m.ScheduleExceptions,
+		&m.AllowsPreOrders,
+		&m.DeletedAt,
+		&m.Version,
+		&m.UpdatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *CatalogStoreDAO) CreateMany(ctx context.Context, models []*CatalogStore) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(models))
+	args := make([]interface{}, 0, len(models)*12)
+
+	for i, model := range models {
+		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			i*12+1, i*12+2, i*12+3, i*12+4, i*12+5, i*12+6, i*12+7, i*12+8, i*12+9, i*12+10, i*12+11, i*12+12)
+
+		args = append(args,
+			model.ID,
+			model.UserID,
+			model.Name,
+			model.Lat,
+			model.Lng,
+			model.Timezone,
+			model.OpeningHours,
+			model.ScheduleExceptions,
+			model.AllowsPreOrders,
+			model.DeletedAt,
+			model.Version,
+			model.UpdatedAt,
+		)
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO catalog_stores (id, user_id, name, lat, lng, timezone, opening_hours, schedule_exceptions, allows_pre_orders, deleted_at, version, updated_at)
+		VALUES %s
+	`, strings.Join(placeholders, ", "))
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *CatalogStoreDAO) UpdateMany(ctx context.Context, models []*CatalogStore) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	query := `
+		UPDATE catalog_stores
+		SET user_id = $1,
+			name = $2,
+			lat = $3,
+			lng = $4,
+			timezone = $5,
+			opening_hours = $6,
+			schedule_exceptions = $7,
+			allows_pre_orders = $8,
+			deleted_at = $9,
+			version = $10,
+			updated_at = $11
+		WHERE id = $12
+	`
+
+	for _, model := range models {
+		_, err := dao.execContext(ctx, query,
+			model.UserID,
+			model.Name,
+			model.Lat,
+			model.Lng,
+			model.Timezone,
+			model.OpeningHours,
+			model.ScheduleExceptions,
+			model.AllowsPreOrders,
+			model.DeletedAt,
+			model.Version,
+			model.UpdatedAt,
+			model.ID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (dao *CatalogStoreDAO) DeleteManyByPks(ctx context.Context, pks []string) error {
+	if len(pks) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(pks))
+	args := make([]interface{}, len(pks))
+	for i, pk := range pks {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = pk
+	}
+
+	query := fmt.Sprintf(`DELETE FROM catalog_stores WHERE id IN (%s)`, strings.Join(placeholders, ","))
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *CatalogStoreDAO) FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*CatalogStore, error) {
+	query := `
+		SELECT id, user_id, name, lat, lng, timezone, opening_hours, schedule_exceptions, allows_pre_orders, deleted_at, version, updated_at
+		FROM catalog_stores
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var m CatalogStore
+	err := row.Scan(
+		&m.ID,
+		&m.UserID,
+		&m.Name,
+		&m.Lat,
+		&m.Lng,
+		&m.Timezone,
+		&m.OpeningHours,
+		&m.ScheduleExceptions,
+		&m.AllowsPreOrders,
+		&m.DeletedAt,
+		&m.Version,
+		&m.UpdatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *CatalogStoreDAO) FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*CatalogStore, error) {
+	query := `
+		SELECT id, user_id, name, lat, lng, timezone, opening_hours, schedule_exceptions, allows_pre_orders, deleted_at, version, updated_at
+		FROM catalog_stores
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*CatalogStore
+	for rows.Next() {
+		var m CatalogStore
+		err := rows.Scan(
+			&m.ID,
+			&m.UserID,
+			&m.Name,
+			&m.Lat,
+			&m.Lng,
+			&m.Timezone,
+			&m.OpeningHours,
+			&m.ScheduleExceptions,
+			&m.AllowsPreOrders,
+			&m.DeletedAt,
+			&m.Version,
+			&m.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *CatalogStoreDAO) FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*CatalogStore, error) {
+	query := `
+		SELECT id, user_id, name, lat, lng, timezone, opening_hours, schedule_exceptions, allows_pre_orders, deleted_at, version, updated_at
+		FROM catalog_stores
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	query += fmt.Sprintf(" LIMIT %d OFFSET %d", limit, offset)
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*CatalogStore
+	for rows.Next() {
+		var m CatalogStore
+		err := rows.Scan(
+			&m.ID,
+			&m.UserID,
+			&m.Name,
+			&m.Lat,
+			&m.Lng,
+			&m.Timezone,
+			&m.OpeningHours,
+			&m.ScheduleExceptions,
+			&m.AllowsPreOrders,
+			&m.DeletedAt,
+			&m.Version,
+			&m.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *CatalogStoreDAO) Count(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := "SELECT COUNT(*) FROM catalog_stores"
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var count int64
+	err := row.Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (dao *CatalogStoreDAO) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := dao.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	ctxWithTx := context.WithValue(ctx, "currentTx", tx)
+
+	err = fn(ctxWithTx)
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
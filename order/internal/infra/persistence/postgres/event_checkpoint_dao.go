@@ -0,0 +1,357 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"ichibuy/order/internal/domain"
+	"strings"
+)
+
+type EventCheckpoint = domain.EventCheckpoint
+
+type EventCheckpointDAO struct {
+	db *sql.DB
+}
+
+func NewEventCheckpointDAO(db *sql.DB) *EventCheckpointDAO {
+	return &EventCheckpointDAO{db: db}
+}
+
+func (dao *EventCheckpointDAO) getTx(ctx context.Context) *sql.Tx {
+	if tx, ok := ctx.Value("currentTx").(*sql.Tx); ok {
+		return tx
+	}
+	return nil
+}
+
+func (dao *EventCheckpointDAO) execContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.ExecContext(ctx, query, args...)
+	}
+	return dao.db.ExecContext(ctx, query, args...)
+}
+
+func (dao *EventCheckpointDAO) queryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryRowContext(ctx, query, args...)
+	}
+	return dao.db.QueryRowContext(ctx, query, args...)
+}
+
+func (dao *EventCheckpointDAO) queryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryContext(ctx, query, args...)
+	}
+	return dao.db.QueryContext(ctx, query, args...)
+}
+
+func (dao *EventCheckpointDAO) Create(ctx context.Context, m *EventCheckpoint) error {
+	query := `
+		INSERT INTO event_checkpoints (consumer, last_event_id, updated_at)
+		VALUES ($1, $2, $3)
+	`
+
+	_, err := dao.execContext(
+		ctx,
+		query,
+		m.Consumer,
+		m.LastEventID,
+		m.UpdatedAt,
+	)
+
+	return err
+}
+
+func (dao *EventCheckpointDAO) Update(ctx context.Context, m *EventCheckpoint) error {
+	query := `
+		UPDATE event_checkpoints
+		SET last_event_id = $1,
+			updated_at = $2
+		WHERE consumer = $3
+	`
+
+	_, err := dao.execContext(ctx, query,
+		m.LastEventID,
+		m.UpdatedAt,
+		m.Consumer,
+	)
+	return err
+}
+
+func (dao *EventCheckpointDAO) PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	setClauses := make([]string, 0, len(fields))
+	args := make([]interface{}, 0, len(fields)+1)
+	i := 1
+
+	for field, value := range fields {
+		setClauses = append(setClauses, fmt.Sprintf("%s = $%d", field, i))
+		args = append(args, value)
+		i++
+	}
+
+	args = append(args, pk)
+
+	query := fmt.Sprintf(`UPDATE event_checkpoints SET %s WHERE consumer = $%d`, strings.Join(setClauses, ", "), i)
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *EventCheckpointDAO) DeleteByPk(ctx context.Context, pk string) error {
+	query := `DELETE FROM event_checkpoints WHERE consumer = $1`
+	_, err := dao.execContext(ctx, query, pk)
+	return err
+}
+
+func (dao *EventCheckpointDAO) FindByPk(ctx context.Context, pk string) (*EventCheckpoint, error) {
+	query := `
+		SELECT consumer, last_event_id, updated_at
+		FROM event_checkpoints
+		WHERE consumer = $1
+	`
+	row := dao.queryRowContext(ctx, query, pk)
+
+	var m EventCheckpoint
+	err := row.Scan(
+		&m.Consumer,
+		&m.LastEventID,
+		&m.UpdatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *EventCheckpointDAO) CreateMany(ctx context.Context, models []*EventCheckpoint) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(models))
+	args := make([]interface{}, 0, len(models)*3)
+
+	for i, model := range models {
+		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d)",
+			i*3+1, i*3+2, i*3+3)
+
+		args = append(args,
+			model.Consumer,
+			model.LastEventID,
+			model.UpdatedAt,
+		)
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO event_checkpoints (consumer, last_event_id, updated_at)
+		VALUES %s
+	`, strings.Join(placeholders, ", "))
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *EventCheckpointDAO) UpdateMany(ctx context.Context, models []*EventCheckpoint) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	query := `
+		UPDATE event_checkpoints
+		SET last_event_id = $1,
+			updated_at = $2
+		WHERE consumer = $3
+	`
+
+	for _, model := range models {
+		_, err := dao.execContext(ctx, query,
+			model.LastEventID,
+			model.UpdatedAt,
+			model.Consumer,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (dao *EventCheckpointDAO) DeleteManyByPks(ctx context.Context, pks []string) error {
+	if len(pks) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(pks))
+	args := make([]interface{}, len(pks))
+	for i, pk := range pks {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = pk
+	}
+
+	query := fmt.Sprintf(`DELETE FROM event_checkpoints WHERE consumer IN (%s)`, strings.Join(placeholders, ","))
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *EventCheckpointDAO) FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*EventCheckpoint, error) {
+	query := `
+		SELECT consumer, last_event_id, updated_at
+		FROM event_checkpoints
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var m EventCheckpoint
+	err := row.Scan(
+		&m.Consumer,
+		&m.LastEventID,
+		&m.UpdatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *EventCheckpointDAO) FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*EventCheckpoint, error) {
+	query := `
+		SELECT consumer, last_event_id, updated_at
+		FROM event_checkpoints
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*EventCheckpoint
+	for rows.Next() {
+		var m EventCheckpoint
+		err := rows.Scan(
+			&m.Consumer,
+			&m.LastEventID,
+			&m.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *EventCheckpointDAO) FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*EventCheckpoint, error) {
+	query := `
+		SELECT consumer, last_event_id, updated_at
+		FROM event_checkpoints
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	query += fmt.Sprintf(" LIMIT %d OFFSET %d", limit, offset)
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*EventCheckpoint
+	for rows.Next() {
+		var m EventCheckpoint
+		err := rows.Scan(
+			&m.Consumer,
+			&m.LastEventID,
+			&m.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *EventCheckpointDAO) Count(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := "SELECT COUNT(*) FROM event_checkpoints"
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var count int64
+	err := row.Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (dao *EventCheckpointDAO) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := dao.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	ctxWithTx := context.WithValue(ctx, "currentTx", tx)
+
+	err = fn(ctxWithTx)
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
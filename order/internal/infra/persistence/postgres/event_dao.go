@@ -48,8 +48,8 @@ func (dao *EventDAO) queryContext(ctx context.Context, query string, args ...int
 
 func (dao *EventDAO) Create(ctx context.Context, m *Event) error {
 	query := `
-		INSERT INTO events (id, type, data, timestamp)
-		VALUES ($1, $2, $3, $4)
+		INSERT INTO events (id, type, schema_version, aggregate_type, aggregate_id, aggregate_version, producer, correlation_id, causation_id, data, timestamp)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 	`
 
 	_, err := dao.execContext(
@@ -57,6 +57,13 @@ func (dao *EventDAO) Create(ctx context.Context, m *Event) error {
 		query,
 		m.ID,
 		m.Type,
+		m.SchemaVersion,
+		m.AggregateType,
+		m.AggregateID,
+		m.AggregateVersion,
+		m.Producer,
+		m.CorrelationID,
+		m.CausationID,
 		m.Data,
 		m.Timestamp,
 	)
@@ -68,13 +75,27 @@ func (dao *EventDAO) Update(ctx context.Context, m *Event) error {
 	query := `
 		UPDATE events
 		SET type = $1,
-			data = $2,
-			timestamp = $3
-		WHERE id = $4
+			schema_version = $2,
+			aggregate_type = $3,
+			aggregate_id = $4,
+			aggregate_version = $5,
+			producer = $6,
+			correlation_id = $7,
+			causation_id = $8,
+			data = $9,
+			timestamp = $10
+		WHERE id = $11
 	`
 
 	_, err := dao.execContext(ctx, query,
 		m.Type,
+		m.SchemaVersion,
+		m.AggregateType,
+		m.AggregateID,
+		m.AggregateVersion,
+		m.Producer,
+		m.CorrelationID,
+		m.CausationID,
 		m.Data,
 		m.Timestamp,
 		m.ID,
@@ -113,7 +134,7 @@ func (dao *EventDAO) DeleteByPk(ctx context.Context, pk string) error {
 
 func (dao *EventDAO) FindByPk(ctx context.Context, pk string) (*Event, error) {
 	query := `
-		SELECT id, type, data, timestamp
+		SELECT id, type, schema_version, aggregate_type, aggregate_id, aggregate_version, producer, correlation_id, causation_id, data, timestamp
 		FROM events
 		WHERE id = $1
 	`
@@ -123,6 +144,13 @@ func (dao *EventDAO) FindByPk(ctx context.Context, pk string) (*Event, error) {
 	err := row.Scan(
 		&m.ID,
 		&m.Type,
+		&m.SchemaVersion,
+		&m.AggregateType,
+		&m.AggregateID,
+		&m.AggregateVersion,
+		&m.Producer,
+		&m.CorrelationID,
+		&m.CausationID,
 		&m.Data,
 		&m.Timestamp,
 	)
@@ -140,22 +168,29 @@ func (dao *EventDAO) CreateMany(ctx context.Context, models []*Event) error {
 	}
 
 	placeholders := make([]string, len(models))
-	args := make([]interface{}, 0, len(models)*4)
+	args := make([]interface{}, 0, len(models)*11)
 
 	for i, model := range models {
-		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d)",
-			i*4+1, i*4+2, i*4+3, i*4+4)
+		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			i*11+1, i*11+2, i*11+3, i*11+4, i*11+5, i*11+6, i*11+7, i*11+8, i*11+9, i*11+10, i*11+11)
 
 		args = append(args,
 			model.ID,
 			model.Type,
+			model.SchemaVersion,
+			model.AggregateType,
+			model.AggregateID,
+			model.AggregateVersion,
+			model.Producer,
+			model.CorrelationID,
+			model.CausationID,
 			model.Data,
 			model.Timestamp,
 		)
 	}
 
 	query := fmt.Sprintf(`
-		INSERT INTO events (id, type, data, timestamp)
+		INSERT INTO events (id, type, schema_version, aggregate_type, aggregate_id, aggregate_version, producer, correlation_id, causation_id, data, timestamp)
 		VALUES %s
 	`, strings.Join(placeholders, ", "))
 
@@ -171,14 +206,28 @@ func (dao *EventDAO) UpdateMany(ctx context.Context, models []*Event) error {
 	query := `
 		UPDATE events
 		SET type = $1,
-			data = $2,
-			timestamp = $3
-		WHERE id = $4
+			schema_version = $2,
+			aggregate_type = $3,
+			aggregate_id = $4,
+			aggregate_version = $5,
+			producer = $6,
+			correlation_id = $7,
+			causation_id = $8,
+			data = $9,
+			timestamp = $10
+		WHERE id = $11
 	`
 
 	for _, model := range models {
 		_, err := dao.execContext(ctx, query,
 			model.Type,
+			model.SchemaVersion,
+			model.AggregateType,
+			model.AggregateID,
+			model.AggregateVersion,
+			model.Producer,
+			model.CorrelationID,
+			model.CausationID,
 			model.Data,
 			model.Timestamp,
 			model.ID,
@@ -210,7 +259,7 @@ func (dao *EventDAO) DeleteManyByPks(ctx context.Context, pks []string) error {
 
 func (dao *EventDAO) FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*Event, error) {
 	query := `
-		SELECT id, type, data, timestamp
+		SELECT id, type, schema_version, aggregate_type, aggregate_id, aggregate_version, producer, correlation_id, causation_id, data, timestamp
 		FROM events
 	`
 
@@ -228,6 +277,13 @@ func (dao *EventDAO) FindOne(ctx context.Context, where string, sort string, arg
 	err := row.Scan(
 		&m.ID,
 		&m.Type,
+		&m.SchemaVersion,
+		&m.AggregateType,
+		&m.AggregateID,
+		&m.AggregateVersion,
+		&m.Producer,
+		&m.CorrelationID,
+		&m.CausationID,
 		&m.Data,
 		&m.Timestamp,
 	)
@@ -241,7 +297,7 @@ func (dao *EventDAO) FindOne(ctx context.Context, where string, sort string, arg
 
 func (dao *EventDAO) FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*Event, error) {
 	query := `
-		SELECT id, type, data, timestamp
+		SELECT id, type, schema_version, aggregate_type, aggregate_id, aggregate_version, producer, correlation_id, causation_id, data, timestamp
 		FROM events
 	`
 
@@ -265,6 +321,13 @@ func (dao *EventDAO) FindAll(ctx context.Context, where string, sort string, arg
 		err := rows.Scan(
 			&m.ID,
 			&m.Type,
+			&m.SchemaVersion,
+			&m.AggregateType,
+			&m.AggregateID,
+			&m.AggregateVersion,
+			&m.Producer,
+			&m.CorrelationID,
+			&m.CausationID,
 			&m.Data,
 			&m.Timestamp,
 		)
@@ -283,7 +346,7 @@ func (dao *EventDAO) FindAll(ctx context.Context, where string, sort string, arg
 
 func (dao *EventDAO) FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*Event, error) {
 	query := `
-		SELECT id, type, data, timestamp
+		SELECT id, type, schema_version, aggregate_type, aggregate_id, aggregate_version, producer, correlation_id, causation_id, data, timestamp
 		FROM events
 	`
 
@@ -309,6 +372,13 @@ func (dao *EventDAO) FindPaginated(ctx context.Context, limit, offset int, where
 		err := rows.Scan(
 			&m.ID,
 			&m.Type,
+			&m.SchemaVersion,
+			&m.AggregateType,
+			&m.AggregateID,
+			&m.AggregateVersion,
+			&m.Producer,
+			&m.CorrelationID,
+			&m.CausationID,
 			&m.Data,
 			&m.Timestamp,
 		)
@@ -48,8 +48,8 @@ func (dao *OrderDAO) queryContext(ctx context.Context, query string, args ...int
 
 func (dao *OrderDAO) Create(ctx context.Context, m *Order) error {
 	query := `
-		INSERT INTO orders (id, code, current_status, order_lines, customer_id, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		INSERT INTO orders (id, code, current_status, order_lines, customer_id, store_id, checkout_id, subtotal, discount, tax_rate, tax, total, fulfillment_method, fulfillment_status, delivery_address, delivery_fee, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
 	`
 
 	_, err := dao.execContext(
@@ -60,6 +60,17 @@ func (dao *OrderDAO) Create(ctx context.Context, m *Order) error {
 		m.CurrentStatus,
 		m.OrderLines,
 		m.CustomerID,
+		m.StoreID,
+		m.CheckoutID,
+		m.Subtotal,
+		m.Discount,
+		m.TaxRate,
+		m.Tax,
+		m.Total,
+		m.FulfillmentMethod,
+		m.FulfillmentStatus,
+		m.DeliveryAddress,
+		m.DeliveryFee,
 		m.CreatedAt,
 		m.UpdatedAt,
 	)
@@ -74,9 +85,20 @@ func (dao *OrderDAO) Update(ctx context.Context, m *Order) error {
 			current_status = $2,
 			order_lines = $3,
 			customer_id = $4,
-			created_at = $5,
-			updated_at = $6
-		WHERE id = $7
+			store_id = $5,
+			checkout_id = $6,
+			subtotal = $7,
+			discount = $8,
+			tax_rate = $9,
+			tax = $10,
+			total = $11,
+			fulfillment_method = $12,
+			fulfillment_status = $13,
+			delivery_address = $14,
+			delivery_fee = $15,
+			created_at = $16,
+			updated_at = $17
+		WHERE id = $18
 	`
 
 	_, err := dao.execContext(ctx, query,
@@ -84,6 +106,17 @@ func (dao *OrderDAO) Update(ctx context.Context, m *Order) error {
 		m.CurrentStatus,
 		m.OrderLines,
 		m.CustomerID,
+		m.StoreID,
+		m.CheckoutID,
+		m.Subtotal,
+		m.Discount,
+		m.TaxRate,
+		m.Tax,
+		m.Total,
+		m.FulfillmentMethod,
+		m.FulfillmentStatus,
+		m.DeliveryAddress,
+		m.DeliveryFee,
 		m.CreatedAt,
 		m.UpdatedAt,
 		m.ID,
@@ -122,7 +155,7 @@ func (dao *OrderDAO) DeleteByPk(ctx context.Context, pk string) error {
 
 func (dao *OrderDAO) FindByPk(ctx context.Context, pk string) (*Order, error) {
 	query := `
-		SELECT id, code, current_status, order_lines, customer_id, created_at, updated_at
+		SELECT id, code, current_status, order_lines, customer_id, store_id, checkout_id, subtotal, discount, tax_rate, tax, total, fulfillment_method, fulfillment_status, delivery_address, delivery_fee, created_at, updated_at
 		FROM orders
 		WHERE id = $1
 	`
@@ -135,6 +168,17 @@ func (dao *OrderDAO) FindByPk(ctx context.Context, pk string) (*Order, error) {
 		&m.CurrentStatus,
 		&m.OrderLines,
 		&m.CustomerID,
+		&m.StoreID,
+		&m.CheckoutID,
+		&m.Subtotal,
+		&m.Discount,
+		&m.TaxRate,
+		&m.Tax,
+		&m.Total,
+		&m.FulfillmentMethod,
+		&m.FulfillmentStatus,
+		&m.DeliveryAddress,
+		&m.DeliveryFee,
 		&m.CreatedAt,
 		&m.UpdatedAt,
 	)
@@ -152,11 +196,11 @@ func (dao *OrderDAO) CreateMany(ctx context.Context, models []*Order) error {
 	}
 
 	placeholders := make([]string, len(models))
-	args := make([]interface{}, 0, len(models)*7)
+	args := make([]interface{}, 0, len(models)*18)
 
 	for i, model := range models {
-		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d)",
-			i*7+1, i*7+2, i*7+3, i*7+4, i*7+5, i*7+6, i*7+7)
+		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			i*18+1, i*18+2, i*18+3, i*18+4, i*18+5, i*18+6, i*18+7, i*18+8, i*18+9, i*18+10, i*18+11, i*18+12, i*18+13, i*18+14, i*18+15, i*18+16, i*18+17, i*18+18)
 
 		args = append(args,
 			model.ID,
@@ -164,13 +208,24 @@ func (dao *OrderDAO) CreateMany(ctx context.Context, models []*Order) error {
 			model.CurrentStatus,
 			model.OrderLines,
 			model.CustomerID,
+			model.StoreID,
+			model.CheckoutID,
+			model.Subtotal,
+			model.Discount,
+			model.TaxRate,
+			model.Tax,
+			model.Total,
+			model.FulfillmentMethod,
+			model.FulfillmentStatus,
+			model.DeliveryAddress,
+			model.DeliveryFee,
 			model.CreatedAt,
 			model.UpdatedAt,
 		)
 	}
 
 	query := fmt.Sprintf(`
-		INSERT INTO orders (id, code, current_status, order_lines, customer_id, created_at, updated_at)
+		INSERT INTO orders (id, code, current_status, order_lines, customer_id, store_id, checkout_id, subtotal, discount, tax_rate, tax, total, fulfillment_method, fulfillment_status, delivery_address, delivery_fee, created_at, updated_at)
 		VALUES %s
 	`, strings.Join(placeholders, ", "))
 
@@ -189,9 +244,20 @@ func (dao *OrderDAO) UpdateMany(ctx context.Context, models []*Order) error {
 			current_status = $2,
 			order_lines = $3,
 			customer_id = $4,
-			created_at = $5,
-			updated_at = $6
-		WHERE id = $7
+			store_id = $5,
+			checkout_id = $6,
+			subtotal = $7,
+			discount = $8,
+			tax_rate = $9,
+			tax = $10,
+			total = $11,
+			fulfillment_method = $12,
+			fulfillment_status = $13,
+			delivery_address = $14,
+			delivery_fee = $15,
+			created_at = $16,
+			updated_at = $17
+		WHERE id = $18
 	`
 
 	for _, model := range models {
@@ -200,6 +266,17 @@ func (dao *OrderDAO) UpdateMany(ctx context.Context, models []*Order) error {
 			model.CurrentStatus,
 			model.OrderLines,
 			model.CustomerID,
+			model.StoreID,
+			model.CheckoutID,
+			model.Subtotal,
+			model.Discount,
+			model.TaxRate,
+			model.Tax,
+			model.Total,
+			model.FulfillmentMethod,
+			model.FulfillmentStatus,
+			model.DeliveryAddress,
+			model.DeliveryFee,
 			model.CreatedAt,
 			model.UpdatedAt,
 			model.ID,
@@ -231,7 +308,7 @@ func (dao *OrderDAO) DeleteManyByPks(ctx context.Context, pks []string) error {
 
 func (dao *OrderDAO) FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*Order, error) {
 	query := `
-		SELECT id, code, current_status, order_lines, customer_id, created_at, updated_at
+		SELECT id, code, current_status, order_lines, customer_id, store_id, checkout_id, subtotal, discount, tax_rate, tax, total, fulfillment_method, fulfillment_status, delivery_address, delivery_fee, created_at, updated_at
 		FROM orders
 	`
 
@@ -252,6 +329,17 @@ func (dao *OrderDAO) FindOne(ctx context.Context, where string, sort string, arg
 		&m.CurrentStatus,
 		&m.OrderLines,
 		&m.CustomerID,
+		&m.StoreID,
+		&m.CheckoutID,
+		&m.Subtotal,
+		&m.Discount,
+		&m.TaxRate,
+		&m.Tax,
+		&m.Total,
+		&m.FulfillmentMethod,
+		&m.FulfillmentStatus,
+		&m.DeliveryAddress,
+		&m.DeliveryFee,
 		&m.CreatedAt,
 		&m.UpdatedAt,
 	)
@@ -265,7 +353,7 @@ func (dao *OrderDAO) FindOne(ctx context.Context, where string, sort string, arg
 
 func (dao *OrderDAO) FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*Order, error) {
 	query := `
-		SELECT id, code, current_status, order_lines, customer_id, created_at, updated_at
+		SELECT id, code, current_status, order_lines, customer_id, store_id, checkout_id, subtotal, discount, tax_rate, tax, total, fulfillment_method, fulfillment_status, delivery_address, delivery_fee, created_at, updated_at
 		FROM orders
 	`
 
@@ -292,6 +380,17 @@ func (dao *OrderDAO) FindAll(ctx context.Context, where string, sort string, arg
 			&m.CurrentStatus,
 			&m.OrderLines,
 			&m.CustomerID,
+			&m.StoreID,
+			&m.CheckoutID,
+			&m.Subtotal,
+			&m.Discount,
+			&m.TaxRate,
+			&m.Tax,
+			&m.Total,
+			&m.FulfillmentMethod,
+			&m.FulfillmentStatus,
+			&m.DeliveryAddress,
+			&m.DeliveryFee,
 			&m.CreatedAt,
 			&m.UpdatedAt,
 		)
@@ -310,7 +409,7 @@ func (dao *OrderDAO) FindAll(ctx context.Context, where string, sort string, arg
 
 func (dao *OrderDAO) FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*Order, error) {
 	query := `
-		SELECT id, code, current_status, order_lines, customer_id, created_at, updated_at
+		SELECT id, code, current_status, order_lines, customer_id, store_id, checkout_id, subtotal, discount, tax_rate, tax, total, fulfillment_method, fulfillment_status, delivery_address, delivery_fee, created_at, updated_at
 		FROM orders
 	`
 
@@ -339,6 +438,17 @@ func (dao *OrderDAO) FindPaginated(ctx context.Context, limit, offset int, where
 			&m.CurrentStatus,
 			&m.OrderLines,
 			&m.CustomerID,
+			&m.StoreID,
+			&m.CheckoutID,
+			&m.Subtotal,
+			&m.Discount,
+			&m.TaxRate,
+			&m.Tax,
+			&m.Total,
+			&m.FulfillmentMethod,
+			&m.FulfillmentStatus,
+			&m.DeliveryAddress,
+			&m.DeliveryFee,
 			&m.CreatedAt,
 			&m.UpdatedAt,
 		)
@@ -0,0 +1,507 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"ichibuy/order/internal/domain"
+	"strings"
+)
+
+type Promotion = domain.Promotion
+
+type PromotionDAO struct {
+	db *sql.DB
+}
+
+func NewPromotionDAO(db *sql.DB) *PromotionDAO {
+	return &PromotionDAO{db: db}
+}
+
+func (dao *PromotionDAO) getTx(ctx context.Context) *sql.Tx {
+	if tx, ok := ctx.Value("currentTx").(*sql.Tx); ok {
+		return tx
+	}
+	return nil
+}
+
+func (dao *PromotionDAO) execContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.ExecContext(ctx, query, args...)
+	}
+	return dao.db.ExecContext(ctx, query, args...)
+}
+
+func (dao *PromotionDAO) queryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryRowContext(ctx, query, args...)
+	}
+	return dao.db.QueryRowContext(ctx, query, args...)
+}
+
+func (dao *PromotionDAO) queryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryContext(ctx, query, args...)
+	}
+	return dao.db.QueryContext(ctx, query, args...)
+}
+
+func (dao *PromotionDAO) Create(ctx context.Context, m *Promotion) error {
+	query := `
+		INSERT INTO promotions (id, store_id, code, type, value, currency, min_order_amount, product_id, buy_quantity, get_quantity, max_redemptions, max_redemptions_per_customer, redemptions, active, starts_at, ends_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
+	`
+
+	_, err := dao.execContext(
+		ctx,
+		query,
+		m.ID,
+		m.StoreID,
+		m.Code,
+		m.Type,
+		m.Value,
+		m.Currency,
+		m.MinOrderAmount,
+		m.ProductID,
+		m.BuyQuantity,
+		m.GetQuantity,
+		m.MaxRedemptions,
+		m.MaxRedemptionsPerCustomer,
+		m.Redemptions,
+		m.Active,
+		m.StartsAt,
+		m.EndsAt,
+		m.CreatedAt,
+		m.UpdatedAt,
+	)
+
+	return err
+}
+
+func (dao *PromotionDAO) Update(ctx context.Context, m *Promotion) error {
+	query := `
+		UPDATE promotions
+		SET store_id = $1,
+			code = $2,
+			type = $3,
+			value = $4,
+			currency = $5,
+			min_order_amount = $6,
+			product_id = $7,
+			buy_quantity = $8,
+			get_quantity = $9,
+			max_redemptions = $10,
+			max_redemptions_per_customer = $11,
+			redemptions = $12,
+			active = $13,
+			starts_at = $14,
+			ends_at = $15,
+			created_at = $16,
+			updated_at = $17
+		WHERE id = $18
+	`
+
+	_, err := dao.execContext(ctx, query,
+		m.StoreID,
+		m.Code,
+		m.Type,
+		m.Value,
+		m.Currency,
+		m.MinOrderAmount,
+		m.ProductID,
+		m.BuyQuantity,
+		m.GetQuantity,
+		m.MaxRedemptions,
+		m.MaxRedemptionsPerCustomer,
+		m.Redemptions,
+		m.Active,
+		m.StartsAt,
+		m.EndsAt,
+		m.CreatedAt,
+		m.UpdatedAt,
+		m.ID,
+	)
+	return err
+}
+
+func (dao *PromotionDAO) PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	setClauses := make([]string, 0, len(fields))
+	args := make([]interface{}, 0, len(fields)+1)
+	i := 1
+
+	for field, value := range fields {
+		setClauses = append(setClauses, fmt.Sprintf("%s = $%d", field, i))
+		args = append(args, value)
+		i++
+	}
+
+	args = append(args, pk)
+
+	query := fmt.Sprintf(`UPDATE promotions SET %s WHERE id = $%d`, strings.Join(setClauses, ", "), i)
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *PromotionDAO) DeleteByPk(ctx context.Context, pk string) error {
+	query := `DELETE FROM promotions WHERE id = $1`
+	_, err := dao.execContext(ctx, query, pk)
+	return err
+}
+
+func (dao *PromotionDAO) FindByPk(ctx context.Context, pk string) (*Promotion, error) {
+	query := `
+		SELECT id, store_id, code, type, value, currency, min_order_amount, product_id, buy_quantity, get_quantity, max_redemptions, max_redemptions_per_customer, redemptions, active, starts_at, ends_at, created_at, updated_at
+		FROM promotions
+		WHERE id = $1
+	`
+	row := dao.queryRowContext(ctx, query, pk)
+
+	var m Promotion
+	err := row.Scan(
+		&m.ID,
+		&m.StoreID,
+		&m.Code,
+		&m.Type,
+		&m.Value,
+		&m.Currency,
+		&m.MinOrderAmount,
+		&m.ProductID,
+		&m.BuyQuantity,
+		&m.GetQuantity,
+		&m.MaxRedemptions,
+		&m.MaxRedemptionsPerCustomer,
+		&m.Redemptions,
+		&m.Active,
+		&m.StartsAt,
+		&m.EndsAt,
+		&m.CreatedAt,
+		&m.UpdatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *PromotionDAO) CreateMany(ctx context.Context, models []*Promotion) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(models))
+	args := make([]interface{}, 0, len(models)*18)
+
+	for i, model := range models {
+		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			i*18+1, i*18+2, i*18+3, i*18+4, i*18+5, i*18+6, i*18+7, i*18+8, i*18+9, i*18+10, i*18+11, i*18+12, i*18+13, i*18+14, i*18+15, i*18+16, i*18+17, i*18+18)
+
+		args = append(args,
+			model.ID,
+			model.StoreID,
+			model.Code,
+			model.Type,
+			model.Value,
+			model.Currency,
+			model.MinOrderAmount,
+			model.ProductID,
+			model.BuyQuantity,
+			model.GetQuantity,
+			model.MaxRedemptions,
+			model.MaxRedemptionsPerCustomer,
+			model.Redemptions,
+			model.Active,
+			model.StartsAt,
+			model.EndsAt,
+			model.CreatedAt,
+			model.UpdatedAt,
+		)
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO promotions (id, store_id, code, type, value, currency, min_order_amount, product_id, buy_quantity, get_quantity, max_redemptions, max_redemptions_per_customer, redemptions, active, starts_at, ends_at, created_at, updated_at)
+		VALUES %s
+	`, strings.Join(placeholders, ", "))
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *PromotionDAO) UpdateMany(ctx context.Context, models []*Promotion) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	query := `
+		UPDATE promotions
+		SET store_id = $1,
+			code = $2,
+			type = $3,
+			value = $4,
+			currency = $5,
+			min_order_amount = $6,
+			product_id = $7,
+			buy_quantity = $8,
+			get_quantity = $9,
+			max_redemptions = $10,
+			max_redemptions_per_customer = $11,
+			redemptions = $12,
+			active = $13,
+			starts_at = $14,
+			ends_at = $15,
+			created_at = $16,
+			updated_at = $17
+		WHERE id = $18
+	`
+
+	for _, model := range models {
+		_, err := dao.execContext(ctx, query,
+			model.StoreID,
+			model.Code,
+			model.Type,
+			model.Value,
+			model.Currency,
+			model.MinOrderAmount,
+			model.ProductID,
+			model.BuyQuantity,
+			model.GetQuantity,
+			model.MaxRedemptions,
+			model.MaxRedemptionsPerCustomer,
+			model.Redemptions,
+			model.Active,
+			model.StartsAt,
+			model.EndsAt,
+			model.CreatedAt,
+			model.UpdatedAt,
+			model.ID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (dao *PromotionDAO) DeleteManyByPks(ctx context.Context, pks []string) error {
+	if len(pks) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(pks))
+	args := make([]interface{}, len(pks))
+	for i, pk := range pks {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = pk
+	}
+
+	query := fmt.Sprintf(`DELETE FROM promotions WHERE id IN (%s)`, strings.Join(placeholders, ","))
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *PromotionDAO) FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*Promotion, error) {
+	query := `
+		SELECT id, store_id, code, type, value, currency, min_order_amount, product_id, buy_quantity, get_quantity, max_redemptions, max_redemptions_per_customer, redemptions, active, starts_at, ends_at, created_at, updated_at
+		FROM promotions
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var m Promotion
+	err := row.Scan(
+		&m.ID,
+		&m.StoreID,
+		&m.Code,
+		&m.Type,
+		&m.Value,
+		&m.Currency,
+		&m.MinOrderAmount,
+		&m.ProductID,
+		&m.BuyQuantity,
+		&m.GetQuantity,
+		&m.MaxRedemptions,
+		&m.MaxRedemptionsPerCustomer,
+		&m.Redemptions,
+		&m.Active,
+		&m.StartsAt,
+		&m.EndsAt,
+		&m.CreatedAt,
+		&m.UpdatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *PromotionDAO) FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*Promotion, error) {
+	query := `
+		SELECT id, store_id, code, type, value, currency, min_order_amount, product_id, buy_quantity, get_quantity, max_redemptions, max_redemptions_per_customer, redemptions, active, starts_at, ends_at, created_at, updated_at
+		FROM promotions
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*Promotion
+	for rows.Next() {
+		var m Promotion
+		err := rows.Scan(
+			&m.ID,
+			&m.StoreID,
+			&m.Code,
+			&m.Type,
+			&m.Value,
+			&m.Currency,
+			&m.MinOrderAmount,
+			&m.ProductID,
+			&m.BuyQuantity,
+			&m.GetQuantity,
+			&m.MaxRedemptions,
+			&m.MaxRedemptionsPerCustomer,
+			&m.Redemptions,
+			&m.Active,
+			&m.StartsAt,
+			&m.EndsAt,
+			&m.CreatedAt,
+			&m.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *PromotionDAO) FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*Promotion, error) {
+	query := `
+		SELECT id, store_id, code, type, value, currency, min_order_amount, product_id, buy_quantity, get_quantity, max_redemptions, max_redemptions_per_customer, redemptions, active, starts_at, ends_at, created_at, updated_at
+		FROM promotions
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	query += fmt.Sprintf(" LIMIT %d OFFSET %d", limit, offset)
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*Promotion
+	for rows.Next() {
+		var m Promotion
+		err := rows.Scan(
+			&m.ID,
+			&m.StoreID,
+			&m.Code,
+			&m.Type,
+			&m.Value,
+			&m.Currency,
+			&m.MinOrderAmount,
+			&m.ProductID,
+			&m.BuyQuantity,
+			&m.GetQuantity,
+			&m.MaxRedemptions,
+			&m.MaxRedemptionsPerCustomer,
+			&m.Redemptions,
+			&m.Active,
+			&m.StartsAt,
+			&m.EndsAt,
+			&m.CreatedAt,
+			&m.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *PromotionDAO) Count(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := "SELECT COUNT(*) FROM promotions"
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var count int64
+	err := row.Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (dao *PromotionDAO) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := dao.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	ctxWithTx := context.WithValue(ctx, "currentTx", tx)
+
+	err = fn(ctxWithTx)
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
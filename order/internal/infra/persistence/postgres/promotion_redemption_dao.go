@@ -0,0 +1,407 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"ichibuy/order/internal/domain"
+	"strings"
+)
+
+type PromotionRedemption = domain.PromotionRedemption
+
+type PromotionRedemptionDAO struct {
+	db *sql.DB
+}
+
+func NewPromotionRedemptionDAO(db *sql.DB) *PromotionRedemptionDAO {
+	return &PromotionRedemptionDAO{db: db}
+}
+
+func (dao *PromotionRedemptionDAO) getTx(ctx context.Context) *sql.Tx {
+	if tx, ok := ctx.Value("currentTx").(*sql.Tx); ok {
+		return tx
+	}
+	return nil
+}
+
+func (dao *PromotionRedemptionDAO) execContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.ExecContext(ctx, query, args...)
+	}
+	return dao.db.ExecContext(ctx, query, args...)
+}
+
+func (dao *PromotionRedemptionDAO) queryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryRowContext(ctx, query, args...)
+	}
+	return dao.db.QueryRowContext(ctx, query, args...)
+}
+
+func (dao *PromotionRedemptionDAO) queryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryContext(ctx, query, args...)
+	}
+	return dao.db.QueryContext(ctx, query, args...)
+}
+
+func (dao *PromotionRedemptionDAO) Create(ctx context.Context, m *PromotionRedemption) error {
+	query := `
+		INSERT INTO promotion_redemptions (id, promotion_id, order_id, customer_id, sequence, customer_sequence, discount, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	_, err := dao.execContext(
+		ctx,
+		query,
+		m.ID,
+		m.PromotionID,
+		m.OrderID,
+		m.CustomerID,
+		m.Sequence,
+		m.CustomerSequence,
+		m.Discount,
+		m.CreatedAt,
+	)
+
+	return err
+}
+
+func (dao *PromotionRedemptionDAO) Update(ctx context.Context, m *PromotionRedemption) error {
+	query := `
+		UPDATE promotion_redemptions
+		SET promotion_id = $1,
+			order_id = $2,
+			customer_id = $3,
+			sequence = $4,
+			customer_sequence = $5,
+			discount = $6,
+			created_at = $7
+		WHERE id = $8
+	`
+
+	_, err := dao.execContext(ctx, query,
+		m.PromotionID,
+		m.OrderID,
+		m.CustomerID,
+		m.Sequence,
+		m.CustomerSequence,
+		m.Discount,
+		m.CreatedAt,
+		m.ID,
+	)
+	return err
+}
+
+func (dao *PromotionRedemptionDAO) PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	setClauses := make([]string, 0, len(fields))
+	args := make([]interface{}, 0, len(fields)+1)
+	i := 1
+
+	for field, value := range fields {
+		setClauses = append(setClauses, fmt.Sprintf("%s = $%d", field, i))
+		args = append(args, value)
+		i++
+	}
+
+	args = append(args, pk)
+
+	query := fmt.Sprintf(`UPDATE promotion_redemptions SET %s WHERE id = $%d`, strings.Join(setClauses, ", "), i)
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *PromotionRedemptionDAO) DeleteByPk(ctx context.Context, pk string) error {
+	query := `DELETE FROM promotion_redemptions WHERE id = $1`
+	_, err := dao.execContext(ctx, query, pk)
+	return err
+}
+
+func (dao *PromotionRedemptionDAO) FindByPk(ctx context.Context, pk string) (*PromotionRedemption, error) {
+	query := `
+		SELECT id, promotion_id, order_id, customer_id, sequence, customer_sequence, discount, created_at
+		FROM promotion_redemptions
+		WHERE id = $1
+	`
+	row := dao.queryRowContext(ctx, query, pk)
+
+	var m PromotionRedemption
+	err := row.Scan(
+		&m.ID,
+		&m.PromotionID,
+		&m.OrderID,
+		&m.CustomerID,
+		&m.Sequence,
+		&m.CustomerSequence,
+		&m.Discount,
+		&m.CreatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *PromotionRedemptionDAO) CreateMany(ctx context.Context, models []*PromotionRedemption) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(models))
+	args := make([]interface{}, 0, len(models)*8)
+
+	for i, model := range models {
+		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			i*8+1, i*8+2, i*8+3, i*8+4, i*8+5, i*8+6, i*8+7, i*8+8)
+
+		args = append(args,
+			model.ID,
+			model.PromotionID,
+			model.OrderID,
+			model.CustomerID,
+			model.Sequence,
+			model.CustomerSequence,
+			model.Discount,
+			model.CreatedAt,
+		)
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO promotion_redemptions (id, promotion_id, order_id, customer_id, sequence, customer_sequence, discount, created_at)
+		VALUES %s
+	`, strings.Join(placeholders, ", "))
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *PromotionRedemptionDAO) UpdateMany(ctx context.Context, models []*PromotionRedemption) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	query := `
+		UPDATE promotion_redemptions
+		SET promotion_id = $1,
+			order_id = $2,
+			customer_id = $3,
+			sequence = $4,
+			customer_sequence = $5,
+			discount = $6,
+			created_at = $7
+		WHERE id = $8
+	`
+
+	for _, model := range models {
+		_, err := dao.execContext(ctx, query,
+			model.PromotionID,
+			model.OrderID,
+			model.CustomerID,
+			model.Sequence,
+			model.CustomerSequence,
+			model.Discount,
+			model.CreatedAt,
+			model.ID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (dao *PromotionRedemptionDAO) DeleteManyByPks(ctx context.Context, pks []string) error {
+	if len(pks) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(pks))
+	args := make([]interface{}, len(pks))
+	for i, pk := range pks {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = pk
+	}
+
+	query := fmt.Sprintf(`DELETE FROM promotion_redemptions WHERE id IN (%s)`, strings.Join(placeholders, ","))
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *PromotionRedemptionDAO) FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*PromotionRedemption, error) {
+	query := `
+		SELECT id, promotion_id, order_id, customer_id, sequence, customer_sequence, discount, created_at
+		FROM promotion_redemptions
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var m PromotionRedemption
+	err := row.Scan(
+		&m.ID,
+		&m.PromotionID,
+		&m.OrderID,
+		&m.CustomerID,
+		&m.Sequence,
+		&m.CustomerSequence,
+		&m.Discount,
+		&m.CreatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *PromotionRedemptionDAO) FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*PromotionRedemption, error) {
+	query := `
+		SELECT id, promotion_id, order_id, customer_id, sequence, customer_sequence, discount, created_at
+		FROM promotion_redemptions
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*PromotionRedemption
+	for rows.Next() {
+		var m PromotionRedemption
+		err := rows.Scan(
+			&m.ID,
+			&m.PromotionID,
+			&m.OrderID,
+			&m.CustomerID,
+			&m.Sequence,
+			&m.CustomerSequence,
+			&m.Discount,
+			&m.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *PromotionRedemptionDAO) FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*PromotionRedemption, error) {
+	query := `
+		SELECT id, promotion_id, order_id, customer_id, sequence, customer_sequence, discount, created_at
+		FROM promotion_redemptions
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	query += fmt.Sprintf(" LIMIT %d OFFSET %d", limit, offset)
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*PromotionRedemption
+	for rows.Next() {
+		var m PromotionRedemption
+		err := rows.Scan(
+			&m.ID,
+			&m.PromotionID,
+			&m.OrderID,
+			&m.CustomerID,
+			&m.Sequence,
+			&m.CustomerSequence,
+			&m.Discount,
+			&m.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *PromotionRedemptionDAO) Count(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := "SELECT COUNT(*) FROM promotion_redemptions"
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var count int64
+	err := row.Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (dao *PromotionRedemptionDAO) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := dao.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	ctxWithTx := context.WithValue(ctx, "currentTx", tx)
+
+	err = fn(ctxWithTx)
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
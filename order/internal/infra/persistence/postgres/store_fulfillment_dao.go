@@ -0,0 +1,387 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"ichibuy/order/internal/domain"
+	"strings"
+)
+
+type StoreFulfillment = domain.StoreFulfillment
+
+type StoreFulfillmentDAO struct {
+	db *sql.DB
+}
+
+func NewStoreFulfillmentDAO(db *sql.DB) *StoreFulfillmentDAO {
+	return &StoreFulfillmentDAO{db: db}
+}
+
+func (dao *StoreFulfillmentDAO) getTx(ctx context.Context) *sql.Tx {
+	if tx, ok := ctx.Value("currentTx").(*sql.Tx); ok {
+		return tx
+	}
+	return nil
+}
+
+func (dao *StoreFulfillmentDAO) execContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.ExecContext(ctx, query, args...)
+	}
+	return dao.db.ExecContext(ctx, query, args...)
+}
+
+func (dao *StoreFulfillmentDAO) queryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryRowContext(ctx, query, args...)
+	}
+	return dao.db.QueryRowContext(ctx, query, args...)
+}
+
+func (dao *StoreFulfillmentDAO) queryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryContext(ctx, query, args...)
+	}
+	return dao.db.QueryContext(ctx, query, args...)
+}
+
+func (dao *StoreFulfillmentDAO) Create(ctx context.Context, m *StoreFulfillment) error {
+	query := `
+		INSERT INTO store_fulfillments (store_id, methods, delivery_zones, shipping_fee, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	_, err := dao.execContext(
+		ctx,
+		query,
+		m.StoreID,
+		m.Methods,
+		m.DeliveryZones,
+		m.ShippingFee,
+		m.CreatedAt,
+		m.UpdatedAt,
+	)
+
+	return err
+}
+
+func (dao *StoreFulfillmentDAO) Update(ctx context.Context, m *StoreFulfillment) error {
+	query := `
+		UPDATE store_fulfillments
+		SET methods = $1,
+			delivery_zones = $2,
+			shipping_fee = $3,
+			created_at = $4,
+			updated_at = $5
+		WHERE store_id = $6
+	`
+
+	_, err := dao.execContext(ctx, query,
+		m.Methods,
+		m.DeliveryZones,
+		m.ShippingFee,
+		m.CreatedAt,
+		m.UpdatedAt,
+		m.StoreID,
+	)
+	return err
+}
+
+func (dao *StoreFulfillmentDAO) PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	setClauses := make([]string, 0, len(fields))
+	args := make([]interface{}, 0, len(fields)+1)
+	i := 1
+
+	for field, value := range fields {
+		setClauses = append(setClauses, fmt.Sprintf("%s = $%d", field, i))
+		args = append(args, value)
+		i++
+	}
+
+	args = append(args, pk)
+
+	query := fmt.Sprintf(`UPDATE store_fulfillments SET %s WHERE store_id = $%d`, strings.Join(setClauses, ", "), i)
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *StoreFulfillmentDAO) DeleteByPk(ctx context.Context, pk string) error {
+	query := `DELETE FROM store_fulfillments WHERE store_id = $1`
+	_, err := dao.execContext(ctx, query, pk)
+	return err
+}
+
+func (dao *StoreFulfillmentDAO) FindByPk(ctx context.Context, pk string) (*StoreFulfillment, error) {
+	query := `
+		SELECT store_id, methods, delivery_zones, shipping_fee, created_at, updated_at
+		FROM store_fulfillments
+		WHERE store_id = $1
+	`
+	row := dao.queryRowContext(ctx, query, pk)
+
+	var m StoreFulfillment
+	err := row.Scan(
+		&m.StoreID,
+		&m.Methods,
+		&m.DeliveryZones,
+		&m.ShippingFee,
+		&m.CreatedAt,
+		&m.UpdatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *StoreFulfillmentDAO) CreateMany(ctx context.Context, models []*StoreFulfillment) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(models))
+	args := make([]interface{}, 0, len(models)*6)
+
+	for i, model := range models {
+		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d)",
+			i*6+1, i*6+2, i*6+3, i*6+4, i*6+5, i*6+6)
+
+		args = append(args,
+			model.StoreID,
+			model.Methods,
+			model.DeliveryZones,
+			model.ShippingFee,
+			model.CreatedAt,
+			model.UpdatedAt,
+		)
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO store_fulfillments (store_id, methods, delivery_zones, shipping_fee, created_at, updated_at)
+		VALUES %s
+	`, strings.Join(placeholders, ", "))
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *StoreFulfillmentDAO) UpdateMany(ctx context.Context, models []*StoreFulfillment) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	query := `
+		UPDATE store_fulfillments
+		SET methods = $1,
+			delivery_zones = $2,
+			shipping_fee = $3,
+			created_at = $4,
+			updated_at = $5
+		WHERE store_id = $6
+	`
+
+	for _, model := range models {
+		_, err := dao.execContext(ctx, query,
+			model.Methods,
+			model.DeliveryZones,
+			model.ShippingFee,
+			model.CreatedAt,
+			model.UpdatedAt,
+			model.StoreID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (dao *StoreFulfillmentDAO) DeleteManyByPks(ctx context.Context, pks []string) error {
+	if len(pks) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(pks))
+	args := make([]interface{}, len(pks))
+	for i, pk := range pks {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = pk
+	}
+
+	query := fmt.Sprintf(`DELETE FROM store_fulfillments WHERE store_id IN (%s)`, strings.Join(placeholders, ","))
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *StoreFulfillmentDAO) FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*StoreFulfillment, error) {
+	query := `
+		SELECT store_id, methods, delivery_zones, shipping_fee, created_at, updated_at
+		FROM store_fulfillments
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var m StoreFulfillment
+	err := row.Scan(
+		&m.StoreID,
+		&m.Methods,
+		&m.DeliveryZones,
+		&m.ShippingFee,
+		&m.CreatedAt,
+		&m.UpdatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *StoreFulfillmentDAO) FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*StoreFulfillment, error) {
+	query := `
+		SELECT store_id, methods, delivery_zones, shipping_fee, created_at, updated_at
+		FROM store_fulfillments
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*StoreFulfillment
+	for rows.Next() {
+		var m StoreFulfillment
+		err := rows.Scan(
+			&m.StoreID,
+			&m.Methods,
+			&m.DeliveryZones,
+			&m.ShippingFee,
+			&m.CreatedAt,
+			&m.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *StoreFulfillmentDAO) FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*StoreFulfillment, error) {
+	query := `
+		SELECT store_id, methods, delivery_zones, shipping_fee, created_at, updated_at
+		FROM store_fulfillments
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	query += fmt.Sprintf(" LIMIT %d OFFSET %d", limit, offset)
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*StoreFulfillment
+	for rows.Next() {
+		var m StoreFulfillment
+		err := rows.Scan(
+			&m.StoreID,
+			&m.Methods,
+			&m.DeliveryZones,
+			&m.ShippingFee,
+			&m.CreatedAt,
+			&m.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *StoreFulfillmentDAO) Count(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := "SELECT COUNT(*) FROM store_fulfillments"
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var count int64
+	err := row.Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (dao *StoreFulfillmentDAO) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := dao.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	ctxWithTx := context.WithValue(ctx, "currentTx", tx)
+
+	err = fn(ctxWithTx)
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
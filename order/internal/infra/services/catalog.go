@@ -0,0 +1,99 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"ichibuy/order/internal/domain"
+	"ichibuy/order/internal/domain/dao"
+)
+
+// catalogProductService reads the products from the catalog kept from the store events, and from the store
+// service the products not consumed yet
+type catalogProductService struct {
+	catalogProductDAO dao.CatalogProductDAO
+	fallback          domain.ProductService
+}
+
+func NewCatalogProductService(catalogProductDAO dao.CatalogProductDAO, fallback domain.ProductService) *catalogProductService {
+	return &catalogProductService{catalogProductDAO: catalogProductDAO, fallback: fallback}
+}
+
+func (s *catalogProductService) FindByID(ctx context.Context, id string) (*domain.ProductDTO, error) {
+	product, err := s.catalogProductDAO.FindByPk(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		slog.InfoContext(ctx, "catalog product not found, reading it from the store service", "product_id", id)
+		return s.fallback.FindByID(ctx, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return product.ToDTO(), nil
+}
+
+// catalogStoreService reads the stores from the catalog kept from the store events, and from the store
+// service the stores not consumed yet
+type catalogStoreService struct {
+	catalogStoreDAO dao.CatalogStoreDAO
+	fallback        domain.StoreService
+}
+
+func NewCatalogStoreService(catalogStoreDAO dao.CatalogStoreDAO, fallback domain.StoreService) *catalogStoreService {
+	return &catalogStoreService{catalogStoreDAO: catalogStoreDAO, fallback: fallback}
+}
+
+func (s *catalogStoreService) FindByID(ctx context.Context, id string) (*domain.StoreDTO, error) {
+	store, err := s.catalogStoreDAO.FindByPk(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		slog.InfoContext(ctx, "catalog store not found, reading it from the store service", "store_id", id)
+		return s.fallback.FindByID(ctx, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if store.IsDeleted() {
+		return nil, fmt.Errorf("store %s not found", id)
+	}
+
+	return store.ToDTO(), nil
+}
+
+// catalogStoreAvailabilityService tells if a store is open from the schedule kept in the catalog, and from the
+// store service for the stores whose schedule was not consumed yet
+type catalogStoreAvailabilityService struct {
+	catalogStoreDAO dao.CatalogStoreDAO
+	fallback        domain.StoreAvailabilityService
+}
+
+func NewCatalogStoreAvailabilityService(catalogStoreDAO dao.CatalogStoreDAO, fallback domain.StoreAvailabilityService) *catalogStoreAvailabilityService {
+	return &catalogStoreAvailabilityService{catalogStoreDAO: catalogStoreDAO, fallback: fallback}
+}
+
+func (s *catalogStoreAvailabilityService) FindAvailability(ctx context.Context, storeID string) (*domain.StoreAvailabilityDTO, error) {
+	store, err := s.catalogStoreDAO.FindByPk(ctx, storeID)
+	if errors.Is(err, sql.ErrNoRows) {
+		slog.InfoContext(ctx, "catalog store not found, reading its availability from the store service", "store_id", storeID)
+		return s.fallback.FindAvailability(ctx, storeID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if store.IsDeleted() {
+		return nil, fmt.Errorf("store %s not found", storeID)
+	}
+
+	availability, ok := store.AvailabilityAt(time.Now().UTC())
+	if !ok {
+		slog.InfoContext(ctx, "catalog store without schedule, reading its availability from the store service", "store_id", storeID)
+		return s.fallback.FindAvailability(ctx, storeID)
+	}
+
+	return availability, nil
+}
@@ -25,7 +25,15 @@ func (s *customerService) FindByUserID(ctx context.Context, userID string) (*dom
 		return nil, err
 	}
 
+	var phone *string
+	if resp.Phone != "" {
+		phone = &resp.Phone
+	}
+
 	return &domain.CustomerDTO{
-		ID: resp.Id,
+		ID:        resp.Id,
+		FirstName: resp.FirstName,
+		LastName:  resp.LastName,
+		Phone:     phone,
 	}, nil
 }
@@ -0,0 +1,87 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"ichibuy/order/internal/domain"
+	sharedCtx "ichibuy/order/internal/shared/context"
+)
+
+// customerAddressService reads the addresses of the customers, they are not part of the generated
+// store client yet
+type customerAddressService struct {
+	client  *http.Client
+	baseURL string
+}
+
+func NewCustomerAddressService(client *http.Client, baseURL string) domain.CustomerAddressService {
+	return &customerAddressService{client: client, baseURL: baseURL}
+}
+
+func (s *customerAddressService) FindAddress(ctx context.Context, customerID, addressID string) (*domain.DeliveryAddress, error) {
+	endpoint := fmt.Sprintf(
+		"%s/api/v1/customers/%s/addresses/%s",
+		s.baseURL,
+		url.PathEscape(customerID),
+		url.PathEscape(addressID),
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if token, ok := ctx.Value(sharedCtx.APITokenKey).(string); ok && token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("find customer address failed with status %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Recipient  *string `json:"recipient"`
+		Line1      string  `json:"line1"`
+		Line2      *string `json:"line2"`
+		City       string  `json:"city"`
+		Region     *string `json:"region"`
+		PostalCode *string `json:"postal_code"`
+		Country    string  `json:"country"`
+		Location   *struct {
+			Lat float64 `json:"lat"`
+			Lng float64 `json:"lng"`
+		} `json:"location"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+
+	address := &domain.DeliveryAddress{
+		Line1:      body.Line1,
+		Line2:      body.Line2,
+		City:       body.City,
+		Region:     body.Region,
+		PostalCode: body.PostalCode,
+		Country:    body.Country,
+	}
+
+	if body.Recipient != nil {
+		address.Recipient = *body.Recipient
+	}
+
+	if body.Location != nil {
+		address.Location = &domain.GeoPoint{Lat: body.Location.Lat, Lng: body.Location.Lng}
+	}
+
+	return address, nil
+}
@@ -0,0 +1,80 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"ichibuy/order/internal/domain"
+)
+
+// FakePaymentGatewayName is the provider of the payments created with the fake gateway
+const FakePaymentGatewayName = "fake"
+
+// fakePaymentGateway is a local payment provider for development and tests. Payments stay pending
+// until a webhook signed with the secret confirms or fails them.
+type fakePaymentGateway struct {
+	webhookSecret string
+}
+
+// FakePaymentWebhook is the payload of the fake provider webhooks
+type FakePaymentWebhook struct {
+	Reference     string `json:"reference"`
+	Status        string `json:"status"`
+	FailureReason string `json:"failure_reason"`
+}
+
+func NewFakePaymentGateway(webhookSecret string) *fakePaymentGateway {
+	return &fakePaymentGateway{webhookSecret: webhookSecret}
+}
+
+func (g *fakePaymentGateway) Name() string {
+	return FakePaymentGatewayName
+}
+
+func (g *fakePaymentGateway) CreateIntent(ctx context.Context, params domain.PaymentIntentParams) (*domain.PaymentIntentDTO, error) {
+	reference := "fake_pi_" + uuid.NewString()
+	return &domain.PaymentIntentDTO{
+		ProviderReference: reference,
+		ClientSecret:      reference + "_secret",
+	}, nil
+}
+
+func (g *fakePaymentGateway) Refund(ctx context.Context, providerReference string, amount domain.Money, idempotencyKey string) error {
+	return nil
+}
+
+func (g *fakePaymentGateway) ParseWebhook(payload []byte, signature string) (*domain.PaymentWebhookDTO, error) {
+	expected := SignFakePaymentWebhook(g.webhookSecret, payload)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return nil, domain.ErrInvalidPaymentSignature
+	}
+
+	var webhook FakePaymentWebhook
+	if err := json.Unmarshal(payload, &webhook); err != nil {
+		return nil, err
+	}
+
+	status := domain.PaymentStatus(webhook.Status)
+	if status != domain.SucceededPaymentStatus && status != domain.FailedPaymentStatus {
+		return nil, fmt.Errorf("invalid payment status %s", webhook.Status)
+	}
+
+	return &domain.PaymentWebhookDTO{
+		ProviderReference: webhook.Reference,
+		Status:            status,
+		FailureReason:     webhook.FailureReason,
+	}, nil
+}
+
+// SignFakePaymentWebhook returns the hex encoded HMAC-SHA256 of the payload expected by the fake gateway
+func SignFakePaymentWebhook(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
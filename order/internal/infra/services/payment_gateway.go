@@ -0,0 +1,22 @@
+package services
+
+import (
+	"fmt"
+
+	"ichibuy/order/internal/domain"
+)
+
+// NewPaymentGateway returns the payment gateway selected with PAYMENT_GATEWAY. The webhooks of every
+// provider are signed, so a gateway without a webhook secret is refused.
+func NewPaymentGateway(name, webhookSecret string) (domain.PaymentGateway, error) {
+	if webhookSecret == "" {
+		return nil, fmt.Errorf("payment webhook secret is required")
+	}
+
+	switch name {
+	case FakePaymentGatewayName:
+		return NewFakePaymentGateway(webhookSecret), nil
+	default:
+		return nil, fmt.Errorf("unknown payment gateway %q", name)
+	}
+}
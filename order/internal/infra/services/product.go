@@ -0,0 +1,44 @@
+package services
+
+import (
+	"context"
+
+	storeHTTP "github.com/Jibaru/ichibuy/api-client/go/store"
+
+	"ichibuy/order/internal/domain"
+	sharedCtx "ichibuy/order/internal/shared/context"
+)
+
+type productService struct {
+	client *storeHTTP.APIClient
+}
+
+func NewProductService(client *storeHTTP.APIClient) *productService {
+	return &productService{client: client}
+}
+
+func (s *productService) FindByID(ctx context.Context, id string) (*domain.ProductDTO, error) {
+	ctx = sharedCtx.AddToken(ctx, storeHTTP.ContextAccessToken)
+
+	resp, _, err := s.client.ProductsApi.ApiV1ProductsIdGet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	prices := make([]domain.Money, 0, len(resp.Prices))
+	for _, price := range resp.Prices {
+		money, err := domain.NewMoney(int(price.Amount), price.Currency)
+		if err != nil {
+			return nil, err
+		}
+		prices = append(prices, money)
+	}
+
+	return &domain.ProductDTO{
+		ID:      resp.Id,
+		Name:    resp.Name,
+		StoreID: resp.StoreId,
+		Active:  resp.Active,
+		Prices:  prices,
+	}, nil
+}
@@ -0,0 +1,33 @@
+package services
+
+import (
+	"context"
+
+	storeHTTP "github.com/Jibaru/ichibuy/api-client/go/store"
+
+	"ichibuy/order/internal/domain"
+	sharedCtx "ichibuy/order/internal/shared/context"
+)
+
+type storeService struct {
+	client *storeHTTP.APIClient
+}
+
+func NewStoreService(client *storeHTTP.APIClient) *storeService {
+	return &storeService{client: client}
+}
+
+func (s *storeService) FindByID(ctx context.Context, id string) (*domain.StoreDTO, error) {
+	ctx = sharedCtx.AddToken(ctx, storeHTTP.ContextAccessToken)
+
+	resp, _, err := s.client.StoresApi.ApiV1StoresIdGet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.StoreDTO{
+		ID:       resp.Id,
+		UserID:   resp.UserId,
+		Location: domain.GeoPoint{Lat: float64(resp.Lat), Lng: float64(resp.Lng)},
+	}, nil
+}
@@ -0,0 +1,63 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"ichibuy/order/internal/domain"
+	sharedCtx "ichibuy/order/internal/shared/context"
+)
+
+// storeAvailabilityService reads the schedule fields of the store, they are not part of the generated
+// store client yet
+type storeAvailabilityService struct {
+	client  *http.Client
+	baseURL string
+}
+
+func NewStoreAvailabilityService(client *http.Client, baseURL string) domain.StoreAvailabilityService {
+	return &storeAvailabilityService{client: client, baseURL: baseURL}
+}
+
+func (s *storeAvailabilityService) FindAvailability(ctx context.Context, storeID string) (*domain.StoreAvailabilityDTO, error) {
+	endpoint := fmt.Sprintf("%s/api/v1/stores/%s", s.baseURL, url.PathEscape(storeID))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if token, ok := ctx.Value(sharedCtx.APITokenKey).(string); ok && token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("find store availability failed with status %d", resp.StatusCode)
+	}
+
+	var body struct {
+		ID        string `json:"id"`
+		IsOpenNow bool   `json:"is_open_now"`
+		Schedule  struct {
+			AllowsPreOrders bool `json:"allows_pre_orders"`
+		} `json:"schedule"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+
+	return &domain.StoreAvailabilityDTO{
+		StoreID:         body.ID,
+		IsOpenNow:       body.IsOpenNow,
+		AllowsPreOrders: body.Schedule.AllowsPreOrders,
+	}, nil
+}
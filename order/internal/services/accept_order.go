@@ -0,0 +1,72 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+
+	"ichibuy/order/internal/domain"
+	"ichibuy/order/internal/domain/dao"
+)
+
+type AcceptOrderReq struct {
+	ID     string
+	UserID string
+}
+
+type AcceptOrder struct {
+	orderDAO             dao.OrderDAO
+	orderStatusChangeDAO dao.OrderStatusChangeDAO
+	storeSvc             domain.StoreService
+	eventBus             domain.EventBus
+	nextID               domain.NextID
+}
+
+func NewAcceptOrder(
+	orderDAO dao.OrderDAO,
+	orderStatusChangeDAO dao.OrderStatusChangeDAO,
+	storeSvc domain.StoreService,
+	eventBus domain.EventBus,
+	nextID domain.NextID,
+) *AcceptOrder {
+	return &AcceptOrder{
+		orderDAO:             orderDAO,
+		orderStatusChangeDAO: orderStatusChangeDAO,
+		storeSvc:             storeSvc,
+		eventBus:             eventBus,
+		nextID:               nextID,
+	}
+}
+
+// Exec accepts an order of a store of the user
+func (s *AcceptOrder) Exec(ctx context.Context, req AcceptOrderReq) error {
+	slog.InfoContext(ctx, "accept order started", "req", req)
+
+	order, err := findStoreOrder(ctx, s.orderDAO, s.storeSvc, req.ID, req.UserID)
+	if err != nil {
+		return err
+	}
+
+	if err := order.Accept(s.nextID(), req.UserID); err != nil {
+		slog.ErrorContext(ctx, "accept order domain failed", "error", err.Error())
+		return err
+	}
+
+	err = s.orderDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := s.orderDAO.Update(ctx, order); err != nil {
+			return err
+		}
+
+		if err := s.orderStatusChangeDAO.CreateMany(ctx, order.PullStatusChanges()); err != nil {
+			return err
+		}
+
+		return s.eventBus.Publish(ctx, order.PullEvents()...)
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "save accepted order failed", "error", err.Error())
+		return err
+	}
+
+	slog.InfoContext(ctx, "accept order finished", "order_id", order.GetID())
+	return nil
+}
@@ -0,0 +1,60 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"ichibuy/order/internal/domain"
+	"ichibuy/order/internal/domain/dao"
+)
+
+type AddCartLineReq struct {
+	UserID    string
+	ProductID string
+	Quantity  int
+	// Currency of the cart, only used when the cart is empty
+	Currency string
+}
+
+type AddCartLine struct {
+	cartDAO    dao.CartDAO
+	productSvc domain.ProductService
+	nextID     domain.NextID
+	ttl        time.Duration
+}
+
+func NewAddCartLine(cartDAO dao.CartDAO, productSvc domain.ProductService, nextID domain.NextID, ttl time.Duration) *AddCartLine {
+	return &AddCartLine{
+		cartDAO:    cartDAO,
+		productSvc: productSvc,
+		nextID:     nextID,
+		ttl:        ttl,
+	}
+}
+
+func (s *AddCartLine) Exec(ctx context.Context, req AddCartLineReq) (*GetCartResp, error) {
+	slog.InfoContext(ctx, "add cart line started", "req", req)
+	cart, err := findOrNewCart(ctx, s.cartDAO, s.nextID, req.UserID, s.ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	product, err := s.productSvc.FindByID(ctx, req.ProductID)
+	if err != nil {
+		slog.ErrorContext(ctx, "find product failed", "error", err.Error())
+		return nil, err
+	}
+
+	if err := cart.AddLine(product, req.Quantity, req.Currency); err != nil {
+		slog.ErrorContext(ctx, "add cart line domain failed", "error", err.Error())
+		return nil, err
+	}
+
+	if err := saveCart(ctx, s.cartDAO, cart, s.ttl); err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "add cart line finished", "cart_id", cart.GetID())
+	return mapCartToResp(cart)
+}
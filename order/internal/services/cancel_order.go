@@ -0,0 +1,99 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+
+	"ichibuy/order/internal/domain"
+	"ichibuy/order/internal/domain/dao"
+)
+
+type CancelOrderReq struct {
+	ID     string
+	Reason *string
+	UserID string
+}
+
+type CancelOrder struct {
+	orderDAO             dao.OrderDAO
+	orderStatusChangeDAO dao.OrderStatusChangeDAO
+	paymentDAO           dao.PaymentDAO
+	customerSvc          domain.CustomerService
+	paymentGateway       domain.PaymentGateway
+	eventBus             domain.EventBus
+	nextID               domain.NextID
+}
+
+func NewCancelOrder(
+	orderDAO dao.OrderDAO,
+	orderStatusChangeDAO dao.OrderStatusChangeDAO,
+	paymentDAO dao.PaymentDAO,
+	customerSvc domain.CustomerService,
+	paymentGateway domain.PaymentGateway,
+	eventBus domain.EventBus,
+	nextID domain.NextID,
+) *CancelOrder {
+	return &CancelOrder{
+		orderDAO:             orderDAO,
+		orderStatusChangeDAO: orderStatusChangeDAO,
+		paymentDAO:           paymentDAO,
+		customerSvc:          customerSvc,
+		paymentGateway:       paymentGateway,
+		eventBus:             eventBus,
+		nextID:               nextID,
+	}
+}
+
+// Exec cancels an order of the customer, refunding its payment when it was already paid
+func (s *CancelOrder) Exec(ctx context.Context, req CancelOrderReq) error {
+	slog.InfoContext(ctx, "cancel order started", "req", req)
+
+	order, err := findCustomerOrder(ctx, s.orderDAO, s.customerSvc, req.ID, req.UserID)
+	if err != nil {
+		return err
+	}
+
+	if err := order.Cancel(s.nextID(), req.UserID, req.Reason); err != nil {
+		slog.ErrorContext(ctx, "cancel order domain failed", "error", err.Error())
+		return err
+	}
+
+	// the refund is recorded with the order and asked to the provider once both are stored
+	payment, err := requestOrderRefund(ctx, s.paymentDAO, order)
+	if err != nil {
+		return err
+	}
+
+	events := order.PullEvents()
+	err = s.orderDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := s.orderDAO.Update(ctx, order); err != nil {
+			return err
+		}
+
+		if err := s.orderStatusChangeDAO.CreateMany(ctx, order.PullStatusChanges()); err != nil {
+			return err
+		}
+
+		if payment != nil {
+			if err := s.paymentDAO.Update(ctx, payment); err != nil {
+				return err
+			}
+		}
+
+		return s.eventBus.Publish(ctx, events...)
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "save canceled order failed", "error", err.Error())
+		return err
+	}
+
+	// a failed refund stays pending and is retried by the worker
+	if payment != nil {
+		if err := refundPayment(ctx, s.paymentDAO, s.paymentGateway, s.eventBus, payment); err != nil {
+			slog.WarnContext(ctx, "refund left pending", "payment_id", payment.GetID(), "error", err.Error())
+		}
+	}
+
+	slog.InfoContext(ctx, "cancel order finished", "order_id", order.GetID())
+	return nil
+}
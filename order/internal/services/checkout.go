@@ -0,0 +1,104 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"ichibuy/order/internal/domain"
+)
+
+type CheckoutReq struct {
+	OrderLines []OrderLineReq
+	// CouponCodes by store ID, optional
+	CouponCodes map[string]string
+	// FulfillmentMethods by store ID, pickup for the stores without one
+	FulfillmentMethods map[string]string
+	// DeliveryAddress shared by the stores delivering their order
+	DeliveryAddress *domain.DeliveryAddress
+	// AddressID of a saved address of the customer, used instead of the delivery address
+	AddressID *string
+	UserID    string
+}
+
+type CheckoutResp struct {
+	ID     string            `json:"id"`
+	Orders []CreateOrderResp `json:"orders"`
+}
+
+// Checkout splits a basket with products of several stores into one order per store.
+// The orders share a checkout ID and are created all together or not at all.
+type Checkout struct {
+	createOrder *CreateOrder
+	nextID      domain.NextID
+}
+
+func NewCheckout(createOrder *CreateOrder, nextID domain.NextID) *Checkout {
+	return &Checkout{
+		createOrder: createOrder,
+		nextID:      nextID,
+	}
+}
+
+func (s *Checkout) Exec(ctx context.Context, req CheckoutReq) (*CheckoutResp, error) {
+	slog.InfoContext(ctx, "checkout started", "req", req)
+
+	orderLines, err := s.createOrder.mapOrderLines(ctx, req.OrderLines)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(orderLines) == 0 {
+		return nil, fmt.Errorf("orderLines cannot be empty")
+	}
+
+	storeIDs, linesByStore := domain.GroupOrderLinesByStore(orderLines)
+
+	for storeID := range req.CouponCodes {
+		if _, ok := linesByStore[storeID]; !ok {
+			return nil, fmt.Errorf("coupon for store %s without order lines", storeID)
+		}
+	}
+
+	for storeID := range req.FulfillmentMethods {
+		if _, ok := linesByStore[storeID]; !ok {
+			return nil, fmt.Errorf("fulfillment method for store %s without order lines", storeID)
+		}
+	}
+
+	checkoutID := s.nextID()
+	placed := make([]*placedOrder, 0, len(storeIDs))
+	for _, storeID := range storeIDs {
+		var couponCode *string
+		if code, ok := req.CouponCodes[storeID]; ok {
+			couponCode = &code
+		}
+
+		var fulfillment *FulfillmentReq
+		if method, ok := req.FulfillmentMethods[storeID]; ok {
+			fulfillment = &FulfillmentReq{Method: method, DeliveryAddress: req.DeliveryAddress, AddressID: req.AddressID}
+		}
+
+		placedOrder, err := s.createOrder.place(ctx, linesByStore[storeID], couponCode, fulfillment, &checkoutID, req.UserID)
+		if err != nil {
+			slog.ErrorContext(ctx, "place store order failed", "store_id", storeID, "error", err.Error())
+			return nil, fmt.Errorf("store %s: %w", storeID, err)
+		}
+		placed = append(placed, placedOrder)
+	}
+
+	if err := s.createOrder.save(ctx, placed...); err != nil {
+		return nil, err
+	}
+
+	orders := make([]CreateOrderResp, len(placed))
+	for i, placedOrder := range placed {
+		orders[i] = *mapPlacedOrderToResp(placedOrder)
+	}
+
+	slog.InfoContext(ctx, "checkout finished", "checkout_id", checkoutID, "orders", len(orders))
+	return &CheckoutResp{
+		ID:     checkoutID,
+		Orders: orders,
+	}, nil
+}
@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"ichibuy/order/internal/domain"
+	"ichibuy/order/internal/domain/dao"
+)
+
+type CheckoutCartReq struct {
+	UserID      string
+	CouponCode  *string
+	Fulfillment *FulfillmentReq
+}
+
+type CheckoutCart struct {
+	cartDAO     dao.CartDAO
+	productSvc  domain.ProductService
+	nextID      domain.NextID
+	ttl         time.Duration
+	createOrder *CreateOrder
+}
+
+func NewCheckoutCart(cartDAO dao.CartDAO, productSvc domain.ProductService, nextID domain.NextID, ttl time.Duration, createOrder *CreateOrder) *CheckoutCart {
+	return &CheckoutCart{
+		cartDAO:     cartDAO,
+		productSvc:  productSvc,
+		nextID:      nextID,
+		ttl:         ttl,
+		createOrder: createOrder,
+	}
+}
+
+// Exec turns the cart into an order at the current product prices and empties the cart
+func (s *CheckoutCart) Exec(ctx context.Context, req CheckoutCartReq) (*CreateOrderResp, error) {
+	slog.InfoContext(ctx, "checkout cart started", "req", req)
+	cart, err := findOrNewCart(ctx, s.cartDAO, s.nextID, req.UserID, s.ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := repriceCart(ctx, s.productSvc, cart); err != nil {
+		return nil, err
+	}
+
+	if err := cart.CheckCheckout(); err != nil {
+		slog.ErrorContext(ctx, "check cart checkout failed", "error", err.Error())
+		return nil, err
+	}
+
+	orderLines := []OrderLineReq{}
+	for _, line := range cart.GetLines() {
+		orderLines = append(orderLines, OrderLineReq{
+			ProductID:         line.ProductID,
+			ProductName:       line.ProductName,
+			ProductStoreID:    *cart.GetStoreID(),
+			Quantity:          line.Quantity,
+			UnitPriceAmount:   line.UnitPrice.GetAmount(),
+			UnitPriceCurrency: line.UnitPrice.GetCurrency(),
+		})
+	}
+
+	resp, err := s.createOrder.Exec(ctx, CreateOrderReq{
+		OrderLines:  orderLines,
+		CouponCode:  req.CouponCode,
+		Fulfillment: req.Fulfillment,
+		UserID:      req.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the order already exists, a cart that cannot be deleted only expires later
+	if err := s.cartDAO.DeleteByPk(ctx, cart.GetID()); err != nil {
+		slog.ErrorContext(ctx, "delete cart failed", "cart_id", cart.GetID(), "error", err.Error())
+	}
+
+	slog.InfoContext(ctx, "checkout cart finished", "cart_id", cart.GetID(), "order_id", resp.ID)
+	return resp, nil
+}
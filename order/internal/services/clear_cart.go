@@ -0,0 +1,44 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+
+	"ichibuy/order/internal/domain/dao"
+)
+
+type ClearCartReq struct {
+	UserID string
+}
+
+type ClearCart struct {
+	cartDAO dao.CartDAO
+}
+
+func NewClearCart(cartDAO dao.CartDAO) *ClearCart {
+	return &ClearCart{
+		cartDAO: cartDAO,
+	}
+}
+
+func (s *ClearCart) Exec(ctx context.Context, req ClearCartReq) error {
+	slog.InfoContext(ctx, "clear cart started", "req", req)
+	carts, err := s.cartDAO.FindAll(ctx, "user_id = $1", "", req.UserID)
+	if err != nil {
+		slog.ErrorContext(ctx, "find carts failed", "error", err.Error())
+		return err
+	}
+
+	ids := make([]string, len(carts))
+	for i, cart := range carts {
+		ids[i] = cart.GetID()
+	}
+
+	if err := s.cartDAO.DeleteManyByPks(ctx, ids); err != nil {
+		slog.ErrorContext(ctx, "delete carts failed", "error", err.Error())
+		return err
+	}
+
+	slog.InfoContext(ctx, "clear cart finished", "user_id", req.UserID)
+	return nil
+}
@@ -0,0 +1,107 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"ichibuy/order/internal/domain"
+	"ichibuy/order/internal/domain/dao"
+)
+
+const storeEventsConsumer = "order.store_events"
+
+// ConsumeStoreEvents keeps the catalog, the local copy of the stores and products of the store service,
+// from its events. Each event is handled in a transaction with its checkpoint, and events older than the
+// last one applied to the store or product are skipped, so events read twice change nothing.
+type ConsumeStoreEvents struct {
+	eventCheckpointDAO dao.EventCheckpointDAO
+	catalogStoreDAO    dao.CatalogStoreDAO
+	catalogProductDAO  dao.CatalogProductDAO
+	storeEventsSvc     domain.EventsService
+}
+
+func NewConsumeStoreEvents(
+	eventCheckpointDAO dao.EventCheckpointDAO,
+	catalogStoreDAO dao.CatalogStoreDAO,
+	catalogProductDAO dao.CatalogProductDAO,
+	storeEventsSvc domain.EventsService,
+) *ConsumeStoreEvents {
+	return &ConsumeStoreEvents{
+		eventCheckpointDAO: eventCheckpointDAO,
+		catalogStoreDAO:    catalogStoreDAO,
+		catalogProductDAO:  catalogProductDAO,
+		storeEventsSvc:     storeEventsSvc,
+	}
+}
+
+func (s *ConsumeStoreEvents) Exec(ctx context.Context) error {
+	handled, err := consumeEvents(ctx, s.eventCheckpointDAO, s.storeEventsSvc.FindEventsAfter, storeEventsConsumer, domain.CatalogEventTypes, s.handle)
+	if handled > 0 {
+		slog.InfoContext(ctx, "consume store events finished", "handled", handled)
+	}
+	return err
+}
+
+// Subscribe keeps the catalog from the store events of the message broker until the context is done,
+// instead of reading the events feed of the store service
+func (s *ConsumeStoreEvents) Subscribe(ctx context.Context, broker domain.MessageBroker) error {
+	slog.InfoContext(ctx, "subscribe store events started", "group", storeEventsConsumer)
+	return broker.Subscribe(ctx, storeEventsConsumer, domain.CatalogEventTypes, s.handle)
+}
+
+func (s *ConsumeStoreEvents) handle(ctx context.Context, event domain.Event) error {
+	if event.SchemaVersion > 1 {
+		return fmt.Errorf("event %s has unsupported schema version %d", event.Type, event.SchemaVersion)
+	}
+
+	switch event.Type {
+	case domain.StoreCreated, domain.StoreUpdated, domain.StoreDeleted, domain.StoreRestored:
+		return s.applyStoreEvent(ctx, event)
+	case domain.ProductCreated, domain.ProductUpdated, domain.ProductDeleted, domain.ProductRestored:
+		return s.applyProductEvent(ctx, event)
+	}
+	return nil
+}
+
+func (s *ConsumeStoreEvents) applyStoreEvent(ctx context.Context, event domain.Event) error {
+	store, err := s.catalogStoreDAO.FindByPk(ctx, event.AggregateID)
+	isNew := errors.Is(err, sql.ErrNoRows)
+	if isNew {
+		store = domain.NewCatalogStore(event.AggregateID)
+	} else if err != nil {
+		return err
+	}
+
+	applied, err := store.Apply(event)
+	if err != nil || !applied {
+		return err
+	}
+
+	if isNew {
+		return s.catalogStoreDAO.Create(ctx, store)
+	}
+	return s.catalogStoreDAO.Update(ctx, store)
+}
+
+func (s *ConsumeStoreEvents) applyProductEvent(ctx context.Context, event domain.Event) error {
+	product, err := s.catalogProductDAO.FindByPk(ctx, event.AggregateID)
+	isNew := errors.Is(err, sql.ErrNoRows)
+	if isNew {
+		product = domain.NewCatalogProduct(event.AggregateID)
+	} else if err != nil {
+		return err
+	}
+
+	applied, err := product.Apply(event)
+	if err != nil || !applied {
+		return err
+	}
+
+	if isNew {
+		return s.catalogProductDAO.Create(ctx, product)
+	}
+	return s.catalogProductDAO.Update(ctx, product)
+}
@@ -0,0 +1,66 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"ichibuy/order/internal/domain"
+	"ichibuy/order/internal/domain/dao"
+)
+
+type CountStoreOpenOrdersReq struct {
+	StoreID string
+	UserID  string
+}
+
+type CountStoreOpenOrdersResp struct {
+	Count int64 `json:"count"`
+}
+
+type CountStoreOpenOrders struct {
+	orderDAO dao.OrderDAO
+	storeSvc domain.StoreService
+}
+
+func NewCountStoreOpenOrders(orderDAO dao.OrderDAO, storeSvc domain.StoreService) *CountStoreOpenOrders {
+	return &CountStoreOpenOrders{
+		orderDAO: orderDAO,
+		storeSvc: storeSvc,
+	}
+}
+
+// Exec counts the orders of the store that are not closed yet. Only the store owner can count them
+func (s *CountStoreOpenOrders) Exec(ctx context.Context, req CountStoreOpenOrdersReq) (*CountStoreOpenOrdersResp, error) {
+	slog.InfoContext(ctx, "count store open orders started", "req", req)
+	store, err := s.storeSvc.FindByID(ctx, req.StoreID)
+	if err != nil {
+		slog.ErrorContext(ctx, "find store failed", "error", err.Error())
+		return nil, err
+	}
+
+	if store.UserID != req.UserID {
+		return nil, fmt.Errorf("user id does not match")
+	}
+
+	placeholders := make([]string, len(domain.OpenOrderStatuses))
+	args := make([]any, 0, len(domain.OpenOrderStatuses)+1)
+	for i, status := range domain.OpenOrderStatuses {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args = append(args, status)
+	}
+
+	args = append(args, req.StoreID)
+
+	where := fmt.Sprintf("current_status IN (%s) AND store_id = $%d", strings.Join(placeholders, ", "), len(args))
+
+	count, err := s.orderDAO.Count(ctx, where, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "count orders failed", "error", err.Error())
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "count store open orders finished", "store_id", req.StoreID, "count", count)
+	return &CountStoreOpenOrdersResp{Count: count}, nil
+}
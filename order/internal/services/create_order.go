@@ -2,15 +2,25 @@ package services
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"fmt"
 	"log/slog"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/lib/pq"
 
 	"ichibuy/order/internal/domain"
 	"ichibuy/order/internal/domain/dao"
 )
 
 type CreateOrderReq struct {
-	OrderLines []OrderLineReq
-	UserID     string
+	OrderLines  []OrderLineReq
+	CouponCode  *string
+	Fulfillment *FulfillmentReq
+	UserID      string
 }
 
 type OrderLineReq struct {
@@ -24,56 +34,510 @@ type OrderLineReq struct {
 
 type CreateOrderResp struct {
 	ID string `json:"id"`
+	// CheckoutID groups the orders created together from a multi-store checkout
+	CheckoutID *string    `json:"checkout_id"`
+	Subtotal   MoneyDTO   `json:"subtotal"`
+	Discount   MoneyDTO   `json:"discount"`
+	TaxRate    TaxRateDTO `json:"tax_rate"`
+	Tax        MoneyDTO   `json:"tax"`
+	Total      MoneyDTO   `json:"total"`
+	// Fulfillment of the order, its delivery fee is part of the total
+	Fulfillment FulfillmentDTO `json:"fulfillment"`
+	// Promotions applied to the order, automatic ones and the coupon
+	Promotions []AppliedPromotionDTO `json:"promotions"`
+}
+
+type AppliedPromotionDTO struct {
+	ID       string   `json:"id"`
+	Code     *string  `json:"code"`
+	Type     string   `json:"type"`
+	Discount MoneyDTO `json:"discount"`
 }
 
 type CreateOrder struct {
-	orderDAO     dao.OrderDAO
-	eventBus     domain.EventBus
-	nextID       domain.NextID
-	orderFactory *domain.OrderFactory
+	orderDAO               dao.OrderDAO
+	orderStatusChangeDAO   dao.OrderStatusChangeDAO
+	storeTaxRateDAO        dao.StoreTaxRateDAO
+	storeFulfillmentDAO    dao.StoreFulfillmentDAO
+	promotionDAO           dao.PromotionDAO
+	promotionRedemptionDAO dao.PromotionRedemptionDAO
+	eventBus               domain.EventBus
+	nextID                 domain.NextID
+	orderFactory           *domain.OrderFactory
+	promotionEngine        *domain.PromotionEngine
+	storeSvc               domain.StoreService
+	productSvc             domain.ProductService
+	storeAvailabilitySvc   domain.StoreAvailabilityService
+	customerSvc            domain.CustomerService
+	customerAddressSvc     domain.CustomerAddressService
 }
 
-func NewCreateOrder(orderDAO dao.OrderDAO, eventBus domain.EventBus, nextID domain.NextID, orderFactory *domain.OrderFactory) *CreateOrder {
+func NewCreateOrder(
+	orderDAO dao.OrderDAO,
+	orderStatusChangeDAO dao.OrderStatusChangeDAO,
+	storeTaxRateDAO dao.StoreTaxRateDAO,
+	storeFulfillmentDAO dao.StoreFulfillmentDAO,
+	promotionDAO dao.PromotionDAO,
+	promotionRedemptionDAO dao.PromotionRedemptionDAO,
+	eventBus domain.EventBus,
+	nextID domain.NextID,
+	orderFactory *domain.OrderFactory,
+	promotionEngine *domain.PromotionEngine,
+	storeSvc domain.StoreService,
+	productSvc domain.ProductService,
+	storeAvailabilitySvc domain.StoreAvailabilityService,
+	customerSvc domain.CustomerService,
+	customerAddressSvc domain.CustomerAddressService,
+) *CreateOrder {
 	return &CreateOrder{
-		orderDAO:     orderDAO,
-		eventBus:     eventBus,
-		nextID:       nextID,
-		orderFactory: orderFactory,
+		orderDAO:               orderDAO,
+		orderStatusChangeDAO:   orderStatusChangeDAO,
+		storeTaxRateDAO:        storeTaxRateDAO,
+		storeFulfillmentDAO:    storeFulfillmentDAO,
+		promotionDAO:           promotionDAO,
+		promotionRedemptionDAO: promotionRedemptionDAO,
+		eventBus:               eventBus,
+		nextID:                 nextID,
+		orderFactory:           orderFactory,
+		promotionEngine:        promotionEngine,
+		storeSvc:               storeSvc,
+		productSvc:             productSvc,
+		storeAvailabilitySvc:   storeAvailabilitySvc,
+		customerSvc:            customerSvc,
+		customerAddressSvc:     customerAddressSvc,
 	}
 }
 
+const maxOrderCodeAttempts = 5
+
+// placedOrder is an order built with the promotions applied to it, ready to be saved. The promotions
+// are redeemed when the order is saved.
+type placedOrder struct {
+	order   *domain.Order
+	applied []domain.AppliedPromotion
+	req     placeOrderReq
+}
+
+// placeOrderReq is what an order is placed from, kept to place it again without an automatic promotion
+// exhausted before the order was saved
+type placeOrderReq struct {
+	orderLines  []domain.OrderLine
+	couponCode  *string
+	fulfillment *FulfillmentReq
+	checkoutID  *string
+	userID      string
+	// excludedPromotions are the automatic promotions not applied to the order
+	excludedPromotions []string
+}
+
+// exhaustedPromotionError is returned when an automatic promotion reaches its limits between the order
+// being placed and saved
+type exhaustedPromotionError struct {
+	promotionID string
+}
+
+func (e *exhaustedPromotionError) Error() string {
+	return fmt.Sprintf("automatic promotion %s usage limit reached", e.promotionID)
+}
+
 func (s *CreateOrder) Exec(ctx context.Context, req CreateOrderReq) (*CreateOrderResp, error) {
 	slog.InfoContext(ctx, "create order started", "req", req)
 
-	orderLines, err := s.mapOrderLines(ctx, req)
+	orderLines, err := s.mapOrderLines(ctx, req.OrderLines)
+	if err != nil {
+		return nil, err
+	}
+
+	placed, err := s.place(ctx, orderLines, req.CouponCode, req.Fulfillment, nil, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.save(ctx, placed); err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "create order finished", "order_id", placed.order.GetID())
+	return mapPlacedOrderToResp(placed), nil
+}
+
+// place builds the order of the lines of a single store, applying its tax rate, promotions and delivery fee
+func (s *CreateOrder) place(
+	ctx context.Context,
+	orderLines []domain.OrderLine,
+	couponCode *string,
+	fulfillmentReq *FulfillmentReq,
+	checkoutID *string,
+	userID string,
+) (*placedOrder, error) {
+	return s.placeOrder(ctx, placeOrderReq{
+		orderLines:  slices.Clone(orderLines),
+		couponCode:  couponCode,
+		fulfillment: fulfillmentReq,
+		checkoutID:  checkoutID,
+		userID:      userID,
+	})
+}
+
+// placeOrder builds the order of the request, the promotions apply their discounts to a copy of its lines
+func (s *CreateOrder) placeOrder(ctx context.Context, req placeOrderReq) (*placedOrder, error) {
+	orderLines := slices.Clone(req.orderLines)
+	couponCode, fulfillmentReq, checkoutID, userID := req.couponCode, req.fulfillment, req.checkoutID, req.userID
+
+	if err := s.checkStoreAcceptsOrders(ctx, orderLines); err != nil {
+		return nil, err
+	}
+
+	taxRate, err := s.findTaxRate(ctx, orderLines)
+	if err != nil {
+		return nil, err
+	}
+
+	fulfillment, err := s.quoteFulfillment(ctx, orderLines, fulfillmentReq, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	automatic, coupon, err := s.findPromotions(ctx, orderLines, couponCode)
+	if err != nil {
+		return nil, err
+	}
+	automatic = slices.DeleteFunc(automatic, func(promotion *domain.Promotion) bool {
+		return slices.Contains(req.excludedPromotions, promotion.GetID())
+	})
+
+	applied, orderDiscount, err := s.promotionEngine.Apply(ctx, orderLines, automatic, coupon, time.Now().UTC())
 	if err != nil {
+		slog.ErrorContext(ctx, "apply promotions failed", "error", err.Error())
 		return nil, err
 	}
 
-	order, err := s.orderFactory.NewOrder(ctx, orderLines, req.UserID)
+	order, err := s.orderFactory.NewOrder(ctx, orderLines, orderDiscount, taxRate, fulfillment, checkoutID, userID)
 	if err != nil {
 		slog.ErrorContext(ctx, "new order failed", "error", err.Error())
 		return nil, err
 	}
 
-	if err := s.orderDAO.Create(ctx, order); err != nil {
-		slog.ErrorContext(ctx, "create customer failed", "error", err.Error())
+	return &placedOrder{order: order, applied: applied, req: req}, nil
+}
+
+// save stores the placed orders, their promotion redemptions and events in a single transaction. When an
+// automatic promotion is exhausted meanwhile, the orders are placed again without it and saved again, so
+// only the coupons that cannot be redeemed fail the orders. When the code of an order is already taken,
+// the orders are placed again with new codes.
+func (s *CreateOrder) save(ctx context.Context, placed ...*placedOrder) error {
+	for codeAttempt := 1; ; {
+		err := s.saveOnce(ctx, placed)
+
+		var exhausted *exhaustedPromotionError
+		switch {
+		case isOrderCodeTaken(err):
+			if codeAttempt == maxOrderCodeAttempts {
+				return fmt.Errorf("could not generate a unique order code")
+			}
+			codeAttempt++
+
+			slog.WarnContext(ctx, "order code taken, placing the orders with new codes")
+			if err := s.replace(ctx, placed, ""); err != nil {
+				return err
+			}
+		case errors.As(err, &exhausted):
+			slog.WarnContext(ctx, "automatic promotion exhausted, placing the orders without it", "promotion_id", exhausted.promotionID)
+			if err := s.replace(ctx, placed, exhausted.promotionID); err != nil {
+				return err
+			}
+		default:
+			return err
+		}
+	}
+}
+
+// replace places the orders again with new codes, without the excluded automatic promotion when given
+func (s *CreateOrder) replace(ctx context.Context, placed []*placedOrder, excludedPromotionID string) error {
+	for _, p := range placed {
+		req := p.req
+		if excludedPromotionID != "" {
+			req.excludedPromotions = append(slices.Clone(req.excludedPromotions), excludedPromotionID)
+		}
+
+		replaced, err := s.placeOrder(ctx, req)
+		if err != nil {
+			return err
+		}
+		*p = *replaced
+	}
+	return nil
+}
+
+// isOrderCodeTaken reports whether the orders were not saved because the code of one of them is taken
+func isOrderCodeTaken(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code.Name() == "unique_violation" && pqErr.Constraint == "orders_code_key"
+}
+
+func (s *CreateOrder) saveOnce(ctx context.Context, placed []*placedOrder) error {
+	orders := []*domain.Order{}
+	statusChanges := []*domain.OrderStatusChange{}
+	events := []domain.Event{}
+	for _, p := range placed {
+		orders = append(orders, p.order)
+		statusChanges = append(statusChanges, p.order.PullStatusChanges()...)
+		events = append(events, p.order.PullEvents()...)
+	}
+
+	return s.orderDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := s.orderDAO.CreateMany(ctx, orders); err != nil {
+			slog.ErrorContext(ctx, "create orders failed", "error", err.Error())
+			return err
+		}
+
+		if err := s.orderStatusChangeDAO.CreateMany(ctx, statusChanges); err != nil {
+			slog.ErrorContext(ctx, "create order status changes failed", "error", err.Error())
+			return err
+		}
+
+		promotionEvents, err := s.redeemPromotions(ctx, placed)
+		if err != nil {
+			return err
+		}
+		events = append(events, promotionEvents...)
+
+		if err := s.eventBus.Publish(ctx, events...); err != nil {
+			slog.ErrorContext(ctx, "publish events failed", "error", err.Error())
+			return err
+		}
+
+		return nil
+	})
+}
+
+// redeemPromotions redeems the promotions applied to the orders. Each promotion row is locked until the
+// transaction ends, so concurrent checkouts redeem it one after the other and its limits hold. Promotions
+// are locked in id order so two checkouts sharing promotions cannot deadlock.
+func (s *CreateOrder) redeemPromotions(ctx context.Context, placed []*placedOrder) ([]domain.Event, error) {
+	type application struct {
+		order   *domain.Order
+		applied domain.AppliedPromotion
+	}
+	applications := []application{}
+	for _, p := range placed {
+		for _, applied := range p.applied {
+			applications = append(applications, application{order: p.order, applied: applied})
+		}
+	}
+	sort.SliceStable(applications, func(i, j int) bool {
+		return applications[i].applied.Promotion.GetID() < applications[j].applied.Promotion.GetID()
+	})
+
+	events := []domain.Event{}
+	for _, a := range applications {
+		promotion, err := s.promotionDAO.FindOne(ctx, "id = $1", "id FOR UPDATE", a.applied.Promotion.GetID())
+		if err != nil {
+			slog.ErrorContext(ctx, "lock promotion failed", "promotion_id", a.applied.Promotion.GetID(), "error", err.Error())
+			return nil, err
+		}
+
+		customerRedemptions, err := s.promotionRedemptionDAO.Count(ctx, "promotion_id = $1 AND customer_id = $2", promotion.GetID(), a.order.GetCustomerID())
+		if err != nil {
+			slog.ErrorContext(ctx, "count promotion redemptions failed", "error", err.Error())
+			return nil, err
+		}
+
+		redemption, err := promotion.Redeem(s.nextID(), a.order, a.applied.Discount, int(customerRedemptions))
+		if errors.Is(err, domain.ErrPromotionLimitReached) && !promotion.IsCoupon() {
+			return nil, &exhaustedPromotionError{promotionID: promotion.GetID()}
+		}
+		if err != nil {
+			slog.ErrorContext(ctx, "redeem promotion failed", "promotion_id", promotion.GetID(), "error", err.Error())
+			return nil, err
+		}
+
+		if err := s.promotionDAO.Update(ctx, promotion); err != nil {
+			slog.ErrorContext(ctx, "update promotion failed", "error", err.Error())
+			return nil, err
+		}
+
+		if err := s.promotionRedemptionDAO.Create(ctx, redemption); err != nil {
+			slog.ErrorContext(ctx, "create promotion redemption failed", "error", err.Error())
+			return nil, err
+		}
+
+		events = append(events, promotion.PullEvents()...)
+	}
+
+	return events, nil
+}
+
+func mapPlacedOrderToResp(placed *placedOrder) *CreateOrderResp {
+	order := placed.order
+	appliedDTOs := make([]AppliedPromotionDTO, len(placed.applied))
+	for i, appliedPromotion := range placed.applied {
+		appliedDTOs[i] = AppliedPromotionDTO{
+			ID:       appliedPromotion.Promotion.GetID(),
+			Code:     appliedPromotion.Promotion.GetCode(),
+			Type:     string(appliedPromotion.Promotion.GetType()),
+			Discount: convertMoneyToDTO(appliedPromotion.Discount),
+		}
+	}
+
+	return &CreateOrderResp{
+		ID:          order.GetID(),
+		CheckoutID:  order.GetCheckoutID(),
+		Subtotal:    convertMoneyToDTO(order.GetSubtotal()),
+		Discount:    convertMoneyToDTO(order.GetDiscount()),
+		TaxRate:     convertTaxRateToDTO(order.GetTaxRate()),
+		Tax:         convertMoneyToDTO(order.GetTax()),
+		Total:       convertMoneyToDTO(order.GetTotal()),
+		Fulfillment: mapOrderFulfillmentToDTO(order),
+		Promotions:  appliedDTOs,
+	}
+}
+
+// findPromotions returns the automatic promotions of the order lines store and the coupon with the given code
+func (s *CreateOrder) findPromotions(ctx context.Context, orderLines []domain.OrderLine, couponCode *string) ([]*domain.Promotion, *domain.Promotion, error) {
+	if len(orderLines) == 0 {
+		return nil, nil, nil
+	}
+	storeID := orderLines[0].ProductStoreID
+
+	automatic, err := s.promotionDAO.FindAll(ctx, "store_id = $1 AND code IS NULL AND active = true", "created_at ASC", storeID)
+	if err != nil {
+		slog.ErrorContext(ctx, "find automatic promotions failed", "error", err.Error())
+		return nil, nil, err
+	}
+
+	if couponCode == nil || *couponCode == "" {
+		return automatic, nil, nil
+	}
+
+	coupon, err := s.promotionDAO.FindOne(ctx, "store_id = $1 AND code = $2", "", storeID, domain.NormalizeCouponCode(*couponCode))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, fmt.Errorf("coupon %s not found", *couponCode)
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "find coupon failed", "error", err.Error())
+		return nil, nil, err
+	}
+
+	return automatic, coupon, nil
+}
+
+// checkStoreAcceptsOrders refuses orders for a closed store unless it allows pre-orders
+func (s *CreateOrder) checkStoreAcceptsOrders(ctx context.Context, orderLines []domain.OrderLine) error {
+	if len(orderLines) == 0 {
+		return nil
+	}
+
+	availability, err := s.storeAvailabilitySvc.FindAvailability(ctx, orderLines[0].ProductStoreID)
+	if err != nil {
+		slog.ErrorContext(ctx, "find store availability failed", "error", err.Error())
+		return err
+	}
+
+	if err := availability.CheckAcceptsOrders(); err != nil {
+		slog.WarnContext(ctx, "store does not accept orders", "store_id", availability.StoreID)
+		return err
+	}
+
+	return nil
+}
+
+// quoteFulfillment checks the store offers the fulfillment method and computes its delivery fee
+func (s *CreateOrder) quoteFulfillment(ctx context.Context, orderLines []domain.OrderLine, req *FulfillmentReq, userID string) (domain.OrderFulfillment, error) {
+	if len(orderLines) == 0 {
+		return domain.PickupFulfillment, nil
+	}
+	storeID := orderLines[0].ProductStoreID
+
+	method := domain.PickupFulfillmentMethod
+	var address *domain.DeliveryAddress
+	if req != nil && req.Method != "" {
+		var err error
+		method, err = domain.ParseFulfillmentMethod(req.Method)
+		if err != nil {
+			return domain.OrderFulfillment{}, err
+		}
+		address = req.DeliveryAddress
+
+		if req.AddressID != nil {
+			address, err = s.findCustomerAddress(ctx, *req.AddressID, userID)
+			if err != nil {
+				return domain.OrderFulfillment{}, err
+			}
+		}
+	}
+
+	options, err := findFulfillmentOptions(ctx, s.storeFulfillmentDAO, storeID)
+	if err != nil {
+		return domain.OrderFulfillment{}, err
+	}
+
+	var storeLocation domain.GeoPoint
+	if method == domain.LocalDeliveryFulfillmentMethod {
+		store, err := s.storeSvc.FindByID(ctx, storeID)
+		if err != nil {
+			slog.ErrorContext(ctx, "find store failed", "error", err.Error())
+			return domain.OrderFulfillment{}, err
+		}
+		storeLocation = store.Location
+	}
+
+	fulfillment, err := options.Quote(method, address, storeLocation, orderLines[0].UnitPrice.GetCurrency())
+	if err != nil {
+		slog.ErrorContext(ctx, "quote fulfillment failed", "error", err.Error())
+		return domain.OrderFulfillment{}, err
+	}
+
+	return fulfillment, nil
+}
+
+// findCustomerAddress snapshots a saved address of the customer, so later changes to the address book
+// do not change the order. Addresses without a recipient are delivered to the customer.
+func (s *CreateOrder) findCustomerAddress(ctx context.Context, addressID, userID string) (*domain.DeliveryAddress, error) {
+	customer, err := s.customerSvc.FindByUserID(ctx, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "find customer by user id failed", "error", err.Error())
 		return nil, err
 	}
 
-	if err := s.eventBus.Publish(ctx, order.PullEvents()...); err != nil {
-		slog.ErrorContext(ctx, "publish events failed", "error", err.Error())
+	address, err := s.customerAddressSvc.FindAddress(ctx, customer.ID, addressID)
+	if err != nil {
+		slog.ErrorContext(ctx, "find customer address failed", "error", err.Error())
 		return nil, err
 	}
 
-	slog.InfoContext(ctx, "create order finished", "order_id", order.GetID())
+	if address.Recipient == "" {
+		address.Recipient = customer.FullName()
+	}
+
+	if address.Phone == nil {
+		address.Phone = customer.Phone
+	}
 
-	return &CreateOrderResp{ID: order.GetID()}, nil
+	return address, nil
 }
 
-func (s *CreateOrder) mapOrderLines(ctx context.Context, req CreateOrderReq) ([]domain.OrderLine, error) {
+// findTaxRate returns the tax rate of the order lines store, stores without one are not taxed
+func (s *CreateOrder) findTaxRate(ctx context.Context, orderLines []domain.OrderLine) (domain.TaxRate, error) {
+	if len(orderLines) == 0 {
+		return domain.NoTaxRate, nil
+	}
+
+	storeTaxRate, err := s.storeTaxRateDAO.FindByPk(ctx, orderLines[0].ProductStoreID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.NoTaxRate, nil
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "find store tax rate failed", "error", err.Error())
+		return domain.NoTaxRate, err
+	}
+
+	return storeTaxRate.GetTaxRate(), nil
+}
+
+func (s *CreateOrder) mapOrderLines(ctx context.Context, orderLineReqs []OrderLineReq) ([]domain.OrderLine, error) {
 	orderLines := []domain.OrderLine{}
-	for _, orderLineReq := range req.OrderLines {
+	for _, orderLineReq := range orderLineReqs {
 		unitPrice, err := domain.NewMoney(orderLineReq.UnitPriceAmount, orderLineReq.UnitPriceCurrency)
 		if err != nil {
 			slog.ErrorContext(ctx, "new money failed", "error", err.Error())
@@ -93,6 +557,16 @@ func (s *CreateOrder) mapOrderLines(ctx context.Context, req CreateOrderReq) ([]
 			return nil, err
 		}
 
+		product, err := s.productSvc.FindByID(ctx, orderLine.ProductID)
+		if err != nil {
+			slog.ErrorContext(ctx, "find product failed", "product_id", orderLine.ProductID, "error", err.Error())
+			return nil, err
+		}
+
+		if err := product.CheckOrderLine(*orderLine); err != nil {
+			return nil, err
+		}
+
 		orderLines = append(orderLines, *orderLine)
 	}
 	return orderLines, nil
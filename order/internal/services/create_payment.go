@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"ichibuy/order/internal/domain"
+	"ichibuy/order/internal/domain/dao"
+)
+
+type CreatePaymentReq struct {
+	OrderID string
+	UserID  string
+}
+
+type CreatePaymentResp struct {
+	ID                string   `json:"id"`
+	OrderID           string   `json:"order_id"`
+	Amount            MoneyDTO `json:"amount"`
+	Status            string   `json:"status"`
+	Provider          string   `json:"provider"`
+	ProviderReference string   `json:"provider_reference"`
+	ClientSecret      string   `json:"client_secret"`
+}
+
+type CreatePayment struct {
+	orderDAO       dao.OrderDAO
+	paymentDAO     dao.PaymentDAO
+	customerSvc    domain.CustomerService
+	paymentGateway domain.PaymentGateway
+	nextID         domain.NextID
+}
+
+func NewCreatePayment(
+	orderDAO dao.OrderDAO,
+	paymentDAO dao.PaymentDAO,
+	customerSvc domain.CustomerService,
+	paymentGateway domain.PaymentGateway,
+	nextID domain.NextID,
+) *CreatePayment {
+	return &CreatePayment{
+		orderDAO:       orderDAO,
+		paymentDAO:     paymentDAO,
+		customerSvc:    customerSvc,
+		paymentGateway: paymentGateway,
+		nextID:         nextID,
+	}
+}
+
+// Exec creates a payment intent for the order total in the payment provider
+func (s *CreatePayment) Exec(ctx context.Context, req CreatePaymentReq) (*CreatePaymentResp, error) {
+	slog.InfoContext(ctx, "create payment started", "req", req)
+
+	order, err := findCustomerOrder(ctx, s.orderDAO, s.customerSvc, req.OrderID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := order.CanBePaid(); err != nil {
+		return nil, err
+	}
+
+	pending, err := s.paymentDAO.Count(ctx, "order_id = $1 AND status IN ($2, $3)", order.GetID(), domain.PendingPaymentStatus, domain.SucceededPaymentStatus)
+	if err != nil {
+		slog.ErrorContext(ctx, "count payments failed", "error", err.Error())
+		return nil, err
+	}
+
+	if pending > 0 {
+		return nil, fmt.Errorf("order already has a pending or succeeded payment")
+	}
+
+	paymentID := s.nextID()
+	intent, err := s.paymentGateway.CreateIntent(ctx, domain.PaymentIntentParams{
+		PaymentID: paymentID,
+		OrderID:   order.GetID(),
+		Amount:    order.GetTotal(),
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "create payment intent failed", "error", err.Error())
+		return nil, err
+	}
+
+	payment, err := domain.NewPayment(paymentID, order, s.paymentGateway.Name(), intent.ProviderReference)
+	if err != nil {
+		slog.ErrorContext(ctx, "new payment failed", "error", err.Error())
+		return nil, err
+	}
+
+	if err := s.paymentDAO.Create(ctx, payment); err != nil {
+		slog.ErrorContext(ctx, "create payment failed", "error", err.Error())
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "create payment finished", "payment_id", payment.GetID())
+	return &CreatePaymentResp{
+		ID:                payment.GetID(),
+		OrderID:           payment.GetOrderID(),
+		Amount:            convertMoneyToDTO(payment.GetAmount()),
+		Status:            string(payment.GetStatus()),
+		Provider:          payment.GetProvider(),
+		ProviderReference: payment.GetProviderReference(),
+		ClientSecret:      intent.ClientSecret,
+	}, nil
+}
@@ -0,0 +1,104 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"ichibuy/order/internal/domain"
+	"ichibuy/order/internal/domain/dao"
+)
+
+type CreatePromotionReq struct {
+	StoreID                   string
+	UserID                    string
+	Code                      *string
+	Type                      string
+	Value                     int
+	Currency                  string
+	MinOrderAmount            int
+	ProductID                 *string
+	BuyQuantity               int
+	GetQuantity               int
+	MaxRedemptions            *int
+	MaxRedemptionsPerCustomer *int
+	StartsAt                  *time.Time
+	EndsAt                    *time.Time
+}
+
+type CreatePromotionResp struct {
+	ID string `json:"id"`
+}
+
+type CreatePromotion struct {
+	promotionDAO dao.PromotionDAO
+	storeSvc     domain.StoreService
+	nextID       domain.NextID
+}
+
+func NewCreatePromotion(promotionDAO dao.PromotionDAO, storeSvc domain.StoreService, nextID domain.NextID) *CreatePromotion {
+	return &CreatePromotion{
+		promotionDAO: promotionDAO,
+		storeSvc:     storeSvc,
+		nextID:       nextID,
+	}
+}
+
+func (s *CreatePromotion) Exec(ctx context.Context, req CreatePromotionReq) (*CreatePromotionResp, error) {
+	slog.InfoContext(ctx, "create promotion started", "req", req)
+	store, err := s.storeSvc.FindByID(ctx, req.StoreID)
+	if err != nil {
+		slog.ErrorContext(ctx, "find store failed", "error", err.Error())
+		return nil, err
+	}
+
+	if store.UserID != req.UserID {
+		return nil, fmt.Errorf("user id does not match")
+	}
+
+	promotionType, err := domain.NewPromotionType(req.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	promotion, err := domain.NewPromotion(s.nextID(), domain.NewPromotionParams{
+		StoreID:                   req.StoreID,
+		Code:                      req.Code,
+		Type:                      promotionType,
+		Value:                     req.Value,
+		Currency:                  req.Currency,
+		MinOrderAmount:            req.MinOrderAmount,
+		ProductID:                 req.ProductID,
+		BuyQuantity:               req.BuyQuantity,
+		GetQuantity:               req.GetQuantity,
+		MaxRedemptions:            req.MaxRedemptions,
+		MaxRedemptionsPerCustomer: req.MaxRedemptionsPerCustomer,
+		StartsAt:                  req.StartsAt,
+		EndsAt:                    req.EndsAt,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "new promotion failed", "error", err.Error())
+		return nil, err
+	}
+
+	if promotion.IsCoupon() {
+		count, err := s.promotionDAO.Count(ctx, "store_id = $1 AND code = $2", req.StoreID, *promotion.GetCode())
+		if err != nil {
+			slog.ErrorContext(ctx, "count promotions failed", "error", err.Error())
+			return nil, err
+		}
+
+		if count > 0 {
+			return nil, fmt.Errorf("coupon code %s already exists", *promotion.GetCode())
+		}
+	}
+
+	if err := s.promotionDAO.Create(ctx, promotion); err != nil {
+		slog.ErrorContext(ctx, "create promotion failed", "error", err.Error())
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "create promotion finished", "promotion_id", promotion.GetID())
+	return &CreatePromotionResp{ID: promotion.GetID()}, nil
+}
@@ -0,0 +1,56 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"ichibuy/order/internal/domain"
+	"ichibuy/order/internal/domain/dao"
+)
+
+type DeactivatePromotionReq struct {
+	ID     string
+	UserID string
+}
+
+type DeactivatePromotion struct {
+	promotionDAO dao.PromotionDAO
+	storeSvc     domain.StoreService
+}
+
+func NewDeactivatePromotion(promotionDAO dao.PromotionDAO, storeSvc domain.StoreService) *DeactivatePromotion {
+	return &DeactivatePromotion{
+		promotionDAO: promotionDAO,
+		storeSvc:     storeSvc,
+	}
+}
+
+func (s *DeactivatePromotion) Exec(ctx context.Context, req DeactivatePromotionReq) error {
+	slog.InfoContext(ctx, "deactivate promotion started", "req", req)
+	promotion, err := s.promotionDAO.FindByPk(ctx, req.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "find promotion failed", "error", err.Error())
+		return err
+	}
+
+	store, err := s.storeSvc.FindByID(ctx, promotion.GetStoreID())
+	if err != nil {
+		slog.ErrorContext(ctx, "find store failed", "error", err.Error())
+		return err
+	}
+
+	if store.UserID != req.UserID {
+		return fmt.Errorf("user id does not match")
+	}
+
+	promotion.Deactivate()
+
+	if err := s.promotionDAO.Update(ctx, promotion); err != nil {
+		slog.ErrorContext(ctx, "update promotion failed", "error", err.Error())
+		return err
+	}
+
+	slog.InfoContext(ctx, "deactivate promotion finished", "promotion_id", promotion.GetID())
+	return nil
+}
@@ -0,0 +1,116 @@
+package services
+
+import (
+	"time"
+
+	"ichibuy/order/internal/domain"
+)
+
+// Request pagination filters
+
+type Pagination struct {
+	Limit  int
+	Offset int
+}
+
+type MoneyDTO struct {
+	Amount   int    `json:"amount"` // minor units
+	Currency string `json:"currency"`
+}
+
+type TaxRateDTO struct {
+	Name      string `json:"name"`
+	Rate      int    `json:"rate"` // basis points
+	Inclusive bool   `json:"inclusive"`
+}
+
+func convertMoneyToDTO(money domain.Money) MoneyDTO {
+	return MoneyDTO{Amount: money.GetAmount(), Currency: money.GetCurrency()}
+}
+
+func convertTaxRateToDTO(taxRate domain.TaxRate) TaxRateDTO {
+	return TaxRateDTO{Name: taxRate.Name, Rate: taxRate.Rate, Inclusive: taxRate.Inclusive}
+}
+
+type OrderDTO struct {
+	ID            string         `json:"id"`
+	Code          string         `json:"code"`
+	CurrentStatus string         `json:"current_status"`
+	StoreID       string         `json:"store_id"`
+	CustomerID    string         `json:"customer_id"`
+	CheckoutID    *string        `json:"checkout_id"`
+	OrderLines    []OrderLineDTO `json:"order_lines"`
+	Subtotal      MoneyDTO       `json:"subtotal"`
+	Discount      MoneyDTO       `json:"discount"`
+	TaxRate       TaxRateDTO     `json:"tax_rate"`
+	Tax           MoneyDTO       `json:"tax"`
+	Total         MoneyDTO       `json:"total"`
+	Fulfillment   FulfillmentDTO `json:"fulfillment"`
+	CreatedAt     time.Time      `json:"created_at"`
+	UpdatedAt     time.Time      `json:"updated_at"`
+}
+
+type OrderLineDTO struct {
+	ProductID   string   `json:"product_id"`
+	ProductName string   `json:"product_name"`
+	Quantity    int      `json:"quantity"`
+	UnitPrice   MoneyDTO `json:"unit_price"`
+	Discount    MoneyDTO `json:"discount"`
+	Total       MoneyDTO `json:"total"`
+}
+
+func mapOrderToDTO(order *domain.Order) OrderDTO {
+	orderLines := []OrderLineDTO{}
+	for _, orderLine := range order.GetOrderLines() {
+		orderLines = append(orderLines, OrderLineDTO{
+			ProductID:   orderLine.ProductID,
+			ProductName: orderLine.ProductName,
+			Quantity:    orderLine.Quantity,
+			UnitPrice:   convertMoneyToDTO(orderLine.UnitPrice),
+			Discount:    convertMoneyToDTO(orderLine.Discount),
+			Total:       convertMoneyToDTO(orderLine.Total),
+		})
+	}
+
+	return OrderDTO{
+		ID:            order.GetID(),
+		Code:          string(order.GetCode()),
+		CurrentStatus: string(order.GetCurrentStatus()),
+		StoreID:       order.GetStoreID(),
+		CustomerID:    order.GetCustomerID(),
+		CheckoutID:    order.GetCheckoutID(),
+		OrderLines:    orderLines,
+		Subtotal:      convertMoneyToDTO(order.GetSubtotal()),
+		Discount:      convertMoneyToDTO(order.GetDiscount()),
+		TaxRate:       convertTaxRateToDTO(order.GetTaxRate()),
+		Tax:           convertMoneyToDTO(order.GetTax()),
+		Total:         convertMoneyToDTO(order.GetTotal()),
+		Fulfillment:   mapOrderFulfillmentToDTO(order),
+		CreatedAt:     order.GetCreatedAt(),
+		UpdatedAt:     order.UpdatedAt,
+	}
+}
+
+// FulfillmentReq is how the customer wants to receive an order, pickup when empty
+type FulfillmentReq struct {
+	Method          string
+	DeliveryAddress *domain.DeliveryAddress
+	// AddressID of a saved address of the customer, it is copied into the order instead of DeliveryAddress
+	AddressID *string
+}
+
+type FulfillmentDTO struct {
+	Method          string                  `json:"method"`
+	Status          string                  `json:"status"`
+	DeliveryAddress *domain.DeliveryAddress `json:"delivery_address"`
+	DeliveryFee     MoneyDTO                `json:"delivery_fee"`
+}
+
+func mapOrderFulfillmentToDTO(order *domain.Order) FulfillmentDTO {
+	return FulfillmentDTO{
+		Method:          string(order.GetFulfillmentMethod()),
+		Status:          string(order.GetFulfillmentStatus()),
+		DeliveryAddress: order.GetDeliveryAddress(),
+		DeliveryFee:     convertMoneyToDTO(order.GetDeliveryFee()),
+	}
+}
@@ -0,0 +1,38 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+
+	"ichibuy/order/internal/domain"
+	"ichibuy/order/internal/domain/dao"
+)
+
+const outboxConsumer = "order.outbox"
+
+// ForwardEvents publishes the events table, the outbox of the order service, to the message broker in
+// order. The checkpoint moves once the broker took the event, so an event may be published twice if the
+// job stops in between but is never lost.
+type ForwardEvents struct {
+	eventCheckpointDAO dao.EventCheckpointDAO
+	eventDAO           dao.EventDAO
+	broker             domain.EventBus
+}
+
+func NewForwardEvents(eventCheckpointDAO dao.EventCheckpointDAO, eventDAO dao.EventDAO, broker domain.EventBus) *ForwardEvents {
+	return &ForwardEvents{
+		eventCheckpointDAO: eventCheckpointDAO,
+		eventDAO:           eventDAO,
+		broker:             broker,
+	}
+}
+
+func (s *ForwardEvents) Exec(ctx context.Context) error {
+	handled, err := consumeEvents(ctx, s.eventCheckpointDAO, localEvents(s.eventDAO), outboxConsumer, nil, func(ctx context.Context, event domain.Event) error {
+		return s.broker.Publish(ctx, event)
+	})
+	if handled > 0 {
+		slog.InfoContext(ctx, "forward events finished", "forwarded", handled)
+	}
+	return err
+}
@@ -0,0 +1,150 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"ichibuy/order/internal/domain"
+	"ichibuy/order/internal/domain/dao"
+)
+
+type GetCartReq struct {
+	UserID string
+}
+
+type CartLineDTO struct {
+	ProductID   string   `json:"product_id"`
+	ProductName string   `json:"product_name"`
+	Quantity    int      `json:"quantity"`
+	UnitPrice   MoneyDTO `json:"unit_price"`
+	Available   bool     `json:"available"`
+}
+
+type GetCartResp struct {
+	ID        string        `json:"id"`
+	StoreID   *string       `json:"store_id"`
+	Currency  string        `json:"currency"`
+	Lines     []CartLineDTO `json:"lines"`
+	Total     MoneyDTO      `json:"total"`
+	ExpiresAt time.Time     `json:"expires_at"`
+}
+
+type GetCart struct {
+	cartDAO    dao.CartDAO
+	productSvc domain.ProductService
+	nextID     domain.NextID
+	ttl        time.Duration
+}
+
+func NewGetCart(cartDAO dao.CartDAO, productSvc domain.ProductService, nextID domain.NextID, ttl time.Duration) *GetCart {
+	return &GetCart{
+		cartDAO:    cartDAO,
+		productSvc: productSvc,
+		nextID:     nextID,
+		ttl:        ttl,
+	}
+}
+
+// Exec returns the cart of the user repriced with the current product prices
+func (s *GetCart) Exec(ctx context.Context, req GetCartReq) (*GetCartResp, error) {
+	slog.InfoContext(ctx, "get cart started", "req", req)
+	cart, err := findOrNewCart(ctx, s.cartDAO, s.nextID, req.UserID, s.ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := repriceCart(ctx, s.productSvc, cart); err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "get cart finished", "cart_id", cart.GetID())
+	return mapCartToResp(cart)
+}
+
+// findOrNewCart returns the cart of the user, an expired cart is returned empty
+func findOrNewCart(ctx context.Context, cartDAO dao.CartDAO, nextID domain.NextID, userID string, ttl time.Duration) (*domain.Cart, error) {
+	cart, err := cartDAO.FindOne(ctx, "user_id = $1", "", userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.NewCart(nextID(), userID, ttl)
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "find cart failed", "error", err.Error())
+		return nil, err
+	}
+
+	if cart.IsExpired(time.Now().UTC()) {
+		if err := cart.Clear(); err != nil {
+			return nil, err
+		}
+	}
+
+	return cart, nil
+}
+
+// saveCart creates or updates the cart, extending its expiration
+func saveCart(ctx context.Context, cartDAO dao.CartDAO, cart *domain.Cart, ttl time.Duration) error {
+	cart.Touch(ttl)
+
+	count, err := cartDAO.Count(ctx, "id = $1", cart.GetID())
+	if err != nil {
+		slog.ErrorContext(ctx, "count carts failed", "error", err.Error())
+		return err
+	}
+
+	if count == 0 {
+		err = cartDAO.Create(ctx, cart)
+	} else {
+		err = cartDAO.Update(ctx, cart)
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "save cart failed", "error", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// repriceCart refreshes the cart lines with the current products of the store service
+func repriceCart(ctx context.Context, productSvc domain.ProductService, cart *domain.Cart) error {
+	products := map[string]*domain.ProductDTO{}
+	for _, line := range cart.GetLines() {
+		product, err := productSvc.FindByID(ctx, line.ProductID)
+		if err != nil {
+			slog.WarnContext(ctx, "find product failed", "product_id", line.ProductID, "error", err.Error())
+			continue
+		}
+		products[line.ProductID] = product
+	}
+
+	return cart.Reprice(products)
+}
+
+func mapCartToResp(cart *domain.Cart) (*GetCartResp, error) {
+	total, err := cart.Total()
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]CartLineDTO, 0)
+	for _, line := range cart.GetLines() {
+		lines = append(lines, CartLineDTO{
+			ProductID:   line.ProductID,
+			ProductName: line.ProductName,
+			Quantity:    line.Quantity,
+			UnitPrice:   convertMoneyToDTO(line.UnitPrice),
+			Available:   line.Available,
+		})
+	}
+
+	return &GetCartResp{
+		ID:        cart.GetID(),
+		StoreID:   cart.GetStoreID(),
+		Currency:  cart.GetCurrency(),
+		Lines:     lines,
+		Total:     convertMoneyToDTO(total),
+		ExpiresAt: cart.GetExpiresAt(),
+	}, nil
+}
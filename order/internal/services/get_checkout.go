@@ -0,0 +1,89 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"ichibuy/order/internal/domain"
+	"ichibuy/order/internal/domain/dao"
+)
+
+type GetCheckoutReq struct {
+	ID     string
+	UserID string
+}
+
+type CheckoutOrderDTO struct {
+	ID            string     `json:"id"`
+	Code          string     `json:"code"`
+	CurrentStatus string     `json:"current_status"`
+	StoreID       string     `json:"store_id"`
+	Subtotal      MoneyDTO   `json:"subtotal"`
+	Discount      MoneyDTO   `json:"discount"`
+	TaxRate       TaxRateDTO `json:"tax_rate"`
+	Tax           MoneyDTO   `json:"tax"`
+	Total         MoneyDTO   `json:"total"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+type GetCheckoutResp struct {
+	ID     string             `json:"id"`
+	Orders []CheckoutOrderDTO `json:"orders"`
+}
+
+type GetCheckout struct {
+	orderDAO    dao.OrderDAO
+	customerSvc domain.CustomerService
+}
+
+func NewGetCheckout(orderDAO dao.OrderDAO, customerSvc domain.CustomerService) *GetCheckout {
+	return &GetCheckout{
+		orderDAO:    orderDAO,
+		customerSvc: customerSvc,
+	}
+}
+
+// Exec returns the orders of a checkout, only the customer who placed it can see them
+func (s *GetCheckout) Exec(ctx context.Context, req GetCheckoutReq) (*GetCheckoutResp, error) {
+	slog.InfoContext(ctx, "get checkout started", "req", req)
+
+	customer, err := s.customerSvc.FindByUserID(ctx, req.UserID)
+	if err != nil {
+		slog.ErrorContext(ctx, "find customer by user id failed", "error", err.Error())
+		return nil, err
+	}
+
+	orders, err := s.orderDAO.FindAll(ctx, "checkout_id = $1 AND customer_id = $2", "created_at ASC", req.ID, customer.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "find checkout orders failed", "error", err.Error())
+		return nil, err
+	}
+
+	if len(orders) == 0 {
+		return nil, fmt.Errorf("checkout %s not found", req.ID)
+	}
+
+	dtos := make([]CheckoutOrderDTO, len(orders))
+	for i, order := range orders {
+		dtos[i] = CheckoutOrderDTO{
+			ID:            order.GetID(),
+			Code:          string(order.GetCode()),
+			CurrentStatus: string(order.GetCurrentStatus()),
+			StoreID:       order.GetStoreID(),
+			Subtotal:      convertMoneyToDTO(order.GetSubtotal()),
+			Discount:      convertMoneyToDTO(order.GetDiscount()),
+			TaxRate:       convertTaxRateToDTO(order.GetTaxRate()),
+			Tax:           convertMoneyToDTO(order.GetTax()),
+			Total:         convertMoneyToDTO(order.GetTotal()),
+			CreatedAt:     order.GetCreatedAt(),
+		}
+	}
+
+	slog.InfoContext(ctx, "get checkout finished", "checkout_id", req.ID, "orders", len(dtos))
+	return &GetCheckoutResp{
+		ID:     req.ID,
+		Orders: dtos,
+	}, nil
+}
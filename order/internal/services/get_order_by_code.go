@@ -0,0 +1,65 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"ichibuy/order/internal/domain"
+	"ichibuy/order/internal/domain/dao"
+)
+
+type GetOrderByCodeReq struct {
+	Code   string
+	UserID string
+}
+
+type GetOrderByCode struct {
+	orderDAO    dao.OrderDAO
+	customerSvc domain.CustomerService
+	storeSvc    domain.StoreService
+}
+
+func NewGetOrderByCode(orderDAO dao.OrderDAO, customerSvc domain.CustomerService, storeSvc domain.StoreService) *GetOrderByCode {
+	return &GetOrderByCode{
+		orderDAO:    orderDAO,
+		customerSvc: customerSvc,
+		storeSvc:    storeSvc,
+	}
+}
+
+// Exec returns the order with the code, visible to the customer who placed it and the store owner
+func (s *GetOrderByCode) Exec(ctx context.Context, req GetOrderByCodeReq) (*OrderDTO, error) {
+	slog.InfoContext(ctx, "get order by code started", "req", req)
+
+	// orders created before the current codes keep their original code, which does not have the length and
+	// characters of the current codes
+	code := domain.OrderCode(req.Code)
+	parsed, err := domain.ParseOrderCode(req.Code)
+	if errors.Is(err, domain.ErrOrderCodeMistyped) {
+		return nil, err
+	}
+	if err == nil {
+		code = parsed
+	}
+
+	order, err := s.orderDAO.FindOne(ctx, "code = $1", "", code)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("order %s not found", req.Code)
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "find order by code failed", "error", err.Error())
+		return nil, err
+	}
+
+	if !canViewOrder(ctx, s.customerSvc, s.storeSvc, order, req.UserID) {
+		return nil, fmt.Errorf("order %s not found", req.Code)
+	}
+
+	resp := mapOrderToDTO(order)
+
+	slog.InfoContext(ctx, "get order by code finished", "order_id", order.GetID())
+	return &resp, nil
+}
@@ -0,0 +1,101 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"ichibuy/order/internal/domain"
+	"ichibuy/order/internal/domain/dao"
+)
+
+type GetOrderTimelineReq struct {
+	ID     string
+	UserID string
+}
+
+type OrderStatusChangeDTO struct {
+	FromStatus  *string   `json:"from_status"`
+	ToStatus    string    `json:"to_status"`
+	ActorUserID *string   `json:"actor_user_id"`
+	Reason      *string   `json:"reason"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type GetOrderTimelineResp struct {
+	OrderID       string                 `json:"order_id"`
+	CurrentStatus string                 `json:"current_status"`
+	Timeline      []OrderStatusChangeDTO `json:"timeline"`
+}
+
+type GetOrderTimeline struct {
+	orderDAO             dao.OrderDAO
+	orderStatusChangeDAO dao.OrderStatusChangeDAO
+	customerSvc          domain.CustomerService
+	storeSvc             domain.StoreService
+}
+
+func NewGetOrderTimeline(
+	orderDAO dao.OrderDAO,
+	orderStatusChangeDAO dao.OrderStatusChangeDAO,
+	customerSvc domain.CustomerService,
+	storeSvc domain.StoreService,
+) *GetOrderTimeline {
+	return &GetOrderTimeline{
+		orderDAO:             orderDAO,
+		orderStatusChangeDAO: orderStatusChangeDAO,
+		customerSvc:          customerSvc,
+		storeSvc:             storeSvc,
+	}
+}
+
+// Exec returns the status history of the order, visible to the customer who placed it and the store owner
+func (s *GetOrderTimeline) Exec(ctx context.Context, req GetOrderTimelineReq) (*GetOrderTimelineResp, error) {
+	slog.InfoContext(ctx, "get order timeline started", "req", req)
+
+	order, err := s.orderDAO.FindByPk(ctx, req.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("order %s not found", req.ID)
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "find order failed", "error", err.Error())
+		return nil, err
+	}
+
+	if !canViewOrder(ctx, s.customerSvc, s.storeSvc, order, req.UserID) {
+		return nil, fmt.Errorf("order %s not found", req.ID)
+	}
+
+	changes, err := s.orderStatusChangeDAO.FindAll(ctx, "order_id = $1", "created_at ASC", order.GetID())
+	if err != nil {
+		slog.ErrorContext(ctx, "find order status changes failed", "error", err.Error())
+		return nil, err
+	}
+
+	timeline := make([]OrderStatusChangeDTO, len(changes))
+	for i, change := range changes {
+		var fromStatus *string
+		if change.GetFromStatus() != nil {
+			status := string(*change.GetFromStatus())
+			fromStatus = &status
+		}
+
+		timeline[i] = OrderStatusChangeDTO{
+			FromStatus:  fromStatus,
+			ToStatus:    string(change.GetToStatus()),
+			ActorUserID: change.GetActorUserID(),
+			Reason:      change.GetReason(),
+			CreatedAt:   change.GetCreatedAt(),
+		}
+	}
+
+	slog.InfoContext(ctx, "get order timeline finished", "order_id", order.GetID(), "changes", len(timeline))
+	return &GetOrderTimelineResp{
+		OrderID:       order.GetID(),
+		CurrentStatus: string(order.GetCurrentStatus()),
+		Timeline:      timeline,
+	}, nil
+}
@@ -0,0 +1,99 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"ichibuy/order/internal/domain"
+	"ichibuy/order/internal/domain/dao"
+)
+
+type GetStoreFulfillmentReq struct {
+	StoreID string
+}
+
+type GetStoreFulfillmentResp struct {
+	StoreID       string            `json:"store_id"`
+	Methods       []string          `json:"methods"`
+	DeliveryZones []DeliveryZoneDTO `json:"delivery_zones"`
+	ShippingFee   *MoneyDTO         `json:"shipping_fee"`
+}
+
+type DeliveryZoneDTO struct {
+	Name string `json:"name"`
+	// Type is radius or polygon
+	Type     string            `json:"type"`
+	RadiusKm float64           `json:"radius_km,omitempty"`
+	Polygon  []domain.GeoPoint `json:"polygon,omitempty"`
+	BaseFee  MoneyDTO          `json:"base_fee"`
+	FeePerKm MoneyDTO          `json:"fee_per_km"`
+}
+
+type GetStoreFulfillment struct {
+	storeFulfillmentDAO dao.StoreFulfillmentDAO
+}
+
+func NewGetStoreFulfillment(storeFulfillmentDAO dao.StoreFulfillmentDAO) *GetStoreFulfillment {
+	return &GetStoreFulfillment{
+		storeFulfillmentDAO: storeFulfillmentDAO,
+	}
+}
+
+func (s *GetStoreFulfillment) Exec(ctx context.Context, req GetStoreFulfillmentReq) (*GetStoreFulfillmentResp, error) {
+	slog.InfoContext(ctx, "get store fulfillment started", "req", req)
+	options, err := findFulfillmentOptions(ctx, s.storeFulfillmentDAO, req.StoreID)
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "get store fulfillment finished", "store_id", req.StoreID)
+	return mapFulfillmentOptionsToResp(req.StoreID, options), nil
+}
+
+// findFulfillmentOptions returns the fulfillment options of the store, pickup only when it has none
+func findFulfillmentOptions(ctx context.Context, storeFulfillmentDAO dao.StoreFulfillmentDAO, storeID string) (domain.FulfillmentOptions, error) {
+	storeFulfillment, err := storeFulfillmentDAO.FindByPk(ctx, storeID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.DefaultFulfillmentOptions, nil
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "find store fulfillment failed", "error", err.Error())
+		return domain.FulfillmentOptions{}, err
+	}
+
+	return storeFulfillment.GetOptions(), nil
+}
+
+func mapFulfillmentOptionsToResp(storeID string, options domain.FulfillmentOptions) *GetStoreFulfillmentResp {
+	methods := make([]string, len(options.Methods))
+	for i, method := range options.Methods {
+		methods[i] = string(method)
+	}
+
+	zones := make([]DeliveryZoneDTO, len(options.DeliveryZones))
+	for i, zone := range options.DeliveryZones {
+		zones[i] = DeliveryZoneDTO{
+			Name:     zone.Name,
+			Type:     string(zone.Type),
+			RadiusKm: zone.RadiusKm,
+			Polygon:  zone.Polygon,
+			BaseFee:  convertMoneyToDTO(zone.BaseFee),
+			FeePerKm: convertMoneyToDTO(zone.FeePerKm),
+		}
+	}
+
+	var shippingFee *MoneyDTO
+	if options.ShippingFee != nil {
+		fee := convertMoneyToDTO(*options.ShippingFee)
+		shippingFee = &fee
+	}
+
+	return &GetStoreFulfillmentResp{
+		StoreID:       storeID,
+		Methods:       methods,
+		DeliveryZones: zones,
+		ShippingFee:   shippingFee,
+	}
+}
@@ -0,0 +1,50 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"ichibuy/order/internal/domain"
+	"ichibuy/order/internal/domain/dao"
+)
+
+type GetStoreTaxRateReq struct {
+	StoreID string
+}
+
+type GetStoreTaxRateResp struct {
+	StoreID   string     `json:"store_id"`
+	TaxRate   TaxRateDTO `json:"tax_rate"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+type GetStoreTaxRate struct {
+	storeTaxRateDAO dao.StoreTaxRateDAO
+}
+
+func NewGetStoreTaxRate(storeTaxRateDAO dao.StoreTaxRateDAO) *GetStoreTaxRate {
+	return &GetStoreTaxRate{
+		storeTaxRateDAO: storeTaxRateDAO,
+	}
+}
+
+func (s *GetStoreTaxRate) Exec(ctx context.Context, req GetStoreTaxRateReq) (*GetStoreTaxRateResp, error) {
+	slog.InfoContext(ctx, "get store tax rate started", "req", req)
+	storeTaxRate, err := s.storeTaxRateDAO.FindByPk(ctx, req.StoreID)
+	if err != nil {
+		slog.ErrorContext(ctx, "find store tax rate failed", "error", err.Error())
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "get store tax rate finished", "store_id", req.StoreID)
+	return mapStoreTaxRateToResp(storeTaxRate), nil
+}
+
+func mapStoreTaxRateToResp(storeTaxRate *domain.StoreTaxRate) *GetStoreTaxRateResp {
+	return &GetStoreTaxRateResp{
+		StoreID:   storeTaxRate.GetStoreID(),
+		TaxRate:   convertTaxRateToDTO(storeTaxRate.GetTaxRate()),
+		UpdatedAt: storeTaxRate.GetUpdatedAt(),
+	}
+}
@@ -0,0 +1,151 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"ichibuy/order/internal/domain"
+	"ichibuy/order/internal/domain/dao"
+)
+
+type HandlePaymentWebhookReq struct {
+	Payload   []byte
+	Signature string
+}
+
+type HandlePaymentWebhook struct {
+	orderDAO             dao.OrderDAO
+	orderStatusChangeDAO dao.OrderStatusChangeDAO
+	paymentDAO           dao.PaymentDAO
+	paymentGateway       domain.PaymentGateway
+	eventBus             domain.EventBus
+	nextID               domain.NextID
+}
+
+func NewHandlePaymentWebhook(
+	orderDAO dao.OrderDAO,
+	orderStatusChangeDAO dao.OrderStatusChangeDAO,
+	paymentDAO dao.PaymentDAO,
+	paymentGateway domain.PaymentGateway,
+	eventBus domain.EventBus,
+	nextID domain.NextID,
+) *HandlePaymentWebhook {
+	return &HandlePaymentWebhook{
+		orderDAO:             orderDAO,
+		orderStatusChangeDAO: orderStatusChangeDAO,
+		paymentDAO:           paymentDAO,
+		paymentGateway:       paymentGateway,
+		eventBus:             eventBus,
+		nextID:               nextID,
+	}
+}
+
+// Exec confirms or fails the payment notified by the provider and moves its order to paid or payment_failed.
+// Providers retry webhooks, so notifications of an already settled payment are ignored.
+func (s *HandlePaymentWebhook) Exec(ctx context.Context, req HandlePaymentWebhookReq) error {
+	webhook, err := s.paymentGateway.ParseWebhook(req.Payload, req.Signature)
+	if err != nil {
+		slog.ErrorContext(ctx, "parse payment webhook failed", "error", err.Error())
+		return err
+	}
+	slog.InfoContext(ctx, "handle payment webhook started", "webhook", webhook)
+
+	payment, err := s.paymentDAO.FindOne(ctx, "provider = $1 AND provider_reference = $2", "", s.paymentGateway.Name(), webhook.ProviderReference)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("payment %s not found", webhook.ProviderReference)
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "find payment failed", "error", err.Error())
+		return err
+	}
+
+	if payment.GetStatus() == webhook.Status || payment.GetStatus() == domain.RefundPendingPaymentStatus || payment.GetStatus() == domain.RefundedPaymentStatus {
+		slog.InfoContext(ctx, "payment webhook already handled", "payment_id", payment.GetID())
+		return nil
+	}
+
+	order, err := s.orderDAO.FindByPk(ctx, payment.GetOrderID())
+	if err != nil {
+		slog.ErrorContext(ctx, "find order failed", "error", err.Error())
+		return err
+	}
+
+	switch webhook.Status {
+	case domain.SucceededPaymentStatus:
+		err = s.succeed(ctx, payment, order)
+	case domain.FailedPaymentStatus:
+		err = s.fail(ctx, payment, order, webhook.FailureReason)
+	default:
+		err = fmt.Errorf("invalid payment status %s", webhook.Status)
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "update payment failed", "payment_id", payment.GetID(), "error", err.Error())
+		return err
+	}
+
+	events := append(order.PullEvents(), payment.PullEvents()...)
+	err = s.orderDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := s.paymentDAO.Update(ctx, payment); err != nil {
+			return err
+		}
+
+		if err := s.orderDAO.Update(ctx, order); err != nil {
+			return err
+		}
+
+		if err := s.orderStatusChangeDAO.CreateMany(ctx, order.PullStatusChanges()); err != nil {
+			return err
+		}
+
+		return s.eventBus.Publish(ctx, events...)
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "save payment failed", "error", err.Error())
+		return err
+	}
+
+	// a failed refund stays pending and is retried by the worker
+	if payment.GetStatus() == domain.RefundPendingPaymentStatus {
+		if err := refundPayment(ctx, s.paymentDAO, s.paymentGateway, s.eventBus, payment); err != nil {
+			slog.WarnContext(ctx, "refund left pending", "payment_id", payment.GetID(), "error", err.Error())
+		}
+	}
+
+	slog.InfoContext(ctx, "handle payment webhook finished", "payment_id", payment.GetID(), "status", payment.GetStatus())
+	return nil
+}
+
+// succeed confirms the payment, its refund is recorded when the order was canceled or rejected in the meantime
+func (s *HandlePaymentWebhook) succeed(ctx context.Context, payment *domain.Payment, order *domain.Order) error {
+	if err := payment.Succeed(); err != nil {
+		return err
+	}
+
+	if isOrderClosedBeforePayment(order) {
+		return payment.RequestRefund()
+	}
+
+	return order.MarkPaid(s.nextID())
+}
+
+func (s *HandlePaymentWebhook) fail(ctx context.Context, payment *domain.Payment, order *domain.Order, reason string) error {
+	if err := payment.Fail(reason); err != nil {
+		return err
+	}
+
+	if isOrderClosedBeforePayment(order) {
+		return nil
+	}
+
+	return order.MarkPaymentFailed(s.nextID(), reason)
+}
+
+// isOrderClosedBeforePayment reports whether the order was canceled by the customer or rejected by the store
+// before its payment settled, so the payment no longer moves the order
+func isOrderClosedBeforePayment(order *domain.Order) bool {
+	status := order.GetCurrentStatus()
+	return status == domain.CanceledOrderStatus || status == domain.RejectedOrderStatus
+}
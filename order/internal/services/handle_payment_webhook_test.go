@@ -0,0 +1,126 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"ichibuy/order/internal/domain"
+	"ichibuy/order/internal/domain/dao"
+)
+
+type fakeWebhookOrderDAO struct {
+	dao.OrderDAO
+	order *domain.Order
+}
+
+func (d *fakeWebhookOrderDAO) FindByPk(ctx context.Context, pk string) (*domain.Order, error) {
+	return d.order, nil
+}
+
+func (d *fakeWebhookOrderDAO) Update(ctx context.Context, m *domain.Order) error {
+	d.order = m
+	return nil
+}
+
+func (d *fakeWebhookOrderDAO) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type fakeWebhookOrderStatusChangeDAO struct {
+	dao.OrderStatusChangeDAO
+}
+
+func (d *fakeWebhookOrderStatusChangeDAO) CreateMany(ctx context.Context, models []*domain.OrderStatusChange) error {
+	return nil
+}
+
+type fakeWebhookPaymentDAO struct {
+	dao.PaymentDAO
+	payment *domain.Payment
+}
+
+func (d *fakeWebhookPaymentDAO) FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*domain.Payment, error) {
+	return d.payment, nil
+}
+
+func (d *fakeWebhookPaymentDAO) Update(ctx context.Context, m *domain.Payment) error {
+	d.payment = m
+	return nil
+}
+
+func (d *fakeWebhookPaymentDAO) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type fakeWebhookPaymentGateway struct {
+	domain.PaymentGateway
+	webhook *domain.PaymentWebhookDTO
+	refunds []string
+}
+
+func (g *fakeWebhookPaymentGateway) Name() string { return "fake" }
+
+func (g *fakeWebhookPaymentGateway) ParseWebhook(payload []byte, signature string) (*domain.PaymentWebhookDTO, error) {
+	return g.webhook, nil
+}
+
+func (g *fakeWebhookPaymentGateway) Refund(ctx context.Context, providerReference string, amount domain.Money, idempotencyKey string) error {
+	g.refunds = append(g.refunds, providerReference)
+	return nil
+}
+
+type fakeWebhookEventBus struct {
+	events []domain.Event
+}
+
+func (b *fakeWebhookEventBus) Publish(ctx context.Context, events ...domain.Event) error {
+	b.events = append(b.events, events...)
+	return nil
+}
+
+func TestHandlePaymentWebhook_SucceededAfterReject(t *testing.T) {
+	tests := []struct {
+		name           string
+		webhookStatus  domain.PaymentStatus
+		expectedStatus domain.PaymentStatus
+		expectRefund   bool
+	}{
+		{"succeeded payment is refunded", domain.SucceededPaymentStatus, domain.RefundedPaymentStatus, true},
+		{"failed payment leaves the order rejected", domain.FailedPaymentStatus, domain.FailedPaymentStatus, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := &domain.Order{ID: "order-1", CurrentStatus: domain.RejectedOrderStatus}
+			payment := &domain.Payment{
+				ID:                "payment-1",
+				OrderID:           "order-1",
+				Amount:            1500,
+				Currency:          "USD",
+				Status:            domain.PendingPaymentStatus,
+				Provider:          "fake",
+				ProviderReference: "pi_1",
+			}
+
+			orderDAO := &fakeWebhookOrderDAO{order: order}
+			paymentDAO := &fakeWebhookPaymentDAO{payment: payment}
+			gateway := &fakeWebhookPaymentGateway{webhook: &domain.PaymentWebhookDTO{ProviderReference: "pi_1", Status: tt.webhookStatus, FailureReason: "card declined"}}
+			nextID := func() string { return "change-1" }
+
+			svc := NewHandlePaymentWebhook(orderDAO, &fakeWebhookOrderStatusChangeDAO{}, paymentDAO, gateway, &fakeWebhookEventBus{}, nextID)
+			if err := svc.Exec(context.Background(), HandlePaymentWebhookReq{}); err != nil {
+				t.Fatal(err)
+			}
+
+			if paymentDAO.payment.GetStatus() != tt.expectedStatus {
+				t.Fatalf("expected payment %s, got %s", tt.expectedStatus, paymentDAO.payment.GetStatus())
+			}
+			if orderDAO.order.GetCurrentStatus() != domain.RejectedOrderStatus {
+				t.Fatalf("expected the order to stay rejected, got %s", orderDAO.order.GetCurrentStatus())
+			}
+			if refunded := len(gateway.refunds) == 1; refunded != tt.expectRefund {
+				t.Fatalf("expected refund %v, got refunds %v", tt.expectRefund, gateway.refunds)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package services
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"ichibuy/order/internal/domain"
+)
+
+func TestEventsWhere(t *testing.T) {
+	from := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		query eventsQuery
+		where string
+		args  []any
+	}{
+		{
+			name:  "first events",
+			query: eventsQuery{},
+			where: `transaction_id < pg_snapshot_xmin(pg_current_snapshot())`,
+		},
+		{
+			name:  "after the cursor",
+			query: eventsQuery{After: "event-1"},
+			where: `transaction_id < pg_snapshot_xmin(pg_current_snapshot()) AND (transaction_id, position) > (SELECT transaction_id, position FROM events WHERE id = $1)`,
+			args:  []any{"event-1"},
+		},
+		{
+			name:  "every filter",
+			query: eventsQuery{After: "event-1", Types: []domain.EventType{domain.OrderCreated, domain.OrderPaid}, From: &from, AggregateID: "order-1"},
+			where: `transaction_id < pg_snapshot_xmin(pg_current_snapshot()) AND (transaction_id, position) > (SELECT transaction_id, position FROM events WHERE id = $1) AND type IN ($2, $3) AND "timestamp" >= $4 AND aggregate_id = $5`,
+			args:  []any{"event-1", domain.OrderCreated, domain.OrderPaid, from, "order-1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			where, args := eventsWhere(tt.query)
+			if where != tt.where {
+				t.Errorf("expected %s, got %s", tt.where, where)
+			}
+			if !reflect.DeepEqual(args, tt.args) {
+				t.Errorf("expected args %v, got %v", tt.args, args)
+			}
+		})
+	}
+}
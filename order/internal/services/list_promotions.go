@@ -0,0 +1,99 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"ichibuy/order/internal/domain"
+	"ichibuy/order/internal/domain/dao"
+)
+
+type ListPromotionsReq struct {
+	StoreID string
+	UserID  string
+}
+
+type PromotionListItem struct {
+	ID                        string     `json:"id"`
+	StoreID                   string     `json:"store_id"`
+	Code                      *string    `json:"code"`
+	Type                      string     `json:"type"`
+	Value                     int        `json:"value"`
+	Currency                  string     `json:"currency"`
+	MinOrderAmount            int        `json:"min_order_amount"`
+	ProductID                 *string    `json:"product_id"`
+	BuyQuantity               int        `json:"buy_quantity"`
+	GetQuantity               int        `json:"get_quantity"`
+	MaxRedemptions            *int       `json:"max_redemptions"`
+	MaxRedemptionsPerCustomer *int       `json:"max_redemptions_per_customer"`
+	Redemptions               int        `json:"redemptions"`
+	Active                    bool       `json:"active"`
+	StartsAt                  *time.Time `json:"starts_at"`
+	EndsAt                    *time.Time `json:"ends_at"`
+	CreatedAt                 time.Time  `json:"created_at"`
+	UpdatedAt                 time.Time  `json:"updated_at"`
+}
+
+type ListPromotionsResp struct {
+	Promotions []PromotionListItem `json:"promotions"`
+}
+
+type ListPromotions struct {
+	promotionDAO dao.PromotionDAO
+	storeSvc     domain.StoreService
+}
+
+func NewListPromotions(promotionDAO dao.PromotionDAO, storeSvc domain.StoreService) *ListPromotions {
+	return &ListPromotions{
+		promotionDAO: promotionDAO,
+		storeSvc:     storeSvc,
+	}
+}
+
+func (s *ListPromotions) Exec(ctx context.Context, req ListPromotionsReq) (*ListPromotionsResp, error) {
+	slog.InfoContext(ctx, "list promotions started", "req", req)
+	store, err := s.storeSvc.FindByID(ctx, req.StoreID)
+	if err != nil {
+		slog.ErrorContext(ctx, "find store failed", "error", err.Error())
+		return nil, err
+	}
+
+	if store.UserID != req.UserID {
+		return nil, fmt.Errorf("user id does not match")
+	}
+
+	promotions, err := s.promotionDAO.FindAll(ctx, "store_id = $1", "created_at DESC", req.StoreID)
+	if err != nil {
+		slog.ErrorContext(ctx, "find promotions failed", "error", err.Error())
+		return nil, err
+	}
+
+	items := make([]PromotionListItem, len(promotions))
+	for i, promotion := range promotions {
+		items[i] = PromotionListItem{
+			ID:                        promotion.ID,
+			StoreID:                   promotion.StoreID,
+			Code:                      promotion.Code,
+			Type:                      string(promotion.Type),
+			Value:                     promotion.Value,
+			Currency:                  promotion.Currency,
+			MinOrderAmount:            promotion.MinOrderAmount,
+			ProductID:                 promotion.ProductID,
+			BuyQuantity:               promotion.BuyQuantity,
+			GetQuantity:               promotion.GetQuantity,
+			MaxRedemptions:            promotion.MaxRedemptions,
+			MaxRedemptionsPerCustomer: promotion.MaxRedemptionsPerCustomer,
+			Redemptions:               promotion.Redemptions,
+			Active:                    promotion.Active,
+			StartsAt:                  promotion.StartsAt,
+			EndsAt:                    promotion.EndsAt,
+			CreatedAt:                 promotion.CreatedAt,
+			UpdatedAt:                 promotion.UpdatedAt,
+		}
+	}
+
+	slog.InfoContext(ctx, "list promotions finished", "count", len(items))
+	return &ListPromotionsResp{Promotions: items}, nil
+}
@@ -0,0 +1,131 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"ichibuy/order/internal/domain"
+	"ichibuy/order/internal/domain/dao"
+)
+
+var orderStatuses = []domain.OrderStatus{
+	domain.CreatedOrderStatus,
+	domain.PaidOrderStatus,
+	domain.PaymentFailedOrderStatus,
+	domain.AcceptedOrderStatus,
+	domain.FinishedOrderStatus,
+	domain.CanceledOrderStatus,
+	domain.RejectedOrderStatus,
+}
+
+type ListStoreOrdersReq struct {
+	StoreID    string
+	UserID     string
+	Filters    StoreOrderFilters
+	Pagination Pagination
+}
+
+type StoreOrderFilters struct {
+	Statuses []string
+	// CreatedFrom and CreatedTo bound the creation date of the orders, both inclusive
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+}
+
+type ListStoreOrdersResp struct {
+	Orders []OrderDTO `json:"orders"`
+	Total  int64      `json:"total"`
+	Limit  int        `json:"limit"`
+	Offset int        `json:"offset"`
+	// StatusCounts counts the orders of each status within the date filters
+	StatusCounts map[string]int64 `json:"status_counts"`
+}
+
+type ListStoreOrders struct {
+	orderDAO dao.OrderDAO
+	storeSvc domain.StoreService
+}
+
+func NewListStoreOrders(orderDAO dao.OrderDAO, storeSvc domain.StoreService) *ListStoreOrders {
+	return &ListStoreOrders{
+		orderDAO: orderDAO,
+		storeSvc: storeSvc,
+	}
+}
+
+// Exec returns the orders placed at the store, newest first. Only the store owner can list them
+func (s *ListStoreOrders) Exec(ctx context.Context, req ListStoreOrdersReq) (*ListStoreOrdersResp, error) {
+	slog.InfoContext(ctx, "list store orders started", "req", req)
+	store, err := s.storeSvc.FindByID(ctx, req.StoreID)
+	if err != nil {
+		slog.ErrorContext(ctx, "find store failed", "error", err.Error())
+		return nil, err
+	}
+
+	if store.UserID != req.UserID {
+		return nil, fmt.Errorf("user id does not match")
+	}
+
+	whereParts := []string{"store_id = $1"}
+	args := []any{req.StoreID}
+
+	if req.Filters.CreatedFrom != nil {
+		args = append(args, *req.Filters.CreatedFrom)
+		whereParts = append(whereParts, fmt.Sprintf("created_at >= $%d", len(args)))
+	}
+
+	if req.Filters.CreatedTo != nil {
+		args = append(args, *req.Filters.CreatedTo)
+		whereParts = append(whereParts, fmt.Sprintf("created_at <= $%d", len(args)))
+	}
+
+	statusCounts := map[string]int64{}
+	for _, status := range orderStatuses {
+		count, err := s.orderDAO.Count(ctx, strings.Join(append(whereParts, fmt.Sprintf("current_status = $%d", len(args)+1)), " AND "), append(args, status)...)
+		if err != nil {
+			slog.ErrorContext(ctx, "count orders by status failed", "status", status, "error", err.Error())
+			return nil, err
+		}
+		statusCounts[string(status)] = count
+	}
+
+	if len(req.Filters.Statuses) > 0 {
+		placeholders := make([]string, len(req.Filters.Statuses))
+		for i, status := range req.Filters.Statuses {
+			args = append(args, status)
+			placeholders[i] = fmt.Sprintf("$%d", len(args))
+		}
+		whereParts = append(whereParts, fmt.Sprintf("current_status IN (%s)", strings.Join(placeholders, ", ")))
+	}
+
+	where := strings.Join(whereParts, " AND ")
+
+	total, err := s.orderDAO.Count(ctx, where, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "count orders failed", "error", err.Error())
+		return nil, err
+	}
+
+	orders, err := s.orderDAO.FindPaginated(ctx, req.Pagination.Limit, req.Pagination.Offset, where, "created_at DESC", args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "find paginated orders failed", "error", err.Error())
+		return nil, err
+	}
+
+	items := make([]OrderDTO, len(orders))
+	for i, order := range orders {
+		items[i] = mapOrderToDTO(order)
+	}
+
+	slog.InfoContext(ctx, "list store orders finished", "total", total, "count", len(items))
+	return &ListStoreOrdersResp{
+		Orders:       items,
+		Total:        total,
+		Limit:        req.Pagination.Limit,
+		Offset:       req.Pagination.Offset,
+		StatusCounts: statusCounts,
+	}, nil
+}
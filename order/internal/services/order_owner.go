@@ -0,0 +1,76 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"ichibuy/order/internal/domain"
+	"ichibuy/order/internal/domain/dao"
+)
+
+// findCustomerOrder returns the order when it was placed by the customer of the user
+func findCustomerOrder(ctx context.Context, orderDAO dao.OrderDAO, customerSvc domain.CustomerService, orderID, userID string) (*domain.Order, error) {
+	order, err := orderDAO.FindByPk(ctx, orderID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("order %s not found", orderID)
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "find order failed", "error", err.Error())
+		return nil, err
+	}
+
+	customer, err := customerSvc.FindByUserID(ctx, userID)
+	if err != nil {
+		slog.ErrorContext(ctx, "find customer by user id failed", "error", err.Error())
+		return nil, err
+	}
+
+	if order.GetCustomerID() != customer.ID {
+		return nil, fmt.Errorf("order %s not found", orderID)
+	}
+
+	return order, nil
+}
+
+// canViewOrder returns true when the user placed the order or owns its store
+func canViewOrder(ctx context.Context, customerSvc domain.CustomerService, storeSvc domain.StoreService, order *domain.Order, userID string) bool {
+	customer, err := customerSvc.FindByUserID(ctx, userID)
+	if err == nil && customer.ID == order.GetCustomerID() {
+		return true
+	}
+
+	store, err := storeSvc.FindByID(ctx, order.GetStoreID())
+	if err != nil {
+		slog.WarnContext(ctx, "find store failed", "error", err.Error())
+		return false
+	}
+
+	return store.UserID == userID
+}
+
+// findStoreOrder returns the order when its store belongs to the user
+func findStoreOrder(ctx context.Context, orderDAO dao.OrderDAO, storeSvc domain.StoreService, orderID, userID string) (*domain.Order, error) {
+	order, err := orderDAO.FindByPk(ctx, orderID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("order %s not found", orderID)
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "find order failed", "error", err.Error())
+		return nil, err
+	}
+
+	store, err := storeSvc.FindByID(ctx, order.GetStoreID())
+	if err != nil {
+		slog.ErrorContext(ctx, "find store failed", "error", err.Error())
+		return nil, err
+	}
+
+	if store.UserID != userID {
+		return nil, fmt.Errorf("order %s not found", orderID)
+	}
+
+	return order, nil
+}
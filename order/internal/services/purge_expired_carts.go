@@ -0,0 +1,44 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"ichibuy/order/internal/domain/dao"
+)
+
+// PurgeExpiredCarts deletes the carts abandoned longer than their time to live
+type PurgeExpiredCarts struct {
+	cartDAO dao.CartDAO
+}
+
+func NewPurgeExpiredCarts(cartDAO dao.CartDAO) *PurgeExpiredCarts {
+	return &PurgeExpiredCarts{
+		cartDAO: cartDAO,
+	}
+}
+
+func (s *PurgeExpiredCarts) Exec(ctx context.Context) error {
+	now := time.Now().UTC()
+	slog.InfoContext(ctx, "purge expired carts started", "now", now)
+
+	carts, err := s.cartDAO.FindAll(ctx, "expires_at < $1", "", now)
+	if err != nil {
+		slog.ErrorContext(ctx, "find expired carts failed", "error", err.Error())
+		return err
+	}
+
+	ids := make([]string, len(carts))
+	for i, cart := range carts {
+		ids[i] = cart.GetID()
+	}
+
+	if err := s.cartDAO.DeleteManyByPks(ctx, ids); err != nil {
+		slog.ErrorContext(ctx, "delete expired carts failed", "error", err.Error())
+		return err
+	}
+
+	slog.InfoContext(ctx, "purge expired carts finished", "count", len(ids))
+	return nil
+}
@@ -0,0 +1,44 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"ichibuy/order/internal/domain/dao"
+)
+
+// PurgeExpiredIdempotencyKeys deletes the idempotency keys past their time to live
+type PurgeExpiredIdempotencyKeys struct {
+	idempotencyKeyDAO dao.IdempotencyKeyDAO
+}
+
+func NewPurgeExpiredIdempotencyKeys(idempotencyKeyDAO dao.IdempotencyKeyDAO) *PurgeExpiredIdempotencyKeys {
+	return &PurgeExpiredIdempotencyKeys{
+		idempotencyKeyDAO: idempotencyKeyDAO,
+	}
+}
+
+func (s *PurgeExpiredIdempotencyKeys) Exec(ctx context.Context) error {
+	now := time.Now().UTC()
+	slog.InfoContext(ctx, "purge expired idempotency keys started", "now", now)
+
+	keys, err := s.idempotencyKeyDAO.FindAll(ctx, "expires_at < $1", "", now)
+	if err != nil {
+		slog.ErrorContext(ctx, "find expired idempotency keys failed", "error", err.Error())
+		return err
+	}
+
+	ids := make([]string, len(keys))
+	for i, key := range keys {
+		ids[i] = key.GetID()
+	}
+
+	if err := s.idempotencyKeyDAO.DeleteManyByPks(ctx, ids); err != nil {
+		slog.ErrorContext(ctx, "delete expired idempotency keys failed", "error", err.Error())
+		return err
+	}
+
+	slog.InfoContext(ctx, "purge expired idempotency keys finished", "count", len(ids))
+	return nil
+}
@@ -0,0 +1,61 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"ichibuy/order/internal/domain"
+	"ichibuy/order/internal/domain/dao"
+)
+
+// RebuildOrderStatusHistory is the projection of the order status history, it records the status change
+// of the order events that are missing from the history
+type RebuildOrderStatusHistory struct {
+	orderStatusChangeDAO dao.OrderStatusChangeDAO
+}
+
+func NewRebuildOrderStatusHistory(orderStatusChangeDAO dao.OrderStatusChangeDAO) *RebuildOrderStatusHistory {
+	return &RebuildOrderStatusHistory{orderStatusChangeDAO: orderStatusChangeDAO}
+}
+
+// Types returns the order events that carry a status change
+func (s *RebuildOrderStatusHistory) Types() []domain.EventType {
+	return []domain.EventType{
+		domain.OrderCreated,
+		domain.OrderAccepted,
+		domain.OrderPaid,
+		domain.OrderPaymentFailed,
+		domain.OrderCanceled,
+		domain.OrderRejected,
+	}
+}
+
+func (s *RebuildOrderStatusHistory) Handle(ctx context.Context, event domain.Event) error {
+	statusChange, err := eventStatusChange(event)
+	if err != nil || statusChange == nil {
+		return err
+	}
+
+	_, err = s.orderStatusChangeDAO.FindByPk(ctx, statusChange.GetID())
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	return s.orderStatusChangeDAO.Create(ctx, statusChange)
+}
+
+// eventStatusChange returns the status change carried by an order event, nil for the events without one
+func eventStatusChange(event domain.Event) (*domain.OrderStatusChange, error) {
+	var data struct {
+		StatusChange *domain.OrderStatusChange `json:"status_change"`
+	}
+	if err := json.Unmarshal(event.Data, &data); err != nil {
+		return nil, err
+	}
+	return data.StatusChange, nil
+}
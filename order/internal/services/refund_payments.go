@@ -0,0 +1,92 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"ichibuy/order/internal/domain"
+	"ichibuy/order/internal/domain/dao"
+)
+
+// RefundPayments asks the provider for the refunds recorded but not confirmed yet, e.g. when the provider
+// failed right after an order was canceled
+type RefundPayments struct {
+	paymentDAO     dao.PaymentDAO
+	paymentGateway domain.PaymentGateway
+	eventBus       domain.EventBus
+}
+
+func NewRefundPayments(paymentDAO dao.PaymentDAO, paymentGateway domain.PaymentGateway, eventBus domain.EventBus) *RefundPayments {
+	return &RefundPayments{
+		paymentDAO:     paymentDAO,
+		paymentGateway: paymentGateway,
+		eventBus:       eventBus,
+	}
+}
+
+func (s *RefundPayments) Exec(ctx context.Context) error {
+	payments, err := s.paymentDAO.FindAll(ctx, "status = $1 AND provider = $2", "updated_at ASC", domain.RefundPendingPaymentStatus, s.paymentGateway.Name())
+	if err != nil {
+		slog.ErrorContext(ctx, "find pending refunds failed", "error", err.Error())
+		return err
+	}
+
+	for _, payment := range payments {
+		if err := refundPayment(ctx, s.paymentDAO, s.paymentGateway, s.eventBus, payment); err != nil {
+			return err
+		}
+	}
+
+	if len(payments) > 0 {
+		slog.InfoContext(ctx, "refund payments finished", "count", len(payments))
+	}
+	return nil
+}
+
+// requestOrderRefund records the refund of the succeeded payment of the order, it returns nil when the
+// order was not paid. The payment has to be saved with the order before calling the provider.
+func requestOrderRefund(ctx context.Context, paymentDAO dao.PaymentDAO, order *domain.Order) (*domain.Payment, error) {
+	payment, err := paymentDAO.FindOne(ctx, "order_id = $1 AND status = $2", "", order.GetID(), domain.SucceededPaymentStatus)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "find payment failed", "error", err.Error())
+		return nil, err
+	}
+
+	if err := payment.RequestRefund(); err != nil {
+		return nil, err
+	}
+
+	return payment, nil
+}
+
+// refundPayment asks the provider for a requested refund and marks the payment as refunded. The refund
+// is sent with the idempotency key of the payment, so refunds retried after a failure are made once.
+func refundPayment(ctx context.Context, paymentDAO dao.PaymentDAO, paymentGateway domain.PaymentGateway, eventBus domain.EventBus, payment *domain.Payment) error {
+	if err := paymentGateway.Refund(ctx, payment.GetProviderReference(), payment.GetAmount(), payment.RefundIdempotencyKey()); err != nil {
+		slog.ErrorContext(ctx, "refund payment failed", "payment_id", payment.GetID(), "error", err.Error())
+		return err
+	}
+
+	if err := payment.Refund(); err != nil {
+		return err
+	}
+
+	err := paymentDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := paymentDAO.Update(ctx, payment); err != nil {
+			return err
+		}
+
+		return eventBus.Publish(ctx, payment.PullEvents()...)
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "save refunded payment failed", "payment_id", payment.GetID(), "error", err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+
+	"ichibuy/order/internal/domain"
+	"ichibuy/order/internal/domain/dao"
+)
+
+type RejectOrderReq struct {
+	ID     string
+	Reason *string
+	UserID string
+}
+
+type RejectOrder struct {
+	orderDAO             dao.OrderDAO
+	orderStatusChangeDAO dao.OrderStatusChangeDAO
+	paymentDAO           dao.PaymentDAO
+	storeSvc             domain.StoreService
+	paymentGateway       domain.PaymentGateway
+	eventBus             domain.EventBus
+	nextID               domain.NextID
+}
+
+func NewRejectOrder(
+	orderDAO dao.OrderDAO,
+	orderStatusChangeDAO dao.OrderStatusChangeDAO,
+	paymentDAO dao.PaymentDAO,
+	storeSvc domain.StoreService,
+	paymentGateway domain.PaymentGateway,
+	eventBus domain.EventBus,
+	nextID domain.NextID,
+) *RejectOrder {
+	return &RejectOrder{
+		orderDAO:             orderDAO,
+		orderStatusChangeDAO: orderStatusChangeDAO,
+		paymentDAO:           paymentDAO,
+		storeSvc:             storeSvc,
+		paymentGateway:       paymentGateway,
+		eventBus:             eventBus,
+		nextID:               nextID,
+	}
+}
+
+// Exec rejects an order of a store of the user, refunding its payment when it was already paid
+func (s *RejectOrder) Exec(ctx context.Context, req RejectOrderReq) error {
+	slog.InfoContext(ctx, "reject order started", "req", req)
+
+	order, err := findStoreOrder(ctx, s.orderDAO, s.storeSvc, req.ID, req.UserID)
+	if err != nil {
+		return err
+	}
+
+	if err := order.Reject(s.nextID(), req.UserID, req.Reason); err != nil {
+		slog.ErrorContext(ctx, "reject order domain failed", "error", err.Error())
+		return err
+	}
+
+	// the refund is recorded with the order and asked to the provider once both are stored
+	payment, err := requestOrderRefund(ctx, s.paymentDAO, order)
+	if err != nil {
+		return err
+	}
+
+	events := order.PullEvents()
+	err = s.orderDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := s.orderDAO.Update(ctx, order); err != nil {
+			return err
+		}
+
+		if err := s.orderStatusChangeDAO.CreateMany(ctx, order.PullStatusChanges()); err != nil {
+			return err
+		}
+
+		if payment != nil {
+			if err := s.paymentDAO.Update(ctx, payment); err != nil {
+				return err
+			}
+		}
+
+		return s.eventBus.Publish(ctx, events...)
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "save rejected order failed", "error", err.Error())
+		return err
+	}
+
+	// a failed refund stays pending and is retried by the worker
+	if payment != nil {
+		if err := refundPayment(ctx, s.paymentDAO, s.paymentGateway, s.eventBus, payment); err != nil {
+			slog.WarnContext(ctx, "refund left pending", "payment_id", payment.GetID(), "error", err.Error())
+		}
+	}
+
+	slog.InfoContext(ctx, "reject order finished", "order_id", order.GetID())
+	return nil
+}
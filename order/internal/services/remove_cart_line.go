@@ -0,0 +1,49 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"ichibuy/order/internal/domain"
+	"ichibuy/order/internal/domain/dao"
+)
+
+type RemoveCartLineReq struct {
+	UserID    string
+	ProductID string
+}
+
+type RemoveCartLine struct {
+	cartDAO dao.CartDAO
+	nextID  domain.NextID
+	ttl     time.Duration
+}
+
+func NewRemoveCartLine(cartDAO dao.CartDAO, nextID domain.NextID, ttl time.Duration) *RemoveCartLine {
+	return &RemoveCartLine{
+		cartDAO: cartDAO,
+		nextID:  nextID,
+		ttl:     ttl,
+	}
+}
+
+func (s *RemoveCartLine) Exec(ctx context.Context, req RemoveCartLineReq) (*GetCartResp, error) {
+	slog.InfoContext(ctx, "remove cart line started", "req", req)
+	cart, err := findOrNewCart(ctx, s.cartDAO, s.nextID, req.UserID, s.ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cart.RemoveLine(req.ProductID); err != nil {
+		slog.ErrorContext(ctx, "remove cart line domain failed", "error", err.Error())
+		return nil, err
+	}
+
+	if err := saveCart(ctx, s.cartDAO, cart, s.ttl); err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "remove cart line finished", "cart_id", cart.GetID())
+	return mapCartToResp(cart)
+}
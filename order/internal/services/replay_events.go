@@ -0,0 +1,197 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"ichibuy/order/internal/domain"
+	"ichibuy/order/internal/domain/dao"
+)
+
+const replayConsumerPrefix = "replay."
+
+// Projection builds a read model or a side effect from the events of the service. Handle must be
+// idempotent, a replay may hand it events it has already handled.
+type Projection interface {
+	Types() []domain.EventType
+	Handle(ctx context.Context, event domain.Event) error
+}
+
+type ReplayEventsReq struct {
+	Projection string
+	// Types narrows the events of the projection, every type of the projection when empty
+	Types []string
+	From  *time.Time
+	To    *time.Time
+	// DryRun visits the events without handling them nor moving the checkpoint
+	DryRun bool
+	// Restart replays from the first event instead of the checkpoint of the projection
+	Restart bool
+	// Visit is called with every event replayed, before it is handled
+	Visit func(event domain.Event)
+}
+
+type ReplayEventsResp struct {
+	Handled     int    `json:"handled"`
+	LastEventID string `json:"last_event_id"`
+}
+
+// ReplayEvents hands the events table to a projection, checkpointed as "replay.<projection>" so an
+// interrupted replay resumes where it stopped. Replays filtered by types or timestamps have a checkpoint
+// of their own, see replayConsumer.
+type ReplayEvents struct {
+	eventDAO           dao.EventDAO
+	eventCheckpointDAO dao.EventCheckpointDAO
+	projections        map[string]Projection
+}
+
+func NewReplayEvents(eventDAO dao.EventDAO, eventCheckpointDAO dao.EventCheckpointDAO, projections map[string]Projection) *ReplayEvents {
+	return &ReplayEvents{
+		eventDAO:           eventDAO,
+		eventCheckpointDAO: eventCheckpointDAO,
+		projections:        projections,
+	}
+}
+
+// Projections returns the names of the projections that can be replayed
+func (s *ReplayEvents) Projections() []string {
+	names := make([]string, 0, len(s.projections))
+	for name := range s.projections {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (s *ReplayEvents) Exec(ctx context.Context, req ReplayEventsReq) (*ReplayEventsResp, error) {
+	slog.InfoContext(ctx, "replay events started", "projection", req.Projection, "dry_run", req.DryRun, "restart", req.Restart)
+	projection, ok := s.projections[req.Projection]
+	if !ok {
+		return nil, fmt.Errorf("projection %s not found", req.Projection)
+	}
+
+	types, err := replayTypes(req.Projection, projection.Types(), req.Types)
+	if err != nil {
+		return nil, err
+	}
+
+	consumer := replayConsumer(req.Projection, req.Types, req.From, req.To)
+	source := func(ctx context.Context, after string, types []domain.EventType, limit int) ([]domain.Event, error) {
+		return findLocalEvents(ctx, s.eventDAO, eventsQuery{After: after, Types: types, From: req.From, To: req.To, Limit: limit})
+	}
+
+	visit := func(event domain.Event) {
+		if req.Visit != nil {
+			req.Visit(event)
+		}
+	}
+
+	resp := &ReplayEventsResp{}
+	if req.DryRun {
+		after := ""
+		if !req.Restart {
+			checkpoint, err := s.eventCheckpointDAO.FindByPk(ctx, consumer)
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				slog.ErrorContext(ctx, "find event checkpoint failed", "error", err.Error())
+				return nil, err
+			}
+			if checkpoint != nil {
+				after = checkpoint.GetLastEventID()
+			}
+		}
+
+		for {
+			events, err := source(ctx, after, types, eventsBatchSize)
+			if err != nil {
+				return nil, err
+			}
+			for _, event := range events {
+				visit(event)
+				resp.Handled++
+				resp.LastEventID = event.ID
+				after = event.ID
+			}
+			if len(events) < eventsBatchSize {
+				break
+			}
+		}
+
+		slog.InfoContext(ctx, "replay events finished", "projection", req.Projection, "dry_run", true, "events", resp.Handled)
+		return resp, nil
+	}
+
+	if req.Restart {
+		if err := s.eventCheckpointDAO.DeleteByPk(ctx, consumer); err != nil {
+			slog.ErrorContext(ctx, "delete event checkpoint failed", "error", err.Error())
+			return nil, err
+		}
+	}
+
+	handled, err := consumeEvents(ctx, s.eventCheckpointDAO, source, consumer, types, func(ctx context.Context, event domain.Event) error {
+		visit(event)
+		if err := projection.Handle(ctx, event); err != nil {
+			return err
+		}
+		resp.LastEventID = event.ID
+		return nil
+	})
+	resp.Handled = handled
+	if err != nil {
+		return resp, err
+	}
+
+	slog.InfoContext(ctx, "replay events finished", "projection", req.Projection, "handled", handled)
+	return resp, nil
+}
+
+// replayConsumer names the checkpoint of a replay, "replay.<projection>" for the replays without filters.
+// Filtered replays add the hash of their filters, so they neither resume from nor move the checkpoint of
+// the replays of other events.
+func replayConsumer(projection string, types []string, from, to *time.Time) string {
+	consumer := replayConsumerPrefix + projection
+	if len(types) == 0 && from == nil && to == nil {
+		return consumer
+	}
+
+	sortedTypes := slices.Clone(types)
+	sort.Strings(sortedTypes)
+	filters := []string{"types=" + strings.Join(slices.Compact(sortedTypes), ",")}
+	if from != nil {
+		filters = append(filters, "from="+from.UTC().Format(time.RFC3339Nano))
+	}
+	if to != nil {
+		filters = append(filters, "to="+to.UTC().Format(time.RFC3339Nano))
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join(filters, "&")))
+	return consumer + "." + hex.EncodeToString(sum[:6])
+}
+
+// replayTypes returns the event types to replay, the requested types when set, which must be handled by the
+// projection, or every type of the projection
+func replayTypes(projectionName string, projectionTypes []domain.EventType, requested []string) ([]domain.EventType, error) {
+	if len(requested) == 0 {
+		return projectionTypes, nil
+	}
+
+	types := []domain.EventType{}
+	for _, value := range requested {
+		eventType := domain.EventType(value)
+		if !slices.Contains(projectionTypes, eventType) {
+			return nil, fmt.Errorf("projection %s does not handle %s events", projectionName, value)
+		}
+		if !slices.Contains(types, eventType) {
+			types = append(types, eventType)
+		}
+	}
+	return types, nil
+}
@@ -0,0 +1,154 @@
+package services
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"ichibuy/order/internal/domain"
+)
+
+func TestReplayTypes(t *testing.T) {
+	projectionTypes := []domain.EventType{domain.OrderCreated, domain.OrderPaid, domain.OrderCanceled}
+
+	tests := []struct {
+		name      string
+		requested []string
+		expected  []domain.EventType
+		expectErr bool
+	}{
+		{
+			name:     "every type of the projection when none is requested",
+			expected: projectionTypes,
+		},
+		{
+			name:      "requested types in the requested order",
+			requested: []string{"OrderCanceled", "OrderCreated"},
+			expected:  []domain.EventType{domain.OrderCanceled, domain.OrderCreated},
+		},
+		{
+			name:      "repeated types are replayed once",
+			requested: []string{"OrderPaid", "OrderPaid"},
+			expected:  []domain.EventType{domain.OrderPaid},
+		},
+		{
+			name:      "type not handled by the projection",
+			requested: []string{"OrderPaid", "OrderAccepted"},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			types, err := replayTypes("order_status_history", projectionTypes, tt.requested)
+			if tt.expectErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(types, tt.expected) {
+				t.Fatalf("expected types %v, got %v", tt.expected, types)
+			}
+		})
+	}
+}
+
+func TestReplayConsumer(t *testing.T) {
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+
+	unfiltered := replayConsumer("order_status_history", nil, nil, nil)
+	if unfiltered != "replay.order_status_history" {
+		t.Fatalf("expected the checkpoint of the projection, got %s", unfiltered)
+	}
+
+	filtered := replayConsumer("order_status_history", []string{"OrderPaid", "OrderCreated"}, &from, nil)
+	if !strings.HasPrefix(filtered, unfiltered+".") {
+		t.Fatalf("expected a checkpoint of the projection for the filters, got %s", filtered)
+	}
+
+	if replayConsumer("order_status_history", []string{"OrderCreated", "OrderPaid", "OrderPaid"}, &from, nil) != filtered {
+		t.Fatal("expected the same checkpoint for the same filters in another order")
+	}
+
+	others := []string{
+		replayConsumer("order_status_history", []string{"OrderCreated"}, &from, nil),
+		replayConsumer("order_status_history", []string{"OrderPaid", "OrderCreated"}, &from, &to),
+		replayConsumer("order_status_history", nil, &from, nil),
+	}
+	for _, other := range others {
+		if other == filtered || other == unfiltered {
+			t.Fatalf("expected another checkpoint for other filters, got %s", other)
+		}
+	}
+}
+
+func TestEventStatusChange(t *testing.T) {
+	createdAt := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	from := domain.CreatedOrderStatus
+	reason := "out of stock"
+
+	tests := []struct {
+		name      string
+		data      string
+		expected  *domain.OrderStatusChange
+		expectErr bool
+	}{
+		{
+			name: "first status of the order",
+			data: `{"id":"order-1","status_change":{"id":"change-1","order_id":"order-1","from_status":null,"to_status":"created","created_at":"2025-03-01T10:00:00Z"}}`,
+			expected: &domain.OrderStatusChange{
+				ID:        "change-1",
+				OrderID:   "order-1",
+				ToStatus:  domain.CreatedOrderStatus,
+				CreatedAt: createdAt,
+			},
+		},
+		{
+			name: "change with the previous status and reason",
+			data: `{"id":"order-1","status_change":{"id":"change-2","order_id":"order-1","from_status":"created","to_status":"rejected","reason":"out of stock","created_at":"2025-03-01T10:00:00Z"}}`,
+			expected: &domain.OrderStatusChange{
+				ID:         "change-2",
+				OrderID:    "order-1",
+				FromStatus: &from,
+				ToStatus:   domain.RejectedOrderStatus,
+				Reason:     &reason,
+				CreatedAt:  createdAt,
+			},
+		},
+		{
+			name: "event without a status change",
+			data: `{"id":"order-1","code":"AB12CD"}`,
+		},
+		{
+			name:      "malformed data",
+			data:      `{"status_change":`,
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statusChange, err := eventStatusChange(domain.Event{ID: "event-1", Type: domain.OrderCreated, Data: []byte(tt.data)})
+			if tt.expectErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(statusChange, tt.expected) {
+				t.Fatalf("expected status change %+v, got %+v", tt.expected, statusChange)
+			}
+		})
+	}
+}
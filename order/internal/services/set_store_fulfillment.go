@@ -0,0 +1,110 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"ichibuy/order/internal/domain"
+	"ichibuy/order/internal/domain/dao"
+)
+
+type SetStoreFulfillmentReq struct {
+	StoreID       string
+	UserID        string
+	Methods       []string
+	DeliveryZones []DeliveryZoneDTO
+	ShippingFee   *MoneyDTO
+}
+
+type SetStoreFulfillment struct {
+	storeFulfillmentDAO dao.StoreFulfillmentDAO
+	storeSvc            domain.StoreService
+}
+
+func NewSetStoreFulfillment(storeFulfillmentDAO dao.StoreFulfillmentDAO, storeSvc domain.StoreService) *SetStoreFulfillment {
+	return &SetStoreFulfillment{
+		storeFulfillmentDAO: storeFulfillmentDAO,
+		storeSvc:            storeSvc,
+	}
+}
+
+func (s *SetStoreFulfillment) Exec(ctx context.Context, req SetStoreFulfillmentReq) (*GetStoreFulfillmentResp, error) {
+	slog.InfoContext(ctx, "set store fulfillment started", "req", req)
+	store, err := s.storeSvc.FindByID(ctx, req.StoreID)
+	if err != nil {
+		slog.ErrorContext(ctx, "find store failed", "error", err.Error())
+		return nil, err
+	}
+
+	if store.UserID != req.UserID {
+		return nil, fmt.Errorf("user id does not match")
+	}
+
+	options, err := mapReqToFulfillmentOptions(req)
+	if err != nil {
+		return nil, err
+	}
+
+	storeFulfillment, err := s.storeFulfillmentDAO.FindByPk(ctx, req.StoreID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		slog.ErrorContext(ctx, "find store fulfillment failed", "error", err.Error())
+		return nil, err
+	}
+
+	if storeFulfillment == nil {
+		storeFulfillment, err = domain.NewStoreFulfillment(req.StoreID, options)
+		if err != nil {
+			slog.ErrorContext(ctx, "new store fulfillment failed", "error", err.Error())
+			return nil, err
+		}
+		err = s.storeFulfillmentDAO.Create(ctx, storeFulfillment)
+	} else {
+		if err := storeFulfillment.Update(options); err != nil {
+			slog.ErrorContext(ctx, "update store fulfillment domain failed", "error", err.Error())
+			return nil, err
+		}
+		err = s.storeFulfillmentDAO.Update(ctx, storeFulfillment)
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "save store fulfillment failed", "error", err.Error())
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "set store fulfillment finished", "store_id", req.StoreID)
+	return mapFulfillmentOptionsToResp(req.StoreID, storeFulfillment.GetOptions()), nil
+}
+
+func mapReqToFulfillmentOptions(req SetStoreFulfillmentReq) (domain.FulfillmentOptions, error) {
+	options := domain.FulfillmentOptions{
+		Methods:       make([]domain.FulfillmentMethod, len(req.Methods)),
+		DeliveryZones: make([]domain.DeliveryZone, len(req.DeliveryZones)),
+	}
+
+	for i, method := range req.Methods {
+		fulfillmentMethod, err := domain.ParseFulfillmentMethod(method)
+		if err != nil {
+			return domain.FulfillmentOptions{}, err
+		}
+		options.Methods[i] = fulfillmentMethod
+	}
+
+	for i, zone := range req.DeliveryZones {
+		options.DeliveryZones[i] = domain.DeliveryZone{
+			Name:     zone.Name,
+			Type:     domain.DeliveryZoneType(zone.Type),
+			RadiusKm: zone.RadiusKm,
+			Polygon:  zone.Polygon,
+			BaseFee:  domain.Money{Amount: zone.BaseFee.Amount, Currency: zone.BaseFee.Currency},
+			FeePerKm: domain.Money{Amount: zone.FeePerKm.Amount, Currency: zone.FeePerKm.Currency},
+		}
+	}
+
+	if req.ShippingFee != nil {
+		options.ShippingFee = &domain.Money{Amount: req.ShippingFee.Amount, Currency: req.ShippingFee.Currency}
+	}
+
+	return options, nil
+}
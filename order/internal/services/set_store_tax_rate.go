@@ -0,0 +1,76 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"ichibuy/order/internal/domain"
+	"ichibuy/order/internal/domain/dao"
+)
+
+type SetStoreTaxRateReq struct {
+	StoreID   string
+	UserID    string
+	Name      string
+	Rate      int
+	Inclusive bool
+}
+
+type SetStoreTaxRate struct {
+	storeTaxRateDAO dao.StoreTaxRateDAO
+	storeSvc        domain.StoreService
+}
+
+func NewSetStoreTaxRate(storeTaxRateDAO dao.StoreTaxRateDAO, storeSvc domain.StoreService) *SetStoreTaxRate {
+	return &SetStoreTaxRate{
+		storeTaxRateDAO: storeTaxRateDAO,
+		storeSvc:        storeSvc,
+	}
+}
+
+func (s *SetStoreTaxRate) Exec(ctx context.Context, req SetStoreTaxRateReq) (*GetStoreTaxRateResp, error) {
+	slog.InfoContext(ctx, "set store tax rate started", "req", req)
+	store, err := s.storeSvc.FindByID(ctx, req.StoreID)
+	if err != nil {
+		slog.ErrorContext(ctx, "find store failed", "error", err.Error())
+		return nil, err
+	}
+
+	if store.UserID != req.UserID {
+		return nil, fmt.Errorf("user id does not match")
+	}
+
+	taxRate, err := domain.NewTaxRate(req.Name, req.Rate, req.Inclusive)
+	if err != nil {
+		slog.ErrorContext(ctx, "new tax rate failed", "error", err.Error())
+		return nil, err
+	}
+
+	storeTaxRate, err := s.storeTaxRateDAO.FindByPk(ctx, req.StoreID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		slog.ErrorContext(ctx, "find store tax rate failed", "error", err.Error())
+		return nil, err
+	}
+
+	if storeTaxRate == nil {
+		storeTaxRate, err = domain.NewStoreTaxRate(req.StoreID, taxRate)
+		if err != nil {
+			slog.ErrorContext(ctx, "new store tax rate failed", "error", err.Error())
+			return nil, err
+		}
+		err = s.storeTaxRateDAO.Create(ctx, storeTaxRate)
+	} else {
+		storeTaxRate.Update(taxRate)
+		err = s.storeTaxRateDAO.Update(ctx, storeTaxRate)
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "save store tax rate failed", "error", err.Error())
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "set store tax rate finished", "store_id", req.StoreID)
+	return mapStoreTaxRateToResp(storeTaxRate), nil
+}
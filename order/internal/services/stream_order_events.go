@@ -0,0 +1,181 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"time"
+
+	"ichibuy/order/internal/domain"
+	"ichibuy/order/internal/domain/dao"
+)
+
+const streamBatchSize = 100
+
+// streamedEventTypes are the order events pushed to the store owners and customers
+var streamedEventTypes = []domain.EventType{
+	domain.OrderCreated,
+	domain.OrderPaid,
+	domain.OrderPaymentFailed,
+	domain.OrderAccepted,
+	domain.OrderCanceled,
+	domain.OrderRejected,
+}
+
+type StreamOrderEventsReq struct {
+	UserID string
+	// LastEventID resumes the stream after the given event, the stream starts with new events when empty
+	LastEventID string
+}
+
+type OrderStreamEvent struct {
+	ID   string
+	Type string
+	Data json.RawMessage
+}
+
+// OrderStreamWriter delivers the stream to the client
+type OrderStreamWriter interface {
+	Send(event OrderStreamEvent) error
+	// Heartbeat keeps the connection alive while there are no events
+	Heartbeat() error
+}
+
+type StreamOrderEvents struct {
+	eventDAO     dao.EventDAO
+	customerSvc  domain.CustomerService
+	storeSvc     domain.StoreService
+	pollInterval time.Duration
+}
+
+func NewStreamOrderEvents(eventDAO dao.EventDAO, customerSvc domain.CustomerService, storeSvc domain.StoreService, pollInterval time.Duration) *StreamOrderEvents {
+	return &StreamOrderEvents{
+		eventDAO:     eventDAO,
+		customerSvc:  customerSvc,
+		storeSvc:     storeSvc,
+		pollInterval: pollInterval,
+	}
+}
+
+// Exec polls the events table and writes the order events of the user, as customer or store owner,
+// until the context is done or the writer fails
+func (s *StreamOrderEvents) Exec(ctx context.Context, req StreamOrderEventsReq, writer OrderStreamWriter) error {
+	slog.InfoContext(ctx, "stream order events started", "req", req)
+
+	recipient := &streamRecipient{
+		userID:   req.UserID,
+		storeSvc: s.storeSvc,
+		stores:   map[string]bool{},
+	}
+
+	customer, err := s.customerSvc.FindByUserID(ctx, req.UserID)
+	if err == nil {
+		recipient.customerID = customer.ID
+	} else {
+		slog.WarnContext(ctx, "find customer by user id failed, streaming store events only", "error", err.Error())
+	}
+
+	cursor, err := s.findCursor(ctx, req.LastEventID)
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		events, err := findLocalEvents(ctx, s.eventDAO, eventsQuery{After: cursor, Types: streamedEventTypes, Limit: streamBatchSize})
+		if err != nil {
+			slog.ErrorContext(ctx, "find events failed", "error", err.Error())
+			return err
+		}
+
+		for _, event := range events {
+			cursor = event.ID
+
+			if !recipient.receives(ctx, event) {
+				continue
+			}
+
+			if err := writer.Send(OrderStreamEvent{ID: event.ID, Type: string(event.Type), Data: event.Data}); err != nil {
+				return err
+			}
+		}
+
+		if len(events) == streamBatchSize {
+			continue
+		}
+
+		if err := writer.Heartbeat(); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			slog.InfoContext(ctx, "stream order events finished", "user_id", req.UserID)
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// findCursor returns the ID of the last event received by the client, or of the last event visible for
+// a new stream, empty when there are no events yet
+func (s *StreamOrderEvents) findCursor(ctx context.Context, lastEventID string) (string, error) {
+	if lastEventID != "" {
+		return lastEventID, nil
+	}
+
+	event, err := s.eventDAO.FindOne(ctx, visibleEventsWhere, "transaction_id DESC, position DESC LIMIT 1")
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "find last event failed", "error", err.Error())
+		return "", err
+	}
+
+	return event.ID, nil
+}
+
+// streamRecipient decides which order events a user receives, caching the stores the user owns
+type streamRecipient struct {
+	userID     string
+	customerID string
+	storeSvc   domain.StoreService
+	stores     map[string]bool
+}
+
+func (r *streamRecipient) receives(ctx context.Context, event domain.Event) bool {
+	var data struct {
+		CustomerID string `json:"customer_id"`
+		StoreID    string `json:"store_id"`
+	}
+	if err := json.Unmarshal(event.Data, &data); err != nil {
+		return false
+	}
+
+	if r.customerID != "" && data.CustomerID == r.customerID {
+		return true
+	}
+
+	if data.StoreID == "" {
+		return false
+	}
+
+	owner, ok := r.stores[data.StoreID]
+	if !ok {
+		store, err := r.storeSvc.FindByID(ctx, data.StoreID)
+		if err != nil {
+			slog.WarnContext(ctx, "find store failed", "store_id", data.StoreID, "error", err.Error())
+			return false
+		}
+
+		owner = store.UserID == r.userID
+		r.stores[data.StoreID] = owner
+	}
+
+	return owner
+}
@@ -0,0 +1,51 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"ichibuy/order/internal/domain"
+	"ichibuy/order/internal/domain/dao"
+)
+
+type UpdateCartLineReq struct {
+	UserID    string
+	ProductID string
+	Quantity  int
+}
+
+type UpdateCartLine struct {
+	cartDAO dao.CartDAO
+	nextID  domain.NextID
+	ttl     time.Duration
+}
+
+func NewUpdateCartLine(cartDAO dao.CartDAO, nextID domain.NextID, ttl time.Duration) *UpdateCartLine {
+	return &UpdateCartLine{
+		cartDAO: cartDAO,
+		nextID:  nextID,
+		ttl:     ttl,
+	}
+}
+
+// Exec sets the quantity of a cart line, a zero quantity removes it
+func (s *UpdateCartLine) Exec(ctx context.Context, req UpdateCartLineReq) (*GetCartResp, error) {
+	slog.InfoContext(ctx, "update cart line started", "req", req)
+	cart, err := findOrNewCart(ctx, s.cartDAO, s.nextID, req.UserID, s.ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cart.UpdateLine(req.ProductID, req.Quantity); err != nil {
+		slog.ErrorContext(ctx, "update cart line domain failed", "error", err.Error())
+		return nil, err
+	}
+
+	if err := saveCart(ctx, s.cartDAO, cart, s.ttl); err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "update cart line finished", "cart_id", cart.GetID())
+	return mapCartToResp(cart)
+}
@@ -0,0 +1,60 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+
+	"ichibuy/order/internal/domain"
+	"ichibuy/order/internal/domain/dao"
+)
+
+type UpdateOrderFulfillmentReq struct {
+	ID     string
+	Status string
+	UserID string
+}
+
+type UpdateOrderFulfillment struct {
+	orderDAO dao.OrderDAO
+	storeSvc domain.StoreService
+	eventBus domain.EventBus
+}
+
+func NewUpdateOrderFulfillment(orderDAO dao.OrderDAO, storeSvc domain.StoreService, eventBus domain.EventBus) *UpdateOrderFulfillment {
+	return &UpdateOrderFulfillment{
+		orderDAO: orderDAO,
+		storeSvc: storeSvc,
+		eventBus: eventBus,
+	}
+}
+
+// Exec moves the fulfillment of an order of the store owner to the given status
+func (s *UpdateOrderFulfillment) Exec(ctx context.Context, req UpdateOrderFulfillmentReq) (*FulfillmentDTO, error) {
+	slog.InfoContext(ctx, "update order fulfillment started", "req", req)
+
+	order, err := findStoreOrder(ctx, s.orderDAO, s.storeSvc, req.ID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := order.UpdateFulfillment(domain.FulfillmentStatus(req.Status)); err != nil {
+		slog.ErrorContext(ctx, "update order fulfillment domain failed", "error", err.Error())
+		return nil, err
+	}
+
+	err = s.orderDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := s.orderDAO.Update(ctx, order); err != nil {
+			return err
+		}
+
+		return s.eventBus.Publish(ctx, order.PullEvents()...)
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "save order fulfillment failed", "error", err.Error())
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "update order fulfillment finished", "order_id", order.GetID())
+	resp := mapOrderFulfillmentToDTO(order)
+	return &resp, nil
+}
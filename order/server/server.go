@@ -25,7 +25,7 @@ import (
 
 func New(cfg config.Config, db *sql.DB) *gin.Engine {
 	router := gin.Default()
-	router.Use(middlewares.UseCORS())
+	router.Use(middlewares.UseCORS(), middlewares.CorrelationID())
 
 	httpClient := &http.Client{
 		Timeout: 10 * time.Second,
@@ -45,32 +45,127 @@ func New(cfg config.Config, db *sql.DB) *gin.Engine {
 	// DAOs
 	eventDAO := postgres.NewEventDAO(db)
 	orderDAO := postgres.NewOrderDAO(db)
+	orderStatusChangeDAO := postgres.NewOrderStatusChangeDAO(db)
+	storeTaxRateDAO := postgres.NewStoreTaxRateDAO(db)
+	storeFulfillmentDAO := postgres.NewStoreFulfillmentDAO(db)
+	promotionDAO := postgres.NewPromotionDAO(db)
+	promotionRedemptionDAO := postgres.NewPromotionRedemptionDAO(db)
+	cartDAO := postgres.NewCartDAO(db)
+	paymentDAO := postgres.NewPaymentDAO(db)
+	idempotencyKeyDAO := postgres.NewIdempotencyKeyDAO(db)
+	catalogStoreDAO := postgres.NewCatalogStoreDAO(db)
+	catalogProductDAO := postgres.NewCatalogProductDAO(db)
 
 	eventBus := events.NewBus(eventDAO)
+	idempotencyMiddleware := middlewares.NewIdempotencyMiddleware(idempotencyKeyDAO, cfg.GetIdempotencyKeyTTL(), cfg.GetIdempotencyKeyLease())
 	nextIDFunc := uuid.NewString
 
 	// Domain Services
 	customerSvc := infraServices.NewCustomerService(storeClient)
+	storeSvc := infraServices.NewStoreService(storeClient)
+	// orders and carts read the stores and products from the catalog kept from the store events
+	catalogStoreSvc := infraServices.NewCatalogStoreService(catalogStoreDAO, storeSvc)
+	productSvc := infraServices.NewCatalogProductService(catalogProductDAO, infraServices.NewProductService(storeClient))
+	storeAvailabilitySvc := infraServices.NewCatalogStoreAvailabilityService(catalogStoreDAO, infraServices.NewStoreAvailabilityService(httpClient, cfg.StoreBaseURL))
+	customerAddressSvc := infraServices.NewCustomerAddressService(httpClient, cfg.StoreBaseURL)
+	paymentGateway, err := infraServices.NewPaymentGateway(cfg.PaymentGateway, cfg.PaymentWebhookSecret)
+	if err != nil {
+		panic(err)
+	}
 
 	// Factories
 	orderFactory := domain.NewOrderFactory(customerSvc, nextIDFunc)
+	promotionEngine := domain.NewPromotionEngine()
 
 	// Use-Cases
-	createOrderService := services.NewCreateOrder(orderDAO, eventBus, nextIDFunc, orderFactory)
+	createOrderService := services.NewCreateOrder(orderDAO, orderStatusChangeDAO, storeTaxRateDAO, storeFulfillmentDAO, promotionDAO, promotionRedemptionDAO, eventBus, nextIDFunc, orderFactory, promotionEngine, catalogStoreSvc, productSvc, storeAvailabilitySvc, customerSvc, customerAddressSvc)
+	createPaymentService := services.NewCreatePayment(orderDAO, paymentDAO, customerSvc, paymentGateway, nextIDFunc)
+	handlePaymentWebhookService := services.NewHandlePaymentWebhook(orderDAO, orderStatusChangeDAO, paymentDAO, paymentGateway, eventBus, nextIDFunc)
+	cancelOrderService := services.NewCancelOrder(orderDAO, orderStatusChangeDAO, paymentDAO, customerSvc, paymentGateway, eventBus, nextIDFunc)
+	acceptOrderService := services.NewAcceptOrder(orderDAO, orderStatusChangeDAO, storeSvc, eventBus, nextIDFunc)
+	rejectOrderService := services.NewRejectOrder(orderDAO, orderStatusChangeDAO, paymentDAO, storeSvc, paymentGateway, eventBus, nextIDFunc)
+	updateOrderFulfillmentService := services.NewUpdateOrderFulfillment(orderDAO, storeSvc, eventBus)
+	getOrderTimelineService := services.NewGetOrderTimeline(orderDAO, orderStatusChangeDAO, customerSvc, storeSvc)
+	getOrderByCodeService := services.NewGetOrderByCode(orderDAO, customerSvc, storeSvc)
+	checkoutService := services.NewCheckout(createOrderService, nextIDFunc)
+	getCheckoutService := services.NewGetCheckout(orderDAO, customerSvc)
+	streamOrderEventsService := services.NewStreamOrderEvents(eventDAO, customerSvc, storeSvc, cfg.GetStreamPollInterval())
+	listStoreOrdersService := services.NewListStoreOrders(orderDAO, storeSvc)
+	countStoreOpenOrdersService := services.NewCountStoreOpenOrders(orderDAO, storeSvc)
+	setStoreTaxRateService := services.NewSetStoreTaxRate(storeTaxRateDAO, storeSvc)
+	getStoreTaxRateService := services.NewGetStoreTaxRate(storeTaxRateDAO)
+	setStoreFulfillmentService := services.NewSetStoreFulfillment(storeFulfillmentDAO, storeSvc)
+	getStoreFulfillmentService := services.NewGetStoreFulfillment(storeFulfillmentDAO)
+	createPromotionService := services.NewCreatePromotion(promotionDAO, storeSvc, nextIDFunc)
+	listPromotionsService := services.NewListPromotions(promotionDAO, storeSvc)
+	deactivatePromotionService := services.NewDeactivatePromotion(promotionDAO, storeSvc)
+	cartTTL := cfg.GetCartTTL()
+	getCartService := services.NewGetCart(cartDAO, productSvc, nextIDFunc, cartTTL)
+	addCartLineService := services.NewAddCartLine(cartDAO, productSvc, nextIDFunc, cartTTL)
+	updateCartLineService := services.NewUpdateCartLine(cartDAO, nextIDFunc, cartTTL)
+	removeCartLineService := services.NewRemoveCartLine(cartDAO, nextIDFunc, cartTTL)
+	clearCartService := services.NewClearCart(cartDAO)
+	checkoutCartService := services.NewCheckoutCart(cartDAO, productSvc, nextIDFunc, cartTTL, createOrderService)
+	listEventsService := services.NewListEvents(eventDAO)
+	listEventSchemasService := services.NewListEventSchemas(domain.EventSchemas)
 
 	// Routes
+	// payment providers sign their webhooks instead of sending a token
+	router.POST("/api/v1/payments/webhook", handlers.HandlePaymentWebhook(handlePaymentWebhookService))
+	// the events feed is read by the other services with a shared token
+	router.GET("/api/v1/events", middlewares.RequireEventsToken(cfg.EventsAPIToken), handlers.ListEvents(listEventsService))
+	router.GET("/api/v1/events/schemas", middlewares.RequireEventsToken(cfg.EventsAPIToken), handlers.ListEventSchemas(listEventSchemasService))
+
 	api := router.Group("/api/v1")
-	api.Use(jwtMiddleware.ValidateToken())
+	api.Use(jwtMiddleware.ValidateToken(), idempotencyMiddleware.Handle())
 	{
 		orders := api.Group("/orders")
 		{
 			orders.POST("", handlers.CreateOrder(createOrderService))
+			orders.GET("/stream", handlers.StreamOrderEvents(streamOrderEventsService))
+			orders.GET("/code/:code", handlers.GetOrderByCode(getOrderByCodeService))
+			orders.POST("/:id/payments", handlers.CreatePayment(createPaymentService))
+			orders.POST("/:id/cancel", handlers.CancelOrder(cancelOrderService))
+			orders.POST("/:id/accept", handlers.AcceptOrder(acceptOrderService))
+			orders.POST("/:id/reject", handlers.RejectOrder(rejectOrderService))
+			orders.PUT("/:id/fulfillment", handlers.UpdateOrderFulfillment(updateOrderFulfillmentService))
+			orders.GET("/:id/timeline", handlers.GetOrderTimeline(getOrderTimelineService))
 			// list my orders
-			// cancel order (by me)
-			// accept order (by the store owner)
-			// reject order (by the store owner)
 
 		}
+
+		checkouts := api.Group("/checkouts")
+		{
+			checkouts.POST("", handlers.Checkout(checkoutService))
+			checkouts.GET("/:id", handlers.GetCheckout(getCheckoutService))
+		}
+
+		stores := api.Group("/stores")
+		{
+			stores.GET("/:storeId/orders", handlers.ListStoreOrders(listStoreOrdersService))
+			stores.GET("/:storeId/orders/open-count", handlers.CountStoreOpenOrders(countStoreOpenOrdersService))
+			stores.PUT("/:storeId/tax-rate", handlers.SetStoreTaxRate(setStoreTaxRateService))
+			stores.GET("/:storeId/tax-rate", handlers.GetStoreTaxRate(getStoreTaxRateService))
+			stores.PUT("/:storeId/fulfillment", handlers.SetStoreFulfillment(setStoreFulfillmentService))
+			stores.GET("/:storeId/fulfillment", handlers.GetStoreFulfillment(getStoreFulfillmentService))
+			stores.POST("/:storeId/promotions", handlers.CreatePromotion(createPromotionService))
+			stores.GET("/:storeId/promotions", handlers.ListPromotions(listPromotionsService))
+		}
+
+		promotions := api.Group("/promotions")
+		{
+			promotions.POST("/:id/deactivate", handlers.DeactivatePromotion(deactivatePromotionService))
+		}
+
+		cart := api.Group("/cart")
+		{
+			cart.GET("", handlers.GetCart(getCartService))
+			cart.DELETE("", handlers.ClearCart(clearCartService))
+			cart.POST("/lines", handlers.AddCartLine(addCartLineService))
+			cart.PUT("/lines/:productId", handlers.UpdateCartLine(updateCartLineService))
+			cart.DELETE("/lines/:productId", handlers.RemoveCartLine(removeCartLineService))
+			cart.POST("/checkout", handlers.CheckoutCart(checkoutCartService))
+		}
 	}
 
 	router.GET("/api/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
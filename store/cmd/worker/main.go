@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+
+	fstorageHTTP "github.com/Jibaru/ichibuy/api-client/go/fstorage"
+
+	"ichibuy/store/config"
+	"ichibuy/store/db"
+	"ichibuy/store/internal/domain"
+	"ichibuy/store/internal/infra/events"
+	"ichibuy/store/internal/infra/persistence/postgres"
+	infraServices "ichibuy/store/internal/infra/services"
+	"ichibuy/store/internal/services"
+	sharedCtx "ichibuy/store/internal/shared/context"
+)
+
+// Worker runs the background jobs of the store service (product imports, purge of deleted records and of expired idempotency keys,
+// provisioning of the customers of new users, webhook deliveries, forwarding of the events to the message broker)
+func main() {
+	cfg := config.Load()
+	db, err := db.New(cfg.PostgresURI)
+	if err != nil {
+		panic(err)
+	}
+	defer db.Close()
+
+	interval := cfg.GetWorkerInterval()
+
+	httpClient := &http.Client{
+		Timeout: 30 * time.Second,
+	}
+
+	fstorageClient := fstorageHTTP.NewAPIClient(&fstorageHTTP.Configuration{
+		BasePath:   cfg.FStorageBaseURL,
+		HTTPClient: httpClient,
+	})
+
+	// DAOs
+	eventDAO := postgres.NewEventDAO(db)
+	storeDAO := postgres.NewStoreDAO(db)
+	customerDAO := postgres.NewCustomerDAO(db)
+	productDAO := postgres.NewProductDAO(db)
+	importJobDAO := postgres.NewImportJobDAO(db)
+	idempotencyKeyDAO := postgres.NewIdempotencyKeyDAO(db)
+	eventCheckpointDAO := postgres.NewEventCheckpointDAO(db)
+	verificationCodeDAO := postgres.NewVerificationCodeDAO(db)
+	webhookSubscriptionDAO := postgres.NewWebhookSubscriptionDAO(db)
+	webhookDeliveryDAO := postgres.NewWebhookDeliveryDAO(db)
+	webhookDeliveryAttemptDAO := postgres.NewWebhookDeliveryAttemptDAO(db)
+
+	eventBus := events.NewBus(eventDAO)
+	nextIDFunc := uuid.NewString
+
+	// Domain ports
+	storageSvc := infraServices.NewStorageService(fstorageClient)
+	fileFetcher := infraServices.NewFileFetcher(infraServices.NewPublicHTTPClient(30 * time.Second))
+	authEventsSvc := infraServices.NewEventsService(httpClient, cfg.AuthBaseURL+"/api/v1/auth/events", cfg.AuthEventsAPIToken)
+	webhookClient := infraServices.NewWebhookClient(cfg.GetWebhookTimeout())
+
+	var orderEventsSvc domain.EventsService
+	if cfg.OrderEventsAPIToken != "" {
+		orderEventsSvc = infraServices.NewEventsService(httpClient, cfg.OrderBaseURL+"/api/v1/events", cfg.OrderEventsAPIToken)
+	}
+
+	var broker domain.MessageBroker
+	if cfg.EventBroker != "" {
+		broker, err = events.NewBroker(context.Background(), cfg.EventBroker, cfg.EventBrokerURL, cfg.GetEventRedeliveryDelay())
+		if err != nil {
+			panic(err)
+		}
+		defer broker.Close()
+	}
+
+	// Factories
+	productFactory := domain.NewProductFactory(storageSvc, nextIDFunc)
+
+	// Jobs
+	processImportJobsService := services.NewProcessImportJobs(importJobDAO, productDAO, storeDAO, eventBus, nextIDFunc, productFactory, fileFetcher, storageSvc)
+	purgeDeletedService := services.NewPurgeDeleted(storeDAO, productDAO, customerDAO, eventBus, storageSvc, cfg.GetSoftDeleteRetention())
+	purgeExpiredIdempotencyKeysService := services.NewPurgeExpiredIdempotencyKeys(idempotencyKeyDAO)
+	purgeExpiredVerificationCodesService := services.NewPurgeExpiredVerificationCodes(verificationCodeDAO)
+	consumeAuthEventsService := services.NewConsumeAuthEvents(eventCheckpointDAO, customerDAO, eventBus, nextIDFunc, authEventsSvc)
+	queueWebhookDeliveriesService := services.NewQueueWebhookDeliveries(eventCheckpointDAO, eventDAO, webhookSubscriptionDAO, webhookDeliveryDAO, nextIDFunc, orderEventsSvc)
+	sendWebhookDeliveriesService := services.NewSendWebhookDeliveries(webhookSubscriptionDAO, webhookDeliveryDAO, webhookDeliveryAttemptDAO, webhookClient, nextIDFunc)
+
+	var forwardEventsService *services.ForwardEvents
+	if broker != nil {
+		forwardEventsService = services.NewForwardEvents(eventCheckpointDAO, eventDAO, broker)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	ctx = context.WithValue(ctx, sharedCtx.APITokenKey, cfg.FStorageAPIToken)
+
+	slog.InfoContext(ctx, "worker started", "interval", interval.String())
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := processImportJobsService.Exec(ctx); err != nil {
+			slog.ErrorContext(ctx, "process import jobs failed", "error", err.Error())
+		}
+
+		if err := purgeDeletedService.Exec(ctx); err != nil {
+			slog.ErrorContext(ctx, "purge deleted failed", "error", err.Error())
+		}
+
+		if err := purgeExpiredIdempotencyKeysService.Exec(ctx); err != nil {
+			slog.ErrorContext(ctx, "purge expired idempotency keys failed", "error", err.Error())
+		}
+
+		if err := purgeExpiredVerificationCodesService.Exec(ctx); err != nil {
+			slog.ErrorContext(ctx, "purge expired verification codes failed", "error", err.Error())
+		}
+
+		if err := consumeAuthEventsService.Exec(ctx); err != nil {
+			slog.ErrorContext(ctx, "consume auth events failed", "error", err.Error())
+		}
+
+		if err := queueWebhookDeliveriesService.Exec(ctx); err != nil {
+			slog.ErrorContext(ctx, "queue webhook deliveries failed", "error", err.Error())
+		}
+
+		if err := sendWebhookDeliveriesService.Exec(ctx); err != nil {
+			slog.ErrorContext(ctx, "send webhook deliveries failed", "error", err.Error())
+		}
+
+		if forwardEventsService != nil {
+			if err := forwardEventsService.Exec(ctx); err != nil {
+				slog.ErrorContext(ctx, "forward events failed", "error", err.Error())
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			slog.InfoContext(ctx, "worker stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -5,15 +5,32 @@ import (
 	"log"
 	"os"
 	"reflect"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
 type Config struct {
-	APIPort         string `env:"API_PORT"`
-	PostgresURI     string `env:"POSTGRES_URI"`
-	AuthBaseURL     string `env:"AUTH_BASE_URL"`
-	FStorageBaseURL string `env:"FSTORAGE_BASE_URL"`
+	APIPort             string `env:"API_PORT"`
+	PostgresURI         string `env:"POSTGRES_URI"`
+	AuthBaseURL         string `env:"AUTH_BASE_URL"`
+	FStorageBaseURL     string `env:"FSTORAGE_BASE_URL"`
+	OrderBaseURL        string `env:"ORDER_BASE_URL"`
+	ExchangeRatesFile   string `env:"EXCHANGE_RATES_FILE"`
+	FStorageAPIToken    string `env:"FSTORAGE_API_TOKEN"`
+	WorkerInterval      string `env:"WORKER_INTERVAL"`
+	SoftDeleteRetention string `env:"SOFT_DELETE_RETENTION"`
+	IdempotencyKeyTTL   string `env:"IDEMPOTENCY_KEY_TTL"`
+	IdempotencyKeyLease string `env:"IDEMPOTENCY_KEY_LEASE"`
+	AuthEventsAPIToken  string `env:"AUTH_EVENTS_API_TOKEN"`
+	VerificationCodeTTL string `env:"VERIFICATION_CODE_TTL"`
+	NotificationsFile   string `env:"NOTIFICATIONS_FILE"`
+	EventsAPIToken      string `env:"EVENTS_API_TOKEN"`
+	OrderEventsAPIToken string `env:"ORDER_EVENTS_API_TOKEN"`
+	WebhookTimeout      string `env:"WEBHOOK_TIMEOUT"`
+	EventBroker         string `env:"EVENT_BROKER"`
+	EventBrokerURL      string `env:"EVENT_BROKER_URL"`
+	EventRedelivery     string `env:"EVENT_REDELIVERY_DELAY"`
 }
 
 func Load() Config {
@@ -45,3 +62,46 @@ func loadFromEnv(cfg *Config) {
 		}
 	}
 }
+
+func (c Config) GetWorkerInterval() time.Duration {
+	return parseDuration(c.WorkerInterval, 30*time.Second)
+}
+
+func (c Config) GetSoftDeleteRetention() time.Duration {
+	return parseDuration(c.SoftDeleteRetention, 30*24*time.Hour)
+}
+
+// GetIdempotencyKeyLease is how long a request holds its idempotency key before a retry can take it over
+func (c Config) GetIdempotencyKeyLease() time.Duration {
+	return parseDuration(c.IdempotencyKeyLease, time.Minute)
+}
+
+func (c Config) GetIdempotencyKeyTTL() time.Duration {
+	return parseDuration(c.IdempotencyKeyTTL, 24*time.Hour)
+}
+
+func (c Config) GetVerificationCodeTTL() time.Duration {
+	return parseDuration(c.VerificationCodeTTL, 10*time.Minute)
+}
+
+func (c Config) GetEventRedeliveryDelay() time.Duration {
+	return parseDuration(c.EventRedelivery, 30*time.Second)
+}
+
+func (c Config) GetWebhookTimeout() time.Duration {
+	return parseDuration(c.WebhookTimeout, 10*time.Second)
+}
+
+func parseDuration(value string, fallback time.Duration) time.Duration {
+	if value == "" {
+		return fallback
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		log.Printf("invalid duration %q, using %s", value, fallback)
+		return fallback
+	}
+
+	return duration
+}
@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE stores ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE products ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE customers ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX idx_stores_deleted_at ON stores(deleted_at);
+CREATE INDEX idx_products_deleted_at ON products(deleted_at);
+CREATE INDEX idx_customers_deleted_at ON customers(deleted_at);
+
+-- products must be purged explicitly so their images and events are handled
+ALTER TABLE products DROP CONSTRAINT fk_store;
+ALTER TABLE products ADD CONSTRAINT fk_store FOREIGN KEY(store_id) REFERENCES stores(id) ON DELETE RESTRICT;
+
@@ -0,0 +1,2 @@
+-- +goose Up
+ALTER TABLE stores ADD COLUMN currencies JSONB NOT NULL DEFAULT '["USD", "PEN"]';
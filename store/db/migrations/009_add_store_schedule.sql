@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE stores ADD COLUMN timezone VARCHAR(64) NOT NULL DEFAULT 'UTC';
+ALTER TABLE stores ADD COLUMN opening_hours JSONB NOT NULL DEFAULT '[]';
+ALTER TABLE stores ADD COLUMN schedule_exceptions JSONB NOT NULL DEFAULT '[]';
+ALTER TABLE stores ADD COLUMN allows_pre_orders BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,21 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS customer_addresses (
+    id UUID PRIMARY KEY,
+    customer_id UUID NOT NULL,
+    label VARCHAR(50) NOT NULL,
+    recipient VARCHAR(100),
+    line1 VARCHAR(200) NOT NULL,
+    line2 VARCHAR(200),
+    city VARCHAR(100) NOT NULL,
+    region VARCHAR(100),
+    postal_code VARCHAR(20),
+    country CHAR(2) NOT NULL,
+    lat DOUBLE PRECISION,
+    lng DOUBLE PRECISION,
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    CONSTRAINT fk_customer FOREIGN KEY(customer_id) REFERENCES customers(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_customer_addresses_customer_id ON customer_addresses(customer_id, created_at);
@@ -0,0 +1,12 @@
+-- +goose Up
+-- keep the oldest active customer of each user, the duplicates are soft deleted so they can still be
+-- restored or purged
+UPDATE customers SET deleted_at = NOW(), updated_at = NOW()
+WHERE deleted_at IS NULL AND id NOT IN (
+    SELECT DISTINCT ON (user_id) id FROM customers
+    WHERE deleted_at IS NULL
+    ORDER BY user_id, created_at ASC, id ASC
+);
+
+DROP INDEX IF EXISTS idx_customers_user_id;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_customers_user_id_active ON customers(user_id) WHERE deleted_at IS NULL;
@@ -0,0 +1,10 @@
+package domain
+
+// UserCreated is published by the auth service when a user signs in for the first time
+const UserCreated EventType = "UserCreated"
+
+type UserEventData struct {
+	ID       string `json:"id"`
+	Email    string `json:"email"`
+	Username string `json:"username"`
+}
@@ -1,24 +1,35 @@
 package domain
 
 import (
-	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 )
 
-type Customer struct {
-	ID        string    `sql:"id,primary"`
-	FirstName string    `sql:"first_name"`
-	LastName  string    `sql:"last_name"`
-	Email     *string   `sql:"email"`
-	Phone     *string   `sql:"phone"`
-	UserID    string    `sql:"user_id"`
-	CreatedAt time.Time `sql:"created_at"`
-	UpdatedAt time.Time `sql:"updated_at"`
+// ErrCustomerAlreadyExists is returned when a user already has an active customer, users have one customer
+var ErrCustomerAlreadyExists = errors.New("user already has a customer")
 
+type Customer struct {
+	ID        string     `sql:"id,primary"`
+	FirstName string     `sql:"first_name"`
+	LastName  string     `sql:"last_name"`
+	Email     *string    `sql:"email"`
+	Phone     *string    `sql:"phone"`
+	UserID    string     `sql:"user_id"`
+	CreatedAt time.Time  `sql:"created_at"`
+	UpdatedAt time.Time  `sql:"updated_at"`
+	DeletedAt *time.Time `sql:"deleted_at"`
+
+	EmailVerifiedAt *time.Time `sql:"email_verified_at"`
+	PhoneVerifiedAt *time.Time `sql:"phone_verified_at"`
+
+	addresses []*CustomerAddress
 	Entity
 }
 
+const maxCustomerAddresses = 20
+
 func NewCustomer(id, firstName, lastName string, email *string, phone *string, userID string) (*Customer, error) {
 	if firstName == "" {
 		return nil, fmt.Errorf("firstName cannot be empty")
@@ -50,6 +61,29 @@ func NewCustomer(id, firstName, lastName string, email *string, phone *string, u
 		}
 	}
 
+	return newCustomer(id, firstName, lastName, email, phone, userID), nil
+}
+
+// NewCustomerFromUser provisions the customer of a new user of the auth service. The username is split
+// into first and last name, the last name is empty when the username has a single word.
+func NewCustomerFromUser(id, userID, email, username string) (*Customer, error) {
+	names := strings.Fields(username)
+	if len(names) == 0 {
+		return nil, fmt.Errorf("username cannot be empty")
+	}
+
+	var customerEmail *string
+	if _, err := NewEmail(email); err == nil {
+		customerEmail = &email
+	}
+
+	firstName := truncateName(names[0])
+	lastName := truncateName(strings.Join(names[1:], " "))
+
+	return newCustomer(id, firstName, lastName, customerEmail, nil, userID), nil
+}
+
+func newCustomer(id, firstName, lastName string, email *string, phone *string, userID string) *Customer {
 	now := time.Now().UTC()
 
 	customer := &Customer{
@@ -63,17 +97,20 @@ func NewCustomer(id, firstName, lastName string, email *string, phone *string, u
 		UpdatedAt: now,
 	}
 
-	data, _ := json.Marshal(customer.createEventData())
-	event := Event{
-		ID:        fmt.Sprintf("%s_%v", customer.GetID(), customer.GetCreatedAt().Unix()),
-		Type:      CustomerCreated,
-		Data:      data,
-		Timestamp: customer.GetCreatedAt(),
-	}
+	event := NewEvent(CustomerCreated, CustomerAggregate, customer.GetID(), customer.createEventData(), customer.GetCreatedAt())
 
 	customer.events = append(customer.events, event)
 
-	return customer, nil
+	return customer
+}
+
+// truncateName keeps the first 50 characters of a name
+func truncateName(name string) string {
+	runes := []rune(name)
+	if len(runes) > 50 {
+		return string(runes[:50])
+	}
+	return name
 }
 
 func (c *Customer) Update(firstName, lastName string, email *string, phone *string, userID string) error {
@@ -111,19 +148,21 @@ func (c *Customer) Update(firstName, lastName string, email *string, phone *stri
 		}
 	}
 
+	// a new email or phone has to be verified again
+	if !sameOptionalString(c.Email, email) {
+		c.EmailVerifiedAt = nil
+	}
+	if !sameOptionalString(c.Phone, phone) {
+		c.PhoneVerifiedAt = nil
+	}
+
 	c.FirstName = firstName
 	c.LastName = lastName
 	c.Email = email
 	c.Phone = phone
 	c.UpdatedAt = time.Now().UTC()
 
-	data, _ := json.Marshal(c.createEventData())
-	event := Event{
-		ID:        fmt.Sprintf("%s_%v", c.GetID(), c.GetUpdatedAt().Unix()),
-		Type:      CustomerUpdated,
-		Data:      data,
-		Timestamp: c.GetUpdatedAt(),
-	}
+	event := NewEvent(CustomerUpdated, CustomerAggregate, c.GetID(), c.createEventData(), c.GetUpdatedAt())
 
 	c.events = append(c.events, event)
 
@@ -141,6 +180,23 @@ func (c *Customer) createEventData() CustomerEventData {
 		phoneStr = &phoneValue
 	}
 
+	addresses := make([]CustomerAddressEventData, len(c.addresses))
+	for i, address := range c.addresses {
+		addresses[i] = CustomerAddressEventData{
+			ID:         address.GetID(),
+			Label:      address.GetLabel(),
+			Recipient:  address.GetRecipient(),
+			Line1:      address.GetLine1(),
+			Line2:      address.GetLine2(),
+			City:       address.GetCity(),
+			Region:     address.GetRegion(),
+			PostalCode: address.GetPostalCode(),
+			Country:    address.GetCountry(),
+			Location:   address.Location(),
+			IsDefault:  address.GetIsDefault(),
+		}
+	}
+
 	return CustomerEventData{
 		ID:        c.GetID(),
 		FirstName: c.GetFirstName(),
@@ -150,29 +206,230 @@ func (c *Customer) createEventData() CustomerEventData {
 		UserID:    c.GetUserID(),
 		CreatedAt: c.GetCreatedAt(),
 		UpdatedAt: c.GetUpdatedAt(),
+		DeletedAt: c.GetDeletedAt(),
+		Addresses: addresses,
+
+		EmailVerifiedAt: c.GetEmailVerifiedAt(),
+		PhoneVerifiedAt: c.GetPhoneVerifiedAt(),
 	}
 }
 
-func (c *Customer) PrepareDelete() {
-	data, _ := json.Marshal(c.createEventData())
+// LoadAddresses sets the address book read from storage, the customer events carry it
+func (c *Customer) LoadAddresses(addresses []*CustomerAddress) {
+	c.addresses = addresses
+}
+
+// AddAddress adds an address to the address book, the first address is the default one
+func (c *Customer) AddAddress(id string, data AddressData, isDefault bool, userID string) (*CustomerAddress, error) {
+	if err := c.CheckOwner(userID); err != nil {
+		return nil, err
+	}
+
+	if len(c.addresses) >= maxCustomerAddresses {
+		return nil, fmt.Errorf("customer cannot have more than %d addresses", maxCustomerAddresses)
+	}
 
-	event := Event{
-		ID:        fmt.Sprintf("%s_%v_delete", c.GetID(), c.GetUpdatedAt().Unix()),
-		Type:      CustomerDeleted,
-		Data:      data,
-		Timestamp: c.GetUpdatedAt(),
+	address, err := newCustomerAddress(id, c.ID, data)
+	if err != nil {
+		return nil, err
 	}
 
+	c.addresses = append(c.addresses, address)
+	if isDefault || len(c.addresses) == 1 {
+		c.setDefaultAddress(address.GetID())
+	}
+
+	c.addressesChanged()
+	return address, nil
+}
+
+// UpdateAddress replaces the data of an address, the default address stays default until another one is
+func (c *Customer) UpdateAddress(addressID string, data AddressData, isDefault bool, userID string) (*CustomerAddress, error) {
+	if err := c.CheckOwner(userID); err != nil {
+		return nil, err
+	}
+
+	address, err := c.FindAddress(addressID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := address.update(data); err != nil {
+		return nil, err
+	}
+
+	if isDefault {
+		c.setDefaultAddress(address.GetID())
+	}
+
+	c.addressesChanged()
+	return address, nil
+}
+
+// RemoveAddress removes an address from the address book, the oldest address left becomes the default
+// one when the default is removed
+func (c *Customer) RemoveAddress(addressID string, userID string) error {
+	if err := c.CheckOwner(userID); err != nil {
+		return err
+	}
+
+	address, err := c.FindAddress(addressID)
+	if err != nil {
+		return err
+	}
+
+	addresses := make([]*CustomerAddress, 0, len(c.addresses)-1)
+	for _, a := range c.addresses {
+		if a.GetID() != addressID {
+			addresses = append(addresses, a)
+		}
+	}
+	c.addresses = addresses
+
+	if address.GetIsDefault() && len(c.addresses) > 0 {
+		c.setDefaultAddress(c.addresses[0].GetID())
+	}
+
+	c.addressesChanged()
+	return nil
+}
+
+func (c *Customer) FindAddress(addressID string) (*CustomerAddress, error) {
+	for _, address := range c.addresses {
+		if address.GetID() == addressID {
+			return address, nil
+		}
+	}
+	return nil, fmt.Errorf("address %s not found", addressID)
+}
+
+// CheckOwner returns an error when the customer does not belong to the given user
+func (c *Customer) CheckOwner(userID string) error {
+	if userID != c.UserID {
+		return fmt.Errorf("user id does not match")
+	}
+	return nil
+}
+
+func (c *Customer) setDefaultAddress(addressID string) {
+	for _, address := range c.addresses {
+		address.IsDefault = address.GetID() == addressID
+	}
+}
+
+func (c *Customer) addressesChanged() {
+	c.UpdatedAt = time.Now().UTC()
+
+	event := NewEvent(CustomerUpdated, CustomerAggregate, c.GetID(), c.createEventData(), c.GetUpdatedAt())
+
+	c.events = append(c.events, event)
+}
+
+// VerificationTarget returns the email or phone to verify, an error when the customer has none or it is
+// already verified
+func (c *Customer) VerificationTarget(channel VerificationChannel) (string, error) {
+	switch channel {
+	case EmailVerificationChannel:
+		if c.Email == nil {
+			return "", fmt.Errorf("customer has no email")
+		}
+		if c.EmailVerifiedAt != nil {
+			return "", fmt.Errorf("email is already verified")
+		}
+		return *c.Email, nil
+	case PhoneVerificationChannel:
+		if c.Phone == nil {
+			return "", fmt.Errorf("customer has no phone")
+		}
+		if c.PhoneVerifiedAt != nil {
+			return "", fmt.Errorf("phone is already verified")
+		}
+		return *c.Phone, nil
+	}
+	return "", fmt.Errorf("invalid verification channel %s", channel)
+}
+
+// MarkVerified records the verification of the email or phone the code was sent to, it fails when the
+// customer changed it after requesting the code
+func (c *Customer) MarkVerified(channel VerificationChannel, target string) error {
+	current, err := c.VerificationTarget(channel)
+	if err != nil {
+		return err
+	}
+
+	if current != target {
+		return fmt.Errorf("%s changed after the verification code was sent", channel)
+	}
+
+	now := time.Now().UTC()
+	if channel == EmailVerificationChannel {
+		c.EmailVerifiedAt = &now
+	} else {
+		c.PhoneVerifiedAt = &now
+	}
+	c.UpdatedAt = now
+
+	event := NewEvent(CustomerUpdated, CustomerAggregate, c.GetID(), c.createEventData(), c.GetUpdatedAt())
+
+	c.events = append(c.events, event)
+	return nil
+}
+
+func sameOptionalString(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// SoftDelete marks the customer as deleted, it can be restored until it is purged
+func (c *Customer) SoftDelete() error {
+	if c.IsDeleted() {
+		return fmt.Errorf("customer is already deleted")
+	}
+
+	now := time.Now().UTC()
+	c.DeletedAt = &now
+	c.UpdatedAt = now
+
+	event := NewEvent(CustomerDeleted, CustomerAggregate, c.GetID(), c.createEventData(), c.GetUpdatedAt())
+
+	c.events = append(c.events, event)
+	return nil
+}
+
+// Restore undoes a soft delete made within the retention window
+func (c *Customer) Restore(retention time.Duration) error {
+	if err := checkRestorable(c.DeletedAt, retention); err != nil {
+		return err
+	}
+
+	c.DeletedAt = nil
+	c.UpdatedAt = time.Now().UTC()
+
+	event := NewEvent(CustomerRestored, CustomerAggregate, c.GetID(), c.createEventData(), c.GetUpdatedAt())
+
 	c.events = append(c.events, event)
+	return nil
+}
+
+func (c *Customer) IsDeleted() bool {
+	return c.DeletedAt != nil
 }
 
 // Getters
-func (c *Customer) GetID() string           { return c.ID }
-func (c *Customer) GetFirstName() string    { return c.FirstName }
-func (c *Customer) GetLastName() string     { return c.LastName }
-func (c *Customer) GetUserID() string       { return c.UserID }
-func (c *Customer) GetCreatedAt() time.Time { return c.CreatedAt }
-func (c *Customer) GetUpdatedAt() time.Time { return c.UpdatedAt }
+func (c *Customer) GetID() string            { return c.ID }
+func (c *Customer) GetFirstName() string     { return c.FirstName }
+func (c *Customer) GetLastName() string      { return c.LastName }
+func (c *Customer) GetUserID() string        { return c.UserID }
+func (c *Customer) GetCreatedAt() time.Time  { return c.CreatedAt }
+func (c *Customer) GetUpdatedAt() time.Time  { return c.UpdatedAt }
+func (c *Customer) GetDeletedAt() *time.Time { return c.DeletedAt }
+
+func (c *Customer) GetEmailVerifiedAt() *time.Time { return c.EmailVerifiedAt }
+func (c *Customer) GetPhoneVerifiedAt() *time.Time { return c.PhoneVerifiedAt }
+
+func (c *Customer) GetAddresses() []*CustomerAddress { return c.addresses }
 
 // GetEmail returns the email value object if set
 func (c *Customer) GetEmail() *Email {
@@ -0,0 +1,153 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var countryCodeRegex = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// CustomerAddress is an address of the address book of a customer, the default one is proposed first
+// when ordering
+type CustomerAddress struct {
+	ID         string    `sql:"id,primary"`
+	CustomerID string    `sql:"customer_id"`
+	Label      string    `sql:"label"`
+	Recipient  *string   `sql:"recipient"`
+	Line1      string    `sql:"line1"`
+	Line2      *string   `sql:"line2"`
+	City       string    `sql:"city"`
+	Region     *string   `sql:"region"`
+	PostalCode *string   `sql:"postal_code"`
+	Country    string    `sql:"country"`
+	Lat        *float64  `sql:"lat"`
+	Lng        *float64  `sql:"lng"`
+	IsDefault  bool      `sql:"is_default"`
+	CreatedAt  time.Time `sql:"created_at"`
+	UpdatedAt  time.Time `sql:"updated_at"`
+}
+
+// AddressData is the data of an address given by the customer
+type AddressData struct {
+	Label      string
+	Recipient  *string
+	Line1      string
+	Line2      *string
+	City       string
+	Region     *string
+	PostalCode *string
+	Country    string // ISO 3166-1 alpha-2
+	Location   *Location
+}
+
+func (d *AddressData) validate() error {
+	d.Label = strings.TrimSpace(d.Label)
+	d.Line1 = strings.TrimSpace(d.Line1)
+	d.City = strings.TrimSpace(d.City)
+	d.Country = strings.ToUpper(strings.TrimSpace(d.Country))
+
+	if d.Label == "" {
+		return fmt.Errorf("label cannot be empty")
+	}
+
+	if len(d.Label) > 50 {
+		return fmt.Errorf("label cannot exceed 50 characters")
+	}
+
+	if d.Recipient != nil && len(*d.Recipient) > 100 {
+		return fmt.Errorf("recipient cannot exceed 100 characters")
+	}
+
+	if d.Line1 == "" {
+		return fmt.Errorf("line1 cannot be empty")
+	}
+
+	if len(d.Line1) > 200 || (d.Line2 != nil && len(*d.Line2) > 200) {
+		return fmt.Errorf("address lines cannot exceed 200 characters")
+	}
+
+	if d.City == "" {
+		return fmt.Errorf("city cannot be empty")
+	}
+
+	if !countryCodeRegex.MatchString(d.Country) {
+		return fmt.Errorf("country must be an ISO 3166-1 alpha-2 code")
+	}
+
+	if d.Location != nil {
+		if d.Location.Lat < -90 || d.Location.Lat > 90 {
+			return fmt.Errorf("latitude must be between -90 and 90")
+		}
+
+		if d.Location.Lng < -180 || d.Location.Lng > 180 {
+			return fmt.Errorf("longitude must be between -180 and 180")
+		}
+	}
+
+	return nil
+}
+
+func newCustomerAddress(id, customerID string, data AddressData) (*CustomerAddress, error) {
+	now := time.Now().UTC()
+	address := &CustomerAddress{
+		ID:         id,
+		CustomerID: customerID,
+		CreatedAt:  now,
+	}
+
+	if err := address.update(data); err != nil {
+		return nil, err
+	}
+
+	return address, nil
+}
+
+func (a *CustomerAddress) update(data AddressData) error {
+	if err := data.validate(); err != nil {
+		return err
+	}
+
+	a.Label = data.Label
+	a.Recipient = data.Recipient
+	a.Line1 = data.Line1
+	a.Line2 = data.Line2
+	a.City = data.City
+	a.Region = data.Region
+	a.PostalCode = data.PostalCode
+	a.Country = data.Country
+	a.Lat, a.Lng = nil, nil
+	if data.Location != nil {
+		a.Lat, a.Lng = &data.Location.Lat, &data.Location.Lng
+	}
+	a.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// Location returns the location of the address when it is known
+func (a *CustomerAddress) Location() *Location {
+	if a.Lat == nil || a.Lng == nil {
+		return nil
+	}
+	return &Location{Lat: *a.Lat, Lng: *a.Lng}
+}
+
+// Getters
+func (a *CustomerAddress) GetID() string           { return a.ID }
+func (a *CustomerAddress) GetCustomerID() string   { return a.CustomerID }
+func (a *CustomerAddress) GetLabel() string        { return a.Label }
+func (a *CustomerAddress) GetRecipient() *string   { return a.Recipient }
+func (a *CustomerAddress) GetLine1() string        { return a.Line1 }
+func (a *CustomerAddress) GetLine2() *string       { return a.Line2 }
+func (a *CustomerAddress) GetCity() string         { return a.City }
+func (a *CustomerAddress) GetRegion() *string      { return a.Region }
+func (a *CustomerAddress) GetPostalCode() *string  { return a.PostalCode }
+func (a *CustomerAddress) GetCountry() string      { return a.Country }
+func (a *CustomerAddress) GetIsDefault() bool      { return a.IsDefault }
+func (a *CustomerAddress) GetCreatedAt() time.Time { return a.CreatedAt }
+func (a *CustomerAddress) GetUpdatedAt() time.Time { return a.UpdatedAt }
+
+func (a *CustomerAddress) TableName() string {
+	return "customer_addresses"
+}
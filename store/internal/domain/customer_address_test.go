@@ -0,0 +1,49 @@
+package domain_test
+
+import (
+	"testing"
+
+	"ichibuy/store/internal/domain"
+)
+
+func TestCustomer_DefaultAddress(t *testing.T) {
+	customer, err := domain.NewCustomer("customer-1", "Ana", "Torres", nil, nil, "user-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := domain.AddressData{Label: "Home", Line1: "Av. Larco 123", City: "Lima", Country: "PE"}
+
+	home, err := customer.AddAddress("address-1", data, false, "user-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !home.GetIsDefault() {
+		t.Fatal("first address should be the default one")
+	}
+
+	data.Label = "Work"
+	work, err := customer.AddAddress("address-2", data, true, "user-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !work.GetIsDefault() || home.GetIsDefault() {
+		t.Fatal("new default address should replace the previous one")
+	}
+
+	if err := customer.RemoveAddress("address-2", "user-1"); err != nil {
+		t.Fatal(err)
+	}
+	if !home.GetIsDefault() {
+		t.Fatal("remaining address should become the default one")
+	}
+
+	if _, err := customer.AddAddress("address-3", data, false, "user-2"); err == nil {
+		t.Fatal("expected an error for another user")
+	}
+
+	data.Country = "Peru"
+	if _, err := customer.AddAddress("address-4", data, false, "user-1"); err == nil {
+		t.Fatal("expected an error for an invalid country code")
+	}
+}
@@ -0,0 +1,47 @@
+package domain_test
+
+import (
+	"testing"
+
+	"ichibuy/store/internal/domain"
+)
+
+func TestNewCustomerFromUser(t *testing.T) {
+	tests := []struct {
+		name      string
+		email     string
+		username  string
+		firstName string
+		lastName  string
+		withEmail bool
+	}{
+		{name: "full name", email: "ana@example.com", username: "Ana María Torres", firstName: "Ana", lastName: "María Torres", withEmail: true},
+		{name: "single word", email: "ana@example.com", username: " ana ", firstName: "ana", lastName: "", withEmail: true},
+		{name: "invalid email", email: "not-an-email", username: "Ana Torres", firstName: "Ana", lastName: "Torres", withEmail: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			customer, err := domain.NewCustomerFromUser("customer-1", "user-1", tt.email, tt.username)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if customer.GetFirstName() != tt.firstName || customer.GetLastName() != tt.lastName {
+				t.Errorf("got %q %q, want %q %q", customer.GetFirstName(), customer.GetLastName(), tt.firstName, tt.lastName)
+			}
+
+			if (customer.GetEmailString() != nil) != tt.withEmail {
+				t.Errorf("got email %v, want email %v", customer.GetEmailString(), tt.withEmail)
+			}
+
+			if events := customer.PullEvents(); len(events) != 1 || events[0].Type != domain.CustomerCreated {
+				t.Errorf("expected a CustomerCreated event, got %v", events)
+			}
+		})
+	}
+
+	if _, err := domain.NewCustomerFromUser("customer-1", "user-1", "ana@example.com", "  "); err == nil {
+		t.Error("expected an error for an empty username")
+	}
+}
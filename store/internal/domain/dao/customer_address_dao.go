@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"context"
+	"ichibuy/store/internal/domain"
+)
+
+type CustomerAddress = domain.CustomerAddress
+
+type CustomerAddressDAO interface {
+	// Create creates a new CustomerAddress
+	Create(ctx context.Context, m *CustomerAddress) error
+
+	// Update updates an existing CustomerAddress
+	Update(ctx context.Context, m *CustomerAddress) error
+
+	// PartialUpdate updates specific fields of a CustomerAddress
+	PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error
+
+	// DeleteByPk deletes a CustomerAddress by primary key
+	DeleteByPk(ctx context.Context, pk string) error
+
+	// FindByPk finds a CustomerAddress by primary key
+	FindByPk(ctx context.Context, pk string) (*CustomerAddress, error)
+
+	// CreateMany creates multiple CustomerAddress records
+	CreateMany(ctx context.Context, models []*CustomerAddress) error
+
+	// UpdateMany updates multiple CustomerAddress records
+	UpdateMany(ctx context.Context, models []*CustomerAddress) error
+
+	// DeleteManyByPks deletes multiple CustomerAddress records by primary keys
+	DeleteManyByPks(ctx context.Context, pks []string) error
+
+	// FindOne finds a single CustomerAddress with optional where clause and sort expression
+	FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*CustomerAddress, error)
+
+	// FindAll finds all CustomerAddress records with optional where clause and sort expression
+	FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*CustomerAddress, error)
+
+	// FindPaginated finds CustomerAddress records with pagination, optional where clause and sort expression
+	FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*CustomerAddress, error)
+
+	// Count counts CustomerAddress records with optional where clause
+	Count(ctx context.Context, where string, args ...interface{}) (int64, error)
+
+	// WithTransaction executes a function within a database transaction
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
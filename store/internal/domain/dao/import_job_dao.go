@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"context"
+	"ichibuy/store/internal/domain"
+)
+
+type ImportJob = domain.ImportJob
+
+type ImportJobDAO interface {
+	// Create creates a new ImportJob
+	Create(ctx context.Context, m *ImportJob) error
+
+	// Update updates an existing ImportJob
+	Update(ctx context.Context, m *ImportJob) error
+
+	// PartialUpdate updates specific fields of a ImportJob
+	PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error
+
+	// DeleteByPk deletes a ImportJob by primary key
+	DeleteByPk(ctx context.Context, pk string) error
+
+	// FindByPk finds a ImportJob by primary key
+	FindByPk(ctx context.Context, pk string) (*ImportJob, error)
+
+	// CreateMany creates multiple ImportJob records
+	CreateMany(ctx context.Context, models []*ImportJob) error
+
+	// UpdateMany updates multiple ImportJob records
+	UpdateMany(ctx context.Context, models []*ImportJob) error
+
+	// DeleteManyByPks deletes multiple ImportJob records by primary keys
+	DeleteManyByPks(ctx context.Context, pks []string) error
+
+	// FindOne finds a single ImportJob with optional where clause and sort expression
+	FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*ImportJob, error)
+
+	// FindAll finds all ImportJob records with optional where clause and sort expression
+	FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*ImportJob, error)
+
+	// FindPaginated finds ImportJob records with pagination, optional where clause and sort expression
+	FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*ImportJob, error)
+
+	// Count counts ImportJob records with optional where clause
+	Count(ctx context.Context, where string, args ...interface{}) (int64, error)
+
+	// WithTransaction executes a function within a database transaction
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
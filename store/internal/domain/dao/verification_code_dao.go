@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"context"
+	"ichibuy/store/internal/domain"
+)
+
+type VerificationCode = domain.VerificationCode
+
+type VerificationCodeDAO interface {
+	// Create creates a new VerificationCode
+	Create(ctx context.Context, m *VerificationCode) error
+
+	// Update updates an existing VerificationCode
+	Update(ctx context.Context, m *VerificationCode) error
+
+	// PartialUpdate updates specific fields of a VerificationCode
+	PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error
+
+	// DeleteByPk deletes a VerificationCode by primary key
+	DeleteByPk(ctx context.Context, pk string) error
+
+	// FindByPk finds a VerificationCode by primary key
+	FindByPk(ctx context.Context, pk string) (*VerificationCode, error)
+
+	// CreateMany creates multiple VerificationCode records
+	CreateMany(ctx context.Context, models []*VerificationCode) error
+
+	// UpdateMany updates multiple VerificationCode records
+	UpdateMany(ctx context.Context, models []*VerificationCode) error
+
+	// DeleteManyByPks deletes multiple VerificationCode records by primary keys
+	DeleteManyByPks(ctx context.Context, pks []string) error
+
+	// FindOne finds a single VerificationCode with optional where clause and sort expression
+	FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*VerificationCode, error)
+
+	// FindAll finds all VerificationCode records with optional where clause and sort expression
+	FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*VerificationCode, error)
+
+	// FindPaginated finds VerificationCode records with pagination, optional where clause and sort expression
+	FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*VerificationCode, error)
+
+	// Count counts VerificationCode records with optional where clause
+	Count(ctx context.Context, where string, args ...interface{}) (int64, error)
+
+	// WithTransaction executes a function within a database transaction
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
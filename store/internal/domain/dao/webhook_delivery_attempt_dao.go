@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"context"
+	"ichibuy/store/internal/domain"
+)
+
+type WebhookDeliveryAttempt = domain.WebhookDeliveryAttempt
+
+type WebhookDeliveryAttemptDAO interface {
+	// Create creates a new WebhookDeliveryAttempt
+	Create(ctx context.Context, m *WebhookDeliveryAttempt) error
+
+	// Update updates an existing WebhookDeliveryAttempt
+	Update(ctx context.Context, m *WebhookDeliveryAttempt) error
+
+	// PartialUpdate updates specific fields of a WebhookDeliveryAttempt
+	PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error
+
+	// DeleteByPk deletes a WebhookDeliveryAttempt by primary key
+	DeleteByPk(ctx context.Context, pk string) error
+
+	// FindByPk finds a WebhookDeliveryAttempt by primary key
+	FindByPk(ctx context.Context, pk string) (*WebhookDeliveryAttempt, error)
+
+	// CreateMany creates multiple WebhookDeliveryAttempt records
+	CreateMany(ctx context.Context, models []*WebhookDeliveryAttempt) error
+
+	// UpdateMany updates multiple WebhookDeliveryAttempt records
+	UpdateMany(ctx context.Context, models []*WebhookDeliveryAttempt) error
+
+	// DeleteManyByPks deletes multiple WebhookDeliveryAttempt records by primary keys
+	DeleteManyByPks(ctx context.Context, pks []string) error
+
+	// FindOne finds a single WebhookDeliveryAttempt with optional where clause and sort expression
+	FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*WebhookDeliveryAttempt, error)
+
+	// FindAll finds all WebhookDeliveryAttempt records with optional where clause and sort expression
+	FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*WebhookDeliveryAttempt, error)
+
+	// FindPaginated finds WebhookDeliveryAttempt records with pagination, optional where clause and sort expression
+	FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*WebhookDeliveryAttempt, error)
+
+	// Count counts WebhookDeliveryAttempt records with optional where clause
+	Count(ctx context.Context, where string, args ...interface{}) (int64, error)
+
+	// WithTransaction executes a function within a database transaction
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
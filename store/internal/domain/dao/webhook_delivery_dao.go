@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"context"
+	"ichibuy/store/internal/domain"
+)
+
+type WebhookDelivery = domain.WebhookDelivery
+
+type WebhookDeliveryDAO interface {
+	// Create creates a new WebhookDelivery
+	Create(ctx context.Context, m *WebhookDelivery) error
+
+	// Update updates an existing WebhookDelivery
+	Update(ctx context.Context, m *WebhookDelivery) error
+
+	// PartialUpdate updates specific fields of a WebhookDelivery
+	PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error
+
+	// DeleteByPk deletes a WebhookDelivery by primary key
+	DeleteByPk(ctx context.Context, pk string) error
+
+	// FindByPk finds a WebhookDelivery by primary key
+	FindByPk(ctx context.Context, pk string) (*WebhookDelivery, error)
+
+	// CreateMany creates multiple WebhookDelivery records
+	CreateMany(ctx context.Context, models []*WebhookDelivery) error
+
+	// UpdateMany updates multiple WebhookDelivery records
+	UpdateMany(ctx context.Context, models []*WebhookDelivery) error
+
+	// DeleteManyByPks deletes multiple WebhookDelivery records by primary keys
+	DeleteManyByPks(ctx context.Context, pks []string) error
+
+	// FindOne finds a single WebhookDelivery with optional where clause and sort expression
+	FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*WebhookDelivery, error)
+
+	// FindAll finds all WebhookDelivery records with optional where clause and sort expression
+	FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*WebhookDelivery, error)
+
+	// FindPaginated finds WebhookDelivery records with pagination, optional where clause and sort expression
+	FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*WebhookDelivery, error)
+
+	// Count counts WebhookDelivery records with optional where clause
+	Count(ctx context.Context, where string, args ...interface{}) (int64, error)
+
+	// WithTransaction executes a function within a database transaction
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
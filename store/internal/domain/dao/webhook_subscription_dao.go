@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"context"
+	"ichibuy/store/internal/domain"
+)
+
+type WebhookSubscription = domain.WebhookSubscription
+
+type WebhookSubscriptionDAO interface {
+	// Create creates a new WebhookSubscription
+	Create(ctx context.Context, m *WebhookSubscription) error
+
+	// Update updates an existing WebhookSubscription
+	Update(ctx context.Context, m *WebhookSubscription) error
+
+	// PartialUpdate updates specific fields of a WebhookSubscription
+	PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error
+
+	// DeleteByPk deletes a WebhookSubscription by primary key
+	DeleteByPk(ctx context.Context, pk string) error
+
+	// FindByPk finds a WebhookSubscription by primary key
+	FindByPk(ctx context.Context, pk string) (*WebhookSubscription, error)
+
+	// CreateMany creates multiple WebhookSubscription records
+	CreateMany(ctx context.Context, models []*WebhookSubscription) error
+
+	// UpdateMany updates multiple WebhookSubscription records
+	UpdateMany(ctx context.Context, models []*WebhookSubscription) error
+
+	// DeleteManyByPks deletes multiple WebhookSubscription records by primary keys
+	DeleteManyByPks(ctx context.Context, pks []string) error
+
+	// FindOne finds a single WebhookSubscription with optional where clause and sort expression
+	FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*WebhookSubscription, error)
+
+	// FindAll finds all WebhookSubscription records with optional where clause and sort expression
+	FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*WebhookSubscription, error)
+
+	// FindPaginated finds WebhookSubscription records with pagination, optional where clause and sort expression
+	FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*WebhookSubscription, error)
+
+	// Count counts WebhookSubscription records with optional where clause
+	Count(ctx context.Context, where string, args ...interface{}) (int64, error)
+
+	// WithTransaction executes a function within a database transaction
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
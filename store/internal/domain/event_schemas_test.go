@@ -0,0 +1,66 @@
+package domain_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"ichibuy/store/internal/domain"
+)
+
+func TestEventSchemas_Validate(t *testing.T) {
+	store, err := domain.NewStore("store-1", "Bodega", nil, -12.05, -77.04, []string{"PEN"}, "PE", "user-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	email := "ana@example.com"
+	customer, err := domain.NewCustomer("customer-1", "Ana", "Torres", &email, nil, "user-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	events := append(store.PullEvents(), customer.PullEvents()...)
+	if len(events) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(events))
+	}
+
+	for _, event := range events {
+		if event.SchemaVersion != 1 || event.AggregateID == "" {
+			t.Errorf("unexpected envelope %+v", event)
+		}
+		if err := domain.EventSchemas.Validate(event); err != nil {
+			t.Errorf("expected %s to match its schema: %v", event.Type, err)
+		}
+	}
+
+	if events[0].ID == events[1].ID {
+		t.Error("expected unique event ids")
+	}
+
+	invalid := events[0]
+	var data map[string]any
+	_ = json.Unmarshal(invalid.Data, &data)
+	data["currencies"] = "PEN"
+	delete(data, "slug")
+	invalid.Data, _ = json.Marshal(data)
+	if err := domain.EventSchemas.Validate(invalid); err == nil {
+		t.Error("expected an error for data not matching the schema")
+	}
+
+	invalid.SchemaVersion = 2
+	if err := domain.EventSchemas.Validate(invalid); err == nil {
+		t.Error("expected an error for a version without schema")
+	}
+}
+
+func TestEventSchemas_Registered(t *testing.T) {
+	types := []domain.EventType{
+		domain.StoreCreated, domain.StoreUpdated, domain.StoreDeleted, domain.StoreRestored,
+		domain.CustomerCreated, domain.CustomerUpdated, domain.CustomerDeleted, domain.CustomerRestored,
+		domain.ProductCreated, domain.ProductUpdated, domain.ProductDeleted, domain.ProductRestored,
+	}
+	for _, eventType := range types {
+		if domain.EventSchemas.LatestVersion(eventType) == 0 {
+			t.Errorf("expected a schema for %s", eventType)
+		}
+	}
+}
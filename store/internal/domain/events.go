@@ -4,27 +4,65 @@ import (
 	"context"
 	"encoding/json"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 type EventType string
 
 const (
-	StoreCreated    EventType = "StoreCreated"
-	StoreUpdated    EventType = "StoreUpdated"
-	StoreDeleted    EventType = "StoreDeleted"
-	CustomerCreated EventType = "CustomerCreated"
-	CustomerUpdated EventType = "CustomerUpdated"
-	CustomerDeleted EventType = "CustomerDeleted"
-	ProductCreated  EventType = "ProductCreated"
-	ProductUpdated  EventType = "ProductUpdated"
-	ProductDeleted  EventType = "ProductDeleted"
+	StoreCreated     EventType = "StoreCreated"
+	StoreUpdated     EventType = "StoreUpdated"
+	StoreDeleted     EventType = "StoreDeleted"
+	StoreRestored    EventType = "StoreRestored"
+	CustomerCreated  EventType = "CustomerCreated"
+	CustomerUpdated  EventType = "CustomerUpdated"
+	CustomerDeleted  EventType = "CustomerDeleted"
+	CustomerRestored EventType = "CustomerRestored"
+	ProductCreated   EventType = "ProductCreated"
+	ProductUpdated   EventType = "ProductUpdated"
+	ProductDeleted   EventType = "ProductDeleted"
+	ProductRestored  EventType = "ProductRestored"
 )
 
+type AggregateType string
+
+const (
+	StoreAggregate    AggregateType = "store"
+	CustomerAggregate AggregateType = "customer"
+	ProductAggregate  AggregateType = "product"
+)
+
+// Event is the envelope of the events published by the services. AggregateVersion counts the events of
+// the aggregate from 1, SchemaVersion is the version of the data schema in the schema registry, and the
+// correlation and causation IDs link the event to the request or event that caused it.
 type Event struct {
-	ID        string          `json:"id" sql:"id,primary"`
-	Type      EventType       `json:"type" sql:"type"`
-	Data      json.RawMessage `json:"data" sql:"data"`
-	Timestamp time.Time       `json:"timestamp" sql:"timestamp"`
+	ID               string          `json:"id" sql:"id,primary"`
+	Type             EventType       `json:"type" sql:"type"`
+	SchemaVersion    int             `json:"schema_version" sql:"schema_version"`
+	AggregateType    AggregateType   `json:"aggregate_type" sql:"aggregate_type"`
+	AggregateID      string          `json:"aggregate_id" sql:"aggregate_id"`
+	AggregateVersion int             `json:"aggregate_version" sql:"aggregate_version"`
+	Producer         string          `json:"producer" sql:"producer"`
+	CorrelationID    *string         `json:"correlation_id" sql:"correlation_id"`
+	CausationID      *string         `json:"causation_id" sql:"causation_id"`
+	Data             json.RawMessage `json:"data" sql:"data"`
+	Timestamp        time.Time       `json:"timestamp" sql:"timestamp"`
+}
+
+// NewEvent returns an event of the aggregate with a time ordered UUID, published with the last schema
+// version of its type. The event bus sets the aggregate version, producer and correlation on publish.
+func NewEvent(eventType EventType, aggregateType AggregateType, aggregateID string, data any, timestamp time.Time) Event {
+	raw, _ := json.Marshal(data)
+	return Event{
+		ID:            uuid.Must(uuid.NewV7()).String(),
+		Type:          eventType,
+		SchemaVersion: EventSchemas.LatestVersion(eventType),
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		Data:          raw,
+		Timestamp:     timestamp,
+	}
 }
 
 func (e *Event) TableName() string {
@@ -35,26 +73,69 @@ type EventBus interface {
 	Publish(ctx context.Context, events ...Event) error
 }
 
+// MessageBroker carries the events between the services. Every consumer group receives the events of its
+// types, shared among the subscribers of the group.
+type MessageBroker interface {
+	EventBus
+	// Subscribe hands the events of the given types to handle until the context is done. An event is
+	// acknowledged when handle returns nil, and redelivered later to the group when it returns an error,
+	// so handle must be idempotent.
+	Subscribe(ctx context.Context, group string, types []EventType, handle func(ctx context.Context, event Event) error) error
+	Close() error
+}
+
+// EventsService reads the events feed of another service
+type EventsService interface {
+	// FindEventsAfter returns the events of the given types after the event with the given ID, from the
+	// first one when it is empty
+	FindEventsAfter(ctx context.Context, after string, types []EventType, limit int) ([]Event, error)
+}
+
 type StoreEventData struct {
-	ID          string    `json:"id"`
-	Name        string    `json:"name"`
-	Description *string   `json:"description"`
-	Location    Location  `json:"location"`
-	Slug        string    `json:"slug"`
-	UserID      string    `json:"user_id"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Description *string    `json:"description"`
+	Location    Location   `json:"location"`
+	Slug        string     `json:"slug"`
+	Currencies  []string   `json:"currencies"`
+	PhoneRegion string     `json:"phone_region"`
+	UserID      string     `json:"user_id"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	DeletedAt   *time.Time `json:"deleted_at"`
+	// Schedule is the opening hours of the store, with its exceptions and pre-order policy
+	Schedule StoreSchedule `json:"schedule"`
 }
 
 type CustomerEventData struct {
-	ID        string    `json:"id"`
-	FirstName string    `json:"first_name"`
-	LastName  string    `json:"last_name"`
-	Email     *string   `json:"email"`
-	Phone     *string   `json:"phone"`
-	UserID    string    `json:"user_id"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID        string     `json:"id"`
+	FirstName string     `json:"first_name"`
+	LastName  string     `json:"last_name"`
+	Email     *string    `json:"email"`
+	Phone     *string    `json:"phone"`
+	UserID    string     `json:"user_id"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	DeletedAt *time.Time `json:"deleted_at"`
+	// EmailVerifiedAt and PhoneVerifiedAt are set once the customer proves the email or phone is theirs
+	EmailVerifiedAt *time.Time `json:"email_verified_at"`
+	PhoneVerifiedAt *time.Time `json:"phone_verified_at"`
+	// Addresses is the address book of the customer
+	Addresses []CustomerAddressEventData `json:"addresses"`
+}
+
+type CustomerAddressEventData struct {
+	ID         string    `json:"id"`
+	Label      string    `json:"label"`
+	Recipient  *string   `json:"recipient"`
+	Line1      string    `json:"line1"`
+	Line2      *string   `json:"line2"`
+	City       string    `json:"city"`
+	Region     *string   `json:"region"`
+	PostalCode *string   `json:"postal_code"`
+	Country    string    `json:"country"`
+	Location   *Location `json:"location"`
+	IsDefault  bool      `json:"is_default"`
 }
 
 type ProductEventData struct {
@@ -67,4 +148,5 @@ type ProductEventData struct {
 	Prices      map[string]Price `json:"prices"`
 	CreatedAt   time.Time        `json:"created_at"`
 	UpdatedAt   time.Time        `json:"updated_at"`
+	DeletedAt   *time.Time       `json:"deleted_at"`
 }
@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+)
+
+// ExchangeRate tells how many units of To one unit of From is worth
+type ExchangeRate struct {
+	From string
+	To   string
+	Rate *big.Rat
+}
+
+func NewExchangeRate(from, to, rate string) (ExchangeRate, error) {
+	from, to = strings.ToUpper(from), strings.ToUpper(to)
+	if !IsValidCurrency(from) || !IsValidCurrency(to) {
+		return ExchangeRate{}, fmt.Errorf("invalid currency")
+	}
+
+	value, ok := new(big.Rat).SetString(rate)
+	if !ok || value.Sign() <= 0 {
+		return ExchangeRate{}, fmt.Errorf("invalid exchange rate %s", rate)
+	}
+
+	return ExchangeRate{From: from, To: to, Rate: value}, nil
+}
+
+// Inverse returns the rate to convert back from To into From
+func (r ExchangeRate) Inverse() ExchangeRate {
+	return ExchangeRate{From: r.To, To: r.From, Rate: new(big.Rat).Inv(r.Rate)}
+}
+
+type ExchangeRateProvider interface {
+	GetRate(ctx context.Context, from, to string) (ExchangeRate, error)
+}
+
+// Convert converts the money with the given rate, rounding half away from zero to the minor units of the target currency
+func (m Money) Convert(rate ExchangeRate) (Money, error) {
+	if m.Currency != rate.From {
+		return Money{}, fmt.Errorf("cannot convert %s with a %s rate", m.Currency, rate.From)
+	}
+
+	from, err := FindCurrency(rate.From)
+	if err != nil {
+		return Money{}, err
+	}
+
+	to, err := FindCurrency(rate.To)
+	if err != nil {
+		return Money{}, err
+	}
+
+	// amount / 10^from.MinorUnits * rate * 10^to.MinorUnits
+	value := new(big.Rat).SetInt64(int64(m.Amount))
+	value.Mul(value, rate.Rate)
+	value.Mul(value, new(big.Rat).SetInt(pow10(to.MinorUnits)))
+	value.Quo(value, new(big.Rat).SetInt(pow10(from.MinorUnits)))
+
+	quotient, remainder := new(big.Int).QuoRem(value.Num(), value.Denom(), new(big.Int))
+	if new(big.Int).Mul(new(big.Int).Abs(remainder), big.NewInt(2)).Cmp(value.Denom()) >= 0 {
+		quotient.Add(quotient, big.NewInt(int64(value.Sign())))
+	}
+
+	if !quotient.IsInt64() || quotient.Int64() > math.MaxInt || quotient.Int64() < math.MinInt {
+		return Money{}, fmt.Errorf("amount overflow")
+	}
+
+	return Money{Amount: int(quotient.Int64()), Currency: to.Code}, nil
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
@@ -0,0 +1,8 @@
+package domain
+
+import "context"
+
+// FileFetcher downloads a remote file so it can be uploaded to the storage service
+type FileFetcher interface {
+	FetchFile(ctx context.Context, url string) (*UploadFileRequest, error)
+}
@@ -0,0 +1,212 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// MaxImportFileSize is the largest catalog file accepted by an import, the file is kept in the job until processed
+const MaxImportFileSize = 5 << 20 // 5MB
+
+type ImportFormat string
+
+const (
+	CSVImportFormat   ImportFormat = "csv"
+	JSONLImportFormat ImportFormat = "jsonl"
+)
+
+func NewImportFormat(format string) (ImportFormat, error) {
+	switch ImportFormat(strings.ToLower(strings.TrimSpace(format))) {
+	case CSVImportFormat:
+		return CSVImportFormat, nil
+	case JSONLImportFormat:
+		return JSONLImportFormat, nil
+	default:
+		return "", fmt.Errorf("invalid import format, should be csv or jsonl")
+	}
+}
+
+type ImportJobStatus string
+
+const (
+	PendingImportJobStatus    ImportJobStatus = "pending"
+	ProcessingImportJobStatus ImportJobStatus = "processing"
+	CompletedImportJobStatus  ImportJobStatus = "completed"
+	FailedImportJobStatus     ImportJobStatus = "failed"
+)
+
+// ImportJob tracks the asynchronous import of a products file into a store. A processing job is held by its
+// worker until LockedUntil, renewed while it runs, so the job of a worker that stopped is taken over by another.
+// LastRow is the last row whose products were saved, the rows up to it are skipped when the job is taken over.
+type ImportJob struct {
+	ID          string          `sql:"id,primary"`
+	StoreID     string          `sql:"store_id"`
+	UserID      string          `sql:"user_id"`
+	Format      ImportFormat    `sql:"format"`
+	Status      ImportJobStatus `sql:"status"`
+	Payload     string          `sql:"payload"`
+	TotalRows   int             `sql:"total_rows"`
+	CreatedRows int             `sql:"created_rows"`
+	FailedRows  int             `sql:"failed_rows"`
+	RowErrors   json.RawMessage `sql:"row_errors"`
+	LastRow     int             `sql:"last_row"`
+	LockedUntil *time.Time      `sql:"locked_until"`
+	CreatedAt   time.Time       `sql:"created_at"`
+	UpdatedAt   time.Time       `sql:"updated_at"`
+	FinishedAt  *time.Time      `sql:"finished_at"`
+
+	// Non-storable
+	rowErrors []ImportRowError
+}
+
+// ImportRowError describes why a row of an import file was rejected
+type ImportRowError struct {
+	Row   int    `json:"row"`
+	Error string `json:"error"`
+}
+
+func NewImportJob(id, storeID, userID string, format ImportFormat, payload string, totalRows int) (*ImportJob, error) {
+	if strings.TrimSpace(storeID) == "" {
+		return nil, fmt.Errorf("storeID cannot be empty")
+	}
+
+	if strings.TrimSpace(payload) == "" {
+		return nil, fmt.Errorf("import file cannot be empty")
+	}
+
+	if len(payload) > MaxImportFileSize {
+		return nil, fmt.Errorf("import file cannot exceed %d bytes", MaxImportFileSize)
+	}
+
+	if totalRows <= 0 {
+		return nil, fmt.Errorf("import file has no rows")
+	}
+
+	now := time.Now().UTC()
+
+	return &ImportJob{
+		ID:        id,
+		StoreID:   storeID,
+		UserID:    userID,
+		Format:    format,
+		Status:    PendingImportJobStatus,
+		Payload:   payload,
+		TotalRows: totalRows,
+		RowErrors: json.RawMessage("[]"),
+		CreatedAt: now,
+		UpdatedAt: now,
+		rowErrors: []ImportRowError{},
+	}, nil
+}
+
+// Start holds the job for a worker until the lease ends. Pending jobs can be started, and processing jobs
+// whose lease ended, which resume after their last saved row.
+func (j *ImportJob) Start(now time.Time, lease time.Duration) error {
+	switch {
+	case j.Status == PendingImportJobStatus:
+	case j.Status == ProcessingImportJobStatus && j.LockedUntil != nil && !now.Before(*j.LockedUntil):
+	default:
+		return fmt.Errorf("import job is not in pending status")
+	}
+
+	lockedUntil := now.Add(lease)
+	j.Status = ProcessingImportJobStatus
+	j.LockedUntil = &lockedUntil
+	j.UpdatedAt = now
+	return nil
+}
+
+// Renew extends the lease of the processing job
+func (j *ImportJob) Renew(now time.Time, lease time.Duration) {
+	lockedUntil := now.Add(lease)
+	j.LockedUntil = &lockedUntil
+	j.UpdatedAt = now
+}
+
+// NeedsRenewal tells if less than half of the lease is left
+func (j *ImportJob) NeedsRenewal(now time.Time, lease time.Duration) bool {
+	return j.LockedUntil == nil || j.LockedUntil.Sub(now) < lease/2
+}
+
+// Progress records the rows handled up to lastRow, with the products created and the rows rejected so far
+func (j *ImportJob) Progress(lastRow, createdRows int, rowErrors []ImportRowError, now time.Time, lease time.Duration) error {
+	if j.Status != ProcessingImportJobStatus {
+		return fmt.Errorf("import job is not in processing status")
+	}
+
+	raw, err := toRawMessage(rowErrors)
+	if err != nil {
+		return err
+	}
+
+	j.LastRow = lastRow
+	j.CreatedRows = createdRows
+	j.FailedRows = len(rowErrors)
+	j.RowErrors = raw
+	j.rowErrors = rowErrors
+	j.Renew(now, lease)
+	return nil
+}
+
+// Finish marks the job as completed, storing the number of created products and the row errors
+func (j *ImportJob) Finish(createdRows int, rowErrors []ImportRowError) error {
+	if j.Status != ProcessingImportJobStatus {
+		return fmt.Errorf("import job is not in processing status")
+	}
+
+	raw, err := toRawMessage(rowErrors)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	j.Status = CompletedImportJobStatus
+	j.CreatedRows = createdRows
+	j.FailedRows = len(rowErrors)
+	j.RowErrors = raw
+	j.rowErrors = rowErrors
+	j.LockedUntil = nil
+	j.UpdatedAt = now
+	j.FinishedAt = &now
+	return nil
+}
+
+// Fail marks the job as failed when the file could not be processed at all
+func (j *ImportJob) Fail(reason string) {
+	now := time.Now().UTC()
+	j.Status = FailedImportJobStatus
+	j.rowErrors = []ImportRowError{{Row: 0, Error: reason}}
+	j.RowErrors, _ = toRawMessage(j.rowErrors)
+	j.LockedUntil = nil
+	j.UpdatedAt = now
+	j.FinishedAt = &now
+}
+
+func (j *ImportJob) GetRowErrors() []ImportRowError {
+	if j.rowErrors == nil {
+		_ = json.Unmarshal(j.RowErrors, &j.rowErrors)
+	}
+
+	return j.rowErrors
+}
+
+func (j *ImportJob) TableName() string {
+	return "import_jobs"
+}
+
+// Getters
+func (j *ImportJob) GetID() string              { return j.ID }
+func (j *ImportJob) GetStoreID() string         { return j.StoreID }
+func (j *ImportJob) GetUserID() string          { return j.UserID }
+func (j *ImportJob) GetFormat() ImportFormat    { return j.Format }
+func (j *ImportJob) GetStatus() ImportJobStatus { return j.Status }
+func (j *ImportJob) GetPayload() string         { return j.Payload }
+func (j *ImportJob) GetTotalRows() int          { return j.TotalRows }
+func (j *ImportJob) GetCreatedRows() int        { return j.CreatedRows }
+func (j *ImportJob) GetLastRow() int            { return j.LastRow }
+func (j *ImportJob) GetFailedRows() int         { return j.FailedRows }
+func (j *ImportJob) GetCreatedAt() time.Time    { return j.CreatedAt }
+func (j *ImportJob) GetUpdatedAt() time.Time    { return j.UpdatedAt }
+func (j *ImportJob) GetFinishedAt() *time.Time  { return j.FinishedAt }
@@ -0,0 +1,62 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"ichibuy/store/internal/domain"
+)
+
+func TestNewImportJob_MaxFileSize(t *testing.T) {
+	payload := "name,prices,image_urls\n" + strings.Repeat("x", domain.MaxImportFileSize)
+	if _, err := domain.NewImportJob("job-1", "store-1", "user-1", domain.CSVImportFormat, payload, 1); err == nil {
+		t.Fatal("expected error for a file larger than the maximum import size")
+	}
+}
+
+func TestImportJob_Start(t *testing.T) {
+	now := time.Date(2026, 3, 31, 12, 0, 0, 0, time.UTC)
+	lease := 5 * time.Minute
+
+	job, err := domain.NewImportJob("job-1", "store-1", "user-1", domain.CSVImportFormat, "name\nCoffee\n", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := job.Start(now, lease); err != nil {
+		t.Fatal(err)
+	}
+	if job.GetStatus() != domain.ProcessingImportJobStatus || !job.LockedUntil.Equal(now.Add(lease)) {
+		t.Fatalf("expected a processing job held until %v, got %s until %v", now.Add(lease), job.GetStatus(), job.LockedUntil)
+	}
+
+	if err := job.Start(now.Add(time.Minute), lease); err == nil {
+		t.Fatal("expected error taking over a job whose lease did not end")
+	}
+
+	if err := job.Progress(50, 48, []domain.ImportRowError{{Row: 7, Error: "invalid price"}}, now.Add(2*time.Minute), lease); err != nil {
+		t.Fatal(err)
+	}
+	if job.GetLastRow() != 50 || job.GetCreatedRows() != 48 || job.GetFailedRows() != 1 {
+		t.Fatalf("unexpected progress %d rows, %d created, %d failed", job.GetLastRow(), job.GetCreatedRows(), job.GetFailedRows())
+	}
+	if job.NeedsRenewal(now.Add(3*time.Minute), lease) || !job.NeedsRenewal(now.Add(5*time.Minute), lease) {
+		t.Fatal("expected the lease renewed once half of it is left")
+	}
+
+	// the worker stopped, the job is taken over once the lease renewed by the progress ends
+	if err := job.Start(now.Add(7*time.Minute), lease); err != nil {
+		t.Fatalf("expected the job taken over after its lease ended, got %v", err)
+	}
+	if job.GetLastRow() != 50 {
+		t.Fatalf("expected the taken over job to resume after row 50, got %d", job.GetLastRow())
+	}
+
+	if err := job.Finish(98, job.GetRowErrors()); err != nil {
+		t.Fatal(err)
+	}
+	if job.LockedUntil != nil {
+		t.Fatal("expected a finished job without lease")
+	}
+}
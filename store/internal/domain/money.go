@@ -1,14 +1,12 @@
 package domain
 
-import "fmt"
-
-var validCurrencies = map[string]bool{
-	"USD": true,
-	"PEN": true,
-}
+import (
+	"fmt"
+	"math"
+)
 
 type Money struct {
-	Amount   int    `json:"amount"` // cents
+	Amount   int    `json:"amount"` // minor units of the currency
 	Currency string `json:"currency"`
 }
 
@@ -17,7 +15,7 @@ func NewMoney(amount int, currency string) (Money, error) {
 		return Money{}, fmt.Errorf("amount cannot be negative")
 	}
 
-	if !validCurrencies[currency] {
+	if !IsValidCurrency(currency) {
 		return Money{}, fmt.Errorf("invalid currency")
 	}
 
@@ -34,3 +32,82 @@ func (m Money) GetAmount() int {
 func (m Money) GetCurrency() string {
 	return m.Currency
 }
+
+// Add returns the sum of both amounts, which must share the same currency
+func (m Money) Add(other Money) (Money, error) {
+	if m.Currency != other.Currency {
+		return Money{}, fmt.Errorf("cannot add %s to %s", other.Currency, m.Currency)
+	}
+
+	if other.Amount > 0 && m.Amount > math.MaxInt-other.Amount {
+		return Money{}, fmt.Errorf("amount overflow")
+	}
+
+	if other.Amount < 0 && m.Amount < math.MinInt-other.Amount {
+		return Money{}, fmt.Errorf("amount overflow")
+	}
+
+	return Money{Amount: m.Amount + other.Amount, Currency: m.Currency}, nil
+}
+
+// Multiply returns the amount multiplied by factor, e.g. a unit price by a quantity
+func (m Money) Multiply(factor int) (Money, error) {
+	if m.Amount == 0 || factor == 0 {
+		return Money{Amount: 0, Currency: m.Currency}, nil
+	}
+
+	result := m.Amount * factor
+	if result/factor != m.Amount || (m.Amount == -1 && factor == math.MinInt) || (factor == -1 && m.Amount == math.MinInt) {
+		return Money{}, fmt.Errorf("amount overflow")
+	}
+
+	return Money{Amount: result, Currency: m.Currency}, nil
+}
+
+// Allocate splits the amount proportionally to ratios without losing minor units.
+// The remainder is spread one unit at a time starting with the first share.
+func (m Money) Allocate(ratios ...int) ([]Money, error) {
+	if m.Amount < 0 {
+		return nil, fmt.Errorf("cannot allocate a negative amount")
+	}
+
+	if len(ratios) == 0 {
+		return nil, fmt.Errorf("at least one ratio is required")
+	}
+
+	total := 0
+	for _, ratio := range ratios {
+		if ratio < 0 {
+			return nil, fmt.Errorf("ratios cannot be negative")
+		}
+		if total > math.MaxInt-ratio {
+			return nil, fmt.Errorf("ratio overflow")
+		}
+		total += ratio
+	}
+
+	if total == 0 {
+		return nil, fmt.Errorf("ratios cannot sum zero")
+	}
+
+	shares := make([]Money, len(ratios))
+	remainder := m.Amount
+	for i, ratio := range ratios {
+		share, err := m.Multiply(ratio)
+		if err != nil {
+			return nil, err
+		}
+		shares[i] = Money{Amount: share.Amount / total, Currency: m.Currency}
+		remainder -= shares[i].Amount
+	}
+
+	for i := 0; remainder > 0; i = (i + 1) % len(shares) {
+		if ratios[i] == 0 {
+			continue
+		}
+		shares[i].Amount++
+		remainder--
+	}
+
+	return shares, nil
+}
@@ -0,0 +1,91 @@
+package domain_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"ichibuy/store/internal/domain"
+)
+
+func TestMoney_Add(t *testing.T) {
+	sum, err := domain.Money{Amount: 150, Currency: "USD"}.Add(domain.Money{Amount: 50, Currency: "USD"})
+	if err != nil || sum.Amount != 200 {
+		t.Fatalf("expected 200, got %v (%v)", sum.Amount, err)
+	}
+
+	if _, err := (domain.Money{Amount: 1, Currency: "USD"}).Add(domain.Money{Amount: 1, Currency: "PEN"}); err == nil {
+		t.Fatal("expected currency mismatch error")
+	}
+
+	if _, err := (domain.Money{Amount: math.MaxInt, Currency: "USD"}).Add(domain.Money{Amount: 1, Currency: "USD"}); err == nil {
+		t.Fatal("expected overflow error")
+	}
+}
+
+func TestMoney_Multiply(t *testing.T) {
+	product, err := domain.Money{Amount: 250, Currency: "PEN"}.Multiply(3)
+	if err != nil || product.Amount != 750 {
+		t.Fatalf("expected 750, got %v (%v)", product.Amount, err)
+	}
+
+	if _, err := (domain.Money{Amount: math.MaxInt / 2, Currency: "PEN"}).Multiply(3); err == nil {
+		t.Fatal("expected overflow error")
+	}
+}
+
+func TestMoney_Allocate(t *testing.T) {
+	shares, err := domain.Money{Amount: 100, Currency: "USD"}.Allocate(1, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []int{34, 33, 33}
+	for i, share := range shares {
+		if share.Amount != expected[i] {
+			t.Fatalf("share %d: expected %d, got %d", i, expected[i], share.Amount)
+		}
+	}
+
+	if _, err := (domain.Money{Amount: 100, Currency: "USD"}).Allocate(0, 0); err == nil {
+		t.Fatal("expected error for zero ratios")
+	}
+}
+
+func TestMoney_Convert(t *testing.T) {
+	tests := []struct {
+		name     string
+		money    domain.Money
+		to       string
+		rate     string
+		expected int
+	}{
+		{"same minor units", domain.Money{Amount: 1000, Currency: "USD"}, "PEN", "3.75", 3750},
+		{"to zero minor units", domain.Money{Amount: 1050, Currency: "USD"}, "CLP", "940", 9870},
+		{"from zero minor units", domain.Money{Amount: 1000, Currency: "JPY"}, "USD", "0.0067", 670},
+		{"rounds half up", domain.Money{Amount: 1, Currency: "USD"}, "EUR", "0.5", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, err := domain.NewExchangeRate(tt.money.Currency, tt.to, tt.rate)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			converted, err := tt.money.Convert(rate)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if converted.Amount != tt.expected || converted.Currency != tt.to {
+				t.Fatalf("expected %d %s, got %d %s", tt.expected, tt.to, converted.Amount, converted.Currency)
+			}
+		})
+	}
+
+	overflow := domain.ExchangeRate{From: "USD", To: "JPY", Rate: big.NewRat(math.MaxInt64, 1)}
+	if _, err := (domain.Money{Amount: 1000, Currency: "USD"}).Convert(overflow); err == nil {
+		t.Fatal("expected overflow error")
+	}
+}
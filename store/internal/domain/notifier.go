@@ -0,0 +1,23 @@
+package domain
+
+import "context"
+
+type NotificationChannel string
+
+const (
+	EmailNotificationChannel NotificationChannel = "email"
+	SMSNotificationChannel   NotificationChannel = "sms"
+)
+
+// Notification is a message sent to a customer by email or SMS
+type Notification struct {
+	Channel NotificationChannel `json:"channel"`
+	To      string              `json:"to"`
+	Subject string              `json:"subject"`
+	Body    string              `json:"body"`
+}
+
+// Notifier sends notifications to the customers, providers implement this port
+type Notifier interface {
+	Notify(ctx context.Context, notification Notification) error
+}
@@ -0,0 +1,17 @@
+package domain
+
+// Order events, published by the order service and read from its events feed
+const (
+	OrderCreated            EventType = "OrderCreated"
+	OrderPaid               EventType = "OrderPaid"
+	OrderAccepted           EventType = "OrderAccepted"
+	OrderRejected           EventType = "OrderRejected"
+	OrderCanceled           EventType = "OrderCanceled"
+	OrderFulfillmentUpdated EventType = "OrderFulfillmentUpdated"
+)
+
+// OrderEventData is the part of the order events the store service reads
+type OrderEventData struct {
+	ID      string `json:"id"`
+	StoreID string `json:"store_id"`
+}
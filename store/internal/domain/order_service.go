@@ -0,0 +1,7 @@
+package domain
+
+import "context"
+
+type OrderService interface {
+	CountOpenOrders(ctx context.Context, storeID string) (int64, error)
+}
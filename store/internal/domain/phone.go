@@ -3,8 +3,44 @@ package domain
 import (
 	"fmt"
 	"regexp"
+	"strings"
 )
 
+// DefaultPhoneRegion is the region of the local phone numbers when none is given
+const DefaultPhoneRegion = "PE"
+
+var (
+	phoneRegex          = regexp.MustCompile(`^\+[1-9]\d{1,14}$`)
+	phoneSeparatorRegex = regexp.MustCompile(`[\s\-.()/]`)
+)
+
+// phoneRegion is the numbering plan of a country: its calling code, the trunk prefix dialed before local
+// numbers and the lengths of its national numbers. National numbers do not start with the trunk prefix.
+type phoneRegion struct {
+	callingCode string
+	trunkPrefix string
+	minLength   int
+	maxLength   int
+}
+
+var phoneRegions = map[string]phoneRegion{
+	"AR": {callingCode: "54", trunkPrefix: "0", minLength: 10, maxLength: 11},
+	"BO": {callingCode: "591", trunkPrefix: "0", minLength: 8, maxLength: 8},
+	"BR": {callingCode: "55", trunkPrefix: "0", minLength: 10, maxLength: 11},
+	"CA": {callingCode: "1", trunkPrefix: "1", minLength: 10, maxLength: 10},
+	"CL": {callingCode: "56", minLength: 9, maxLength: 9},
+	"CO": {callingCode: "57", minLength: 10, maxLength: 10},
+	"EC": {callingCode: "593", trunkPrefix: "0", minLength: 8, maxLength: 9},
+	"ES": {callingCode: "34", minLength: 9, maxLength: 9},
+	"GB": {callingCode: "44", trunkPrefix: "0", minLength: 10, maxLength: 10},
+	"MX": {callingCode: "52", minLength: 10, maxLength: 10},
+	"PE": {callingCode: "51", trunkPrefix: "0", minLength: 8, maxLength: 9},
+	"PY": {callingCode: "595", trunkPrefix: "0", minLength: 9, maxLength: 9},
+	"US": {callingCode: "1", trunkPrefix: "1", minLength: 10, maxLength: 10},
+	"UY": {callingCode: "598", trunkPrefix: "0", minLength: 8, maxLength: 8},
+	"VE": {callingCode: "58", trunkPrefix: "0", minLength: 10, maxLength: 10},
+}
+
 type Phone string
 
 func NewPhone(phone string) (Phone, error) {
@@ -12,7 +48,6 @@ func NewPhone(phone string) (Phone, error) {
 		return "", fmt.Errorf("phone cannot be empty")
 	}
 
-	phoneRegex := regexp.MustCompile(`^\+[1-9]\d{1,14}$`)
 	if !phoneRegex.MatchString(phone) {
 		return "", fmt.Errorf("invalid phone format, should be +code number")
 	}
@@ -20,6 +55,49 @@ func NewPhone(phone string) (Phone, error) {
 	return Phone(phone), nil
 }
 
+// NormalizePhone returns the E.164 form of a phone number. International numbers, with + or 00, keep their
+// calling code, local numbers like "987 654 321" or "(01) 555-1234" get the calling code of the region.
+func NormalizePhone(phone string, region string) (Phone, error) {
+	value := phoneSeparatorRegex.ReplaceAllString(strings.TrimSpace(phone), "")
+	if value == "" {
+		return "", fmt.Errorf("phone cannot be empty")
+	}
+
+	if strings.HasPrefix(value, "00") {
+		value = "+" + strings.TrimPrefix(value, "00")
+	}
+
+	if strings.HasPrefix(value, "+") {
+		return NewPhone(value)
+	}
+
+	if region == "" {
+		region = DefaultPhoneRegion
+	}
+
+	plan, ok := phoneRegions[strings.ToUpper(region)]
+	if !ok {
+		return "", fmt.Errorf("phone region %s is not supported", region)
+	}
+
+	national := value
+	if plan.trunkPrefix != "" && strings.HasPrefix(national, plan.trunkPrefix) {
+		national = strings.TrimPrefix(national, plan.trunkPrefix)
+	}
+
+	if len(national) < plan.minLength || len(national) > plan.maxLength {
+		return "", fmt.Errorf("invalid phone %s for region %s", phone, strings.ToUpper(region))
+	}
+
+	return NewPhone("+" + plan.callingCode + national)
+}
+
+// IsPhoneRegion tells if local phone numbers of the region can be normalized
+func IsPhoneRegion(region string) bool {
+	_, ok := phoneRegions[strings.ToUpper(region)]
+	return ok
+}
+
 func (p Phone) String() string {
 	return string(p)
 }
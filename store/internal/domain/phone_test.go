@@ -0,0 +1,49 @@
+package domain_test
+
+import (
+	"testing"
+
+	"ichibuy/store/internal/domain"
+)
+
+func TestNormalizePhone(t *testing.T) {
+	tests := []struct {
+		phone   string
+		region  string
+		want    string
+		wantErr bool
+	}{
+		{phone: "987 654 321", region: "PE", want: "+51987654321"},
+		{phone: "(01) 555-1234", region: "pe", want: "+5115551234"},
+		{phone: "987654321", region: "", want: "+51987654321"},
+		{phone: "+51 987 654 321", region: "US", want: "+51987654321"},
+		{phone: "0051987654321", region: "PE", want: "+51987654321"},
+		{phone: "(415) 555-2671", region: "US", want: "+14155552671"},
+		{phone: "1 415 555 2671", region: "US", want: "+14155552671"},
+		{phone: "55 1234 5678", region: "MX", want: "+525512345678"},
+		{phone: "12345", region: "PE", wantErr: true},
+		{phone: "987654321", region: "XX", wantErr: true},
+		{phone: "98765a321", region: "PE", wantErr: true},
+		{phone: "", region: "PE", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.phone+"_"+tt.region, func(t *testing.T) {
+			got, err := domain.NormalizePhone(tt.phone, tt.region)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %s", got)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got.String() != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
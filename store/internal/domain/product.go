@@ -18,6 +18,7 @@ type Product struct {
 	Prices      json.RawMessage `sql:"prices"`
 	CreatedAt   time.Time       `sql:"created_at"`
 	UpdatedAt   time.Time       `sql:"updated_at"`
+	DeletedAt   *time.Time      `sql:"deleted_at"`
 
 	// Non-storable
 	prices map[string]Price
@@ -98,6 +99,14 @@ func (p *Product) GetUpdatedAt() time.Time {
 	return p.UpdatedAt
 }
 
+func (p *Product) GetDeletedAt() *time.Time {
+	return p.DeletedAt
+}
+
+func (p *Product) IsDeleted() bool {
+	return p.DeletedAt != nil
+}
+
 func (p *Product) removeImages(ids []string) {
 	images := p.GetImages()
 	for _, id := range ids {
@@ -169,13 +178,7 @@ func (p *Product) Update(
 	p.Images = rawImg
 	p.Prices = rawPrice
 
-	data, _ := json.Marshal(p.createEventData())
-	event := Event{
-		ID:        fmt.Sprintf("%s_%v", p.GetID(), p.GetUpdatedAt().Unix()),
-		Type:      ProductUpdated,
-		Data:      data,
-		Timestamp: p.GetUpdatedAt(),
-	}
+	event := NewEvent(ProductUpdated, ProductAggregate, p.GetID(), p.createEventData(), p.GetUpdatedAt())
 	p.events = append(p.events, event)
 
 	return nil
@@ -191,26 +194,63 @@ func (p *Product) imageIDs() []string {
 	return ids
 }
 
-func (p *Product) PrepareDelete(ctx context.Context, storageSvc StorageService) error {
-	err := storageSvc.DeleteFiles(ctx, p.imageIDs())
-	if err != nil {
-		slog.ErrorContext(ctx, "delete files from storage failed", "image_ids", p.imageIDs(), "error", err.Error())
-		return err
+// SoftDelete marks the product as deleted, its images are kept until it is purged
+func (p *Product) SoftDelete() error {
+	if p.IsDeleted() {
+		return fmt.Errorf("product is already deleted")
 	}
 
-	data, _ := json.Marshal(p.createEventData())
+	p.softDeleteAt(time.Now().UTC())
+	return nil
+}
+
+func (p *Product) softDeleteAt(deletedAt time.Time) {
+	p.DeletedAt = &deletedAt
+	p.UpdatedAt = deletedAt
 
-	event := Event{
-		ID:        fmt.Sprintf("%s_%v_delete", p.GetID(), p.GetUpdatedAt().Unix()),
-		Type:      ProductDeleted,
-		Data:      data,
-		Timestamp: p.GetUpdatedAt(),
+	p.appendDeletedEvent()
+}
+
+// Restore undoes a soft delete made within the retention window
+func (p *Product) Restore(retention time.Duration) error {
+	if err := checkRestorable(p.DeletedAt, retention); err != nil {
+		return err
 	}
 
+	p.DeletedAt = nil
+	p.UpdatedAt = time.Now().UTC()
+
+	event := NewEvent(ProductRestored, ProductAggregate, p.GetID(), p.createEventData(), p.GetUpdatedAt())
+
 	p.events = append(p.events, event)
 	return nil
 }
 
+// PreparePurge is called before the row is hard deleted. Products that were not soft deleted (e.g. purged
+// along with their store) emit ProductDeleted.
+func (p *Product) PreparePurge() {
+	if !p.IsDeleted() {
+		now := time.Now().UTC()
+		p.DeletedAt = &now
+		p.UpdatedAt = now
+		p.appendDeletedEvent()
+	}
+}
+
+// PurgeImages deletes the product images from storage once the row is hard deleted. It is best effort, a
+// failure leaves the files orphaned in storage but never a product without its images.
+func (p *Product) PurgeImages(ctx context.Context, storageSvc StorageService) {
+	if err := storageSvc.DeleteFiles(ctx, p.imageIDs()); err != nil {
+		slog.ErrorContext(ctx, "delete files from storage failed", "image_ids", p.imageIDs(), "error", err.Error())
+	}
+}
+
+func (p *Product) appendDeletedEvent() {
+	event := NewEvent(ProductDeleted, ProductAggregate, p.GetID(), p.createEventData(), p.GetUpdatedAt())
+
+	p.events = append(p.events, event)
+}
+
 func (p *Product) createEventData() ProductEventData {
 	return ProductEventData{
 		ID:          p.GetID(),
@@ -222,5 +262,6 @@ func (p *Product) createEventData() ProductEventData {
 		Prices:      p.GetPrices(),
 		CreatedAt:   p.GetCreatedAt(),
 		UpdatedAt:   p.GetUpdatedAt(),
+		DeletedAt:   p.GetDeletedAt(),
 	}
 }
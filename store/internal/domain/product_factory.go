@@ -2,7 +2,6 @@ package domain
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log/slog"
 	"strings"
@@ -82,13 +81,7 @@ func (f *ProductFactory) NewProduct(
 		UpdatedAt:   time.Now().UTC(),
 	}
 
-	data, _ := json.Marshal(product.createEventData())
-	event := Event{
-		ID:        fmt.Sprintf("%s_%v", product.GetID(), product.CreatedAt.Unix()),
-		Type:      ProductCreated,
-		Data:      data,
-		Timestamp: product.CreatedAt,
-	}
+	event := NewEvent(ProductCreated, ProductAggregate, product.GetID(), product.createEventData(), product.CreatedAt)
 	product.events = append(product.events, event)
 
 	return product, nil
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// PurgeCutoff returns the time before which soft deleted records are out of the restore window and purged
+func PurgeCutoff(now time.Time, retention time.Duration) time.Time {
+	return now.Add(-retention)
+}
+
+// IsPurgeable reports whether a record deleted at deletedAt is out of the restore window at now
+func IsPurgeable(deletedAt *time.Time, now time.Time, retention time.Duration) bool {
+	return deletedAt != nil && deletedAt.Before(PurgeCutoff(now, retention))
+}
+
+func checkRestorable(deletedAt *time.Time, retention time.Duration) error {
+	if deletedAt == nil {
+		return fmt.Errorf("record is not deleted")
+	}
+
+	if IsPurgeable(deletedAt, time.Now().UTC(), retention) {
+		return fmt.Errorf("restore window of %s expired", retention)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"ichibuy/store/internal/domain"
+)
+
+func TestIsPurgeable(t *testing.T) {
+	now := time.Date(2026, 3, 31, 12, 0, 0, 0, time.UTC)
+	retention := 30 * 24 * time.Hour
+	at := func(d time.Duration) *time.Time {
+		deletedAt := now.Add(-d)
+		return &deletedAt
+	}
+
+	tests := []struct {
+		name      string
+		deletedAt *time.Time
+		purgeable bool
+	}{
+		{name: "not deleted", deletedAt: nil, purgeable: false},
+		{name: "deleted within the window", deletedAt: at(24 * time.Hour), purgeable: false},
+		{name: "deleted at the cutoff", deletedAt: at(retention), purgeable: false},
+		{name: "deleted before the cutoff", deletedAt: at(retention + time.Second), purgeable: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := domain.IsPurgeable(tt.deletedAt, now, retention); got != tt.purgeable {
+				t.Errorf("got %v, want %v", got, tt.purgeable)
+			}
+		})
+	}
+}
+
+func TestStore_Restore(t *testing.T) {
+	retention := 30 * 24 * time.Hour
+
+	tests := []struct {
+		name      string
+		deletedAt *time.Time
+		expectErr bool
+	}{
+		{name: "not deleted", deletedAt: nil, expectErr: true},
+		{name: "deleted within the window", deletedAt: ptrTime(time.Now().UTC().Add(-time.Hour)), expectErr: false},
+		{name: "deleted out of the window", deletedAt: ptrTime(time.Now().UTC().Add(-retention - time.Hour)), expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store, err := domain.NewStore("store-1", "Coffee", nil, -12.04, -77.04, []string{"PEN"}, "PE", "user-1")
+			if err != nil {
+				t.Fatal(err)
+			}
+			store.DeletedAt = tt.deletedAt
+
+			err = store.Restore(retention)
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("expected error %v, got %v", tt.expectErr, err)
+			}
+			if err == nil && store.IsDeleted() {
+				t.Errorf("expected store restored")
+			}
+		})
+	}
+}
+
+func ptrTime(t time.Time) *time.Time {
+	return &t
+}
@@ -8,20 +8,33 @@ import (
 )
 
 type Store struct {
-	ID          string    `sql:"id,primary"`
-	Name        string    `sql:"name"`
-	Description *string   `sql:"description"`
-	Lat         float64   `sql:"lat"`
-	Lng         float64   `sql:"lng"`
-	Slug        string    `sql:"slug"`
-	UserID      string    `sql:"user_id"`
-	CreatedAt   time.Time `sql:"created_at"`
-	UpdatedAt   time.Time `sql:"updated_at"`
+	ID          string          `sql:"id,primary"`
+	Name        string          `sql:"name"`
+	Description *string         `sql:"description"`
+	Lat         float64         `sql:"lat"`
+	Lng         float64         `sql:"lng"`
+	Slug        string          `sql:"slug"`
+	Currencies  json.RawMessage `sql:"currencies"`
+	PhoneRegion string          `sql:"phone_region"`
+	UserID      string          `sql:"user_id"`
+	CreatedAt   time.Time       `sql:"created_at"`
+	UpdatedAt   time.Time       `sql:"updated_at"`
+	DeletedAt   *time.Time      `sql:"deleted_at"`
+
+	Timezone           string          `sql:"timezone"`
+	OpeningHours       json.RawMessage `sql:"opening_hours"`
+	ScheduleExceptions json.RawMessage `sql:"schedule_exceptions"`
+	AllowsPreOrders    bool            `sql:"allows_pre_orders"`
 
 	Entity
 }
 
-func NewStore(id, name string, description *string, lat, lng float64, userID string) (*Store, error) {
+// DefaultStoreCurrencies are enabled on stores that do not choose their own
+var DefaultStoreCurrencies = []string{"USD", "PEN"}
+
+// NewStore creates a store, it enables the default currencies when none are given and normalizes the local
+// phone numbers of its customers with the default phone region when none is given
+func NewStore(id, name string, description *string, lat, lng float64, currencies []string, phoneRegion string, userID string) (*Store, error) {
 	if name == "" {
 		return nil, fmt.Errorf("name cannot be empty")
 	}
@@ -42,6 +55,29 @@ func NewStore(id, name string, description *string, lat, lng float64, userID str
 		return nil, fmt.Errorf("longitude must be between -180 and 180")
 	}
 
+	if len(currencies) == 0 {
+		currencies = DefaultStoreCurrencies
+	}
+
+	rawCurrencies, err := newStoreCurrencies(currencies)
+	if err != nil {
+		return nil, err
+	}
+
+	if phoneRegion == "" {
+		phoneRegion = DefaultPhoneRegion
+	}
+
+	phoneRegion, err = validatePhoneRegion(phoneRegion)
+	if err != nil {
+		return nil, err
+	}
+
+	rawOpeningHours, rawExceptions, err := newStoreSchedule(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
 	now := time.Now().UTC()
 	slug := generateSlug(name, now.Unix())
 
@@ -52,33 +88,25 @@ func NewStore(id, name string, description *string, lat, lng float64, userID str
 		Lat:         lat,
 		Lng:         lng,
 		Slug:        slug,
+		Currencies:  rawCurrencies,
+		PhoneRegion: phoneRegion,
 		UserID:      userID,
 		CreatedAt:   now,
 		UpdatedAt:   now,
-	}
 
-	data, _ := json.Marshal(StoreEventData{
-		ID:          store.GetID(),
-		Name:        store.GetName(),
-		Description: store.GetDescription(),
-		Location:    store.Location(),
-		Slug:        store.GetSlug(),
-		UserID:      store.GetUserID(),
-		CreatedAt:   store.GetCreatedAt(),
-		UpdatedAt:   store.GetUpdatedAt(),
-	})
-	event := Event{
-		ID:        fmt.Sprintf("%s_%v", store.GetID(), store.GetCreatedAt().Unix()),
-		Type:      StoreCreated,
-		Data:      data,
-		Timestamp: store.GetCreatedAt(),
+		Timezone:           DefaultStoreTimezone,
+		OpeningHours:       rawOpeningHours,
+		ScheduleExceptions: rawExceptions,
 	}
+
+	event := NewEvent(StoreCreated, StoreAggregate, store.GetID(), store.createEventData(), store.GetCreatedAt())
 	store.events = append(store.events, event)
 
 	return store, nil
 }
 
-func (s *Store) Update(name string, description *string, lat, lng float64, userID string) error {
+// Update changes the store data, currencies and phone region are kept when none are given
+func (s *Store) Update(name string, description *string, lat, lng float64, currencies []string, phoneRegion string, userID string) error {
 	if userID != s.UserID {
 		return fmt.Errorf("user id does not match")
 	}
@@ -103,6 +131,22 @@ func (s *Store) Update(name string, description *string, lat, lng float64, userI
 		return fmt.Errorf("longitude must be between -180 and 180")
 	}
 
+	if len(currencies) > 0 {
+		rawCurrencies, err := newStoreCurrencies(currencies)
+		if err != nil {
+			return err
+		}
+		s.Currencies = rawCurrencies
+	}
+
+	if phoneRegion != "" {
+		region, err := validatePhoneRegion(phoneRegion)
+		if err != nil {
+			return err
+		}
+		s.PhoneRegion = region
+	}
+
 	if s.Name != name {
 		s.Slug = generateSlug(name, time.Now().Unix())
 	}
@@ -113,29 +157,100 @@ func (s *Store) Update(name string, description *string, lat, lng float64, userI
 	s.Lng = lng
 	s.UpdatedAt = time.Now().UTC()
 
-	data, _ := json.Marshal(s.createEventData())
-	event := Event{
-		ID:        fmt.Sprintf("%s_%v", s.GetID(), s.GetUpdatedAt().Unix()),
-		Type:      StoreUpdated,
-		Data:      data,
-		Timestamp: s.GetUpdatedAt(),
+	event := NewEvent(StoreUpdated, StoreAggregate, s.GetID(), s.createEventData(), s.GetUpdatedAt())
+	s.events = append(s.events, event)
+
+	return nil
+}
+
+// SetSchedule replaces the opening hours, exceptions and pre-order policy of the store
+func (s *Store) SetSchedule(schedule StoreSchedule, userID string) error {
+	if err := s.CheckOwner(userID); err != nil {
+		return err
+	}
+
+	if err := schedule.Validate(); err != nil {
+		return err
 	}
+
+	rawOpeningHours, rawExceptions, err := newStoreSchedule(schedule.OpeningHours, schedule.Exceptions)
+	if err != nil {
+		return err
+	}
+
+	s.Timezone = schedule.Timezone
+	s.OpeningHours = rawOpeningHours
+	s.ScheduleExceptions = rawExceptions
+	s.AllowsPreOrders = schedule.AllowsPreOrders
+	s.UpdatedAt = time.Now().UTC()
+
+	event := NewEvent(StoreUpdated, StoreAggregate, s.GetID(), s.createEventData(), s.GetUpdatedAt())
 	s.events = append(s.events, event)
 
 	return nil
 }
 
-func (s *Store) PrepareDelete() {
-	data, _ := json.Marshal(s.createEventData())
+// IsOpenAt tells if the store is open at the given instant according to its schedule
+func (s *Store) IsOpenAt(at time.Time) bool {
+	return s.Schedule().IsOpenAt(at)
+}
+
+// CheckOwner returns an error when the store does not belong to the given user
+func (s *Store) CheckOwner(userID string) error {
+	if userID != s.UserID {
+		return fmt.Errorf("user id does not match")
+	}
+	return nil
+}
+
+// SoftDelete marks the store as deleted, it can be restored until it is purged
+func (s *Store) SoftDelete() error {
+	if s.IsDeleted() {
+		return fmt.Errorf("store is already deleted")
+	}
+
+	now := time.Now().UTC()
+	s.DeletedAt = &now
+	s.UpdatedAt = now
+
+	event := NewEvent(StoreDeleted, StoreAggregate, s.GetID(), s.createEventData(), s.GetUpdatedAt())
+
+	s.events = append(s.events, event)
+	return nil
+}
 
-	event := Event{
-		ID:        fmt.Sprintf("%s_%v_delete", s.GetID(), s.GetUpdatedAt().Unix()),
-		Type:      StoreDeleted,
-		Data:      data,
-		Timestamp: s.GetUpdatedAt(),
+// Restore undoes a soft delete made within the retention window
+func (s *Store) Restore(retention time.Duration) error {
+	if err := checkRestorable(s.DeletedAt, retention); err != nil {
+		return err
 	}
 
+	s.DeletedAt = nil
+	s.UpdatedAt = time.Now().UTC()
+
+	event := NewEvent(StoreRestored, StoreAggregate, s.GetID(), s.createEventData(), s.GetUpdatedAt())
+
 	s.events = append(s.events, event)
+	return nil
+}
+
+// CheckPrices returns an error when a price uses a currency the store has not enabled
+func (s *Store) CheckPrices(prices []Price) error {
+	enabled := map[string]bool{}
+	for _, code := range s.GetCurrencies() {
+		enabled[code] = true
+	}
+
+	for _, price := range prices {
+		if !enabled[price.Value.GetCurrency()] {
+			return fmt.Errorf("currency %s is not enabled for the store", price.Value.GetCurrency())
+		}
+	}
+	return nil
+}
+
+func (s *Store) IsDeleted() bool {
+	return s.DeletedAt != nil
 }
 
 func (s *Store) createEventData() StoreEventData {
@@ -145,17 +260,47 @@ func (s *Store) createEventData() StoreEventData {
 		Description: s.GetDescription(),
 		Location:    s.Location(),
 		Slug:        s.GetSlug(),
+		Currencies:  s.GetCurrencies(),
+		PhoneRegion: s.GetPhoneRegion(),
 		UserID:      s.GetUserID(),
 		CreatedAt:   s.GetCreatedAt(),
 		UpdatedAt:   s.GetUpdatedAt(),
+		DeletedAt:   s.GetDeletedAt(),
+		Schedule:    s.Schedule(),
 	}
 }
 
+func validatePhoneRegion(region string) (string, error) {
+	region = strings.ToUpper(strings.TrimSpace(region))
+	if !IsPhoneRegion(region) {
+		return "", fmt.Errorf("phone region %s is not supported", region)
+	}
+	return region, nil
+}
+
 // Location returns a Location value object
 func (s *Store) Location() Location {
 	return Location{Lat: s.Lat, Lng: s.Lng}
 }
 
+func newStoreCurrencies(codes []string) (json.RawMessage, error) {
+	seen := map[string]bool{}
+	currencies := []string{}
+	for _, code := range codes {
+		currency, err := FindCurrency(strings.TrimSpace(code))
+		if err != nil {
+			return nil, err
+		}
+
+		if !seen[currency.Code] {
+			seen[currency.Code] = true
+			currencies = append(currencies, currency.Code)
+		}
+	}
+
+	return toRawMessage(currencies)
+}
+
 func generateSlug(name string, timestamp int64) string {
 	slug := strings.ToLower(name)
 	slug = strings.ReplaceAll(slug, " ", "-")
@@ -182,12 +327,46 @@ type Location struct {
 }
 
 // Getters
-func (s *Store) GetID() string           { return s.ID }
-func (s *Store) GetName() string         { return s.Name }
-func (s *Store) GetDescription() *string { return s.Description }
-func (s *Store) GetLat() float64         { return s.Lat }
-func (s *Store) GetLng() float64         { return s.Lng }
-func (s *Store) GetSlug() string         { return s.Slug }
-func (s *Store) GetUserID() string       { return s.UserID }
-func (s *Store) GetCreatedAt() time.Time { return s.CreatedAt }
-func (s *Store) GetUpdatedAt() time.Time { return s.UpdatedAt }
+func (s *Store) GetID() string            { return s.ID }
+func (s *Store) GetName() string          { return s.Name }
+func (s *Store) GetDescription() *string  { return s.Description }
+func (s *Store) GetLat() float64          { return s.Lat }
+func (s *Store) GetLng() float64          { return s.Lng }
+func (s *Store) GetSlug() string          { return s.Slug }
+func (s *Store) GetPhoneRegion() string   { return s.PhoneRegion }
+func (s *Store) GetUserID() string        { return s.UserID }
+func (s *Store) GetCreatedAt() time.Time  { return s.CreatedAt }
+func (s *Store) GetUpdatedAt() time.Time  { return s.UpdatedAt }
+func (s *Store) GetDeletedAt() *time.Time { return s.DeletedAt }
+
+// Schedule returns the schedule of the store, stores without one are always open
+func (s *Store) Schedule() StoreSchedule {
+	schedule := StoreSchedule{
+		Timezone:        s.Timezone,
+		OpeningHours:    []OpeningHours{},
+		Exceptions:      []ScheduleException{},
+		AllowsPreOrders: s.AllowsPreOrders,
+	}
+
+	if schedule.Timezone == "" {
+		schedule.Timezone = DefaultStoreTimezone
+	}
+
+	if len(s.OpeningHours) > 0 {
+		_ = json.Unmarshal(s.OpeningHours, &schedule.OpeningHours)
+	}
+
+	if len(s.ScheduleExceptions) > 0 {
+		_ = json.Unmarshal(s.ScheduleExceptions, &schedule.Exceptions)
+	}
+
+	return schedule
+}
+
+func (s *Store) GetCurrencies() []string {
+	currencies := []string{}
+	if len(s.Currencies) > 0 {
+		_ = json.Unmarshal(s.Currencies, &currencies)
+	}
+	return currencies
+}
@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+)
+
+// StoreDeleter deletes a store along with its products, as long as the store has no open orders
+type StoreDeleter struct {
+	orderSvc OrderService
+}
+
+func NewStoreDeleter(orderSvc OrderService) *StoreDeleter {
+	return &StoreDeleter{orderSvc: orderSvc}
+}
+
+// Delete soft deletes the store and its products, emitting ProductDeleted per product and StoreDeleted.
+// Open orders block the deletion unless force is set. Product images are removed from storage when the store is purged.
+func (d *StoreDeleter) Delete(ctx context.Context, store *Store, products []*Product, userID string, force bool) error {
+	if err := store.CheckOwner(userID); err != nil {
+		return err
+	}
+
+	if store.IsDeleted() {
+		return fmt.Errorf("store is already deleted")
+	}
+
+	openOrders, err := d.orderSvc.CountOpenOrders(ctx, store.GetID())
+	if err != nil {
+		slog.ErrorContext(ctx, "count open orders failed", "store_id", store.GetID(), "error", err.Error())
+		return err
+	}
+
+	if openOrders > 0 && !force {
+		return fmt.Errorf("store has %d open orders, use force to delete it anyway", openOrders)
+	}
+
+	if err := store.SoftDelete(); err != nil {
+		return err
+	}
+
+	for _, product := range products {
+		if product.GetStoreID() != store.GetID() {
+			return fmt.Errorf("product %s does not belong to store", product.GetID())
+		}
+
+		if product.IsDeleted() {
+			continue
+		}
+
+		product.softDeleteAt(*store.GetDeletedAt())
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package domain_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"ichibuy/store/internal/domain"
+)
+
+type orderServiceStub struct {
+	openOrders int64
+	err        error
+}
+
+func (s orderServiceStub) CountOpenOrders(ctx context.Context, storeID string) (int64, error) {
+	return s.openOrders, s.err
+}
+
+func TestStoreDeleter_Delete(t *testing.T) {
+	deletedAt := time.Now().UTC().Add(-time.Hour)
+
+	tests := []struct {
+		name       string
+		userID     string
+		openOrders int64
+		orderErr   error
+		force      bool
+		productIn  string
+		expectErr  bool
+	}{
+		{name: "no open orders", userID: "user-1"},
+		{name: "open orders", userID: "user-1", openOrders: 2, expectErr: true},
+		{name: "open orders forced", userID: "user-1", openOrders: 2, force: true},
+		{name: "order service failed", userID: "user-1", orderErr: errors.New("unavailable"), expectErr: true},
+		{name: "not the owner", userID: "user-2", expectErr: true},
+		{name: "product of another store", userID: "user-1", productIn: "store-2", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store, err := domain.NewStore("store-1", "Coffee", nil, -12.04, -77.04, []string{"PEN"}, "PE", "user-1")
+			if err != nil {
+				t.Fatal(err)
+			}
+			store.PullEvents()
+
+			productStoreID := "store-1"
+			if tt.productIn != "" {
+				productStoreID = tt.productIn
+			}
+			products := []*domain.Product{
+				{ID: "product-1", StoreID: productStoreID, Images: json.RawMessage("[]"), Prices: json.RawMessage("[]")},
+				{ID: "product-2", StoreID: productStoreID, Images: json.RawMessage("[]"), Prices: json.RawMessage("[]"), DeletedAt: &deletedAt},
+			}
+
+			deleter := domain.NewStoreDeleter(orderServiceStub{openOrders: tt.openOrders, err: tt.orderErr})
+			err = deleter.Delete(context.Background(), store, products, tt.userID, tt.force)
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("expected error %v, got %v", tt.expectErr, err)
+			}
+			if tt.expectErr {
+				return
+			}
+
+			if !store.IsDeleted() || !products[0].IsDeleted() {
+				t.Fatalf("expected store and products deleted")
+			}
+			if !products[0].GetDeletedAt().Equal(*store.GetDeletedAt()) {
+				t.Errorf("expected product deleted with the store, got %v and %v", products[0].GetDeletedAt(), store.GetDeletedAt())
+			}
+			if !products[1].GetDeletedAt().Equal(deletedAt) {
+				t.Errorf("expected already deleted product kept, got %v", products[1].GetDeletedAt())
+			}
+		})
+	}
+}
@@ -0,0 +1,207 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	// embeds the timezone database, so the store timezones load on hosts without one
+	_ "time/tzdata"
+)
+
+const (
+	// DefaultStoreTimezone is used by stores that have not set a schedule
+	DefaultStoreTimezone = "UTC"
+	scheduleDateLayout   = "2006-01-02"
+)
+
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// TimeRange is a range of the day in HH:MM format, Close can be "24:00" to include the end of the day.
+// Ranges do not cross midnight, a store open overnight uses a range on each day.
+type TimeRange struct {
+	Open  string `json:"open"`
+	Close string `json:"close"`
+}
+
+// OpeningHours are the hours a store opens every week on a day, e.g. monday
+type OpeningHours struct {
+	Day   string `json:"day"`
+	Open  string `json:"open"`
+	Close string `json:"close"`
+}
+
+// ScheduleException replaces the opening hours of a date, like a holiday. Without hours the store is
+// closed the whole date.
+type ScheduleException struct {
+	Date  string      `json:"date"`
+	Name  string      `json:"name"`
+	Hours []TimeRange `json:"hours"`
+}
+
+// StoreSchedule groups the weekly opening hours of a store with its exceptions. A schedule without
+// opening hours keeps the store always open.
+type StoreSchedule struct {
+	Timezone        string              `json:"timezone"`
+	OpeningHours    []OpeningHours      `json:"opening_hours"`
+	Exceptions      []ScheduleException `json:"exceptions"`
+	AllowsPreOrders bool                `json:"allows_pre_orders"`
+}
+
+// Validate normalizes the days of the schedule and returns an error when it is not valid
+func (s *StoreSchedule) Validate() error {
+	if s.Timezone == "" {
+		s.Timezone = DefaultStoreTimezone
+	}
+
+	if _, err := time.LoadLocation(s.Timezone); err != nil {
+		return fmt.Errorf("invalid timezone %s", s.Timezone)
+	}
+
+	for i, hours := range s.OpeningHours {
+		day := strings.ToLower(strings.TrimSpace(hours.Day))
+		if _, ok := weekdays[day]; !ok {
+			return fmt.Errorf("invalid day %s", hours.Day)
+		}
+		s.OpeningHours[i].Day = day
+
+		if err := validateTimeRange(TimeRange{Open: hours.Open, Close: hours.Close}); err != nil {
+			return fmt.Errorf("%s: %w", day, err)
+		}
+	}
+
+	dates := map[string]bool{}
+	for _, exception := range s.Exceptions {
+		if _, err := time.Parse(scheduleDateLayout, exception.Date); err != nil {
+			return fmt.Errorf("invalid exception date %s, expected YYYY-MM-DD", exception.Date)
+		}
+
+		if dates[exception.Date] {
+			return fmt.Errorf("exception date %s is repeated", exception.Date)
+		}
+		dates[exception.Date] = true
+
+		if len(exception.Name) > 100 {
+			return fmt.Errorf("exception name cannot exceed 100 characters")
+		}
+
+		for _, hours := range exception.Hours {
+			if err := validateTimeRange(hours); err != nil {
+				return fmt.Errorf("%s: %w", exception.Date, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// IsOpenAt tells if the store is open at the given instant, in the timezone of the store. The exception of
+// the date wins over the weekly hours, and stores without weekly hours are always open on the other dates.
+func (s StoreSchedule) IsOpenAt(at time.Time) bool {
+	location, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		location = time.UTC
+	}
+
+	local := at.In(location)
+	minute := local.Hour()*60 + local.Minute()
+
+	date := local.Format(scheduleDateLayout)
+	for _, exception := range s.Exceptions {
+		if exception.Date == date {
+			return inAnyRange(exception.Hours, minute)
+		}
+	}
+
+	if len(s.OpeningHours) == 0 {
+		return true
+	}
+
+	ranges := []TimeRange{}
+	for _, hours := range s.OpeningHours {
+		if weekdays[hours.Day] == local.Weekday() {
+			ranges = append(ranges, TimeRange{Open: hours.Open, Close: hours.Close})
+		}
+	}
+
+	return inAnyRange(ranges, minute)
+}
+
+// AcceptsOrdersAt tells if the store takes orders at the given instant, closed stores take them
+// only when they allow pre-orders
+func (s StoreSchedule) AcceptsOrdersAt(at time.Time) bool {
+	return s.AllowsPreOrders || s.IsOpenAt(at)
+}
+
+func inAnyRange(ranges []TimeRange, minute int) bool {
+	for _, r := range ranges {
+		open, _ := parseClock(r.Open)
+		closing, _ := parseClock(r.Close)
+		if minute >= open && minute < closing {
+			return true
+		}
+	}
+	return false
+}
+
+func validateTimeRange(r TimeRange) error {
+	open, err := parseClock(r.Open)
+	if err != nil {
+		return err
+	}
+
+	closing, err := parseClock(r.Close)
+	if err != nil {
+		return err
+	}
+
+	if closing <= open {
+		return fmt.Errorf("close %s must be after open %s", r.Close, r.Open)
+	}
+
+	return nil
+}
+
+// parseClock returns the minutes since midnight of a HH:MM time
+func parseClock(value string) (int, error) {
+	if value == "24:00" {
+		return 24 * 60, nil
+	}
+
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %s, expected HH:MM", value)
+	}
+
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+func newStoreSchedule(openingHours []OpeningHours, exceptions []ScheduleException) (json.RawMessage, json.RawMessage, error) {
+	if openingHours == nil {
+		openingHours = []OpeningHours{}
+	}
+
+	if exceptions == nil {
+		exceptions = []ScheduleException{}
+	}
+
+	rawOpeningHours, err := toRawMessage(openingHours)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rawExceptions, err := toRawMessage(exceptions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rawOpeningHours, rawExceptions, nil
+}
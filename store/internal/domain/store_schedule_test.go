@@ -0,0 +1,124 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"ichibuy/store/internal/domain"
+)
+
+func TestStoreSchedule_IsOpenAt(t *testing.T) {
+	schedule := domain.StoreSchedule{
+		Timezone: "America/Lima",
+		OpeningHours: []domain.OpeningHours{
+			{Day: "Monday", Open: "09:00", Close: "18:00"},
+			{Day: "friday", Open: "20:00", Close: "24:00"},
+			{Day: "saturday", Open: "00:00", Close: "02:00"},
+		},
+		Exceptions: []domain.ScheduleException{
+			{Date: "2026-12-21", Name: "Holiday"},
+			{Date: "2026-12-28", Name: "Short day", Hours: []domain.TimeRange{{Open: "09:00", Close: "12:00"}}},
+		},
+	}
+	if err := schedule.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	lima, _ := time.LoadLocation("America/Lima")
+	tests := []struct {
+		name     string
+		at       time.Time
+		expected bool
+	}{
+		{"monday open", time.Date(2026, 12, 14, 10, 0, 0, 0, lima), true},
+		{"monday closes", time.Date(2026, 12, 14, 18, 0, 0, 0, lima), false},
+		{"monday in utc", time.Date(2026, 12, 14, 22, 0, 0, 0, time.UTC), true},
+		{"tuesday closed", time.Date(2026, 12, 15, 10, 0, 0, 0, lima), false},
+		{"friday night", time.Date(2026, 12, 18, 23, 30, 0, 0, lima), true},
+		{"saturday early", time.Date(2026, 12, 19, 1, 0, 0, 0, lima), true},
+		{"holiday", time.Date(2026, 12, 21, 10, 0, 0, 0, lima), false},
+		{"short day open", time.Date(2026, 12, 28, 11, 0, 0, 0, lima), true},
+		{"short day closed", time.Date(2026, 12, 28, 13, 0, 0, 0, lima), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := schedule.IsOpenAt(tt.at); got != tt.expected {
+				t.Fatalf("expected open %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestStoreSchedule_AcceptsOrdersAt(t *testing.T) {
+	closedDay := time.Date(2026, 12, 15, 10, 0, 0, 0, time.UTC)
+	schedule := domain.StoreSchedule{
+		OpeningHours: []domain.OpeningHours{{Day: "monday", Open: "09:00", Close: "18:00"}},
+	}
+
+	if schedule.AcceptsOrdersAt(closedDay) {
+		t.Fatal("expected closed store to refuse orders")
+	}
+
+	schedule.AllowsPreOrders = true
+	if !schedule.AcceptsOrdersAt(closedDay) {
+		t.Fatal("expected closed store with pre-orders to accept orders")
+	}
+
+	if !(domain.StoreSchedule{}).IsOpenAt(closedDay) {
+		t.Fatal("expected store without opening hours to be always open")
+	}
+}
+
+func TestStoreSchedule_IsOpenAt_ExceptionsWithoutOpeningHours(t *testing.T) {
+	schedule := domain.StoreSchedule{
+		Timezone: "America/Lima",
+		Exceptions: []domain.ScheduleException{
+			{Date: "2026-12-25", Name: "Christmas"},
+			{Date: "2026-12-31", Name: "New Year's Eve", Hours: []domain.TimeRange{{Open: "09:00", Close: "15:00"}}},
+		},
+	}
+	if err := schedule.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	lima, _ := time.LoadLocation("America/Lima")
+	tests := []struct {
+		name     string
+		at       time.Time
+		expected bool
+	}{
+		{"regular day", time.Date(2026, 12, 24, 20, 0, 0, 0, lima), true},
+		{"closed holiday", time.Date(2026, 12, 25, 10, 0, 0, 0, lima), false},
+		{"short day open", time.Date(2026, 12, 31, 10, 0, 0, 0, lima), true},
+		{"short day closed", time.Date(2026, 12, 31, 16, 0, 0, 0, lima), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := schedule.IsOpenAt(tt.at); got != tt.expected {
+				t.Fatalf("expected open %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestStoreSchedule_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule domain.StoreSchedule
+	}{
+		{"invalid timezone", domain.StoreSchedule{Timezone: "Mars/Olympus"}},
+		{"invalid day", domain.StoreSchedule{OpeningHours: []domain.OpeningHours{{Day: "someday", Open: "09:00", Close: "18:00"}}}},
+		{"close before open", domain.StoreSchedule{OpeningHours: []domain.OpeningHours{{Day: "monday", Open: "18:00", Close: "09:00"}}}},
+		{"invalid date", domain.StoreSchedule{Exceptions: []domain.ScheduleException{{Date: "25/12/2026"}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.schedule.Validate(); err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
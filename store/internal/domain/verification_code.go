@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrVerificationCodeInvalid is returned when the code does not match, each failure counts as an attempt
+	ErrVerificationCodeInvalid = errors.New("verification code is not valid")
+	// ErrVerificationCodeExpired is returned when the code is past its expiry, used or out of attempts
+	ErrVerificationCodeExpired = errors.New("verification code expired, request a new one")
+)
+
+type VerificationChannel string
+
+const (
+	EmailVerificationChannel VerificationChannel = "email"
+	PhoneVerificationChannel VerificationChannel = "phone"
+)
+
+const (
+	verificationCodeLength  = 6
+	maxVerificationAttempts = 5
+)
+
+func ParseVerificationChannel(value string) (VerificationChannel, error) {
+	channel := VerificationChannel(strings.ToLower(strings.TrimSpace(value)))
+	switch channel {
+	case EmailVerificationChannel, PhoneVerificationChannel:
+		return channel, nil
+	}
+	return "", fmt.Errorf("invalid verification channel %s, expected email or phone", value)
+}
+
+// VerificationCode is a one-time code sent to the email or phone of a customer to prove it is theirs.
+// Only the hash of the code is stored.
+type VerificationCode struct {
+	ID         string              `sql:"id,primary"`
+	CustomerID string              `sql:"customer_id"`
+	Channel    VerificationChannel `sql:"channel"`
+	Target     string              `sql:"target"`
+	CodeHash   string              `sql:"code_hash"`
+	Attempts   int                 `sql:"attempts"`
+	ExpiresAt  time.Time           `sql:"expires_at"`
+	ConsumedAt *time.Time          `sql:"consumed_at"`
+	CreatedAt  time.Time           `sql:"created_at"`
+}
+
+// NewVerificationCode returns the verification of the current email or phone of the customer and its
+// crypto random code, to be sent to the customer
+func NewVerificationCode(id string, customer *Customer, channel VerificationChannel, ttl time.Duration) (*VerificationCode, string, error) {
+	target, err := customer.VerificationTarget(channel)
+	if err != nil {
+		return nil, "", err
+	}
+
+	code, err := newVerificationCodeValue()
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := time.Now().UTC()
+	return &VerificationCode{
+		ID:         id,
+		CustomerID: customer.GetID(),
+		Channel:    channel,
+		Target:     target,
+		CodeHash:   hashVerificationCode(id, code),
+		ExpiresAt:  now.Add(ttl),
+		CreatedAt:  now,
+	}, code, nil
+}
+
+// Verify consumes the code when it matches, a wrong code counts as an attempt
+func (v *VerificationCode) Verify(code string, at time.Time) error {
+	if v.ConsumedAt != nil || v.Attempts >= maxVerificationAttempts || v.IsExpired(at) {
+		return ErrVerificationCodeExpired
+	}
+
+	hash := hashVerificationCode(v.ID, strings.TrimSpace(code))
+	if subtle.ConstantTimeCompare([]byte(hash), []byte(v.CodeHash)) != 1 {
+		v.Attempts++
+		return ErrVerificationCodeInvalid
+	}
+
+	consumedAt := at.UTC()
+	v.ConsumedAt = &consumedAt
+	return nil
+}
+
+func (v *VerificationCode) IsExpired(at time.Time) bool {
+	return !at.Before(v.ExpiresAt)
+}
+
+func (v *VerificationCode) GetID() string                   { return v.ID }
+func (v *VerificationCode) GetCustomerID() string           { return v.CustomerID }
+func (v *VerificationCode) GetChannel() VerificationChannel { return v.Channel }
+func (v *VerificationCode) GetTarget() string               { return v.Target }
+func (v *VerificationCode) GetAttempts() int                { return v.Attempts }
+func (v *VerificationCode) GetExpiresAt() time.Time         { return v.ExpiresAt }
+func (v *VerificationCode) GetConsumedAt() *time.Time       { return v.ConsumedAt }
+func (v *VerificationCode) GetCreatedAt() time.Time         { return v.CreatedAt }
+
+func (v *VerificationCode) TableName() string {
+	return "verification_codes"
+}
+
+func newVerificationCodeValue() (string, error) {
+	max := big.NewInt(10)
+	b := make([]byte, verificationCodeLength)
+	for i := range b {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		b[i] = byte('0' + n.Int64())
+	}
+	return string(b), nil
+}
+
+// hashVerificationCode salts the code with the verification ID, so equal codes have different hashes
+func hashVerificationCode(id, code string) string {
+	sum := sha256.Sum256([]byte(id + ":" + code))
+	return hex.EncodeToString(sum[:])
+}
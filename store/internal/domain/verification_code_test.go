@@ -0,0 +1,64 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"ichibuy/store/internal/domain"
+)
+
+func TestVerificationCode_Verify(t *testing.T) {
+	email := "ana@example.com"
+	customer, err := domain.NewCustomer("customer-1", "Ana", "Torres", &email, nil, "user-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	verification, code, err := domain.NewVerificationCode("code-1", customer, domain.EmailVerificationChannel, 10*time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(code) != 6 {
+		t.Fatalf("expected a 6 digit code, got %q", code)
+	}
+
+	wrong := "000000"
+	if code == wrong {
+		wrong = "111111"
+	}
+
+	if err := verification.Verify(wrong, time.Now().UTC()); !errors.Is(err, domain.ErrVerificationCodeInvalid) {
+		t.Errorf("expected ErrVerificationCodeInvalid, got %v", err)
+	}
+
+	if verification.GetAttempts() != 1 {
+		t.Errorf("expected 1 attempt, got %d", verification.GetAttempts())
+	}
+
+	if err := verification.Verify(code, time.Now().Add(11*time.Minute)); !errors.Is(err, domain.ErrVerificationCodeExpired) {
+		t.Errorf("expected ErrVerificationCodeExpired, got %v", err)
+	}
+
+	if err := verification.Verify(code, time.Now().UTC()); err != nil {
+		t.Fatalf("expected the code to match, got %v", err)
+	}
+
+	if err := verification.Verify(code, time.Now().UTC()); !errors.Is(err, domain.ErrVerificationCodeExpired) {
+		t.Errorf("expected a consumed code to be rejected, got %v", err)
+	}
+
+	customer.PullEvents()
+	if err := customer.MarkVerified(domain.EmailVerificationChannel, verification.GetTarget()); err != nil {
+		t.Fatal(err)
+	}
+
+	if customer.GetEmailVerifiedAt() == nil {
+		t.Error("expected the email to be verified")
+	}
+
+	if _, err := customer.VerificationTarget(domain.PhoneVerificationChannel); err == nil {
+		t.Error("expected an error for a customer without phone")
+	}
+}
@@ -0,0 +1,254 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type WebhookDeliveryStatus string
+
+const (
+	PendingWebhookDeliveryStatus   WebhookDeliveryStatus = "pending"
+	SucceededWebhookDeliveryStatus WebhookDeliveryStatus = "succeeded"
+	FailedWebhookDeliveryStatus    WebhookDeliveryStatus = "failed"
+)
+
+const (
+	// MaxWebhookAttempts is the number of sends before a delivery fails for good
+	MaxWebhookAttempts = 6
+	// webhookRetryDelay is the wait after the first failed send, doubled after each failure
+	webhookRetryDelay = time.Minute
+	// MaxWebhookResponseLength is the part of the response body kept in the attempts log
+	MaxWebhookResponseLength = 256
+)
+
+// Headers of the webhook requests
+const (
+	WebhookEventHeader     = "X-Ichibuy-Event"
+	WebhookDeliveryHeader  = "X-Ichibuy-Delivery"
+	WebhookTimestampHeader = "X-Ichibuy-Timestamp"
+	WebhookSignatureHeader = "X-Ichibuy-Signature"
+)
+
+// WebhookPayload is the body posted to the merchant
+type WebhookPayload struct {
+	ID            string          `json:"id"`
+	Type          EventType       `json:"type"`
+	SchemaVersion int             `json:"schema_version"`
+	StoreID       string          `json:"store_id"`
+	Data          json.RawMessage `json:"data"`
+	Timestamp     time.Time       `json:"timestamp"`
+}
+
+// WebhookDelivery is an event sent to a webhook subscription. Pending deliveries are sent once
+// NextAttemptAt is reached and retried with exponential backoff, each send is kept as an attempt.
+type WebhookDelivery struct {
+	ID             string                `sql:"id,primary"`
+	SubscriptionID string                `sql:"subscription_id"`
+	StoreID        string                `sql:"store_id"`
+	EventID        string                `sql:"event_id"`
+	EventType      EventType             `sql:"event_type"`
+	Payload        json.RawMessage       `sql:"payload"`
+	Status         WebhookDeliveryStatus `sql:"status"`
+	Attempts       int                   `sql:"attempts"`
+	NextAttemptAt  *time.Time            `sql:"next_attempt_at"`
+	LastStatusCode *int                  `sql:"last_status_code"`
+	LastError      *string               `sql:"last_error"`
+	DeliveredAt    *time.Time            `sql:"delivered_at"`
+	CreatedAt      time.Time             `sql:"created_at"`
+	UpdatedAt      time.Time             `sql:"updated_at"`
+}
+
+// WebhookDeliveryAttempt is a send of a delivery with the response of the merchant
+type WebhookDeliveryAttempt struct {
+	ID           string    `sql:"id,primary"`
+	DeliveryID   string    `sql:"delivery_id"`
+	StatusCode   *int      `sql:"status_code"`
+	ResponseBody *string   `sql:"response_body"`
+	Error        *string   `sql:"error"`
+	DurationMs   int       `sql:"duration_ms"`
+	AttemptedAt  time.Time `sql:"attempted_at"`
+}
+
+// WebhookRequest is a signed delivery ready to be posted
+type WebhookRequest struct {
+	URL     string
+	Headers map[string]string
+	Body    []byte
+}
+
+type WebhookResponse struct {
+	StatusCode int
+	Body       string
+}
+
+// WebhookClient posts the webhook requests, it returns an error when no response is received
+type WebhookClient interface {
+	Post(ctx context.Context, req WebhookRequest) (*WebhookResponse, error)
+}
+
+func NewWebhookDelivery(id string, subscription *WebhookSubscription, event Event) (*WebhookDelivery, error) {
+	payload, err := json.Marshal(WebhookPayload{
+		ID:            event.ID,
+		Type:          event.Type,
+		SchemaVersion: event.SchemaVersion,
+		StoreID:       subscription.GetStoreID(),
+		Data:          event.Data,
+		Timestamp:     event.Timestamp,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	return &WebhookDelivery{
+		ID:             id,
+		SubscriptionID: subscription.GetID(),
+		StoreID:        subscription.GetStoreID(),
+		EventID:        event.ID,
+		EventType:      event.Type,
+		Payload:        payload,
+		Status:         PendingWebhookDeliveryStatus,
+		NextAttemptAt:  &now,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}, nil
+}
+
+// Request returns the delivery signed by the subscription at the given time
+func (d *WebhookDelivery) Request(subscription *WebhookSubscription, at time.Time) WebhookRequest {
+	timestamp := at.Unix()
+	return WebhookRequest{
+		URL: subscription.GetURL(),
+		Headers: map[string]string{
+			"Content-Type":         "application/json",
+			WebhookEventHeader:     string(d.EventType),
+			WebhookDeliveryHeader:  d.ID,
+			WebhookTimestampHeader: strconv.FormatInt(timestamp, 10),
+			WebhookSignatureHeader: subscription.Sign(timestamp, d.Payload),
+		},
+		Body: d.Payload,
+	}
+}
+
+// RecordAttempt records the result of a send, a 2xx response succeeds and anything else is retried after a
+// delay that doubles on each attempt until MaxWebhookAttempts is reached
+func (d *WebhookDelivery) RecordAttempt(attemptID string, resp *WebhookResponse, sendErr error, duration time.Duration, at time.Time) *WebhookDeliveryAttempt {
+	attempt := &WebhookDeliveryAttempt{
+		ID:          attemptID,
+		DeliveryID:  d.ID,
+		DurationMs:  int(duration.Milliseconds()),
+		AttemptedAt: at,
+	}
+
+	d.Attempts++
+	d.UpdatedAt = at
+	d.LastStatusCode = nil
+	d.LastError = nil
+
+	if sendErr != nil {
+		reason := sendErr.Error()
+		attempt.Error = &reason
+		d.LastError = &reason
+	} else {
+		body := resp.Body
+		if len(body) > MaxWebhookResponseLength {
+			body = strings.ToValidUTF8(body[:MaxWebhookResponseLength], "")
+		}
+		statusCode := resp.StatusCode
+		attempt.StatusCode, attempt.ResponseBody = &statusCode, &body
+		d.LastStatusCode = &statusCode
+
+		if statusCode >= 200 && statusCode < 300 {
+			d.Status = SucceededWebhookDeliveryStatus
+			d.NextAttemptAt = nil
+			d.DeliveredAt = &at
+			return attempt
+		}
+
+		reason := fmt.Sprintf("unexpected status %d", statusCode)
+		d.LastError = &reason
+	}
+
+	if d.Attempts >= MaxWebhookAttempts {
+		d.Status = FailedWebhookDeliveryStatus
+		d.NextAttemptAt = nil
+		return attempt
+	}
+
+	next := at.Add(webhookRetryDelay << (d.Attempts - 1))
+	d.NextAttemptAt = &next
+	return attempt
+}
+
+// Redeliver sends the delivery again with a new round of attempts, the previous attempts are kept
+func (d *WebhookDelivery) Redeliver(at time.Time) error {
+	if d.Status == PendingWebhookDeliveryStatus {
+		return fmt.Errorf("delivery is already pending")
+	}
+
+	d.Status = PendingWebhookDeliveryStatus
+	d.Attempts = 0
+	d.NextAttemptAt = &at
+	d.UpdatedAt = at
+	return nil
+}
+
+func (d *WebhookDelivery) GetID() string                    { return d.ID }
+func (d *WebhookDelivery) GetSubscriptionID() string        { return d.SubscriptionID }
+func (d *WebhookDelivery) GetStoreID() string               { return d.StoreID }
+func (d *WebhookDelivery) GetEventID() string               { return d.EventID }
+func (d *WebhookDelivery) GetEventType() EventType          { return d.EventType }
+func (d *WebhookDelivery) GetPayload() json.RawMessage      { return d.Payload }
+func (d *WebhookDelivery) GetStatus() WebhookDeliveryStatus { return d.Status }
+func (d *WebhookDelivery) GetAttempts() int                 { return d.Attempts }
+func (d *WebhookDelivery) GetNextAttemptAt() *time.Time     { return d.NextAttemptAt }
+func (d *WebhookDelivery) GetLastStatusCode() *int          { return d.LastStatusCode }
+func (d *WebhookDelivery) GetLastError() *string            { return d.LastError }
+func (d *WebhookDelivery) GetDeliveredAt() *time.Time       { return d.DeliveredAt }
+func (d *WebhookDelivery) GetCreatedAt() time.Time          { return d.CreatedAt }
+func (d *WebhookDelivery) GetUpdatedAt() time.Time          { return d.UpdatedAt }
+
+func (d *WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+func (a *WebhookDeliveryAttempt) GetID() string             { return a.ID }
+func (a *WebhookDeliveryAttempt) GetDeliveryID() string     { return a.DeliveryID }
+func (a *WebhookDeliveryAttempt) GetStatusCode() *int       { return a.StatusCode }
+func (a *WebhookDeliveryAttempt) GetResponseBody() *string  { return a.ResponseBody }
+func (a *WebhookDeliveryAttempt) GetError() *string         { return a.Error }
+func (a *WebhookDeliveryAttempt) GetDurationMs() int        { return a.DurationMs }
+func (a *WebhookDeliveryAttempt) GetAttemptedAt() time.Time { return a.AttemptedAt }
+
+func (a *WebhookDeliveryAttempt) TableName() string {
+	return "webhook_delivery_attempts"
+}
+
+// WebhookEventStoreID returns the store an event belongs to, from the store, product or order data
+func WebhookEventStoreID(event Event) (string, error) {
+	switch event.Type {
+	case StoreUpdated, StoreDeleted, StoreRestored:
+		var data StoreEventData
+		if err := json.Unmarshal(event.Data, &data); err != nil {
+			return "", err
+		}
+		return data.ID, nil
+	case ProductCreated, ProductUpdated, ProductDeleted, ProductRestored:
+		var data ProductEventData
+		if err := json.Unmarshal(event.Data, &data); err != nil {
+			return "", err
+		}
+		return data.StoreID, nil
+	}
+
+	var data OrderEventData
+	if err := json.Unmarshal(event.Data, &data); err != nil {
+		return "", err
+	}
+	return data.StoreID, nil
+}
@@ -0,0 +1,194 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	webhookSecretPrefix    = "whsec_"
+	minWebhookSecretLength = 16
+)
+
+// StoreWebhookEventTypes are the events of the store service a store can subscribe to
+var StoreWebhookEventTypes = []EventType{
+	StoreUpdated,
+	StoreDeleted,
+	StoreRestored,
+	ProductCreated,
+	ProductUpdated,
+	ProductDeleted,
+	ProductRestored,
+}
+
+// OrderWebhookEventTypes are the events of the order service a store can subscribe to
+var OrderWebhookEventTypes = []EventType{
+	OrderCreated,
+	OrderPaid,
+	OrderAccepted,
+	OrderRejected,
+	OrderCanceled,
+	OrderFulfillmentUpdated,
+}
+
+func IsWebhookEventType(eventType EventType) bool {
+	return slices.Contains(StoreWebhookEventTypes, eventType) || slices.Contains(OrderWebhookEventTypes, eventType)
+}
+
+// WebhookSubscription sends the selected events of a store to a URL of the merchant, signed with the secret
+type WebhookSubscription struct {
+	ID         string          `sql:"id,primary"`
+	StoreID    string          `sql:"store_id"`
+	URL        string          `sql:"url"`
+	Secret     string          `sql:"secret"`
+	EventTypes json.RawMessage `sql:"event_types"`
+	Active     bool            `sql:"active"`
+	CreatedAt  time.Time       `sql:"created_at"`
+	UpdatedAt  time.Time       `sql:"updated_at"`
+
+	eventTypes []EventType
+}
+
+// NewWebhookSubscription subscribes the URL to the events of the store, a secret is generated when empty
+func NewWebhookSubscription(id string, store *Store, rawURL, secret string, eventTypes []string, userID string) (*WebhookSubscription, error) {
+	if err := store.CheckOwner(userID); err != nil {
+		return nil, err
+	}
+
+	if secret == "" {
+		generated, err := newWebhookSecret()
+		if err != nil {
+			return nil, err
+		}
+		secret = generated
+	}
+
+	if len(secret) < minWebhookSecretLength {
+		return nil, fmt.Errorf("secret must have at least %d characters", minWebhookSecretLength)
+	}
+
+	now := time.Now().UTC()
+	subscription := &WebhookSubscription{
+		ID:        id,
+		StoreID:   store.GetID(),
+		Secret:    secret,
+		Active:    true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := subscription.set(rawURL, eventTypes); err != nil {
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+func (w *WebhookSubscription) Update(rawURL string, eventTypes []string, active bool) error {
+	if err := w.set(rawURL, eventTypes); err != nil {
+		return err
+	}
+
+	w.Active = active
+	w.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// Subscribes returns true when the events of the type are sent to the URL
+func (w *WebhookSubscription) Subscribes(eventType EventType) bool {
+	return w.Active && slices.Contains(w.GetEventTypes(), eventType)
+}
+
+// Sign returns the signature of a delivery: the hex HMAC-SHA256 of "<timestamp>.<body>" with the secret,
+// so receivers can check the sender and reject old deliveries
+func (w *WebhookSubscription) Sign(timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(w.Secret))
+	fmt.Fprintf(mac, "%d.", timestamp)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (w *WebhookSubscription) set(rawURL string, eventTypes []string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Hostname() == "" {
+		return fmt.Errorf("url must be an absolute http or https url")
+	}
+
+	host := parsed.Hostname()
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return fmt.Errorf("url cannot point to a private address")
+	}
+	if ip := net.ParseIP(host); ip != nil && !IsPublicIP(ip) {
+		return fmt.Errorf("url cannot point to a private address")
+	}
+
+	if len(eventTypes) == 0 {
+		return fmt.Errorf("eventTypes cannot be empty")
+	}
+
+	parsedTypes := []EventType{}
+	for _, value := range eventTypes {
+		eventType := EventType(value)
+		if !IsWebhookEventType(eventType) {
+			return fmt.Errorf("event type %s cannot be subscribed", value)
+		}
+		if !slices.Contains(parsedTypes, eventType) {
+			parsedTypes = append(parsedTypes, eventType)
+		}
+	}
+
+	rawTypes, err := json.Marshal(parsedTypes)
+	if err != nil {
+		return err
+	}
+
+	w.URL = rawURL
+	w.EventTypes, w.eventTypes = rawTypes, parsedTypes
+	return nil
+}
+
+// IsPublicIP reports whether the URLs given by merchants, like webhooks and imported images, can be requested
+// at the IP, loopback, private, link-local and unspecified addresses are internal to the platform. The URLs are
+// checked when given but their host can resolve to another address later, so the clients check every address
+// they dial too.
+func IsPublicIP(ip net.IP) bool {
+	return !ip.IsLoopback() && !ip.IsPrivate() && !ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast() &&
+		!ip.IsUnspecified()
+}
+
+func (w *WebhookSubscription) GetID() string           { return w.ID }
+func (w *WebhookSubscription) GetStoreID() string      { return w.StoreID }
+func (w *WebhookSubscription) GetURL() string          { return w.URL }
+func (w *WebhookSubscription) GetSecret() string       { return w.Secret }
+func (w *WebhookSubscription) IsActive() bool          { return w.Active }
+func (w *WebhookSubscription) GetCreatedAt() time.Time { return w.CreatedAt }
+func (w *WebhookSubscription) GetUpdatedAt() time.Time { return w.UpdatedAt }
+
+// GetEventTypes returns the subscribed events, decoding them when the subscription was loaded from storage
+func (w *WebhookSubscription) GetEventTypes() []EventType {
+	if w.eventTypes == nil && len(w.EventTypes) > 0 {
+		_ = json.Unmarshal(w.EventTypes, &w.eventTypes)
+	}
+	return w.eventTypes
+}
+
+func (w *WebhookSubscription) TableName() string {
+	return "webhook_subscriptions"
+}
+
+func newWebhookSecret() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return webhookSecretPrefix + hex.EncodeToString(b), nil
+}
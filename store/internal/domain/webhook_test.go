@@ -0,0 +1,159 @@
+package domain_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"ichibuy/store/internal/domain"
+)
+
+func newWebhookSubscription(t *testing.T) *domain.WebhookSubscription {
+	t.Helper()
+	store, err := domain.NewStore("store-1", "Bodega", nil, -12.05, -77.04, []string{"PEN"}, "PE", "user-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	subscription, err := domain.NewWebhookSubscription("webhook-1", store, "https://example.com/hooks", "", []string{"OrderPaid", "ProductCreated"}, "user-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return subscription
+}
+
+func TestNewWebhookSubscription(t *testing.T) {
+	store, err := domain.NewStore("store-1", "Bodega", nil, -12.05, -77.04, []string{"PEN"}, "PE", "user-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := domain.NewWebhookSubscription("webhook-1", store, "https://example.com", "", []string{"OrderPaid"}, "user-2"); err == nil {
+		t.Error("expected error for a user that does not own the store")
+	}
+	if _, err := domain.NewWebhookSubscription("webhook-1", store, "ftp://example.com", "", []string{"OrderPaid"}, "user-1"); err == nil {
+		t.Error("expected error for a non http url")
+	}
+	if _, err := domain.NewWebhookSubscription("webhook-1", store, "https://example.com", "", []string{"UserCreated"}, "user-1"); err == nil {
+		t.Error("expected error for an event that cannot be subscribed")
+	}
+	for _, rawURL := range []string{"http://localhost:8080/hooks", "http://127.0.0.1/hooks", "http://10.0.0.5/hooks", "http://169.254.169.254/latest", "http://[::1]/hooks"} {
+		if _, err := domain.NewWebhookSubscription("webhook-1", store, rawURL, "", []string{"OrderPaid"}, "user-1"); err == nil {
+			t.Errorf("expected error for the private url %s", rawURL)
+		}
+	}
+
+	subscription := newWebhookSubscription(t)
+	if len(subscription.GetSecret()) < 16 {
+		t.Errorf("expected a generated secret, got %q", subscription.GetSecret())
+	}
+	if !subscription.Subscribes(domain.OrderPaid) || subscription.Subscribes(domain.OrderCanceled) {
+		t.Errorf("unexpected subscribed events %v", subscription.GetEventTypes())
+	}
+}
+
+func TestWebhookDelivery_Request(t *testing.T) {
+	subscription := newWebhookSubscription(t)
+	delivery, err := domain.NewWebhookDelivery("delivery-1", subscription, domain.Event{
+		ID:        "event-1",
+		Type:      domain.OrderPaid,
+		Data:      []byte(`{"id":"order-1","store_id":"store-1"}`),
+		Timestamp: time.Now().UTC(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	at := time.Unix(1700000000, 0)
+	req := delivery.Request(subscription, at)
+
+	mac := hmac.New(sha256.New, []byte(subscription.GetSecret()))
+	mac.Write([]byte("1700000000."))
+	mac.Write(req.Body)
+	expected := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	if req.Headers[domain.WebhookSignatureHeader] != expected {
+		t.Errorf("expected signature %s, got %s", expected, req.Headers[domain.WebhookSignatureHeader])
+	}
+	if req.Headers[domain.WebhookTimestampHeader] != "1700000000" {
+		t.Errorf("unexpected timestamp %s", req.Headers[domain.WebhookTimestampHeader])
+	}
+	if req.Headers[domain.WebhookEventHeader] != "OrderPaid" {
+		t.Errorf("unexpected event %s", req.Headers[domain.WebhookEventHeader])
+	}
+}
+
+func TestIsPublicIP(t *testing.T) {
+	tests := []struct {
+		ip       string
+		expected bool
+	}{
+		{ip: "93.184.216.34", expected: true},
+		{ip: "2606:2800:220:1:248:1893:25c8:1946", expected: true},
+		{ip: "127.0.0.1", expected: false},
+		{ip: "::1", expected: false},
+		{ip: "10.1.2.3", expected: false},
+		{ip: "172.16.0.1", expected: false},
+		{ip: "172.31.255.255", expected: false},
+		{ip: "172.32.0.1", expected: true},
+		{ip: "192.168.1.1", expected: false},
+		{ip: "169.254.169.254", expected: false},
+		{ip: "fe80::1", expected: false},
+		{ip: "fd00::1", expected: false},
+		{ip: "0.0.0.0", expected: false},
+		{ip: "::", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ip, func(t *testing.T) {
+			if got := domain.IsPublicIP(net.ParseIP(tt.ip)); got != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestWebhookDelivery_RecordAttempt(t *testing.T) {
+	subscription := newWebhookSubscription(t)
+	delivery, err := domain.NewWebhookDelivery("delivery-1", subscription, domain.Event{ID: "event-1", Type: domain.OrderPaid, Data: []byte(`{}`)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	at := time.Now().UTC()
+	attempt := delivery.RecordAttempt("attempt-1", &domain.WebhookResponse{StatusCode: 500, Body: strings.Repeat("boom ", 100)}, nil, time.Second, at)
+	if *attempt.GetStatusCode() != 500 || delivery.GetStatus() != domain.PendingWebhookDeliveryStatus {
+		t.Fatalf("expected a pending delivery after a 500, got %s", delivery.GetStatus())
+	}
+	if len(*attempt.GetResponseBody()) != domain.MaxWebhookResponseLength {
+		t.Errorf("expected the response body truncated to %d bytes, got %d", domain.MaxWebhookResponseLength, len(*attempt.GetResponseBody()))
+	}
+	if !delivery.GetNextAttemptAt().Equal(at.Add(time.Minute)) {
+		t.Errorf("expected a retry after 1 minute, got %v", delivery.GetNextAttemptAt())
+	}
+
+	delivery.RecordAttempt("attempt-2", nil, errors.New("timeout"), time.Second, at)
+	if !delivery.GetNextAttemptAt().Equal(at.Add(2 * time.Minute)) {
+		t.Errorf("expected a retry after 2 minutes, got %v", delivery.GetNextAttemptAt())
+	}
+
+	for i := delivery.GetAttempts(); i < domain.MaxWebhookAttempts; i++ {
+		delivery.RecordAttempt("attempt", nil, errors.New("timeout"), time.Second, at)
+	}
+	if delivery.GetStatus() != domain.FailedWebhookDeliveryStatus || delivery.GetNextAttemptAt() != nil {
+		t.Fatalf("expected a failed delivery, got %s", delivery.GetStatus())
+	}
+
+	if err := delivery.Redeliver(at); err != nil {
+		t.Fatal(err)
+	}
+	delivery.RecordAttempt("attempt-ok", &domain.WebhookResponse{StatusCode: 204}, nil, time.Second, at)
+	if delivery.GetStatus() != domain.SucceededWebhookDeliveryStatus || delivery.GetDeliveredAt() == nil {
+		t.Errorf("expected a succeeded delivery, got %s", delivery.GetStatus())
+	}
+}
@@ -0,0 +1,38 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"ichibuy/store/internal/domain"
+)
+
+// Message brokers selected with EVENT_BROKER
+const (
+	MemoryBrokerKind = "memory"
+	NATSBrokerKind   = "nats"
+	RedisBrokerKind  = "redis"
+)
+
+// NewBroker returns the message broker of the given kind connected to url. The memory broker is refused: the
+// workers run in separate processes, so the events forwarded to it would be acknowledged without reaching the
+// subscribers of the other services. It is only used by the tests, with NewMemoryBroker.
+func NewBroker(ctx context.Context, kind, url string, redeliveryDelay time.Duration) (domain.MessageBroker, error) {
+	var broker domain.MessageBroker
+	var err error
+	switch kind {
+	case MemoryBrokerKind:
+		err = fmt.Errorf("the %s event broker only delivers within a process, use %s or %s between the workers", kind, NATSBrokerKind, RedisBrokerKind)
+	case NATSBrokerKind:
+		broker, err = NewNATSBroker(ctx, url, redeliveryDelay)
+	case RedisBrokerKind:
+		broker, err = NewRedisBroker(ctx, url, redeliveryDelay)
+	default:
+		err = fmt.Errorf("unknown event broker %q", kind)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return broker, nil
+}
@@ -2,26 +2,75 @@ package events
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 
 	"ichibuy/store/internal/domain"
 	"ichibuy/store/internal/domain/dao"
+	sharedCtx "ichibuy/store/internal/shared/context"
 )
 
+// producer names the service in the events it publishes
+const producer = "store"
+
 type Bus struct {
 	eventDAO dao.EventDAO
+	schemas  *domain.SchemaRegistry
 }
 
 func NewBus(eventDAO dao.EventDAO) *Bus {
 	return &Bus{
 		eventDAO: eventDAO,
+		schemas:  domain.EventSchemas,
 	}
 }
 
+// Publish validates the events against their schemas and stores them with the next versions of their
+// aggregates, the correlation and causation of the context and the producer. A unique index on the
+// aggregate version rejects the events of two writers of the same aggregate racing each other.
 func (b *Bus) Publish(ctx context.Context, events ...domain.Event) error {
+	correlationID := sharedCtx.StringValue(ctx, sharedCtx.CorrelationIDKey)
+	causationID := sharedCtx.StringValue(ctx, sharedCtx.CausationIDKey)
+
+	versions := map[string]int{}
 	evts := make([]*domain.Event, len(events))
 	for i, event := range events {
+		if err := b.schemas.Validate(event); err != nil {
+			return err
+		}
+
+		version, err := b.nextAggregateVersion(ctx, versions, event)
+		if err != nil {
+			return err
+		}
+
+		event.AggregateVersion = version
+		event.Producer = producer
+		if event.CorrelationID == nil {
+			event.CorrelationID = correlationID
+		}
+		if event.CausationID == nil {
+			event.CausationID = causationID
+		}
 		evts[i] = &event
 	}
 
 	return b.eventDAO.CreateMany(ctx, evts)
 }
+
+func (b *Bus) nextAggregateVersion(ctx context.Context, versions map[string]int, event domain.Event) (int, error) {
+	key := string(event.AggregateType) + "/" + event.AggregateID
+	version, ok := versions[key]
+	if !ok {
+		last, err := b.eventDAO.FindOne(ctx, "aggregate_type = $1 AND aggregate_id = $2", "aggregate_version DESC", event.AggregateType, event.AggregateID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return 0, err
+		}
+		if last != nil {
+			version = last.AggregateVersion
+		}
+	}
+
+	versions[key] = version + 1
+	return version + 1, nil
+}
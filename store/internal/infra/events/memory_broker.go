@@ -0,0 +1,110 @@
+package events
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"ichibuy/store/internal/domain"
+)
+
+// MemoryBroker is a message broker kept in memory, for the tests and for running the services in a single
+// process. The events are kept in a log read by every consumer group from its own position, and the
+// events nacked are redelivered to the group after the redelivery delay.
+type MemoryBroker struct {
+	mu              sync.Mutex
+	log             []domain.Event
+	groups          map[string]*memoryGroup
+	published       chan struct{}
+	redeliveryDelay time.Duration
+}
+
+type memoryGroup struct {
+	next      int
+	redeliver []domain.Event
+}
+
+func NewMemoryBroker(redeliveryDelay time.Duration) *MemoryBroker {
+	return &MemoryBroker{
+		groups:          map[string]*memoryGroup{},
+		published:       make(chan struct{}),
+		redeliveryDelay: redeliveryDelay,
+	}
+}
+
+func (b *MemoryBroker) Publish(ctx context.Context, events ...domain.Event) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.log = append(b.log, events...)
+	b.notify()
+	return nil
+}
+
+// Subscribe reads the log from the first event the first time a group subscribes. The subscribers of a
+// group share its position, so they must take the same types.
+func (b *MemoryBroker) Subscribe(ctx context.Context, group string, types []domain.EventType, handle func(ctx context.Context, event domain.Event) error) error {
+	for {
+		event, published, ok := b.next(group, types)
+		if !ok {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-published:
+			}
+			continue
+		}
+
+		if err := handle(ctx, event); err != nil {
+			time.AfterFunc(b.redeliveryDelay, func() { b.nack(group, event) })
+		}
+	}
+}
+
+func (b *MemoryBroker) Close() error {
+	return nil
+}
+
+// next returns the next event of the group, or the channel closed on the next publish when there is none
+func (b *MemoryBroker) next(group string, types []domain.EventType) (domain.Event, chan struct{}, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	g, ok := b.groups[group]
+	if !ok {
+		g = &memoryGroup{}
+		b.groups[group] = g
+	}
+
+	if len(g.redeliver) > 0 {
+		event := g.redeliver[0]
+		g.redeliver = g.redeliver[1:]
+		return event, nil, true
+	}
+
+	for g.next < len(b.log) {
+		event := b.log[g.next]
+		g.next++
+		if slices.Contains(types, event.Type) {
+			return event, nil, true
+		}
+	}
+
+	return domain.Event{}, b.published, false
+}
+
+func (b *MemoryBroker) nack(group string, event domain.Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	g := b.groups[group]
+	g.redeliver = append(g.redeliver, event)
+	b.notify()
+}
+
+// notify wakes up the subscribers waiting for events, it must be called holding the lock
+func (b *MemoryBroker) notify() {
+	close(b.published)
+	b.published = make(chan struct{})
+}
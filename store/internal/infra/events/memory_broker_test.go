@@ -0,0 +1,82 @@
+package events_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"ichibuy/store/internal/domain"
+	"ichibuy/store/internal/infra/events"
+)
+
+// received collects the IDs of the events handled by a subscriber
+type received struct {
+	mu  sync.Mutex
+	ids []string
+}
+
+func (r *received) add(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.ids = append(r.ids, id)
+}
+
+func (r *received) wait(t *testing.T, count int) []string {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		r.mu.Lock()
+		ids := append([]string{}, r.ids...)
+		r.mu.Unlock()
+		if len(ids) >= count {
+			return ids
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("expected %d events, got %v", count, r.ids)
+	return nil
+}
+
+func TestMemoryBroker_Subscribe(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	broker := events.NewMemoryBroker(10 * time.Millisecond)
+	err := broker.Publish(ctx,
+		domain.Event{ID: "1", Type: domain.ProductCreated},
+		domain.Event{ID: "2", Type: domain.CustomerCreated},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	catalog, webhooks := &received{}, &received{}
+	go broker.Subscribe(ctx, "catalog", []domain.EventType{domain.ProductCreated, domain.ProductUpdated}, func(ctx context.Context, event domain.Event) error {
+		catalog.add(event.ID)
+		return nil
+	})
+
+	failed := false
+	go broker.Subscribe(ctx, "webhooks", []domain.EventType{domain.ProductUpdated}, func(ctx context.Context, event domain.Event) error {
+		// the first delivery is nacked, the event is redelivered
+		if !failed {
+			failed = true
+			return errors.New("webhook failed")
+		}
+		webhooks.add(event.ID)
+		return nil
+	})
+
+	if err := broker.Publish(ctx, domain.Event{ID: "3", Type: domain.ProductUpdated}); err != nil {
+		t.Fatal(err)
+	}
+
+	if ids := catalog.wait(t, 2); len(ids) != 2 || ids[0] != "1" || ids[1] != "3" {
+		t.Fatalf("expected catalog to receive 1 and 3, got %v", ids)
+	}
+	if ids := webhooks.wait(t, 1); len(ids) != 1 || ids[0] != "3" {
+		t.Fatalf("expected webhooks to receive 3 once, got %v", ids)
+	}
+}
@@ -0,0 +1,115 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+
+	"ichibuy/store/internal/domain"
+)
+
+const (
+	// natsStream keeps the events of every service, under the subjects ichibuy.events.<producer>.<type>
+	natsStream        = "ICHIBUY_EVENTS"
+	natsSubjectPrefix = "ichibuy.events"
+	natsMaxAge        = 7 * 24 * time.Hour
+)
+
+// NATSBroker is a message broker on NATS JetStream. Consumer groups are durable consumers of the events
+// stream, and the event ID is the message ID, so an event published twice within the duplicates window
+// of the stream is stored once.
+type NATSBroker struct {
+	conn            *nats.Conn
+	js              jetstream.JetStream
+	redeliveryDelay time.Duration
+}
+
+func NewNATSBroker(ctx context.Context, url string, redeliveryDelay time.Duration) (*NATSBroker, error) {
+	conn, err := nats.Connect(url)
+	if err != nil {
+		return nil, err
+	}
+
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	_, err = js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:     natsStream,
+		Subjects: []string{natsSubjectPrefix + ".>"},
+		MaxAge:   natsMaxAge,
+	})
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return &NATSBroker{conn: conn, js: js, redeliveryDelay: redeliveryDelay}, nil
+}
+
+func (b *NATSBroker) Publish(ctx context.Context, events ...domain.Event) error {
+	for _, event := range events {
+		data, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+
+		subject := fmt.Sprintf("%s.%s.%s", natsSubjectPrefix, event.Producer, event.Type)
+		if _, err := b.js.Publish(ctx, subject, data, jetstream.WithMsgID(event.ID)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *NATSBroker) Subscribe(ctx context.Context, group string, types []domain.EventType, handle func(ctx context.Context, event domain.Event) error) error {
+	subjects := make([]string, len(types))
+	for i, eventType := range types {
+		subjects[i] = fmt.Sprintf("%s.*.%s", natsSubjectPrefix, eventType)
+	}
+
+	consumer, err := b.js.CreateOrUpdateConsumer(ctx, natsStream, jetstream.ConsumerConfig{
+		Durable:        group,
+		FilterSubjects: subjects,
+		DeliverPolicy:  jetstream.DeliverAllPolicy,
+		AckPolicy:      jetstream.AckExplicitPolicy,
+		AckWait:        b.redeliveryDelay,
+	})
+	if err != nil {
+		return err
+	}
+
+	consumeCtx, err := consumer.Consume(func(msg jetstream.Msg) {
+		var event domain.Event
+		if err := json.Unmarshal(msg.Data(), &event); err != nil {
+			slog.ErrorContext(ctx, "decode event failed", "group", group, "subject", msg.Subject(), "error", err.Error())
+			_ = msg.Term()
+			return
+		}
+
+		if err := handle(ctx, event); err != nil {
+			slog.ErrorContext(ctx, "handle event failed", "group", group, "event_id", event.ID, "error", err.Error())
+			_ = msg.NakWithDelay(b.redeliveryDelay)
+			return
+		}
+		_ = msg.Ack()
+	})
+	if err != nil {
+		return err
+	}
+	defer consumeCtx.Stop()
+
+	<-ctx.Done()
+	return nil
+}
+
+func (b *NATSBroker) Close() error {
+	return b.conn.Drain()
+}
@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/store/internal/services"
+)
+
+type ConfirmCustomerVerificationBody struct {
+	Channel string `json:"channel" binding:"required"`
+	Code    string `json:"code" binding:"required"`
+}
+
+// ConfirmCustomerVerification godoc
+// @Summary      Confirm customer verification
+// @Description  Check the one-time code sent to the customer and mark the email or phone as verified
+// @Tags         customers
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Customer ID"
+// @Param        body body ConfirmCustomerVerificationBody true "Verification channel and code"
+// @Success      204
+// @Failure      400  {object}  ErrorResp
+// @Failure      401  {object}  ErrorResp
+// @Router       /api/v1/customers/{id}/verifications/confirm [post]
+// @Security     BearerAuth
+func ConfirmCustomerVerification(confirmCustomerVerificationService *services.ConfirmCustomerVerification) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		id := c.Param("id")
+		if id == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "id parameter is required"})
+			return
+		}
+
+		var body ConfirmCustomerVerificationBody
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		err := confirmCustomerVerificationService.Exec(c, services.ConfirmCustomerVerificationReq{
+			CustomerID: id,
+			Channel:    body.Channel,
+			Code:       body.Code,
+			UserID:     userID.(string),
+		})
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusNoContent, nil)
+	}
+}
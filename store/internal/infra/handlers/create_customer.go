@@ -1,10 +1,12 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 
+	"ichibuy/store/internal/domain"
 	"ichibuy/store/internal/services"
 )
 
@@ -12,7 +14,12 @@ type CreateCustomerBody struct {
 	FirstName string  `json:"first_name" binding:"required"`
 	LastName  string  `json:"last_name" binding:"required"`
 	Email     *string `json:"email"`
-	Phone     *string `json:"phone"`
+	// Phone in E.164 or local format, local numbers use the phone region
+	Phone *string `json:"phone"`
+	// PhoneRegion of a local phone number, e.g. PE
+	PhoneRegion *string `json:"phone_region"`
+	// StoreID is the store the customer comes from, its phone region is used when none is given
+	StoreID *string `json:"store_id"`
 }
 
 // CreateCustomer godoc
@@ -25,6 +32,7 @@ type CreateCustomerBody struct {
 // @Success      201  {object}  services.CreateCustomerResp
 // @Failure      400  {object}  ErrorResp
 // @Failure      401  {object}  ErrorResp
+// @Failure      409  {object}  ErrorResp
 // @Router       /api/v1/customers [post]
 // @Security     BearerAuth
 func CreateCustomer(createCustomerService *services.CreateCustomer) gin.HandlerFunc {
@@ -42,12 +50,18 @@ func CreateCustomer(createCustomerService *services.CreateCustomer) gin.HandlerF
 		}
 
 		resp, err := createCustomerService.Exec(c, services.CreateCustomerReq{
-			FirstName: req.FirstName,
-			LastName:  req.LastName,
-			Email:     req.Email,
-			Phone:     req.Phone,
-			UserID:    userID.(string),
+			FirstName:   req.FirstName,
+			LastName:    req.LastName,
+			Email:       req.Email,
+			Phone:       req.Phone,
+			PhoneRegion: req.PhoneRegion,
+			StoreID:     req.StoreID,
+			UserID:      userID.(string),
 		})
+		if errors.Is(err, domain.ErrCustomerAlreadyExists) {
+			c.JSON(http.StatusConflict, ErrorResp{Error: err.Error()})
+			return
+		}
 		if err != nil {
 			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
 			return
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/store/internal/domain"
+	"ichibuy/store/internal/services"
+)
+
+type CustomerAddressBody struct {
+	// Label names the address for the customer, e.g. Home or Work
+	Label     string  `json:"label" binding:"required"`
+	Recipient *string `json:"recipient"`
+	Line1     string  `json:"line1" binding:"required"`
+	Line2     *string `json:"line2"`
+	City      string  `json:"city" binding:"required"`
+	Region    *string `json:"region"`
+	// PostalCode of the address
+	PostalCode *string `json:"postal_code"`
+	// Country is the ISO 3166-1 alpha-2 code, e.g. PE
+	Country  string           `json:"country" binding:"required"`
+	Location *domain.Location `json:"location"`
+	// IsDefault makes it the default address of the customer, the first address is always the default
+	IsDefault bool `json:"is_default"`
+}
+
+func (b CustomerAddressBody) toAddressReq() services.AddressReq {
+	return services.AddressReq{
+		Label:      b.Label,
+		Recipient:  b.Recipient,
+		Line1:      b.Line1,
+		Line2:      b.Line2,
+		City:       b.City,
+		Region:     b.Region,
+		PostalCode: b.PostalCode,
+		Country:    b.Country,
+		Location:   b.Location,
+		IsDefault:  b.IsDefault,
+	}
+}
+
+// CreateCustomerAddress godoc
+// @Summary      Create customer address
+// @Description  Add an address to a customer (customer owner only)
+// @Tags         customers
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Customer ID"
+// @Param        address body CustomerAddressBody true "Address data"
+// @Success      201  {object}  services.CustomerAddressResp
+// @Failure      400  {object}  ErrorResp
+// @Router       /api/v1/customers/{id}/addresses [post]
+// @Security     BearerAuth
+func CreateCustomerAddress(createCustomerAddressService *services.CreateCustomerAddress) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		id := c.Param("id")
+		if id == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "id parameter is required"})
+			return
+		}
+
+		var req CustomerAddressBody
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		resp, err := createCustomerAddressService.Exec(c, services.CreateCustomerAddressReq{
+			CustomerID: id,
+			Address:    req.toAddressReq(),
+			UserID:     userID.(string),
+		})
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusCreated, resp)
+	}
+}
@@ -14,6 +14,10 @@ type CreateStoreBody struct {
 	Description *string `json:"description"`
 	Lat         float64 `json:"lat" binding:"required"`
 	Lng         float64 `json:"lng" binding:"required"`
+	// Currencies enabled for the store prices, defaults to USD and PEN
+	Currencies []string `json:"currencies"`
+	// PhoneRegion is the ISO country code of the local phone numbers of the customers, defaults to PE
+	PhoneRegion string `json:"phone_region"`
 }
 
 // CreateStore godoc
@@ -46,6 +50,8 @@ func CreateStore(createStoreService *services.CreateStore) gin.HandlerFunc {
 			Name:        req.Name,
 			Description: req.Description,
 			Location:    domain.Location{Lat: req.Lat, Lng: req.Lng},
+			Currencies:  req.Currencies,
+			PhoneRegion: req.PhoneRegion,
 			UserID:      userID.(string),
 		})
 		if err != nil {
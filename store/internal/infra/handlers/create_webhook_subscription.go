@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/store/internal/services"
+)
+
+type CreateWebhookSubscriptionBody struct {
+	URL string `json:"url" binding:"required"`
+	// Secret signs the deliveries, one is generated when empty
+	Secret     string   `json:"secret"`
+	EventTypes []string `json:"event_types" binding:"required"`
+}
+
+// CreateWebhookSubscription godoc
+// @Summary      Create webhook subscription
+// @Description  Subscribe a URL to store, product and order events of a store, deliveries are signed with the secret returned only here (store owner only)
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Store ID"
+// @Param        webhook body CreateWebhookSubscriptionBody true "Webhook subscription"
+// @Success      201  {object}  services.CreateWebhookSubscriptionResp
+// @Failure      400  {object}  ErrorResp
+// @Router       /api/v1/stores/{id}/webhooks [post]
+// @Security     BearerAuth
+func CreateWebhookSubscription(createWebhookSubscriptionService *services.CreateWebhookSubscription) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		id := c.Param("id")
+		if id == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "id parameter is required"})
+			return
+		}
+
+		var req CreateWebhookSubscriptionBody
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		resp, err := createWebhookSubscriptionService.Exec(c, services.CreateWebhookSubscriptionReq{
+			StoreID:    id,
+			URL:        req.URL,
+			Secret:     req.Secret,
+			EventTypes: req.EventTypes,
+			UserID:     userID.(string),
+		})
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusCreated, resp)
+	}
+}
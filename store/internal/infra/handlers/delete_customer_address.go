@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/store/internal/services"
+)
+
+// DeleteCustomerAddress godoc
+// @Summary      Delete customer address
+// @Description  Remove an address of a customer, the oldest remaining address becomes the default (customer owner only)
+// @Tags         customers
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Customer ID"
+// @Param        addressId path string true "Address ID"
+// @Success      204
+// @Failure      400  {object}  ErrorResp
+// @Router       /api/v1/customers/{id}/addresses/{addressId} [delete]
+// @Security     BearerAuth
+func DeleteCustomerAddress(deleteCustomerAddressService *services.DeleteCustomerAddress) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		id := c.Param("id")
+		addressID := c.Param("addressId")
+		if id == "" || addressID == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "id and addressId parameters are required"})
+			return
+		}
+
+		err := deleteCustomerAddressService.Exec(c, services.DeleteCustomerAddressReq{
+			CustomerID: id,
+			AddressID:  addressID,
+			UserID:     userID.(string),
+		})
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusNoContent, nil)
+	}
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 
@@ -10,24 +11,34 @@ import (
 
 // DeleteStore godoc
 // @Summary      Delete store by ID
-// @Description  Delete a store
+// @Description  Delete a store along with its products. Stores with open orders can only be deleted with force
 // @Tags         stores
 // @Accept       json
 // @Produce      json
 // @Param        id path string true "Store ID"
+// @Param        force query bool false "Delete even if the store has open orders"
 // @Success      204
 // @Failure      400  {object}  ErrorResp
+// @Failure      401  {object}  ErrorResp
 // @Router       /api/v1/stores/{id} [delete]
 // @Security     BearerAuth
 func DeleteStore(deleteStoreService *services.DeleteStore) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
 		id := c.Param("id")
 		if id == "" {
 			c.JSON(http.StatusBadRequest, ErrorResp{Error: "id parameter is required"})
 			return
 		}
 
-		err := deleteStoreService.Exec(c, services.DeleteStoreReq{ID: id})
+		force, _ := strconv.ParseBool(c.Query("force"))
+
+		err := deleteStoreService.Exec(c, services.DeleteStoreReq{ID: id, UserID: userID.(string), Force: force})
 		if err != nil {
 			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
 			return
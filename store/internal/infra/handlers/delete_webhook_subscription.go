@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/store/internal/services"
+)
+
+// DeleteWebhookSubscription godoc
+// @Summary      Delete webhook subscription
+// @Description  Delete a webhook subscription with its delivery log (store owner only)
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Store ID"
+// @Param        webhookId path string true "Webhook subscription ID"
+// @Success      204
+// @Failure      400  {object}  ErrorResp
+// @Router       /api/v1/stores/{id}/webhooks/{webhookId} [delete]
+// @Security     BearerAuth
+func DeleteWebhookSubscription(deleteWebhookSubscriptionService *services.DeleteWebhookSubscription) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		id := c.Param("id")
+		webhookID := c.Param("webhookId")
+		if id == "" || webhookID == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "id and webhookId parameters are required"})
+			return
+		}
+
+		err := deleteWebhookSubscriptionService.Exec(c, services.DeleteWebhookSubscriptionReq{
+			StoreID:        id,
+			SubscriptionID: webhookID,
+			UserID:         userID.(string),
+		})
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusNoContent, nil)
+	}
+}
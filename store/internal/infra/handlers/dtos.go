@@ -14,12 +14,12 @@ type ImageDTO struct {
 
 type PriceDTO struct {
 	ID       string `json:"id"`
-	Amount   int    `json:"amount"` // cents
+	Amount   int    `json:"amount"` // minor units
 	Currency string `json:"currency"`
 }
 
 type NewPriceDTO struct {
-	Amount   int    `json:"amount"` // cents
+	Amount   int    `json:"amount"` // minor units
 	Currency string `json:"currency"`
 }
 
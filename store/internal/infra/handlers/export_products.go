@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/store/internal/services"
+)
+
+var exportContentTypes = map[string]string{
+	"csv":   "text/csv",
+	"jsonl": "application/x-ndjson",
+}
+
+// ExportProducts godoc
+// @Summary      Export store products
+// @Description  Stream the store catalog as CSV or JSONL, using the same layout accepted by the import endpoint
+// @Tags         products
+// @Produce      text/csv
+// @Produce      application/x-ndjson
+// @Param        id path string true "Store ID"
+// @Param        format query string false "File format (csv or jsonl)" default(csv)
+// @Success      200  {file}  file
+// @Failure      400  {object}  ErrorResp
+// @Failure      401  {object}  ErrorResp
+// @Router       /api/v1/stores/{id}/products/export [get]
+// @Security     BearerAuth
+func ExportProducts(exportProductsService *services.ExportProducts) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		id := c.Param("id")
+		if id == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "id parameter is required"})
+			return
+		}
+
+		req := services.ExportProductsReq{
+			StoreID: id,
+			UserID:  userID.(string),
+			Format:  strings.ToLower(c.DefaultQuery("format", "csv")),
+		}
+
+		if err := exportProductsService.Validate(c, req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.Header("Content-Type", exportContentTypes[req.Format])
+		c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="products-%s.%s"`, id, req.Format))
+		c.Status(http.StatusOK)
+
+		// headers are already sent, so failures can only be logged
+		if err := exportProductsService.Exec(c, req, c.Writer); err != nil {
+			slog.ErrorContext(c, "export products stream failed", "store_id", id, "error", err.Error())
+		}
+	}
+}
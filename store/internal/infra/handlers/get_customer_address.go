@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/store/internal/services"
+)
+
+// GetCustomerAddress godoc
+// @Summary      Get customer address
+// @Description  Get an address of a customer (customer owner only)
+// @Tags         customers
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Customer ID"
+// @Param        addressId path string true "Address ID"
+// @Success      200  {object}  services.CustomerAddressResp
+// @Failure      400  {object}  ErrorResp
+// @Router       /api/v1/customers/{id}/addresses/{addressId} [get]
+// @Security     BearerAuth
+func GetCustomerAddress(getCustomerAddressService *services.GetCustomerAddress) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		id := c.Param("id")
+		addressID := c.Param("addressId")
+		if id == "" || addressID == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "id and addressId parameters are required"})
+			return
+		}
+
+		resp, err := getCustomerAddressService.Exec(c, services.GetCustomerAddressReq{
+			CustomerID: id,
+			AddressID:  addressID,
+			UserID:     userID.(string),
+		})
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
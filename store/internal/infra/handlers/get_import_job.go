@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/store/internal/services"
+)
+
+// GetImportJob godoc
+// @Summary      Get import job by ID
+// @Description  Retrieve the status and per-row errors of a products import job
+// @Tags         products
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Import job ID"
+// @Success      200  {object}  services.GetImportJobResp
+// @Failure      400  {object}  ErrorResp
+// @Failure      401  {object}  ErrorResp
+// @Failure      404  {object}  ErrorResp
+// @Router       /api/v1/imports/{id} [get]
+// @Security     BearerAuth
+func GetImportJob(getImportJobService *services.GetImportJob) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		id := c.Param("id")
+		if id == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "id parameter is required"})
+			return
+		}
+
+		resp, err := getImportJobService.Exec(c, services.GetImportJobReq{ID: id, UserID: userID.(string)})
+		if err != nil {
+			c.JSON(http.StatusNotFound, ErrorResp{Error: "import job not found"})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
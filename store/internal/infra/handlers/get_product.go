@@ -15,6 +15,7 @@ import (
 // @Accept       json
 // @Produce      json
 // @Param        id   path      string  true  "Product ID"
+// @Param        currency query string false "Currency to display the converted prices in"
 // @Success      200  {object}  services.GetProductResp
 // @Failure      400  {object}  ErrorResp
 // @Failure      401  {object}  ErrorResp
@@ -35,7 +36,7 @@ func GetProduct(getProductService *services.GetProduct) gin.HandlerFunc {
 			return
 		}
 
-		resp, err := getProductService.Exec(c, id)
+		resp, err := getProductService.Exec(c, services.GetProductReq{ID: id, Currency: c.Query("currency")})
 		if err != nil {
 			c.JSON(http.StatusNotFound, ErrorResp{Error: err.Error()})
 			return
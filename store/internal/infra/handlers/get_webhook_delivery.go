@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/store/internal/services"
+)
+
+// GetWebhookDelivery godoc
+// @Summary      Get webhook delivery
+// @Description  Get a webhook delivery with its payload and every attempt with the response received (store owner only)
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Store ID"
+// @Param        webhookId path string true "Webhook subscription ID"
+// @Param        deliveryId path string true "Delivery ID"
+// @Success      200  {object}  services.GetWebhookDeliveryResp
+// @Failure      400  {object}  ErrorResp
+// @Router       /api/v1/stores/{id}/webhooks/{webhookId}/deliveries/{deliveryId} [get]
+// @Security     BearerAuth
+func GetWebhookDelivery(getWebhookDeliveryService *services.GetWebhookDelivery) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		id := c.Param("id")
+		webhookID := c.Param("webhookId")
+		deliveryID := c.Param("deliveryId")
+		if id == "" || webhookID == "" || deliveryID == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "id, webhookId and deliveryId parameters are required"})
+			return
+		}
+
+		resp, err := getWebhookDeliveryService.Exec(c, services.GetWebhookDeliveryReq{
+			StoreID:        id,
+			SubscriptionID: webhookID,
+			DeliveryID:     deliveryID,
+			UserID:         userID.(string),
+		})
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
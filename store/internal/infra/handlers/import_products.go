@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/store/internal/domain"
+	"ichibuy/store/internal/services"
+)
+
+// importFormOverhead is the room left for the other fields of the import form
+const importFormOverhead = 1 << 20
+
+// ImportProducts godoc
+// @Summary      Import products into a store
+// @Description  Queue an asynchronous import of a CSV or JSONL catalog file. CSV columns: name, description, active, prices ("1500 PEN|400 USD"), image_urls ("url1|url2"). JSONL lines: {"name","description","active","prices":[{"amount","currency"}],"image_urls":[]}
+// @Tags         products
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        id path string true "Store ID"
+// @Param        file formData file true "Catalog file"
+// @Param        format formData string false "File format (csv or jsonl), inferred from the file extension when empty"
+// @Success      202  {object}  services.CreateImportJobResp
+// @Failure      400  {object}  ErrorResp
+// @Failure      401  {object}  ErrorResp
+// @Failure      413  {object}  ErrorResp
+// @Router       /api/v1/stores/{id}/products/import [post]
+// @Security     BearerAuth
+func ImportProducts(createImportJobService *services.CreateImportJob) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		id := c.Param("id")
+		if id == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "id parameter is required"})
+			return
+		}
+
+		fileTooLarge := ErrorResp{Error: fmt.Sprintf("import file cannot exceed %d bytes", domain.MaxImportFileSize)}
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, domain.MaxImportFileSize+importFormOverhead)
+
+		fileHeader, err := c.FormFile("file")
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				c.JSON(http.StatusRequestEntityTooLarge, fileTooLarge)
+				return
+			}
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "file is required"})
+			return
+		}
+
+		file, err := fileHeader.Open()
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "failed to process uploaded file: " + err.Error()})
+			return
+		}
+		defer file.Close()
+
+		payload, err := io.ReadAll(io.LimitReader(file, domain.MaxImportFileSize+1))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "failed to process uploaded file: " + err.Error()})
+			return
+		}
+
+		if len(payload) > domain.MaxImportFileSize {
+			c.JSON(http.StatusRequestEntityTooLarge, fileTooLarge)
+			return
+		}
+
+		format := c.PostForm("format")
+		if format == "" {
+			format = strings.TrimPrefix(filepath.Ext(fileHeader.Filename), ".")
+		}
+
+		resp, err := createImportJobService.Exec(c, services.CreateImportJobReq{
+			StoreID: id,
+			UserID:  userID.(string),
+			Format:  format,
+			Payload: string(payload),
+		})
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusAccepted, resp)
+	}
+}
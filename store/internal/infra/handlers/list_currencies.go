@@ -0,0 +1,24 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/store/internal/services"
+)
+
+// ListCurrencies godoc
+// @Summary      List currencies
+// @Description  List the supported ISO-4217 currencies with their minor units
+// @Tags         currencies
+// @Produce      json
+// @Success      200  {object}  services.ListCurrenciesResp
+// @Failure      401  {object}  ErrorResp
+// @Router       /api/v1/currencies [get]
+// @Security     BearerAuth
+func ListCurrencies(listCurrenciesService *services.ListCurrencies) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, listCurrenciesService.Exec(c))
+	}
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/store/internal/services"
+)
+
+// ListCustomerAddresses godoc
+// @Summary      List customer addresses
+// @Description  List the addresses of a customer, the default one included (customer owner only)
+// @Tags         customers
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Customer ID"
+// @Success      200  {object}  services.ListCustomerAddressesResp
+// @Failure      400  {object}  ErrorResp
+// @Router       /api/v1/customers/{id}/addresses [get]
+// @Security     BearerAuth
+func ListCustomerAddresses(listCustomerAddressesService *services.ListCustomerAddresses) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		id := c.Param("id")
+		if id == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "id parameter is required"})
+			return
+		}
+
+		resp, err := listCustomerAddressesService.Exec(c, services.ListCustomerAddressesReq{
+			CustomerID: id,
+			UserID:     userID.(string),
+		})
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
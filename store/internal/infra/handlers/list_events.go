@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/store/internal/services"
+)
+
+// ListEvents godoc
+// @Summary      List events
+// @Description  Returns the store events after the given event, in order, for the other services to consume them
+// @Tags         events
+// @Accept       json
+// @Produce      json
+// @Param        after query string false "ID of the last event received"
+// @Param        types query string false "Comma separated event types, e.g. ProductUpdated"
+// @Param        from query string false "RFC3339 timestamp of the first events"
+// @Param        to query string false "RFC3339 timestamp the events are before"
+// @Param        aggregate_id query string false "ID of the aggregate of the events"
+// @Param        limit query int false "Maximum number of events, 100 by default and 500 at most"
+// @Success      200    {object}    services.ListEventsResp
+// @Failure      400    {object}    ErrorResp
+// @Failure      401    {object}    ErrorResp
+// @Router       /api/v1/events [get]
+// @Security     BearerAuth
+func ListEvents(listEvents *services.ListEvents) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req := services.ListEventsReq{After: c.Query("after"), AggregateID: c.Query("aggregate_id")}
+
+		if types := c.Query("types"); types != "" {
+			req.Types = strings.Split(types, ",")
+		}
+
+		for key, target := range map[string]**time.Time{"from": &req.From, "to": &req.To} {
+			if value := c.Query(key); value != "" {
+				parsed, err := time.Parse(time.RFC3339, value)
+				if err != nil {
+					c.JSON(http.StatusBadRequest, ErrorResp{Error: key + " must be a RFC3339 timestamp"})
+					return
+				}
+				*target = &parsed
+			}
+		}
+
+		if limit := c.Query("limit"); limit != "" {
+			value, err := strconv.Atoi(limit)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, ErrorResp{Error: "limit must be a number"})
+				return
+			}
+			req.Limit = value
+		}
+
+		resp, err := listEvents.Exec(c, req)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
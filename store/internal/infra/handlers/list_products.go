@@ -19,6 +19,8 @@ import (
 // @Param        name query string false "Filter by name"
 // @Param        description query string false "Filter by description"
 // @Param        active query bool false "Filter by active status"
+// @Param        include_deleted query bool false "Include soft deleted products"
+// @Param        currency query string false "Currency to display the converted prices in"
 // @Param        sort_by query string false "Sort by field" default("name")
 // @Param        sort_order query string false "Sort order" default("ASC")
 // @Param        offset query int false "Offset" default(0)
@@ -56,6 +58,8 @@ func ListProducts(listProductsService *services.ListProducts) gin.HandlerFunc {
 			}
 		}
 
+		filters.IncludeDeleted, _ = strconv.ParseBool(c.Query("include_deleted"))
+
 		offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
 		limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
 
@@ -73,6 +77,7 @@ func ListProducts(listProductsService *services.ListProducts) gin.HandlerFunc {
 			Filters:    filters,
 			Pagination: pagination,
 			Sorting:    sorting,
+			Currency:   c.Query("currency"),
 		}
 
 		resp, err := listProductsService.Exec(c, serviceReq)
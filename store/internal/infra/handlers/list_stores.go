@@ -17,6 +17,7 @@ import (
 // @Produce      json
 // @Param        name query string false "Filter by name"
 // @Param        description query string false "Filter by description"
+// @Param        include_deleted query bool false "Include soft deleted stores"
 // @Param        sort_by query string false "Sort by field" default("name")
 // @Param        sort_order query string false "Sort order" default("ASC")
 // @Param        offset query int false "Offset" default(0)
@@ -46,6 +47,8 @@ func ListStores(listStoresService *services.ListStores) gin.HandlerFunc {
 			filters.Description = &description
 		}
 
+		filters.IncludeDeleted, _ = strconv.ParseBool(c.Query("include_deleted"))
+
 		offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
 		limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
 
@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/store/internal/services"
+)
+
+// ListWebhookDeliveries godoc
+// @Summary      List webhook deliveries
+// @Description  List the deliveries of a webhook subscription, newest first, with their last response (store owner only)
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Store ID"
+// @Param        webhookId path string true "Webhook subscription ID"
+// @Param        status query string false "Delivery status" Enums(pending, succeeded, failed)
+// @Param        offset query int false "Offset" default(0)
+// @Param        limit query int false "Limit" default(10)
+// @Success      200  {object}  services.ListWebhookDeliveriesResp
+// @Failure      400  {object}  ErrorResp
+// @Router       /api/v1/stores/{id}/webhooks/{webhookId}/deliveries [get]
+// @Security     BearerAuth
+func ListWebhookDeliveries(listWebhookDeliveriesService *services.ListWebhookDeliveries) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		id := c.Param("id")
+		webhookID := c.Param("webhookId")
+		if id == "" || webhookID == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "id and webhookId parameters are required"})
+			return
+		}
+
+		var status *string
+		if value := c.Query("status"); value != "" {
+			status = &value
+		}
+
+		offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+		limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+		resp, err := listWebhookDeliveriesService.Exec(c, services.ListWebhookDeliveriesReq{
+			StoreID:        id,
+			SubscriptionID: webhookID,
+			Status:         status,
+			Pagination: services.Pagination{
+				Offset: offset,
+				Limit:  limit,
+			},
+			UserID: userID.(string),
+		})
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
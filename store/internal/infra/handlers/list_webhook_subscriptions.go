@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/store/internal/services"
+)
+
+// ListWebhookSubscriptions godoc
+// @Summary      List webhook subscriptions
+// @Description  List the webhook subscriptions of a store, without their secrets (store owner only)
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Store ID"
+// @Success      200  {object}  services.ListWebhookSubscriptionsResp
+// @Failure      400  {object}  ErrorResp
+// @Router       /api/v1/stores/{id}/webhooks [get]
+// @Security     BearerAuth
+func ListWebhookSubscriptions(listWebhookSubscriptionsService *services.ListWebhookSubscriptions) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		id := c.Param("id")
+		if id == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "id parameter is required"})
+			return
+		}
+
+		resp, err := listWebhookSubscriptionsService.Exec(c, services.ListWebhookSubscriptionsReq{
+			StoreID: id,
+			UserID:  userID.(string),
+		})
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
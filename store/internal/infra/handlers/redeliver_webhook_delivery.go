@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/store/internal/services"
+)
+
+// RedeliverWebhookDelivery godoc
+// @Summary      Redeliver webhook delivery
+// @Description  Queue a succeeded or failed delivery to be sent again with a new round of retries (store owner only)
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Store ID"
+// @Param        webhookId path string true "Webhook subscription ID"
+// @Param        deliveryId path string true "Delivery ID"
+// @Success      202  {object}  services.WebhookDeliveryResp
+// @Failure      400  {object}  ErrorResp
+// @Router       /api/v1/stores/{id}/webhooks/{webhookId}/deliveries/{deliveryId}/redeliver [post]
+// @Security     BearerAuth
+func RedeliverWebhookDelivery(redeliverWebhookDeliveryService *services.RedeliverWebhookDelivery) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		id := c.Param("id")
+		webhookID := c.Param("webhookId")
+		deliveryID := c.Param("deliveryId")
+		if id == "" || webhookID == "" || deliveryID == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "id, webhookId and deliveryId parameters are required"})
+			return
+		}
+
+		resp, err := redeliverWebhookDeliveryService.Exec(c, services.RedeliverWebhookDeliveryReq{
+			StoreID:        id,
+			SubscriptionID: webhookID,
+			DeliveryID:     deliveryID,
+			UserID:         userID.(string),
+		})
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusAccepted, resp)
+	}
+}
@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/store/internal/services"
+)
+
+type RequestCustomerVerificationBody struct {
+	Channel string `json:"channel" binding:"required"`
+}
+
+// RequestCustomerVerification godoc
+// @Summary      Request customer verification
+// @Description  Send a one-time code to the email or phone of the customer
+// @Tags         customers
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Customer ID"
+// @Param        body body RequestCustomerVerificationBody true "Verification channel (email or phone)"
+// @Success      201  {object}  services.RequestCustomerVerificationResp
+// @Failure      400  {object}  ErrorResp
+// @Failure      401  {object}  ErrorResp
+// @Router       /api/v1/customers/{id}/verifications [post]
+// @Security     BearerAuth
+func RequestCustomerVerification(requestCustomerVerificationService *services.RequestCustomerVerification) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		id := c.Param("id")
+		if id == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "id parameter is required"})
+			return
+		}
+
+		var body RequestCustomerVerificationBody
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		resp, err := requestCustomerVerificationService.Exec(c, services.RequestCustomerVerificationReq{
+			CustomerID: id,
+			Channel:    body.Channel,
+			UserID:     userID.(string),
+		})
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusCreated, resp)
+	}
+}
@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/store/internal/domain"
+	"ichibuy/store/internal/services"
+)
+
+type SetStoreScheduleBody struct {
+	// Timezone is an IANA timezone like America/Lima, UTC when empty
+	Timezone string `json:"timezone"`
+	// OpeningHours of every week, the store is always open when empty
+	OpeningHours []domain.OpeningHours `json:"opening_hours"`
+	// Exceptions replace the opening hours of a date, without hours the store is closed that date
+	Exceptions []domain.ScheduleException `json:"exceptions"`
+	// AllowsPreOrders lets customers order while the store is closed
+	AllowsPreOrders bool `json:"allows_pre_orders"`
+}
+
+// SetStoreSchedule godoc
+// @Summary      Set store schedule
+// @Description  Replace the weekly opening hours, holiday exceptions and pre-order policy of a store (store owner only)
+// @Tags         stores
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Store ID"
+// @Param        schedule body SetStoreScheduleBody true "Store schedule"
+// @Success      204
+// @Failure      400  {object}  ErrorResp
+// @Router       /api/v1/stores/{id}/schedule [put]
+// @Security     BearerAuth
+func SetStoreSchedule(setStoreScheduleService *services.SetStoreSchedule) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		id := c.Param("id")
+		if id == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "id parameter is required"})
+			return
+		}
+
+		var req SetStoreScheduleBody
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		err := setStoreScheduleService.Exec(c, services.SetStoreScheduleReq{
+			ID: id,
+			Schedule: domain.StoreSchedule{
+				Timezone:        req.Timezone,
+				OpeningHours:    req.OpeningHours,
+				Exceptions:      req.Exceptions,
+				AllowsPreOrders: req.AllowsPreOrders,
+			},
+			UserID: userID.(string),
+		})
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusNoContent, nil)
+	}
+}
@@ -12,7 +12,12 @@ type UpdateCustomerBody struct {
 	FirstName string  `json:"first_name" binding:"required"`
 	LastName  string  `json:"last_name" binding:"required"`
 	Email     *string `json:"email"`
-	Phone     *string `json:"phone"`
+	// Phone in E.164 or local format, local numbers use the phone region
+	Phone *string `json:"phone"`
+	// PhoneRegion of a local phone number, e.g. PE
+	PhoneRegion *string `json:"phone_region"`
+	// StoreID is the store the customer comes from, its phone region is used when none is given
+	StoreID *string `json:"store_id"`
 }
 
 // UpdateCustomer godoc
@@ -48,12 +53,14 @@ func UpdateCustomer(updateCustomerService *services.UpdateCustomer) gin.HandlerF
 		}
 
 		err := updateCustomerService.Exec(c, services.UpdateCustomerReq{
-			ID:        id,
-			FirstName: req.FirstName,
-			LastName:  req.LastName,
-			Email:     req.Email,
-			Phone:     req.Phone,
-			UserID:    userID.(string),
+			ID:          id,
+			FirstName:   req.FirstName,
+			LastName:    req.LastName,
+			Email:       req.Email,
+			Phone:       req.Phone,
+			PhoneRegion: req.PhoneRegion,
+			StoreID:     req.StoreID,
+			UserID:      userID.(string),
 		})
 		if err != nil {
 			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
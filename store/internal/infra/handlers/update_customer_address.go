@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/store/internal/services"
+)
+
+// UpdateCustomerAddress godoc
+// @Summary      Update customer address
+// @Description  Replace an address of a customer (customer owner only)
+// @Tags         customers
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Customer ID"
+// @Param        addressId path string true "Address ID"
+// @Param        address body CustomerAddressBody true "Address data"
+// @Success      200  {object}  services.CustomerAddressResp
+// @Failure      400  {object}  ErrorResp
+// @Router       /api/v1/customers/{id}/addresses/{addressId} [put]
+// @Security     BearerAuth
+func UpdateCustomerAddress(updateCustomerAddressService *services.UpdateCustomerAddress) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		id := c.Param("id")
+		addressID := c.Param("addressId")
+		if id == "" || addressID == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "id and addressId parameters are required"})
+			return
+		}
+
+		var req CustomerAddressBody
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		resp, err := updateCustomerAddressService.Exec(c, services.UpdateCustomerAddressReq{
+			CustomerID: id,
+			AddressID:  addressID,
+			Address:    req.toAddressReq(),
+			UserID:     userID.(string),
+		})
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -13,6 +13,10 @@ type UpdateStoreBody struct {
 	Name        string          `json:"name" binding:"required"`
 	Description *string         `json:"description"`
 	Location    domain.Location `json:"location" binding:"required"`
+	// Currencies enabled for the store prices, kept as they are when empty
+	Currencies []string `json:"currencies"`
+	// PhoneRegion is the ISO country code of the local phone numbers of the customers, kept when empty
+	PhoneRegion string `json:"phone_region"`
 }
 
 // UpdateStore godoc
@@ -52,6 +56,8 @@ func UpdateStore(updateStoreService *services.UpdateStore) gin.HandlerFunc {
 			Name:        req.Name,
 			Description: req.Description,
 			Location:    req.Location,
+			Currencies:  req.Currencies,
+			PhoneRegion: req.PhoneRegion,
 			UserID:      userID.(string),
 		})
 		if err != nil {
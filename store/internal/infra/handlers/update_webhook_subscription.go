@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/store/internal/services"
+)
+
+type UpdateWebhookSubscriptionBody struct {
+	URL        string   `json:"url" binding:"required"`
+	EventTypes []string `json:"event_types" binding:"required"`
+	// Active false pauses the deliveries, they are sent once the subscription is active again
+	Active bool `json:"active"`
+}
+
+// UpdateWebhookSubscription godoc
+// @Summary      Update webhook subscription
+// @Description  Replace the URL and events of a webhook subscription, or pause it (store owner only)
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Store ID"
+// @Param        webhookId path string true "Webhook subscription ID"
+// @Param        webhook body UpdateWebhookSubscriptionBody true "Webhook subscription"
+// @Success      200  {object}  services.WebhookSubscriptionResp
+// @Failure      400  {object}  ErrorResp
+// @Router       /api/v1/stores/{id}/webhooks/{webhookId} [put]
+// @Security     BearerAuth
+func UpdateWebhookSubscription(updateWebhookSubscriptionService *services.UpdateWebhookSubscription) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		id := c.Param("id")
+		webhookID := c.Param("webhookId")
+		if id == "" || webhookID == "" {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: "id and webhookId parameters are required"})
+			return
+		}
+
+		var req UpdateWebhookSubscriptionBody
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		resp, err := updateWebhookSubscriptionService.Exec(c, services.UpdateWebhookSubscriptionReq{
+			StoreID:        id,
+			SubscriptionID: webhookID,
+			URL:            req.URL,
+			EventTypes:     req.EventTypes,
+			Active:         req.Active,
+			UserID:         userID.(string),
+		})
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"ichibuy/store/internal/services"
+)
+
+type UpsertMyCustomerBody struct {
+	FirstName string  `json:"first_name" binding:"required"`
+	LastName  string  `json:"last_name" binding:"required"`
+	Email     *string `json:"email"`
+	// Phone in E.164 or local format, local numbers use the phone region
+	Phone *string `json:"phone"`
+	// PhoneRegion of a local phone number, e.g. PE
+	PhoneRegion *string `json:"phone_region"`
+	// StoreID is the store the customer comes from, its phone region is used when none is given
+	StoreID *string `json:"store_id"`
+}
+
+// UpsertMyCustomer godoc
+// @Summary      Create or update my customer
+// @Description  Create the customer of the authenticated user, or update it when it already exists
+// @Tags         customers
+// @Accept       json
+// @Produce      json
+// @Param        customer body UpsertMyCustomerBody true "Customer data"
+// @Success      200  {object}  services.UpsertMyCustomerResp
+// @Success      201  {object}  services.UpsertMyCustomerResp
+// @Failure      400  {object}  ErrorResp
+// @Failure      401  {object}  ErrorResp
+// @Router       /api/v1/customers/me [put]
+// @Security     BearerAuth
+func UpsertMyCustomer(upsertMyCustomerService *services.UpsertMyCustomer) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, ErrorResp{Error: "user not found in context"})
+			return
+		}
+
+		var req UpsertMyCustomerBody
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		resp, err := upsertMyCustomerService.Exec(c, services.UpsertMyCustomerReq{
+			FirstName:   req.FirstName,
+			LastName:    req.LastName,
+			Email:       req.Email,
+			Phone:       req.Phone,
+			PhoneRegion: req.PhoneRegion,
+			StoreID:     req.StoreID,
+			UserID:      userID.(string),
+		})
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResp{Error: err.Error()})
+			return
+		}
+
+		if resp.Created {
+			c.JSON(http.StatusCreated, resp)
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
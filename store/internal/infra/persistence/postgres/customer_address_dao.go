@@ -0,0 +1,477 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"ichibuy/store/internal/domain"
+	"strings"
+)
+
+type CustomerAddress = domain.CustomerAddress
+
+type CustomerAddressDAO struct {
+	db *sql.DB
+}
+
+func NewCustomerAddressDAO(db *sql.DB) *CustomerAddressDAO {
+	return &CustomerAddressDAO{db: db}
+}
+
+func (dao *CustomerAddressDAO) getTx(ctx context.Context) *sql.Tx {
+	if tx, ok := ctx.Value("currentTx").(*sql.Tx); ok {
+		return tx
+	}
+	return nil
+}
+
+func (dao *CustomerAddressDAO) execContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.ExecContext(ctx, query, args...)
+	}
+	return dao.db.ExecContext(ctx, query, args...)
+}
+
+func (dao *CustomerAddressDAO) queryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryRowContext(ctx, query, args...)
+	}
+	return dao.db.QueryRowContext(ctx, query, args...)
+}
+
+func (dao *CustomerAddressDAO) queryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryContext(ctx, query, args...)
+	}
+	return dao.db.QueryContext(ctx, query, args...)
+}
+
+func (dao *CustomerAddressDAO) Create(ctx context.Context, m *CustomerAddress) error {
+	query := `
+		INSERT INTO customer_addresses (id, customer_id, label, recipient, line1, line2, city, region, postal_code, country, lat, lng, is_default, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+	`
+
+	_, err := dao.execContext(
+		ctx,
+		query,
+		m.ID,
+		m.CustomerID,
+		m.Label,
+		m.Recipient,
+		m.Line1,
+		m.Line2,
+		m.City,
+		m.Region,
+		m.PostalCode,
+		m.Country,
+		m.Lat,
+		m.Lng,
+		m.IsDefault,
+		m.CreatedAt,
+		m.UpdatedAt,
+	)
+
+	return err
+}
+
+func (dao *CustomerAddressDAO) Update(ctx context.Context, m *CustomerAddress) error {
+	query := `
+		UPDATE customer_addresses
+		SET customer_id = $1,
+			label = $2,
+			recipient = $3,
+			line1 = $4,
+			line2 = $5,
+			city = $6,
+			region = $7,
+			postal_code = $8,
+			country = $9,
+			lat = $10,
+			lng = $11,
+			is_default = $12,
+			created_at = $13,
+			updated_at = $14
+		WHERE id = $15
+	`
+
+	_, err := dao.execContext(ctx, query,
+		m.CustomerID,
+		m.Label,
+		m.Recipient,
+		m.Line1,
+		m.Line2,
+		m.City,
+		m.Region,
+		m.PostalCode,
+		m.Country,
+		m.Lat,
+		m.Lng,
+		m.IsDefault,
+		m.CreatedAt,
+		m.UpdatedAt,
+		m.ID,
+	)
+	return err
+}
+
+func (dao *CustomerAddressDAO) PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	setClauses := make([]string, 0, len(fields))
+	args := make([]interface{}, 0, len(fields)+1)
+	i := 1
+
+	for field, value := range fields {
+		setClauses = append(setClauses, fmt.Sprintf("%s = $%d", field, i))
+		args = append(args, value)
+		i++
+	}
+
+	args = append(args, pk)
+
+	query := fmt.Sprintf(`UPDATE customer_addresses SET %s WHERE id = $%d`, strings.Join(setClauses, ", "), i)
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *CustomerAddressDAO) DeleteByPk(ctx context.Context, pk string) error {
+	query := `DELETE FROM customer_addresses WHERE id = $1`
+	_, err := dao.execContext(ctx, query, pk)
+	return err
+}
+
+func (dao *CustomerAddressDAO) FindByPk(ctx context.Context, pk string) (*CustomerAddress, error) {
+	query := `
+		SELECT id, customer_id, label, recipient, line1, line2, city, region, postal_code, country, lat, lng, is_default, created_at, updated_at
+		FROM customer_addresses
+		WHERE id = $1
+	`
+	row := dao.queryRowContext(ctx, query, pk)
+
+	var m CustomerAddress
+	err := row.Scan(
+		&m.ID,
+		&m.CustomerID,
+		&m.Label,
+		&m.Recipient,
+		&m.Line1,
+		&m.Line2,
+		&m.City,
+		&m.Region,
+		&m.PostalCode,
+		&m.Country,
+		&m.Lat,
+		&m.Lng,
+		&m.IsDefault,
+		&m.CreatedAt,
+		&m.UpdatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *CustomerAddressDAO) CreateMany(ctx context.Context, models []*CustomerAddress) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(models))
+	args := make([]interface{}, 0, len(models)*15)
+
+	for i, model := range models {
+		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			i*15+1, i*15+2, i*15+3, i*15+4, i*15+5, i*15+6, i*15+7, i*15+8, i*15+9, i*15+10, i*15+11, i*15+12, i*15+13, i*15+14, i*15+15)
+
+		args = append(args,
+			model.ID,
+			model.CustomerID,
+			model.Label,
+			model.Recipient,
+			model.Line1,
+			model.Line2,
+			model.City,
+			model.Region,
+			model.PostalCode,
+			model.Country,
+			model.Lat,
+			model.Lng,
+			model.IsDefault,
+			model.CreatedAt,
+			model.UpdatedAt,
+		)
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO customer_addresses (id, customer_id, label, recipient, line1, line2, city, region, postal_code, country, lat, lng, is_default, created_at, updated_at)
+		VALUES %s
+	`, strings.Join(placeholders, ", "))
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *CustomerAddressDAO) UpdateMany(ctx context.Context, models []*CustomerAddress) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	query := `
+		UPDATE customer_addresses
+		SET customer_id = $1,
+			label = $2,
+			recipient = $3,
+			line1 = $4,
+			line2 = $5,
+			city = $6,
+			region = $7,
+			postal_code = $8,
+			country = $9,
+			lat = $10,
+			lng = $11,
+			is_default = $12,
+			created_at = $13,
+			updated_at = $14
+		WHERE id = $15
+	`
+
+	for _, model := range models {
+		_, err := dao.execContext(ctx, query,
+			model.CustomerID,
+			model.Label,
+			model.Recipient,
+			model.Line1,
+			model.Line2,
+			model.City,
+			model.Region,
+			model.PostalCode,
+			model.Country,
+			model.Lat,
+			model.Lng,
+			model.IsDefault,
+			model.CreatedAt,
+			model.UpdatedAt,
+			model.ID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (dao *CustomerAddressDAO) DeleteManyByPks(ctx context.Context, pks []string) error {
+	if len(pks) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(pks))
+	args := make([]interface{}, len(pks))
+	for i, pk := range pks {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = pk
+	}
+
+	query := fmt.Sprintf(`DELETE FROM customer_addresses WHERE id IN (%s)`, strings.Join(placeholders, ","))
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *CustomerAddressDAO) FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*CustomerAddress, error) {
+	query := `
+		SELECT id, customer_id, label, recipient, line1, line2, city, region, postal_code, country, lat, lng, is_default, created_at, updated_at
+		FROM customer_addresses
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var m CustomerAddress
+	err := row.Scan(
+		&m.ID,
+		&m.CustomerID,
+		&m.Label,
+		&m.Recipient,
+		&m.Line1,
+		&m.Line2,
+		&m.City,
+		&m.Region,
+		&m.PostalCode,
+		&m.Country,
+		&m.Lat,
+		&m.Lng,
+		&m.IsDefault,
+		&m.CreatedAt,
+		&m.UpdatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *CustomerAddressDAO) FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*CustomerAddress, error) {
+	query := `
+		SELECT id, customer_id, label, recipient, line1, line2, city, region, postal_code, country, lat, lng, is_default, created_at, updated_at
+		FROM customer_addresses
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*CustomerAddress
+	for rows.Next() {
+		var m CustomerAddress
+		err := rows.Scan(
+			&m.ID,
+			&m.CustomerID,
+			&m.Label,
+			&m.Recipient,
+			&m.Line1,
+			&m.Line2,
+			&m.City,
+			&m.Region,
+			&m.PostalCode,
+			&m.Country,
+			&m.Lat,
+			&m.Lng,
+			&m.IsDefault,
+			&m.CreatedAt,
+			&m.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *CustomerAddressDAO) FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*CustomerAddress, error) {
+	query := `
+		SELECT id, customer_id, label, recipient, line1, line2, city, region, postal_code, country, lat, lng, is_default, created_at, updated_at
+		FROM customer_addresses
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	query += fmt.Sprintf(" LIMIT %d OFFSET %d", limit, offset)
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*CustomerAddress
+	for rows.Next() {
+		var m CustomerAddress
+		err := rows.Scan(
+			&m.ID,
+			&m.CustomerID,
+			&m.Label,
+			&m.Recipient,
+			&m.Line1,
+			&m.Line2,
+			&m.City,
+			&m.Region,
+			&m.PostalCode,
+			&m.Country,
+			&m.Lat,
+			&m.Lng,
+			&m.IsDefault,
+			&m.CreatedAt,
+			&m.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *CustomerAddressDAO) Count(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := "SELECT COUNT(*) FROM customer_addresses"
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var count int64
+	err := row.Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (dao *CustomerAddressDAO) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := dao.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	ctxWithTx := context.WithValue(ctx, "currentTx", tx)
+
+	err = fn(ctxWithTx)
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -48,8 +48,8 @@ func (dao *CustomerDAO) queryContext(ctx context.Context, query string, args ...
 
 func (dao *CustomerDAO) Create(ctx context.Context, m *Customer) error {
 	query := `
-		INSERT INTO customers (id, first_name, last_name, email, phone, user_id, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		INSERT INTO customers (id, first_name, last_name, email, phone, user_id, created_at, updated_at, deleted_at, email_verified_at, phone_verified_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 	`
 
 	_, err := dao.execContext(
@@ -63,6 +63,9 @@ func (dao *CustomerDAO) Create(ctx context.Context, m *Customer) error {
 		m.UserID,
 		m.CreatedAt,
 		m.UpdatedAt,
+		m.DeletedAt,
+		m.EmailVerifiedAt,
+		m.PhoneVerifiedAt,
 	)
 
 	return err
@@ -77,8 +80,11 @@ func (dao *CustomerDAO) Update(ctx context.Context, m *Customer) error {
 			phone = $4,
 			user_id = $5,
 			created_at = $6,
-			updated_at = $7
-		WHERE id = $8
+			updated_at = $7,
+			deleted_at = $8,
+			email_verified_at = $9,
+			phone_verified_at = $10
+		WHERE id = $11
 	`
 
 	_, err := dao.execContext(ctx, query,
@@ -89,6 +95,9 @@ func (dao *CustomerDAO) Update(ctx context.Context, m *Customer) error {
 		m.UserID,
 		m.CreatedAt,
 		m.UpdatedAt,
+		m.DeletedAt,
+		m.EmailVerifiedAt,
+		m.PhoneVerifiedAt,
 		m.ID,
 	)
 	return err
@@ -125,7 +134,7 @@ func (dao *CustomerDAO) DeleteByPk(ctx context.Context, pk string) error {
 
 func (dao *CustomerDAO) FindByPk(ctx context.Context, pk string) (*Customer, error) {
 	query := `
-		SELECT id, first_name, last_name, email, phone, user_id, created_at, updated_at
+		SELECT id, first_name, last_name, email, phone, user_id, created_at, updated_at, deleted_at, email_verified_at, phone_verified_at
 		FROM customers
 		WHERE id = $1
 	`
@@ -141,6 +150,9 @@ func (dao *CustomerDAO) FindByPk(ctx context.Context, pk string) (*Customer, err
 		&m.UserID,
 		&m.CreatedAt,
 		&m.UpdatedAt,
+		&m.DeletedAt,
+		&m.EmailVerifiedAt,
+		&m.PhoneVerifiedAt,
 	)
 
 	if err != nil {
@@ -156,11 +168,11 @@ func (dao *CustomerDAO) CreateMany(ctx context.Context, models []*Customer) erro
 	}
 
 	placeholders := make([]string, len(models))
-	args := make([]interface{}, 0, len(models)*8)
+	args := make([]interface{}, 0, len(models)*11)
 
 	for i, model := range models {
-		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
-			i*8+1, i*8+2, i*8+3, i*8+4, i*8+5, i*8+6, i*8+7, i*8+8)
+		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			i*11+1, i*11+2, i*11+3, i*11+4, i*11+5, i*11+6, i*11+7, i*11+8, i*11+9, i*11+10, i*11+11)
 
 		args = append(args,
 			model.ID,
@@ -171,11 +183,14 @@ func (dao *CustomerDAO) CreateMany(ctx context.Context, models []*Customer) erro
 			model.UserID,
 			model.CreatedAt,
 			model.UpdatedAt,
+			model.DeletedAt,
+			model.EmailVerifiedAt,
+			model.PhoneVerifiedAt,
 		)
 	}
 
 	query := fmt.Sprintf(`
-		INSERT INTO customers (id, first_name, last_name, email, phone, user_id, created_at, updated_at)
+		INSERT INTO customers (id, first_name, last_name, email, phone, user_id, created_at, updated_at, deleted_at, email_verified_at, phone_verified_at)
 		VALUES %s
 	`, strings.Join(placeholders, ", "))
 
@@ -196,8 +211,11 @@ func (dao *CustomerDAO) UpdateMany(ctx context.Context, models []*Customer) erro
 			phone = $4,
 			user_id = $5,
 			created_at = $6,
-			updated_at = $7
-		WHERE id = $8
+			updated_at = $7,
+			deleted_at = $8,
+			email_verified_at = $9,
+			phone_verified_at = $10
+		WHERE id = $11
 	`
 
 	for _, model := range models {
@@ -209,6 +227,9 @@ func (dao *CustomerDAO) UpdateMany(ctx context.Context, models []*Customer) erro
 			model.UserID,
 			model.CreatedAt,
 			model.UpdatedAt,
+			model.DeletedAt,
+			model.EmailVerifiedAt,
+			model.PhoneVerifiedAt,
 			model.ID,
 		)
 		if err != nil {
@@ -238,7 +259,7 @@ func (dao *CustomerDAO) DeleteManyByPks(ctx context.Context, pks []string) error
 
 func (dao *CustomerDAO) FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*Customer, error) {
 	query := `
-		SELECT id, first_name, last_name, email, phone, user_id, created_at, updated_at
+		SELECT id, first_name, last_name, email, phone, user_id, created_at, updated_at, deleted_at, email_verified_at, phone_verified_at
 		FROM customers
 	`
 
@@ -262,6 +283,9 @@ func (dao *CustomerDAO) FindOne(ctx context.Context, where string, sort string,
 		&m.UserID,
 		&m.CreatedAt,
 		&m.UpdatedAt,
+		&m.DeletedAt,
+		&m.EmailVerifiedAt,
+		&m.PhoneVerifiedAt,
 	)
 
 	if err != nil {
@@ -273,7 +297,7 @@ func (dao *CustomerDAO) FindOne(ctx context.Context, where string, sort string,
 
 func (dao *CustomerDAO) FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*Customer, error) {
 	query := `
-		SELECT id, first_name, last_name, email, phone, user_id, created_at, updated_at
+		SELECT id, first_name, last_name, email, phone, user_id, created_at, updated_at, deleted_at, email_verified_at, phone_verified_at
 		FROM customers
 	`
 
@@ -303,6 +327,9 @@ func (dao *CustomerDAO) FindAll(ctx context.Context, where string, sort string,
 			&m.UserID,
 			&m.CreatedAt,
 			&m.UpdatedAt,
+			&m.DeletedAt,
+			&m.EmailVerifiedAt,
+			&m.PhoneVerifiedAt,
 		)
 		if err != nil {
 			return nil, err
@@ -319,7 +346,7 @@ func (dao *CustomerDAO) FindAll(ctx context.Context, where string, sort string,
 
 func (dao *CustomerDAO) FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*Customer, error) {
 	query := `
-		SELECT id, first_name, last_name, email, phone, user_id, created_at, updated_at
+		SELECT id, first_name, last_name, email, phone, user_id, created_at, updated_at, deleted_at, email_verified_at, phone_verified_at
 		FROM customers
 	`
 
@@ -351,6 +378,9 @@ func (dao *CustomerDAO) FindPaginated(ctx context.Context, limit, offset int, wh
 			&m.UserID,
 			&m.CreatedAt,
 			&m.UpdatedAt,
+			&m.DeletedAt,
+			&m.EmailVerifiedAt,
+			&m.PhoneVerifiedAt,
 		)
 		if err != nil {
 			return nil, err
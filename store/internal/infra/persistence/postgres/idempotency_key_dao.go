@@ -0,0 +1,457 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"ichibuy/store/internal/domain"
+	"strings"
+)
+
+type IdempotencyKey = domain.IdempotencyKey
+
+type IdempotencyKeyDAO struct {
+	db *sql.DB
+}
+
+func NewIdempotencyKeyDAO(db *sql.DB) *IdempotencyKeyDAO {
+	return &IdempotencyKeyDAO{db: db}
+}
+
+func (dao *IdempotencyKeyDAO) getTx(ctx context.Context) *sql.Tx {
+	if tx, ok := ctx.Value("currentTx").(*sql.Tx); ok {
+		return tx
+	}
+	return nil
+}
+
+func (dao *IdempotencyKeyDAO) execContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.ExecContext(ctx, query, args...)
+	}
+	return dao.db.ExecContext(ctx, query, args...)
+}
+
+func (dao *IdempotencyKeyDAO) queryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryRowContext(ctx, query, args...)
+	}
+	return dao.db.QueryRowContext(ctx, query, args...)
+}
+
+func (dao *IdempotencyKeyDAO) queryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryContext(ctx, query, args...)
+	}
+	return dao.db.QueryContext(ctx, query, args...)
+}
+
+func (dao *IdempotencyKeyDAO) Create(ctx context.Context, m *IdempotencyKey) error {
+	query := `
+		INSERT INTO idempotency_keys (id, user_id, key, method, path, fingerprint, status, response_status, response_body, locked_until, expires_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+	`
+
+	_, err := dao.execContext(
+		ctx,
+		query,
+		m.ID,
+		m.UserID,
+		m.Key,
+		m.Method,
+		m.Path,
+		m.Fingerprint,
+		m.Status,
+		m.ResponseStatus,
+		m.ResponseBody,
+		m.LockedUntil,
+		m.ExpiresAt,
+		m.CreatedAt,
+		m.UpdatedAt,
+	)
+
+	return err
+}
+
+func (dao *IdempotencyKeyDAO) Update(ctx context.Context, m *IdempotencyKey) error {
+	query := `
+		UPDATE idempotency_keys
+		SET user_id = $1,
+			key = $2,
+			method = $3,
+			path = $4,
+			fingerprint = $5,
+			status = $6,
+			response_status = $7,
+			response_body = $8,
+			locked_until = $9,
+			expires_at = $10,
+			created_at = $11,
+			updated_at = $12
+		WHERE id = $13
+	`
+
+	_, err := dao.execContext(ctx, query,
+		m.UserID,
+		m.Key,
+		m.Method,
+		m.Path,
+		m.Fingerprint,
+		m.Status,
+		m.ResponseStatus,
+		m.ResponseBody,
+		m.LockedUntil,
+		m.ExpiresAt,
+		m.CreatedAt,
+		m.UpdatedAt,
+		m.ID,
+	)
+	return err
+}
+
+func (dao *IdempotencyKeyDAO) PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	setClauses := make([]string, 0, len(fields))
+	args := make([]interface{}, 0, len(fields)+1)
+	i := 1
+
+	for field, value := range fields {
+		setClauses = append(setClauses, fmt.Sprintf("%s = $%d", field, i))
+		args = append(args, value)
+		i++
+	}
+
+	args = append(args, pk)
+
+	query := fmt.Sprintf(`UPDATE idempotency_keys SET %s WHERE id = $%d`, strings.Join(setClauses, ", "), i)
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *IdempotencyKeyDAO) DeleteByPk(ctx context.Context, pk string) error {
+	query := `DELETE FROM idempotency_keys WHERE id = $1`
+	_, err := dao.execContext(ctx, query, pk)
+	return err
+}
+
+func (dao *IdempotencyKeyDAO) FindByPk(ctx context.Context, pk string) (*IdempotencyKey, error) {
+	query := `
+		SELECT id, user_id, key, method, path, fingerprint, status, response_status, response_body, locked_until, expires_at, created_at, updated_at
+		FROM idempotency_keys
+		WHERE id = $1
+	`
+	row := dao.queryRowContext(ctx, query, pk)
+
+	var m IdempotencyKey
+	err := row.Scan(
+		&m.ID,
+		&m.UserID,
+		&m.Key,
+		&m.Method,
+		&m.Path,
+		&m.Fingerprint,
+		&m.Status,
+		&m.ResponseStatus,
+		&m.ResponseBody,
+		&m.LockedUntil,
+		&m.ExpiresAt,
+		&m.CreatedAt,
+		&m.UpdatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *IdempotencyKeyDAO) CreateMany(ctx context.Context, models []*IdempotencyKey) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(models))
+	args := make([]interface{}, 0, len(models)*13)
+
+	for i, model := range models {
+		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			i*13+1, i*13+2, i*13+3, i*13+4, i*13+5, i*13+6, i*13+7, i*13+8, i*13+9, i*13+10, i*13+11, i*13+12, i*13+13)
+
+		args = append(args,
+			model.ID,
+			model.UserID,
+			model.Key,
+			model.Method,
+			model.Path,
+			model.Fingerprint,
+			model.Status,
+			model.ResponseStatus,
+			model.ResponseBody,
+			model.LockedUntil,
+			model.ExpiresAt,
+			model.CreatedAt,
+			model.UpdatedAt,
+		)
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO idempotency_keys (id, user_id, key, method, path, fingerprint, status, response_status, response_body, locked_until, expires_at, created_at, updated_at)
+		VALUES %s
+	`, strings.Join(placeholders, ", "))
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *IdempotencyKeyDAO) UpdateMany(ctx context.Context, models []*IdempotencyKey) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	query := `
+		UPDATE idempotency_keys
+		SET user_id = $1,
+			key = $2,
+			method = $3,
+			path = $4,
+			fingerprint = $5,
+			status = $6,
+			response_status = $7,
+			response_body = $8,
+			locked_until = $9,
+			expires_at = $10,
+			created_at = $11,
+			updated_at = $12
+		WHERE id = $13
+	`
+
+	for _, model := range models {
+		_, err := dao.execContext(ctx, query,
+			model.UserID,
+			model.Key,
+			model.Method,
+			model.Path,
+			model.Fingerprint,
+			model.Status,
+			model.ResponseStatus,
+			model.ResponseBody,
+			model.LockedUntil,
+			model.ExpiresAt,
+			model.CreatedAt,
+			model.UpdatedAt,
+			model.ID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (dao *IdempotencyKeyDAO) DeleteManyByPks(ctx context.Context, pks []string) error {
+	if len(pks) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(pks))
+	args := make([]interface{}, len(pks))
+	for i, pk := range pks {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = pk
+	}
+
+	query := fmt.Sprintf(`DELETE FROM idempotency_keys WHERE id IN (%s)`, strings.Join(placeholders, ","))
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *IdempotencyKeyDAO) FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*IdempotencyKey, error) {
+	query := `
+		SELECT id, user_id, key, method, path, fingerprint, status, response_status, response_body, locked_until, expires_at, created_at, updated_at
+		FROM idempotency_keys
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var m IdempotencyKey
+	err := row.Scan(
+		&m.ID,
+		&m.UserID,
+		&m.Key,
+		&m.Method,
+		&m.Path,
+		&m.Fingerprint,
+		&m.Status,
+		&m.ResponseStatus,
+		&m.ResponseBody,
+		&m.LockedUntil,
+		&m.ExpiresAt,
+		&m.CreatedAt,
+		&m.UpdatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *IdempotencyKeyDAO) FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*IdempotencyKey, error) {
+	query := `
+		SELECT id, user_id, key, method, path, fingerprint, status, response_status, response_body, locked_until, expires_at, created_at, updated_at
+		FROM idempotency_keys
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*IdempotencyKey
+	for rows.Next() {
+		var m IdempotencyKey
+		err := rows.Scan(
+			&m.ID,
+			&m.UserID,
+			&m.Key,
+			&m.Method,
+			&m.Path,
+			&m.Fingerprint,
+			&m.Status,
+			&m.ResponseStatus,
+			&m.ResponseBody,
+			&m.LockedUntil,
+			&m.ExpiresAt,
+			&m.CreatedAt,
+			&m.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *IdempotencyKeyDAO) FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*IdempotencyKey, error) {
+	query := `
+		SELECT id, user_id, key, method, path, fingerprint, status, response_status, response_body, locked_until, expires_at, created_at, updated_at
+		FROM idempotency_keys
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	query += fmt.Sprintf(" LIMIT %d OFFSET %d", limit, offset)
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*IdempotencyKey
+	for rows.Next() {
+		var m IdempotencyKey
+		err := rows.Scan(
+			&m.ID,
+			&m.UserID,
+			&m.Key,
+			&m.Method,
+			&m.Path,
+			&m.Fingerprint,
+			&m.Status,
+			&m.ResponseStatus,
+			&m.ResponseBody,
+			&m.LockedUntil,
+			&m.ExpiresAt,
+			&m.CreatedAt,
+			&m.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *IdempotencyKeyDAO) Count(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := "SELECT COUNT(*) FROM idempotency_keys"
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var count int64
+	err := row.Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (dao *IdempotencyKeyDAO) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := dao.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	ctxWithTx := context.WithValue(ctx, "currentTx", tx)
+
+	err = fn(ctxWithTx)
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,477 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"ichibuy/store/internal/domain"
+	"strings"
+)
+
+type ImportJob = domain.ImportJob
+
+type ImportJobDAO struct {
+	db *sql.DB
+}
+
+func NewImportJobDAO(db *sql.DB) *ImportJobDAO {
+	return &ImportJobDAO{db: db}
+}
+
+func (dao *ImportJobDAO) getTx(ctx context.Context) *sql.Tx {
+	if tx, ok := ctx.Value("currentTx").(*sql.Tx); ok {
+		return tx
+	}
+	return nil
+}
+
+func (dao *ImportJobDAO) execContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.ExecContext(ctx, query, args...)
+	}
+	return dao.db.ExecContext(ctx, query, args...)
+}
+
+func (dao *ImportJobDAO) queryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryRowContext(ctx, query, args...)
+	}
+	return dao.db.QueryRowContext(ctx, query, args...)
+}
+
+func (dao *ImportJobDAO) queryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryContext(ctx, query, args...)
+	}
+	return dao.db.QueryContext(ctx, query, args...)
+}
+
+func (dao *ImportJobDAO) Create(ctx context.Context, m *ImportJob) error {
+	query := `
+		INSERT INTO import_jobs (id, store_id, user_id, format, status, payload, total_rows, created_rows, failed_rows, row_errors, last_row, locked_until, created_at, updated_at, finished_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+	`
+
+	_, err := dao.execContext(
+		ctx,
+		query,
+		m.ID,
+		m.StoreID,
+		m.UserID,
+		m.Format,
+		m.Status,
+		m.Payload,
+		m.TotalRows,
+		m.CreatedRows,
+		m.FailedRows,
+		m.RowErrors,
+		m.LastRow,
+		m.LockedUntil,
+		m.CreatedAt,
+		m.UpdatedAt,
+		m.FinishedAt,
+	)
+
+	return err
+}
+
+func (dao *ImportJobDAO) Update(ctx context.Context, m *ImportJob) error {
+	query := `
+		UPDATE import_jobs
+		SET store_id = $1,
+			user_id = $2,
+			format = $3,
+			status = $4,
+			payload = $5,
+			total_rows = $6,
+			created_rows = $7,
+			failed_rows = $8,
+			row_errors = $9,
+			last_row = $10,
+			locked_until = $11,
+			created_at = $12,
+			updated_at = $13,
+			finished_at = $14
+		WHERE id = $15
+	`
+
+	_, err := dao.execContext(ctx, query,
+		m.StoreID,
+		m.UserID,
+		m.Format,
+		m.Status,
+		m.Payload,
+		m.TotalRows,
+		m.CreatedRows,
+		m.FailedRows,
+		m.RowErrors,
+		m.LastRow,
+		m.LockedUntil,
+		m.CreatedAt,
+		m.UpdatedAt,
+		m.FinishedAt,
+		m.ID,
+	)
+	return err
+}
+
+func (dao *ImportJobDAO) PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	setClauses := make([]string, 0, len(fields))
+	args := make([]interface{}, 0, len(fields)+1)
+	i := 1
+
+	for field, value := range fields {
+		setClauses = append(setClauses, fmt.Sprintf("%s = $%d", field, i))
+		args = append(args, value)
+		i++
+	}
+
+	args = append(args, pk)
+
+	query := fmt.Sprintf(`UPDATE import_jobs SET %s WHERE id = $%d`, strings.Join(setClauses, ", "), i)
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *ImportJobDAO) DeleteByPk(ctx context.Context, pk string) error {
+	query := `DELETE FROM import_jobs WHERE id = $1`
+	_, err := dao.execContext(ctx, query, pk)
+	return err
+}
+
+func (dao *ImportJobDAO) FindByPk(ctx context.Context, pk string) (*ImportJob, error) {
+	query := `
+		SELECT id, store_id, user_id, format, status, payload, total_rows, created_rows, failed_rows, row_errors, last_row, locked_until, created_at, updated_at, finished_at
+		FROM import_jobs
+		WHERE id = $1
+	`
+	row := dao.queryRowContext(ctx, query, pk)
+
+	var m ImportJob
+	err := row.Scan(
+		&m.ID,
+		&m.StoreID,
+		&m.UserID,
+		&m.Format,
+		&m.Status,
+		&m.Payload,
+		&m.TotalRows,
+		&m.CreatedRows,
+		&m.FailedRows,
+		&m.RowErrors,
+		&m.LastRow,
+		&m.LockedUntil,
+		&m.CreatedAt,
+		&m.UpdatedAt,
+		&m.FinishedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *ImportJobDAO) CreateMany(ctx context.Context, models []*ImportJob) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(models))
+	args := make([]interface{}, 0, len(models)*15)
+
+	for i, model := range models {
+		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			i*15+1, i*15+2, i*15+3, i*15+4, i*15+5, i*15+6, i*15+7, i*15+8, i*15+9, i*15+10, i*15+11, i*15+12, i*15+13, i*15+14, i*15+15)
+
+		args = append(args,
+			model.ID,
+			model.StoreID,
+			model.UserID,
+			model.Format,
+			model.Status,
+			model.Payload,
+			model.TotalRows,
+			model.CreatedRows,
+			model.FailedRows,
+			model.RowErrors,
+			model.LastRow,
+			model.LockedUntil,
+			model.CreatedAt,
+			model.UpdatedAt,
+			model.FinishedAt,
+		)
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO import_jobs (id, store_id, user_id, format, status, payload, total_rows, created_rows, failed_rows, row_errors, last_row, locked_until, created_at, updated_at, finished_at)
+		VALUES %s
+	`, strings.Join(placeholders, ", "))
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *ImportJobDAO) UpdateMany(ctx context.Context, models []*ImportJob) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	query := `
+		UPDATE import_jobs
+		SET store_id = $1,
+			user_id = $2,
+			format = $3,
+			status = $4,
+			payload = $5,
+			total_rows = $6,
+			created_rows = $7,
+			failed_rows = $8,
+			row_errors = $9,
+			last_row = $10,
+			locked_until = $11,
+			created_at = $12,
+			updated_at = $13,
+			finished_at = $14
+		WHERE id = $15
+	`
+
+	for _, model := range models {
+		_, err := dao.execContext(ctx, query,
+			model.StoreID,
+			model.UserID,
+			model.Format,
+			model.Status,
+			model.Payload,
+			model.TotalRows,
+			model.CreatedRows,
+			model.FailedRows,
+			model.RowErrors,
+			model.LastRow,
+			model.LockedUntil,
+			model.CreatedAt,
+			model.UpdatedAt,
+			model.FinishedAt,
+			model.ID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (dao *ImportJobDAO) DeleteManyByPks(ctx context.Context, pks []string) error {
+	if len(pks) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(pks))
+	args := make([]interface{}, len(pks))
+	for i, pk := range pks {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = pk
+	}
+
+	query := fmt.Sprintf(`DELETE FROM import_jobs WHERE id IN (%s)`, strings.Join(placeholders, ","))
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *ImportJobDAO) FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*ImportJob, error) {
+	query := `
+		SELECT id, store_id, user_id, format, status, payload, total_rows, created_rows, failed_rows, row_errors, last_row, locked_until, created_at, updated_at, finished_at
+		FROM import_jobs
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var m ImportJob
+	err := row.Scan(
+		&m.ID,
+		&m.StoreID,
+		&m.UserID,
+		&m.Format,
+		&m.Status,
+		&m.Payload,
+		&m.TotalRows,
+		&m.CreatedRows,
+		&m.FailedRows,
+		&m.RowErrors,
+		&m.LastRow,
+		&m.LockedUntil,
+		&m.CreatedAt,
+		&m.UpdatedAt,
+		&m.FinishedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *ImportJobDAO) FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*ImportJob, error) {
+	query := `
+		SELECT id, store_id, user_id, format, status, payload, total_rows, created_rows, failed_rows, row_errors, last_row, locked_until, created_at, updated_at, finished_at
+		FROM import_jobs
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*ImportJob
+	for rows.Next() {
+		var m ImportJob
+		err := rows.Scan(
+			&m.ID,
+			&m.StoreID,
+			&m.UserID,
+			&m.Format,
+			&m.Status,
+			&m.Payload,
+			&m.TotalRows,
+			&m.CreatedRows,
+			&m.FailedRows,
+			&m.RowErrors,
+			&m.LastRow,
+			&m.LockedUntil,
+			&m.CreatedAt,
+			&m.UpdatedAt,
+			&m.FinishedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *ImportJobDAO) FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*ImportJob, error) {
+	query := `
+		SELECT id, store_id, user_id, format, status, payload, total_rows, created_rows, failed_rows, row_errors, last_row, locked_until, created_at, updated_at, finished_at
+		FROM import_jobs
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	query += fmt.Sprintf(" LIMIT %d OFFSET %d", limit, offset)
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*ImportJob
+	for rows.Next() {
+		var m ImportJob
+		err := rows.Scan(
+			&m.ID,
+			&m.StoreID,
+			&m.UserID,
+			&m.Format,
+			&m.Status,
+			&m.Payload,
+			&m.TotalRows,
+			&m.CreatedRows,
+			&m.FailedRows,
+			&m.RowErrors,
+			&m.LastRow,
+			&m.LockedUntil,
+			&m.CreatedAt,
+			&m.UpdatedAt,
+			&m.FinishedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *ImportJobDAO) Count(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := "SELECT COUNT(*) FROM import_jobs"
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var count int64
+	err := row.Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (dao *ImportJobDAO) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := dao.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	ctxWithTx := context.WithValue(ctx, "currentTx", tx)
+
+	err = fn(ctxWithTx)
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
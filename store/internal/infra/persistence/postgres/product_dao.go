@@ -48,8 +48,8 @@ func (dao *ProductDAO) queryContext(ctx context.Context, query string, args ...i
 
 func (dao *ProductDAO) Create(ctx context.Context, m *Product) error {
 	query := `
-		INSERT INTO products (id, name, description, active, store_id, images, prices, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		INSERT INTO products (id, name, description, active, store_id, images, prices, created_at, updated_at, deleted_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 	`
 
 	_, err := dao.execContext(
@@ -64,6 +64,7 @@ func (dao *ProductDAO) Create(ctx context.Context, m *Product) error {
 		m.Prices,
 		m.CreatedAt,
 		m.UpdatedAt,
+		m.DeletedAt,
 	)
 
 	return err
@@ -79,8 +80,9 @@ func (dao *ProductDAO) Update(ctx context.Context, m *Product) error {
 			images = $5,
 			prices = $6,
 			created_at = $7,
-			updated_at = $8
-		WHERE id = $9
+			updated_at = $8,
+			deleted_at = $9
+		WHERE id = $10
 	`
 
 	_, err := dao.execContext(ctx, query,
@@ -92,6 +94,7 @@ func (dao *ProductDAO) Update(ctx context.Context, m *Product) error {
 		m.Prices,
 		m.CreatedAt,
 		m.UpdatedAt,
+		m.DeletedAt,
 		m.ID,
 	)
 	return err
@@ -128,7 +131,7 @@ func (dao *ProductDAO) DeleteByPk(ctx context.Context, pk string) error {
 
 func (dao *ProductDAO) FindByPk(ctx context.Context, pk string) (*Product, error) {
 	query := `
-		SELECT id, name, description, active, store_id, images, prices, created_at, updated_at
+		SELECT id, name, description, active, store_id, images, prices, created_at, updated_at, deleted_at
 		FROM products
 		WHERE id = $1
 	`
@@ -145,6 +148,7 @@ func (dao *ProductDAO) FindByPk(ctx context.Context, pk string) (*Product, error
 		&m.Prices,
 		&m.CreatedAt,
 		&m.UpdatedAt,
+		&m.DeletedAt,
 	)
 
 	if err != nil {
@@ -160,11 +164,11 @@ func (dao *ProductDAO) CreateMany(ctx context.Context, models []*Product) error
 	}
 
 	placeholders := make([]string, len(models))
-	args := make([]interface{}, 0, len(models)*9)
+	args := make([]interface{}, 0, len(models)*10)
 
 	for i, model := range models {
-		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
-			i*9+1, i*9+2, i*9+3, i*9+4, i*9+5, i*9+6, i*9+7, i*9+8, i*9+9)
+		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			i*10+1, i*10+2, i*10+3, i*10+4, i*10+5, i*10+6, i*10+7, i*10+8, i*10+9, i*10+10)
 
 		args = append(args,
 			model.ID,
@@ -176,11 +180,12 @@ func (dao *ProductDAO) CreateMany(ctx context.Context, models []*Product) error
 			model.Prices,
 			model.CreatedAt,
 			model.UpdatedAt,
+			model.DeletedAt,
 		)
 	}
 
 	query := fmt.Sprintf(`
-		INSERT INTO products (id, name, description, active, store_id, images, prices, created_at, updated_at)
+		INSERT INTO products (id, name, description, active, store_id, images, prices, created_at, updated_at, deleted_at)
 		VALUES %s
 	`, strings.Join(placeholders, ", "))
 
@@ -202,8 +207,9 @@ func (dao *ProductDAO) UpdateMany(ctx context.Context, models []*Product) error
 			images = $5,
 			prices = $6,
 			created_at = $7,
-			updated_at = $8
-		WHERE id = $9
+			updated_at = $8,
+			deleted_at = $9
+		WHERE id = $10
 	`
 
 	for _, model := range models {
@@ -216,6 +222,7 @@ func (dao *ProductDAO) UpdateMany(ctx context.Context, models []*Product) error
 			model.Prices,
 			model.CreatedAt,
 			model.UpdatedAt,
+			model.DeletedAt,
 			model.ID,
 		)
 		if err != nil {
@@ -245,7 +252,7 @@ func (dao *ProductDAO) DeleteManyByPks(ctx context.Context, pks []string) error
 
 func (dao *ProductDAO) FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*Product, error) {
 	query := `
-		SELECT id, name, description, active, store_id, images, prices, created_at, updated_at
+		SELECT id, name, description, active, store_id, images, prices, created_at, updated_at, deleted_at
 		FROM products
 	`
 
@@ -270,6 +277,7 @@ func (dao *ProductDAO) FindOne(ctx context.Context, where string, sort string, a
 		&m.Prices,
 		&m.CreatedAt,
 		&m.UpdatedAt,
+		&m.DeletedAt,
 	)
 
 	if err != nil {
@@ -281,7 +289,7 @@ func (dao *ProductDAO) FindOne(ctx context.Context, where string, sort string, a
 
 func (dao *ProductDAO) FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*Product, error) {
 	query := `
-		SELECT id, name, description, active, store_id, images, prices, created_at, updated_at
+		SELECT id, name, description, active, store_id, images, prices, created_at, updated_at, deleted_at
 		FROM products
 	`
 
@@ -312,6 +320,7 @@ func (dao *ProductDAO) FindAll(ctx context.Context, where string, sort string, a
 			&m.Prices,
 			&m.CreatedAt,
 			&m.UpdatedAt,
+			&m.DeletedAt,
 		)
 		if err != nil {
 			return nil, err
@@ -328,7 +337,7 @@ func (dao *ProductDAO) FindAll(ctx context.Context, where string, sort string, a
 
 func (dao *ProductDAO) FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*Product, error) {
 	query := `
-		SELECT id, name, description, active, store_id, images, prices, created_at, updated_at
+		SELECT id, name, description, active, store_id, images, prices, created_at, updated_at, deleted_at
 		FROM products
 	`
 
@@ -361,6 +370,7 @@ func (dao *ProductDAO) FindPaginated(ctx context.Context, limit, offset int, whe
 			&m.Prices,
 			&m.CreatedAt,
 			&m.UpdatedAt,
+			&m.DeletedAt,
 		)
 		if err != nil {
 			return nil, err
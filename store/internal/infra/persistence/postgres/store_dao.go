@@ -48,8 +48,8 @@ func (dao *StoreDAO) queryContext(ctx context.Context, query string, args ...int
 
 func (dao *StoreDAO) Create(ctx context.Context, m *Store) error {
 	query := `
-		INSERT INTO stores (id, name, description, lat, lng, slug, user_id, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		INSERT INTO stores (id, name, description, lat, lng, slug, currencies, phone_region, user_id, created_at, updated_at, deleted_at, timezone, opening_hours, schedule_exceptions, allows_pre_orders)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
 	`
 
 	_, err := dao.execContext(
@@ -61,9 +61,16 @@ func (dao *StoreDAO) Create(ctx context.Context, m *Store) error {
 		m.Lat,
 		m.Lng,
 		m.Slug,
+		m.Currencies,
+		m.PhoneRegion,
 		m.UserID,
 		m.CreatedAt,
 		m.UpdatedAt,
+		m.DeletedAt,
+		m.Timezone,
+		m.OpeningHours,
+		m.ScheduleExceptions,
+		m.AllowsPreOrders,
 	)
 
 	return err
@@ -77,10 +84,17 @@ func (dao *StoreDAO) Update(ctx context.Context, m *Store) error {
 			lat = $3,
 			lng = $4,
 			slug = $5,
-			user_id = $6,
-			created_at = $7,
-			updated_at = $8
-		WHERE id = $9
+			currencies = $6,
+			phone_region = $7,
+			user_id = $8,
+			created_at = $9,
+			updated_at = $10,
+			deleted_at = $11,
+			timezone = $12,
+			opening_hours = $13,
+			schedule_exceptions = $14,
+			allows_pre_orders = $15
+		WHERE id = $16
 	`
 
 	_, err := dao.execContext(ctx, query,
@@ -89,9 +103,16 @@ func (dao *StoreDAO) Update(ctx context.Context, m *Store) error {
 		m.Lat,
 		m.Lng,
 		m.Slug,
+		m.Currencies,
+		m.PhoneRegion,
 		m.UserID,
 		m.CreatedAt,
 		m.UpdatedAt,
+		m.DeletedAt,
+		m.Timezone,
+		m.OpeningHours,
+		m.ScheduleExceptions,
+		m.AllowsPreOrders,
 		m.ID,
 	)
 	return err
@@ -128,7 +149,7 @@ func (dao *StoreDAO) DeleteByPk(ctx context.Context, pk string) error {
 
 func (dao *StoreDAO) FindByPk(ctx context.Context, pk string) (*Store, error) {
 	query := `
-		SELECT id, name, description, lat, lng, slug, user_id, created_at, updated_at
+		SELECT id, name, description, lat, lng, slug, currencies, phone_region, user_id, created_at, updated_at, deleted_at, timezone, opening_hours, schedule_exceptions, allows_pre_orders
 		FROM stores
 		WHERE id = $1
 	`
@@ -142,9 +163,16 @@ func (dao *StoreDAO) FindByPk(ctx context.Context, pk string) (*Store, error) {
 		&m.Lat,
 		&m.Lng,
 		&m.Slug,
+		&m.Currencies,
+		&m.PhoneRegion,
 		&m.UserID,
 		&m.CreatedAt,
 		&m.UpdatedAt,
+		&m.DeletedAt,
+		&m.Timezone,
+		&m.OpeningHours,
+		&m.ScheduleExceptions,
+		&m.AllowsPreOrders,
 	)
 
 	if err != nil {
@@ -160,11 +188,11 @@ func (dao *StoreDAO) CreateMany(ctx context.Context, models []*Store) error {
 	}
 
 	placeholders := make([]string, len(models))
-	args := make([]interface{}, 0, len(models)*9)
+	args := make([]interface{}, 0, len(models)*16)
 
 	for i, model := range models {
-		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
-			i*9+1, i*9+2, i*9+3, i*9+4, i*9+5, i*9+6, i*9+7, i*9+8, i*9+9)
+		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			i*16+1, i*16+2, i*16+3, i*16+4, i*16+5, i*16+6, i*16+7, i*16+8, i*16+9, i*16+10, i*16+11, i*16+12, i*16+13, i*16+14, i*16+15, i*16+16)
 
 		args = append(args,
 			model.ID,
@@ -173,14 +201,21 @@ func (dao *StoreDAO) CreateMany(ctx context.Context, models []*Store) error {
 			model.Lat,
 			model.Lng,
 			model.Slug,
+			model.Currencies,
+			model.PhoneRegion,
 			model.UserID,
 			model.CreatedAt,
 			model.UpdatedAt,
+			model.DeletedAt,
+			model.Timezone,
+			model.OpeningHours,
+			model.ScheduleExceptions,
+			model.AllowsPreOrders,
 		)
 	}
 
 	query := fmt.Sprintf(`
-		INSERT INTO stores (id, name, description, lat, lng, slug, user_id, created_at, updated_at)
+		INSERT INTO stores (id, name, description, lat, lng, slug, currencies, phone_region, user_id, created_at, updated_at, deleted_at, timezone, opening_hours, schedule_exceptions, allows_pre_orders)
 		VALUES %s
 	`, strings.Join(placeholders, ", "))
 
@@ -200,10 +235,17 @@ func (dao *StoreDAO) UpdateMany(ctx context.Context, models []*Store) error {
 			lat = $3,
 			lng = $4,
 			slug = $5,
-			user_id = $6,
-			created_at = $7,
-			updated_at = $8
-		WHERE id = $9
+			currencies = $6,
+			phone_region = $7,
+			user_id = $8,
+			created_at = $9,
+			updated_at = $10,
+			deleted_at = $11,
+			timezone = $12,
+			opening_hours = $13,
+			schedule_exceptions = $14,
+			allows_pre_orders = $15
+		WHERE id = $16
 	`
 
 	for _, model := range models {
@@ -213,9 +255,16 @@ func (dao *StoreDAO) UpdateMany(ctx context.Context, models []*Store) error {
 			model.Lat,
 			model.Lng,
 			model.Slug,
+			model.Currencies,
+			model.PhoneRegion,
 			model.UserID,
 			model.CreatedAt,
 			model.UpdatedAt,
+			model.DeletedAt,
+			model.Timezone,
+			model.OpeningHours,
+			model.ScheduleExceptions,
+			model.AllowsPreOrders,
 			model.ID,
 		)
 		if err != nil {
@@ -245,7 +294,7 @@ func (dao *StoreDAO) DeleteManyByPks(ctx context.Context, pks []string) error {
 
 func (dao *StoreDAO) FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*Store, error) {
 	query := `
-		SELECT id, name, description, lat, lng, slug, user_id, created_at, updated_at
+		SELECT id, name, description, lat, lng, slug, currencies, phone_region, user_id, created_at, updated_at, deleted_at, timezone, opening_hours, schedule_exceptions, allows_pre_orders
 		FROM stores
 	`
 
@@ -267,9 +316,16 @@ func (dao *StoreDAO) FindOne(ctx context.Context, where string, sort string, arg
 		&m.Lat,
 		&m.Lng,
 		&m.Slug,
+		&m.Currencies,
+		&m.PhoneRegion,
 		&m.UserID,
 		&m.CreatedAt,
 		&m.UpdatedAt,
+		&m.DeletedAt,
+		&m.Timezone,
+		&m.OpeningHours,
+		&m.ScheduleExceptions,
+		&m.AllowsPreOrders,
 	)
 
 	if err != nil {
@@ -281,7 +337,7 @@ func (dao *StoreDAO) FindOne(ctx context.Context, where string, sort string, arg
 
 func (dao *StoreDAO) FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*Store, error) {
 	query := `
-		SELECT id, name, description, lat, lng, slug, user_id, created_at, updated_at
+		SELECT id, name, description, lat, lng, slug, currencies, phone_region, user_id, created_at, updated_at, deleted_at, timezone, opening_hours, schedule_exceptions, allows_pre_orders
 		FROM stores
 	`
 
@@ -309,9 +365,16 @@ func (dao *StoreDAO) FindAll(ctx context.Context, where string, sort string, arg
 			&m.Lat,
 			&m.Lng,
 			&m.Slug,
+			&m.Currencies,
+			&m.PhoneRegion,
 			&m.UserID,
 			&m.CreatedAt,
 			&m.UpdatedAt,
+			&m.DeletedAt,
+			&m.Timezone,
+			&m.OpeningHours,
+			&m.ScheduleExceptions,
+			&m.AllowsPreOrders,
 		)
 		if err != nil {
 			return nil, err
@@ -328,7 +391,7 @@ func (dao *StoreDAO) FindAll(ctx context.Context, where string, sort string, arg
 
 func (dao *StoreDAO) FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*Store, error) {
 	query := `
-		SELECT id, name, description, lat, lng, slug, user_id, created_at, updated_at
+		SELECT id, name, description, lat, lng, slug, currencies, phone_region, user_id, created_at, updated_at, deleted_at, timezone, opening_hours, schedule_exceptions, allows_pre_orders
 		FROM stores
 	`
 
@@ -358,9 +421,16 @@ func (dao *StoreDAO) FindPaginated(ctx context.Context, limit, offset int, where
 			&m.Lat,
 			&m.Lng,
 			&m.Slug,
+			&m.Currencies,
+			&m.PhoneRegion,
 			&m.UserID,
 			&m.CreatedAt,
 			&m.UpdatedAt,
+			&m.DeletedAt,
+			&m.Timezone,
+			&m.OpeningHours,
+			&m.ScheduleExceptions,
+			&m.AllowsPreOrders,
 		)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,417 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"ichibuy/store/internal/domain"
+	"strings"
+)
+
+type VerificationCode = domain.VerificationCode
+
+type VerificationCodeDAO struct {
+	db *sql.DB
+}
+
+func NewVerificationCodeDAO(db *sql.DB) *VerificationCodeDAO {
+	return &VerificationCodeDAO{db: db}
+}
+
+func (dao *VerificationCodeDAO) getTx(ctx context.Context) *sql.Tx {
+	if tx, ok := ctx.Value("currentTx").(*sql.Tx); ok {
+		return tx
+	}
+	return nil
+}
+
+func (dao *VerificationCodeDAO) execContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.ExecContext(ctx, query, args...)
+	}
+	return dao.db.ExecContext(ctx, query, args...)
+}
+
+func (dao *VerificationCodeDAO) queryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryRowContext(ctx, query, args...)
+	}
+	return dao.db.QueryRowContext(ctx, query, args...)
+}
+
+func (dao *VerificationCodeDAO) queryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryContext(ctx, query, args...)
+	}
+	return dao.db.QueryContext(ctx, query, args...)
+}
+
+func (dao *VerificationCodeDAO) Create(ctx context.Context, m *VerificationCode) error {
+	query := `
+		INSERT INTO verification_codes (id, customer_id, channel, target, code_hash, attempts, expires_at, consumed_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	_, err := dao.execContext(
+		ctx,
+		query,
+		m.ID,
+		m.CustomerID,
+		m.Channel,
+		m.Target,
+		m.CodeHash,
+		m.Attempts,
+		m.ExpiresAt,
+		m.ConsumedAt,
+		m.CreatedAt,
+	)
+
+	return err
+}
+
+func (dao *VerificationCodeDAO) Update(ctx context.Context, m *VerificationCode) error {
+	query := `
+		UPDATE verification_codes
+		SET customer_id = $1,
+			channel = $2,
+			target = $3,
+			code_hash = $4,
+			attempts = $5,
+			expires_at = $6,
+			consumed_at = $7,
+			created_at = $8
+		WHERE id = $9
+	`
+
+	_, err := dao.execContext(ctx, query,
+		m.CustomerID,
+		m.Channel,
+		m.Target,
+		m.CodeHash,
+		m.Attempts,
+		m.ExpiresAt,
+		m.ConsumedAt,
+		m.CreatedAt,
+		m.ID,
+	)
+	return err
+}
+
+func (dao *VerificationCodeDAO) PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	setClauses := make([]string, 0, len(fields))
+	args := make([]interface{}, 0, len(fields)+1)
+	i := 1
+
+	for field, value := range fields {
+		setClauses = append(setClauses, fmt.Sprintf("%s = $%d", field, i))
+		args = append(args, value)
+		i++
+	}
+
+	args = append(args, pk)
+
+	query := fmt.Sprintf(`UPDATE verification_codes SET %s WHERE id = $%d`, strings.Join(setClauses, ", "), i)
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *VerificationCodeDAO) DeleteByPk(ctx context.Context, pk string) error {
+	query := `DELETE FROM verification_codes WHERE id = $1`
+	_, err := dao.execContext(ctx, query, pk)
+	return err
+}
+
+func (dao *VerificationCodeDAO) FindByPk(ctx context.Context, pk string) (*VerificationCode, error) {
+	query := `
+		SELECT id, customer_id, channel, target, code_hash, attempts, expires_at, consumed_at, created_at
+		FROM verification_codes
+		WHERE id = $1
+	`
+	row := dao.queryRowContext(ctx, query, pk)
+
+	var m VerificationCode
+	err := row.Scan(
+		&m.ID,
+		&m.CustomerID,
+		&m.Channel,
+		&m.Target,
+		&m.CodeHash,
+		&m.Attempts,
+		&m.ExpiresAt,
+		&m.ConsumedAt,
+		&m.CreatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *VerificationCodeDAO) CreateMany(ctx context.Context, models []*VerificationCode) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(models))
+	args := make([]interface{}, 0, len(models)*9)
+
+	for i, model := range models {
+		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			i*9+1, i*9+2, i*9+3, i*9+4, i*9+5, i*9+6, i*9+7, i*9+8, i*9+9)
+
+		args = append(args,
+			model.ID,
+			model.CustomerID,
+			model.Channel,
+			model.Target,
+			model.CodeHash,
+			model.Attempts,
+			model.ExpiresAt,
+			model.ConsumedAt,
+			model.CreatedAt,
+		)
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO verification_codes (id, customer_id, channel, target, code_hash, attempts, expires_at, consumed_at, created_at)
+		VALUES %s
+	`, strings.Join(placeholders, ", "))
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *VerificationCodeDAO) UpdateMany(ctx context.Context, models []*VerificationCode) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	query := `
+		UPDATE verification_codes
+		SET customer_id = $1,
+			channel = $2,
+			target = $3,
+			code_hash = $4,
+			attempts = $5,
+			expires_at = $6,
+			consumed_at = $7,
+			created_at = $8
+		WHERE id = $9
+	`
+
+	for _, model := range models {
+		_, err := dao.execContext(ctx, query,
+			model.CustomerID,
+			model.Channel,
+			model.Target,
+			model.CodeHash,
+			model.Attempts,
+			model.ExpiresAt,
+			model.ConsumedAt,
+			model.CreatedAt,
+			model.ID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (dao *VerificationCodeDAO) DeleteManyByPks(ctx context.Context, pks []string) error {
+	if len(pks) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(pks))
+	args := make([]interface{}, len(pks))
+	for i, pk := range pks {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = pk
+	}
+
+	query := fmt.Sprintf(`DELETE FROM verification_codes WHERE id IN (%s)`, strings.Join(placeholders, ","))
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *VerificationCodeDAO) FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*VerificationCode, error) {
+	query := `
+		SELECT id, customer_id, channel, target, code_hash, attempts, expires_at, consumed_at, created_at
+		FROM verification_codes
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var m VerificationCode
+	err := row.Scan(
+		&m.ID,
+		&m.CustomerID,
+		&m.Channel,
+		&m.Target,
+		&m.CodeHash,
+		&m.Attempts,
+		&m.ExpiresAt,
+		&m.ConsumedAt,
+		&m.CreatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *VerificationCodeDAO) FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*VerificationCode, error) {
+	query := `
+		SELECT id, customer_id, channel, target, code_hash, attempts, expires_at, consumed_at, created_at
+		FROM verification_codes
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*VerificationCode
+	for rows.Next() {
+		var m VerificationCode
+		err := rows.Scan(
+			&m.ID,
+			&m.CustomerID,
+			&m.Channel,
+			&m.Target,
+			&m.CodeHash,
+			&m.Attempts,
+			&m.ExpiresAt,
+			&m.ConsumedAt,
+			&m.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *VerificationCodeDAO) FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*VerificationCode, error) {
+	query := `
+		SELECT id, customer_id, channel, target, code_hash, attempts, expires_at, consumed_at, created_at
+		FROM verification_codes
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	query += fmt.Sprintf(" LIMIT %d OFFSET %d", limit, offset)
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*VerificationCode
+	for rows.Next() {
+		var m VerificationCode
+		err := rows.Scan(
+			&m.ID,
+			&m.CustomerID,
+			&m.Channel,
+			&m.Target,
+			&m.CodeHash,
+			&m.Attempts,
+			&m.ExpiresAt,
+			&m.ConsumedAt,
+			&m.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *VerificationCodeDAO) Count(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := "SELECT COUNT(*) FROM verification_codes"
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var count int64
+	err := row.Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (dao *VerificationCodeDAO) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := dao.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	ctxWithTx := context.WithValue(ctx, "currentTx", tx)
+
+	err = fn(ctxWithTx)
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,397 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"ichibuy/store/internal/domain"
+	"strings"
+)
+
+type WebhookDeliveryAttempt = domain.WebhookDeliveryAttempt
+
+type WebhookDeliveryAttemptDAO struct {
+	db *sql.DB
+}
+
+func NewWebhookDeliveryAttemptDAO(db *sql.DB) *WebhookDeliveryAttemptDAO {
+	return &WebhookDeliveryAttemptDAO{db: db}
+}
+
+func (dao *WebhookDeliveryAttemptDAO) getTx(ctx context.Context) *sql.Tx {
+	if tx, ok := ctx.Value("currentTx").(*sql.Tx); ok {
+		return tx
+	}
+	return nil
+}
+
+func (dao *WebhookDeliveryAttemptDAO) execContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.ExecContext(ctx, query, args...)
+	}
+	return dao.db.ExecContext(ctx, query, args...)
+}
+
+func (dao *WebhookDeliveryAttemptDAO) queryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryRowContext(ctx, query, args...)
+	}
+	return dao.db.QueryRowContext(ctx, query, args...)
+}
+
+func (dao *WebhookDeliveryAttemptDAO) queryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryContext(ctx, query, args...)
+	}
+	return dao.db.QueryContext(ctx, query, args...)
+}
+
+func (dao *WebhookDeliveryAttemptDAO) Create(ctx context.Context, m *WebhookDeliveryAttempt) error {
+	query := `
+		INSERT INTO webhook_delivery_attempts (id, delivery_id, status_code, response_body, error, duration_ms, attempted_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	_, err := dao.execContext(
+		ctx,
+		query,
+		m.ID,
+		m.DeliveryID,
+		m.StatusCode,
+		m.ResponseBody,
+		m.Error,
+		m.DurationMs,
+		m.AttemptedAt,
+	)
+
+	return err
+}
+
+func (dao *WebhookDeliveryAttemptDAO) Update(ctx context.Context, m *WebhookDeliveryAttempt) error {
+	query := `
+		UPDATE webhook_delivery_attempts
+		SET delivery_id = $1,
+			status_code = $2,
+			response_body = $3,
+			error = $4,
+			duration_ms = $5,
+			attempted_at = $6
+		WHERE id = $7
+	`
+
+	_, err := dao.execContext(ctx, query,
+		m.DeliveryID,
+		m.StatusCode,
+		m.ResponseBody,
+		m.Error,
+		m.DurationMs,
+		m.AttemptedAt,
+		m.ID,
+	)
+	return err
+}
+
+func (dao *WebhookDeliveryAttemptDAO) PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	setClauses := make([]string, 0, len(fields))
+	args := make([]interface{}, 0, len(fields)+1)
+	i := 1
+
+	for field, value := range fields {
+		setClauses = append(setClauses, fmt.Sprintf("%s = $%d", field, i))
+		args = append(args, value)
+		i++
+	}
+
+	args = append(args, pk)
+
+	query := fmt.Sprintf(`UPDATE webhook_delivery_attempts SET %s WHERE id = $%d`, strings.Join(setClauses, ", "), i)
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *WebhookDeliveryAttemptDAO) DeleteByPk(ctx context.Context, pk string) error {
+	query := `DELETE FROM webhook_delivery_attempts WHERE id = $1`
+	_, err := dao.execContext(ctx, query, pk)
+	return err
+}
+
+func (dao *WebhookDeliveryAttemptDAO) FindByPk(ctx context.Context, pk string) (*WebhookDeliveryAttempt, error) {
+	query := `
+		SELECT id, delivery_id, status_code, response_body, error, duration_ms, attempted_at
+		FROM webhook_delivery_attempts
+		WHERE id = $1
+	`
+	row := dao.queryRowContext(ctx, query, pk)
+
+	var m WebhookDeliveryAttempt
+	err := row.Scan(
+		&m.ID,
+		&m.DeliveryID,
+		&m.StatusCode,
+		&m.ResponseBody,
+		&m.Error,
+		&m.DurationMs,
+		&m.AttemptedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *WebhookDeliveryAttemptDAO) CreateMany(ctx context.Context, models []*WebhookDeliveryAttempt) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(models))
+	args := make([]interface{}, 0, len(models)*7)
+
+	for i, model := range models {
+		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			i*7+1, i*7+2, i*7+3, i*7+4, i*7+5, i*7+6, i*7+7)
+
+		args = append(args,
+			model.ID,
+			model.DeliveryID,
+			model.StatusCode,
+			model.ResponseBody,
+			model.Error,
+			model.DurationMs,
+			model.AttemptedAt,
+		)
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO webhook_delivery_attempts (id, delivery_id, status_code, response_body, error, duration_ms, attempted_at)
+		VALUES %s
+	`, strings.Join(placeholders, ", "))
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *WebhookDeliveryAttemptDAO) UpdateMany(ctx context.Context, models []*WebhookDeliveryAttempt) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	query := `
+		UPDATE webhook_delivery_attempts
+		SET delivery_id = $1,
+			status_code = $2,
+			response_body = $3,
+			error = $4,
+			duration_ms = $5,
+			attempted_at = $6
+		WHERE id = $7
+	`
+
+	for _, model := range models {
+		_, err := dao.execContext(ctx, query,
+			model.DeliveryID,
+			model.StatusCode,
+			model.ResponseBody,
+			model.Error,
+			model.DurationMs,
+			model.AttemptedAt,
+			model.ID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (dao *WebhookDeliveryAttemptDAO) DeleteManyByPks(ctx context.Context, pks []string) error {
+	if len(pks) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(pks))
+	args := make([]interface{}, len(pks))
+	for i, pk := range pks {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = pk
+	}
+
+	query := fmt.Sprintf(`DELETE FROM webhook_delivery_attempts WHERE id IN (%s)`, strings.Join(placeholders, ","))
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *WebhookDeliveryAttemptDAO) FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*WebhookDeliveryAttempt, error) {
+	query := `
+		SELECT id, delivery_id, status_code, response_body, error, duration_ms, attempted_at
+		FROM webhook_delivery_attempts
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var m WebhookDeliveryAttempt
+	err := row.Scan(
+		&m.ID,
+		&m.DeliveryID,
+		&m.StatusCode,
+		&m.ResponseBody,
+		&m.Error,
+		&m.DurationMs,
+		&m.AttemptedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *WebhookDeliveryAttemptDAO) FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*WebhookDeliveryAttempt, error) {
+	query := `
+		SELECT id, delivery_id, status_code, response_body, error, duration_ms, attempted_at
+		FROM webhook_delivery_attempts
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*WebhookDeliveryAttempt
+	for rows.Next() {
+		var m WebhookDeliveryAttempt
+		err := rows.Scan(
+			&m.ID,
+			&m.DeliveryID,
+			&m.StatusCode,
+			&m.ResponseBody,
+			&m.Error,
+			&m.DurationMs,
+			&m.AttemptedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *WebhookDeliveryAttemptDAO) FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*WebhookDeliveryAttempt, error) {
+	query := `
+		SELECT id, delivery_id, status_code, response_body, error, duration_ms, attempted_at
+		FROM webhook_delivery_attempts
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	query += fmt.Sprintf(" LIMIT %d OFFSET %d", limit, offset)
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*WebhookDeliveryAttempt
+	for rows.Next() {
+		var m WebhookDeliveryAttempt
+		err := rows.Scan(
+			&m.ID,
+			&m.DeliveryID,
+			&m.StatusCode,
+			&m.ResponseBody,
+			&m.Error,
+			&m.DurationMs,
+			&m.AttemptedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *WebhookDeliveryAttemptDAO) Count(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := "SELECT COUNT(*) FROM webhook_delivery_attempts"
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var count int64
+	err := row.Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (dao *WebhookDeliveryAttemptDAO) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := dao.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	ctxWithTx := context.WithValue(ctx, "currentTx", tx)
+
+	err = fn(ctxWithTx)
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
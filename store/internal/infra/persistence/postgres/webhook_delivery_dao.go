@@ -0,0 +1,467 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"ichibuy/store/internal/domain"
+	"strings"
+)
+
+type WebhookDelivery = domain.WebhookDelivery
+
+type WebhookDeliveryDAO struct {
+	db *sql.DB
+}
+
+func NewWebhookDeliveryDAO(db *sql.DB) *WebhookDeliveryDAO {
+	return &WebhookDeliveryDAO{db: db}
+}
+
+func (dao *WebhookDeliveryDAO) getTx(ctx context.Context) *sql.Tx {
+	if tx, ok := ctx.Value("currentTx").(*sql.Tx); ok {
+		return tx
+	}
+	return nil
+}
+
+func (dao *WebhookDeliveryDAO) execContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.ExecContext(ctx, query, args...)
+	}
+	return dao.db.ExecContext(ctx, query, args...)
+}
+
+func (dao *WebhookDeliveryDAO) queryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryRowContext(ctx, query, args...)
+	}
+	return dao.db.QueryRowContext(ctx, query, args...)
+}
+
+func (dao *WebhookDeliveryDAO) queryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	if tx := dao.getTx(ctx); tx != nil {
+		return tx.QueryContext(ctx, query, args...)
+	}
+	return dao.db.QueryContext(ctx, query, args...)
+}
+
+func (dao *WebhookDeliveryDAO) Create(ctx context.Context, m *WebhookDelivery) error {
+	query := `
+		INSERT INTO webhook_deliveries (id, subscription_id, store_id, event_id, event_type, payload, status, attempts, next_attempt_at, last_status_code, last_error, delivered_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+	`
+
+	_, err := dao.execContext(
+		ctx,
+		query,
+		m.ID,
+		m.SubscriptionID,
+		m.StoreID,
+		m.EventID,
+		m.EventType,
+		m.Payload,
+		m.Status,
+		m.Attempts,
+		m.NextAttemptAt,
+		m.LastStatusCode,
+		m.LastError,
+		m.DeliveredAt,
+		m.CreatedAt,
+		m.UpdatedAt,
+	)
+
+	return err
+}
+
+func (dao *WebhookDeliveryDAO) Update(ctx context.Context, m *WebhookDelivery) error {
+	query := `
+		UPDATE webhook_deliveries
+		SET subscription_id = $1,
+			store_id = $2,
+			event_id = $3,
+			event_type = $4,
+			payload = $5,
+			status = $6,
+			attempts = $7,
+			next_attempt_at = $8,
+			last_status_code = $9,
+			last_error = $10,
+			delivered_at = $11,
+			created_at = $12,
+			updated_at = $13
+		WHERE id = $14
+	`
+
+	_, err := dao.execContext(ctx, query,
+		m.SubscriptionID,
+		m.StoreID,
+		m.EventID,
+		m.EventType,
+		m.Payload,
+		m.Status,
+		m.Attempts,
+		m.NextAttemptAt,
+		m.LastStatusCode,
+		m.LastError,
+		m.DeliveredAt,
+		m.CreatedAt,
+		m.UpdatedAt,
+		m.ID,
+	)
+	return err
+}
+
+func (dao *WebhookDeliveryDAO) PartialUpdate(ctx context.Context, pk string, fields map[string]interface{}) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	setClauses := make([]string, 0, len(fields))
+	args := make([]interface{}, 0, len(fields)+1)
+	i := 1
+
+	for field, value := range fields {
+		setClauses = append(setClauses, fmt.Sprintf("%s = $%d", field, i))
+		args = append(args, value)
+		i++
+	}
+
+	args = append(args, pk)
+
+	query := fmt.Sprintf(`UPDATE webhook_deliveries SET %s WHERE id = $%d`, strings.Join(setClauses, ", "), i)
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *WebhookDeliveryDAO) DeleteByPk(ctx context.Context, pk string) error {
+	query := `DELETE FROM webhook_deliveries WHERE id = $1`
+	_, err := dao.execContext(ctx, query, pk)
+	return err
+}
+
+func (dao *WebhookDeliveryDAO) FindByPk(ctx context.Context, pk string) (*WebhookDelivery, error) {
+	query := `
+		SELECT id, subscription_id, store_id, event_id, event_type, payload, status, attempts, next_attempt_at, last_status_code, last_error, delivered_at, created_at, updated_at
+		FROM webhook_deliveries
+		WHERE id = $1
+	`
+	row := dao.queryRowContext(ctx, query, pk)
+
+	var m WebhookDelivery
+	err := row.Scan(
+		&m.ID,
+		&m.SubscriptionID,
+		&m.StoreID,
+		&m.EventID,
+		&m.EventType,
+		&m.Payload,
+		&m.Status,
+		&m.Attempts,
+		&m.NextAttemptAt,
+		&m.LastStatusCode,
+		&m.LastError,
+		&m.DeliveredAt,
+		&m.CreatedAt,
+		&m.UpdatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *WebhookDeliveryDAO) CreateMany(ctx context.Context, models []*WebhookDelivery) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(models))
+	args := make([]interface{}, 0, len(models)*14)
+
+	for i, model := range models {
+		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			i*14+1, i*14+2, i*14+3, i*14+4, i*14+5, i*14+6, i*14+7, i*14+8, i*14+9, i*14+10, i*14+11, i*14+12, i*14+13, i*14+14)
+
+		args = append(args,
+			model.ID,
+			model.SubscriptionID,
+			model.StoreID,
+			model.EventID,
+			model.EventType,
+			model.Payload,
+			model.Status,
+			model.Attempts,
+			model.NextAttemptAt,
+			model.LastStatusCode,
+			model.LastError,
+			model.DeliveredAt,
+			model.CreatedAt,
+			model.UpdatedAt,
+		)
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO webhook_deliveries (id, subscription_id, store_id, event_id, event_type, payload, status, attempts, next_attempt_at, last_status_code, last_error, delivered_at, created_at, updated_at)
+		VALUES %s
+	`, strings.Join(placeholders, ", "))
+
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *WebhookDeliveryDAO) UpdateMany(ctx context.Context, models []*WebhookDelivery) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	query := `
+		UPDATE webhook_deliveries
+		SET subscription_id = $1,
+			store_id = $2,
+			event_id = $3,
+			event_type = $4,
+			payload = $5,
+			status = $6,
+			attempts = $7,
+			next_attempt_at = $8,
+			last_status_code = $9,
+			last_error = $10,
+			delivered_at = $11,
+			created_at = $12,
+			updated_at = $13
+		WHERE id = $14
+	`
+
+	for _, model := range models {
+		_, err := dao.execContext(ctx, query,
+			model.SubscriptionID,
+			model.StoreID,
+			model.EventID,
+			model.EventType,
+			model.Payload,
+			model.Status,
+			model.Attempts,
+			model.NextAttemptAt,
+			model.LastStatusCode,
+			model.LastError,
+			model.DeliveredAt,
+			model.CreatedAt,
+			model.UpdatedAt,
+			model.ID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (dao *WebhookDeliveryDAO) DeleteManyByPks(ctx context.Context, pks []string) error {
+	if len(pks) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(pks))
+	args := make([]interface{}, len(pks))
+	for i, pk := range pks {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = pk
+	}
+
+	query := fmt.Sprintf(`DELETE FROM webhook_deliveries WHERE id IN (%s)`, strings.Join(placeholders, ","))
+	_, err := dao.execContext(ctx, query, args...)
+	return err
+}
+
+func (dao *WebhookDeliveryDAO) FindOne(ctx context.Context, where string, sort string, args ...interface{}) (*WebhookDelivery, error) {
+	query := `
+		SELECT id, subscription_id, store_id, event_id, event_type, payload, status, attempts, next_attempt_at, last_status_code, last_error, delivered_at, created_at, updated_at
+		FROM webhook_deliveries
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var m WebhookDelivery
+	err := row.Scan(
+		&m.ID,
+		&m.SubscriptionID,
+		&m.StoreID,
+		&m.EventID,
+		&m.EventType,
+		&m.Payload,
+		&m.Status,
+		&m.Attempts,
+		&m.NextAttemptAt,
+		&m.LastStatusCode,
+		&m.LastError,
+		&m.DeliveredAt,
+		&m.CreatedAt,
+		&m.UpdatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (dao *WebhookDeliveryDAO) FindAll(ctx context.Context, where string, sort string, args ...interface{}) ([]*WebhookDelivery, error) {
+	query := `
+		SELECT id, subscription_id, store_id, event_id, event_type, payload, status, attempts, next_attempt_at, last_status_code, last_error, delivered_at, created_at, updated_at
+		FROM webhook_deliveries
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*WebhookDelivery
+	for rows.Next() {
+		var m WebhookDelivery
+		err := rows.Scan(
+			&m.ID,
+			&m.SubscriptionID,
+			&m.StoreID,
+			&m.EventID,
+			&m.EventType,
+			&m.Payload,
+			&m.Status,
+			&m.Attempts,
+			&m.NextAttemptAt,
+			&m.LastStatusCode,
+			&m.LastError,
+			&m.DeliveredAt,
+			&m.CreatedAt,
+			&m.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *WebhookDeliveryDAO) FindPaginated(ctx context.Context, limit, offset int, where string, sort string, args ...interface{}) ([]*WebhookDelivery, error) {
+	query := `
+		SELECT id, subscription_id, store_id, event_id, event_type, payload, status, attempts, next_attempt_at, last_status_code, last_error, delivered_at, created_at, updated_at
+		FROM webhook_deliveries
+	`
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	if sort != "" {
+		query += " ORDER BY " + sort
+	}
+
+	query += fmt.Sprintf(" LIMIT %d OFFSET %d", limit, offset)
+
+	rows, err := dao.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var models []*WebhookDelivery
+	for rows.Next() {
+		var m WebhookDelivery
+		err := rows.Scan(
+			&m.ID,
+			&m.SubscriptionID,
+			&m.StoreID,
+			&m.EventID,
+			&m.EventType,
+			&m.Payload,
+			&m.Status,
+			&m.Attempts,
+			&m.NextAttemptAt,
+			&m.LastStatusCode,
+			&m.LastError,
+			&m.DeliveredAt,
+			&m.CreatedAt,
+			&m.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func (dao *WebhookDeliveryDAO) Count(ctx context.Context, where string, args ...interface{}) (int64, error) {
+	query := "SELECT COUNT(*) FROM webhook_deliveries"
+
+	if where != "" {
+		query += " WHERE " + where
+	}
+
+	row := dao.queryRowContext(ctx, query, args...)
+
+	var count int64
+	err := row.Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (dao *WebhookDeliveryDAO) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := dao.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	ctxWithTx := context.WithValue(ctx, "currentTx", tx)
+
+	err = fn(ctxWithTx)
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
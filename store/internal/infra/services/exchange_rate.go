@@ -0,0 +1,87 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"ichibuy/store/internal/domain"
+)
+
+// exchangeRatesFile is the layout of the rates file, e.g. {"base": "USD", "rates": {"PEN": "3.75", "EUR": "0.92"}}
+type exchangeRatesFile struct {
+	Base  string            `json:"base"`
+	Rates map[string]string `json:"rates"`
+}
+
+type fileExchangeRateProvider struct {
+	base  string
+	rates map[string]domain.ExchangeRate
+}
+
+// NewFileExchangeRateProvider loads the rates from a json file quoted against a single base currency.
+// An empty path gives a provider that only converts a currency into itself.
+func NewFileExchangeRateProvider(path string) (domain.ExchangeRateProvider, error) {
+	provider := &fileExchangeRateProvider{rates: map[string]domain.ExchangeRate{}}
+	if path == "" {
+		return provider, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file exchangeRatesFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("invalid exchange rates file: %w", err)
+	}
+
+	provider.base = strings.ToUpper(file.Base)
+	for code, value := range file.Rates {
+		rate, err := domain.NewExchangeRate(provider.base, code, value)
+		if err != nil {
+			return nil, err
+		}
+		provider.rates[rate.To] = rate
+	}
+
+	return provider, nil
+}
+
+func (p *fileExchangeRateProvider) GetRate(ctx context.Context, from, to string) (domain.ExchangeRate, error) {
+	from, to = strings.ToUpper(from), strings.ToUpper(to)
+	if from == to {
+		return domain.NewExchangeRate(from, to, "1")
+	}
+
+	fromBase, err := p.baseRate(from)
+	if err != nil {
+		return domain.ExchangeRate{}, err
+	}
+
+	toBase, err := p.baseRate(to)
+	if err != nil {
+		return domain.ExchangeRate{}, err
+	}
+
+	// from -> base -> to
+	rate := new(big.Rat).Mul(fromBase.Inverse().Rate, toBase.Rate)
+	return domain.ExchangeRate{From: from, To: to, Rate: rate}, nil
+}
+
+// baseRate returns the rate from the base currency into code
+func (p *fileExchangeRateProvider) baseRate(code string) (domain.ExchangeRate, error) {
+	if code == p.base {
+		return domain.NewExchangeRate(code, code, "1")
+	}
+
+	rate, ok := p.rates[code]
+	if !ok {
+		return domain.ExchangeRate{}, fmt.Errorf("exchange rate for %s not available", code)
+	}
+	return rate, nil
+}
@@ -0,0 +1,85 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"ichibuy/store/internal/domain"
+)
+
+const maxFetchedFileSize = 10 << 20 // 10MB
+
+type fileFetcher struct {
+	client *http.Client
+}
+
+// NewFileFetcher downloads the files of the URLs given by the merchants, the client must be a public HTTP
+// client so the URLs cannot reach the internal addresses
+func NewFileFetcher(client *http.Client) domain.FileFetcher {
+	return &fileFetcher{client: client}
+}
+
+func (f *fileFetcher) FetchFile(ctx context.Context, rawURL string) (*domain.UploadFileRequest, error) {
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") {
+		return nil, fmt.Errorf("invalid image url: %s", rawURL)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, parsedURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch %s failed with status %d", rawURL, resp.StatusCode)
+	}
+
+	// the declared type and size are checked before reading the body, the read is capped anyway
+	contentType := resp.Header.Get("Content-Type")
+	if contentType != "" && !strings.HasPrefix(contentType, "image/") {
+		return nil, fmt.Errorf("%s is not an image", rawURL)
+	}
+
+	if resp.ContentLength > maxFetchedFileSize {
+		return nil, fmt.Errorf("image %s exceeds %d bytes", rawURL, maxFetchedFileSize)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxFetchedFileSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) > maxFetchedFileSize {
+		return nil, fmt.Errorf("image %s exceeds %d bytes", rawURL, maxFetchedFileSize)
+	}
+
+	detected := http.DetectContentType(data)
+	if !strings.HasPrefix(detected, "image/") {
+		return nil, fmt.Errorf("%s is not an image", rawURL)
+	}
+	if contentType == "" {
+		contentType = detected
+	}
+
+	fileName := path.Base(parsedURL.Path)
+	if fileName == "" || fileName == "." || fileName == "/" {
+		fileName = "image"
+	}
+
+	return &domain.UploadFileRequest{
+		FileName:    fileName,
+		ContentType: contentType,
+		Data:        data,
+	}, nil
+}
@@ -0,0 +1,62 @@
+package services_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"ichibuy/store/internal/infra/services"
+)
+
+// pngHeader is enough of a PNG file to be detected as an image
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func Test_fileFetcher_FetchFile(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/image.png":
+			w.Header().Set("Content-Type", "image/png")
+			_, _ = w.Write(pngHeader)
+		case "/page.html":
+			w.Header().Set("Content-Type", "text/html")
+			_, _ = w.Write([]byte("<html></html>"))
+		case "/fake.png":
+			w.Header().Set("Content-Type", "image/png")
+			_, _ = w.Write([]byte("<html></html>"))
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name      string
+		client    *http.Client
+		path      string
+		expectErr bool
+	}{
+		{"image", server.Client(), "/image.png", false},
+		{"declared type is not an image", server.Client(), "/page.html", true},
+		{"content is not an image", server.Client(), "/fake.png", true},
+		{"loopback address refused by the public client", services.NewPublicHTTPClient(time.Second), "/image.png", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := services.NewFileFetcher(tt.client).FetchFile(context.Background(), server.URL+tt.path)
+			if tt.expectErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if file.FileName != "image.png" || file.ContentType != "image/png" {
+				t.Fatalf("unexpected file %s of type %s", file.FileName, file.ContentType)
+			}
+		})
+	}
+}
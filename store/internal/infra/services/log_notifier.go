@@ -0,0 +1,51 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"ichibuy/store/internal/domain"
+)
+
+// logNotifier is the local notifier: it logs the notifications and, when a file is configured, appends
+// them to it as JSON lines, so codes can be read in development and tests
+type logNotifier struct {
+	filePath string
+	mu       sync.Mutex
+}
+
+func NewLogNotifier(filePath string) domain.Notifier {
+	return &logNotifier{filePath: filePath}
+}
+
+func (n *logNotifier) Notify(ctx context.Context, notification domain.Notification) error {
+	slog.InfoContext(ctx, "notification sent", "channel", notification.Channel, "to", notification.To, "subject", notification.Subject, "body", notification.Body)
+
+	if n.filePath == "" {
+		return nil
+	}
+
+	line, err := json.Marshal(struct {
+		domain.Notification
+		SentAt time.Time `json:"sent_at"`
+	}{Notification: notification, SentAt: time.Now().UTC()})
+	if err != nil {
+		return err
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	file, err := os.OpenFile(n.filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = file.Write(append(line, '\n'))
+	return err
+}
@@ -0,0 +1,53 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"ichibuy/store/internal/domain"
+	sharedCtx "ichibuy/store/internal/shared/context"
+)
+
+type orderService struct {
+	client  *http.Client
+	baseURL string
+}
+
+func NewOrderService(client *http.Client, baseURL string) domain.OrderService {
+	return &orderService{client: client, baseURL: baseURL}
+}
+
+func (s *orderService) CountOpenOrders(ctx context.Context, storeID string) (int64, error) {
+	endpoint := fmt.Sprintf("%s/api/v1/stores/%s/orders/open-count", s.baseURL, url.PathEscape(storeID))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	if token, ok := ctx.Value(sharedCtx.APITokenKey).(string); ok && token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("count open orders failed with status %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Count int64 `json:"count"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return 0, err
+	}
+
+	return body.Count, nil
+}
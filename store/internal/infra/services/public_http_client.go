@@ -0,0 +1,51 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"ichibuy/store/internal/domain"
+)
+
+var errRedirectRefused = errors.New("redirects are not followed")
+
+// NewPublicHTTPClient requests the URLs given by the merchants. It only dials public addresses, checked after
+// the host is resolved, and does not follow redirects.
+func NewPublicHTTPClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 10 * time.Second,
+		Control: dialPublicOnly,
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: 10 * time.Second,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return errRedirectRefused
+		},
+	}
+}
+
+// dialPublicOnly refuses the connections to the internal addresses, it runs on the resolved address so a host
+// resolving to another address than when it was given is still checked
+func dialPublicOnly(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || !domain.IsPublicIP(ip) {
+		return fmt.Errorf("address %s is not public", host)
+	}
+	return nil
+}
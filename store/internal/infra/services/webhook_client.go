@@ -0,0 +1,43 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"ichibuy/store/internal/domain"
+)
+
+type webhookClient struct {
+	client *http.Client
+}
+
+// NewWebhookClient posts the webhooks to the merchant endpoints with a client that only dials public addresses
+func NewWebhookClient(timeout time.Duration) domain.WebhookClient {
+	return &webhookClient{client: NewPublicHTTPClient(timeout)}
+}
+
+func (c *webhookClient) Post(ctx context.Context, webhookReq domain.WebhookRequest) (*domain.WebhookResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookReq.URL, bytes.NewReader(webhookReq.Body))
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range webhookReq.Headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, domain.MaxWebhookResponseLength))
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.WebhookResponse{StatusCode: resp.StatusCode, Body: string(body)}, nil
+}
@@ -0,0 +1,279 @@
+package services
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"ichibuy/store/internal/domain"
+)
+
+// Catalog files share the same layout for import and export, so an export can be imported back.
+//
+// CSV columns: name, description, active, prices, image_urls
+//   - prices: "1500 PEN|400 USD" (amount in minor units followed by the currency)
+//   - image_urls: "https://a.com/1.png|https://a.com/2.png"
+//
+// JSONL: one catalogRecord per line.
+
+var catalogCSVHeader = []string{"name", "description", "active", "prices", "image_urls"}
+
+const catalogListSeparator = "|"
+
+type CatalogRow struct {
+	Line        int
+	Name        string
+	Description *string
+	Active      bool
+	Prices      []NewPriceDTO
+	ImageURLs   []string
+}
+
+type catalogRecord struct {
+	Name        string        `json:"name"`
+	Description *string       `json:"description"`
+	Active      *bool         `json:"active"`
+	Prices      []NewPriceDTO `json:"prices"`
+	ImageURLs   []string      `json:"image_urls"`
+}
+
+// parseCatalogFile returns the well-formed rows of a catalog file and the errors of the malformed ones.
+// An error is returned only when the file itself cannot be read.
+func parseCatalogFile(format domain.ImportFormat, payload string) ([]CatalogRow, []domain.ImportRowError, error) {
+	switch format {
+	case domain.CSVImportFormat:
+		return parseCatalogCSV(payload)
+	case domain.JSONLImportFormat:
+		return parseCatalogJSONL(payload)
+	default:
+		return nil, nil, fmt.Errorf("invalid import format")
+	}
+}
+
+func parseCatalogCSV(payload string) ([]CatalogRow, []domain.ImportRowError, error) {
+	reader := csv.NewReader(strings.NewReader(payload))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid csv header: %w", err)
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	for _, required := range []string{"name", "prices", "image_urls"} {
+		if _, ok := columns[required]; !ok {
+			return nil, nil, fmt.Errorf("csv header must contain %s column", required)
+		}
+	}
+
+	rows := []CatalogRow{}
+	rowErrors := []domain.ImportRowError{}
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		line, _ := reader.FieldPos(0)
+		if err != nil {
+			rowErrors = append(rowErrors, domain.ImportRowError{Row: line, Error: err.Error()})
+			continue
+		}
+
+		cell := func(name string) string {
+			i, ok := columns[name]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		row := CatalogRow{
+			Line:      line,
+			Name:      cell("name"),
+			Active:    true,
+			ImageURLs: splitCatalogList(cell("image_urls")),
+		}
+
+		if description := cell("description"); description != "" {
+			row.Description = &description
+		}
+
+		if active := cell("active"); active != "" {
+			value, err := strconv.ParseBool(active)
+			if err != nil {
+				rowErrors = append(rowErrors, domain.ImportRowError{Row: line, Error: "invalid active value: " + active})
+				continue
+			}
+			row.Active = value
+		}
+
+		prices, err := parseCatalogPrices(cell("prices"))
+		if err != nil {
+			rowErrors = append(rowErrors, domain.ImportRowError{Row: line, Error: err.Error()})
+			continue
+		}
+		row.Prices = prices
+
+		rows = append(rows, row)
+	}
+
+	return rows, rowErrors, nil
+}
+
+func parseCatalogJSONL(payload string) ([]CatalogRow, []domain.ImportRowError, error) {
+	scanner := bufio.NewScanner(strings.NewReader(payload))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1<<20)
+
+	rows := []CatalogRow{}
+	rowErrors := []domain.ImportRowError{}
+
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+
+		var record catalogRecord
+		if err := json.Unmarshal([]byte(text), &record); err != nil {
+			rowErrors = append(rowErrors, domain.ImportRowError{Row: line, Error: "invalid json: " + err.Error()})
+			continue
+		}
+
+		active := true
+		if record.Active != nil {
+			active = *record.Active
+		}
+
+		rows = append(rows, CatalogRow{
+			Line:        line,
+			Name:        strings.TrimSpace(record.Name),
+			Description: record.Description,
+			Active:      active,
+			Prices:      record.Prices,
+			ImageURLs:   record.ImageURLs,
+		})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("invalid jsonl file: %w", err)
+	}
+
+	return rows, rowErrors, nil
+}
+
+func parseCatalogPrices(value string) ([]NewPriceDTO, error) {
+	prices := []NewPriceDTO{}
+	for _, item := range splitCatalogList(value) {
+		parts := strings.Fields(item)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid price %q, should be amount followed by currency", item)
+		}
+
+		amount, err := strconv.Atoi(parts[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid price amount %q", parts[0])
+		}
+
+		prices = append(prices, NewPriceDTO{Amount: amount, Currency: strings.ToUpper(parts[1])})
+	}
+	return prices, nil
+}
+
+func splitCatalogList(value string) []string {
+	items := []string{}
+	for _, item := range strings.Split(value, catalogListSeparator) {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// catalogWriter writes products using the catalog file layout
+type catalogWriter struct {
+	format domain.ImportFormat
+	csv    *csv.Writer
+	json   *json.Encoder
+}
+
+func newCatalogWriter(format domain.ImportFormat, w io.Writer) (*catalogWriter, error) {
+	switch format {
+	case domain.CSVImportFormat:
+		cw := &catalogWriter{format: format, csv: csv.NewWriter(w)}
+		if err := cw.csv.Write(catalogCSVHeader); err != nil {
+			return nil, err
+		}
+		return cw, nil
+	case domain.JSONLImportFormat:
+		return &catalogWriter{format: format, json: json.NewEncoder(w)}, nil
+	default:
+		return nil, fmt.Errorf("invalid export format")
+	}
+}
+
+func (w *catalogWriter) Write(product *domain.Product) error {
+	prices := convertDomainPricesToDTOs(product.GetPrices())
+	images := convertDomainImagesToDTOs(product.GetImages())
+
+	if w.format == domain.JSONLImportFormat {
+		record := catalogRecord{
+			Name:        product.GetName(),
+			Description: product.GetDescription(),
+			Prices:      make([]NewPriceDTO, len(prices)),
+			ImageURLs:   make([]string, len(images)),
+		}
+		active := product.GetActive()
+		record.Active = &active
+		for i, price := range prices {
+			record.Prices[i] = NewPriceDTO{Amount: price.Amount, Currency: price.Currency}
+		}
+		for i, image := range images {
+			record.ImageURLs[i] = image.URL
+		}
+		return w.json.Encode(record)
+	}
+
+	priceCells := make([]string, len(prices))
+	for i, price := range prices {
+		priceCells[i] = fmt.Sprintf("%d %s", price.Amount, price.Currency)
+	}
+
+	imageCells := make([]string, len(images))
+	for i, image := range images {
+		imageCells[i] = image.URL
+	}
+
+	description := ""
+	if product.GetDescription() != nil {
+		description = *product.GetDescription()
+	}
+
+	return w.csv.Write([]string{
+		product.GetName(),
+		description,
+		strconv.FormatBool(product.GetActive()),
+		strings.Join(priceCells, catalogListSeparator),
+		strings.Join(imageCells, catalogListSeparator),
+	})
+}
+
+func (w *catalogWriter) Flush() error {
+	if w.csv != nil {
+		w.csv.Flush()
+		return w.csv.Error()
+	}
+	return nil
+}
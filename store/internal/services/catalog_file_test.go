@@ -0,0 +1,109 @@
+package services
+
+import (
+	"reflect"
+	"testing"
+
+	"ichibuy/store/internal/domain"
+)
+
+func TestParseCatalogFile(t *testing.T) {
+	description := "Arabica beans"
+
+	tests := []struct {
+		name           string
+		format         domain.ImportFormat
+		payload        string
+		expectedRows   []CatalogRow
+		expectedErrors []int
+		expectErr      bool
+	}{
+		{
+			name:   "csv rows",
+			format: domain.CSVImportFormat,
+			payload: "name,description,active,prices,image_urls\n" +
+				"Coffee,Arabica beans,true,1500 pen|400 USD,https://a.com/1.png|https://a.com/2.png\n" +
+				"Tea,,false,900 PEN,https://a.com/3.png\n",
+			expectedRows: []CatalogRow{
+				{Line: 2, Name: "Coffee", Description: &description, Active: true, Prices: []NewPriceDTO{{Amount: 1500, Currency: "PEN"}, {Amount: 400, Currency: "USD"}}, ImageURLs: []string{"https://a.com/1.png", "https://a.com/2.png"}},
+				{Line: 3, Name: "Tea", Active: false, Prices: []NewPriceDTO{{Amount: 900, Currency: "PEN"}}, ImageURLs: []string{"https://a.com/3.png"}},
+			},
+		},
+		{
+			name:   "csv without active column defaults to active",
+			format: domain.CSVImportFormat,
+			payload: "name,prices,image_urls\n" +
+				"Coffee,1500 PEN,https://a.com/1.png\n",
+			expectedRows: []CatalogRow{
+				{Line: 2, Name: "Coffee", Active: true, Prices: []NewPriceDTO{{Amount: 1500, Currency: "PEN"}}, ImageURLs: []string{"https://a.com/1.png"}},
+			},
+		},
+		{
+			name:   "csv malformed rows are reported by line",
+			format: domain.CSVImportFormat,
+			payload: "name,active,prices,image_urls\n" +
+				"Coffee,maybe,1500 PEN,https://a.com/1.png\n" +
+				"Tea,true,PEN,https://a.com/2.png\n" +
+				"Cake,true,abc PEN,https://a.com/3.png\n",
+			expectedRows:   []CatalogRow{},
+			expectedErrors: []int{2, 3, 4},
+		},
+		{
+			name:      "csv without required column",
+			format:    domain.CSVImportFormat,
+			payload:   "name,prices\nCoffee,1500 PEN\n",
+			expectErr: true,
+		},
+		{
+			name:   "jsonl rows",
+			format: domain.JSONLImportFormat,
+			payload: `{"name":" Coffee ","description":"Arabica beans","prices":[{"amount":1500,"currency":"PEN"}],"image_urls":["https://a.com/1.png"]}` + "\n" +
+				"\n" +
+				`{"name":"Tea","active":false,"prices":[{"amount":900,"currency":"PEN"}],"image_urls":["https://a.com/3.png"]}` + "\n",
+			expectedRows: []CatalogRow{
+				{Line: 1, Name: "Coffee", Description: &description, Active: true, Prices: []NewPriceDTO{{Amount: 1500, Currency: "PEN"}}, ImageURLs: []string{"https://a.com/1.png"}},
+				{Line: 3, Name: "Tea", Active: false, Prices: []NewPriceDTO{{Amount: 900, Currency: "PEN"}}, ImageURLs: []string{"https://a.com/3.png"}},
+			},
+		},
+		{
+			name:           "jsonl invalid line",
+			format:         domain.JSONLImportFormat,
+			payload:        "{\"name\":\n",
+			expectedRows:   []CatalogRow{},
+			expectedErrors: []int{1},
+		},
+		{
+			name:      "unknown format",
+			format:    domain.ImportFormat("xml"),
+			payload:   "<catalog/>",
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, rowErrors, err := parseCatalogFile(tt.format, tt.payload)
+			if tt.expectErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(rows, tt.expectedRows) {
+				t.Fatalf("expected rows %+v, got %+v", tt.expectedRows, rows)
+			}
+
+			lines := []int{}
+			for _, rowError := range rowErrors {
+				lines = append(lines, rowError.Row)
+			}
+			if len(lines) != len(tt.expectedErrors) || (len(lines) > 0 && !reflect.DeepEqual(lines, tt.expectedErrors)) {
+				t.Fatalf("expected errors at lines %v, got %v", tt.expectedErrors, rowErrors)
+			}
+		})
+	}
+}
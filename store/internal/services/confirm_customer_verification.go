@@ -0,0 +1,110 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"ichibuy/store/internal/domain"
+	"ichibuy/store/internal/domain/dao"
+)
+
+type ConfirmCustomerVerificationReq struct {
+	CustomerID string
+	Channel    string
+	Code       string
+	UserID     string
+}
+
+// ConfirmCustomerVerification checks the last code sent to the customer and marks the email or phone as
+// verified when it matches
+type ConfirmCustomerVerification struct {
+	customerDAO         dao.CustomerDAO
+	customerAddressDAO  dao.CustomerAddressDAO
+	verificationCodeDAO dao.VerificationCodeDAO
+	eventBus            domain.EventBus
+}
+
+func NewConfirmCustomerVerification(
+	customerDAO dao.CustomerDAO,
+	customerAddressDAO dao.CustomerAddressDAO,
+	verificationCodeDAO dao.VerificationCodeDAO,
+	eventBus domain.EventBus,
+) *ConfirmCustomerVerification {
+	return &ConfirmCustomerVerification{
+		customerDAO:         customerDAO,
+		customerAddressDAO:  customerAddressDAO,
+		verificationCodeDAO: verificationCodeDAO,
+		eventBus:            eventBus,
+	}
+}
+
+func (s *ConfirmCustomerVerification) Exec(ctx context.Context, req ConfirmCustomerVerificationReq) error {
+	slog.InfoContext(ctx, "confirm customer verification started", "customer_id", req.CustomerID, "channel", req.Channel)
+
+	channel, err := domain.ParseVerificationChannel(req.Channel)
+	if err != nil {
+		return err
+	}
+
+	customer, err := findCustomerWithAddresses(ctx, s.customerDAO, s.customerAddressDAO, req.CustomerID)
+	if err != nil {
+		return err
+	}
+
+	if err := customer.CheckOwner(req.UserID); err != nil {
+		return err
+	}
+
+	// the code is locked until the transaction ends, so concurrent attempts are counted one after the other
+	var verifyErr error
+	err = s.customerDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		verification, err := s.verificationCodeDAO.FindOne(ctx, "customer_id = $1 AND channel = $2 AND consumed_at IS NULL", "created_at DESC LIMIT 1 FOR UPDATE", customer.GetID(), channel)
+		if errors.Is(err, sql.ErrNoRows) {
+			verifyErr = domain.ErrVerificationCodeExpired
+			return nil
+		}
+		if err != nil {
+			slog.ErrorContext(ctx, "find verification code failed", "error", err.Error())
+			return err
+		}
+
+		if err := verification.Verify(req.Code, time.Now().UTC()); err != nil {
+			slog.WarnContext(ctx, "verify code failed", "verification_id", verification.GetID(), "error", err.Error())
+			verifyErr = err
+
+			// failed attempts are kept, so the code is locked after too many of them
+			if errors.Is(err, domain.ErrVerificationCodeInvalid) {
+				return s.verificationCodeDAO.Update(ctx, verification)
+			}
+			return nil
+		}
+
+		if err := customer.MarkVerified(channel, verification.GetTarget()); err != nil {
+			slog.ErrorContext(ctx, "mark customer verified failed", "error", err.Error())
+			return err
+		}
+
+		if err := s.verificationCodeDAO.Update(ctx, verification); err != nil {
+			return err
+		}
+
+		if err := s.customerDAO.Update(ctx, customer); err != nil {
+			return err
+		}
+
+		return s.eventBus.Publish(ctx, customer.PullEvents()...)
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "save customer verification failed", "error", err.Error())
+		return err
+	}
+	if verifyErr != nil {
+		return verifyErr
+	}
+
+	slog.InfoContext(ctx, "confirm customer verification finished", "customer_id", customer.GetID(), "channel", channel)
+	return nil
+}
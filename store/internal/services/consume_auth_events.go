@@ -0,0 +1,84 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"ichibuy/store/internal/domain"
+	"ichibuy/store/internal/domain/dao"
+)
+
+const authEventsConsumer = "store.auth_events"
+
+// ConsumeAuthEvents provisions a customer for every user created in the auth service. Each event is
+// handled in a transaction with its checkpoint, and users with a customer are skipped, so events read
+// twice do not create customers twice.
+type ConsumeAuthEvents struct {
+	eventCheckpointDAO dao.EventCheckpointDAO
+	customerDAO        dao.CustomerDAO
+	eventBus           domain.EventBus
+	nextID             domain.NextID
+	authEventsSvc      domain.EventsService
+}
+
+func NewConsumeAuthEvents(
+	eventCheckpointDAO dao.EventCheckpointDAO,
+	customerDAO dao.CustomerDAO,
+	eventBus domain.EventBus,
+	nextID domain.NextID,
+	authEventsSvc domain.EventsService,
+) *ConsumeAuthEvents {
+	return &ConsumeAuthEvents{
+		eventCheckpointDAO: eventCheckpointDAO,
+		customerDAO:        customerDAO,
+		eventBus:           eventBus,
+		nextID:             nextID,
+		authEventsSvc:      authEventsSvc,
+	}
+}
+
+func (s *ConsumeAuthEvents) Exec(ctx context.Context) error {
+	handled, err := consumeEvents(ctx, s.eventCheckpointDAO, s.authEventsSvc.FindEventsAfter, authEventsConsumer, []domain.EventType{domain.UserCreated}, s.handle)
+	if handled > 0 {
+		slog.InfoContext(ctx, "consume auth events finished", "handled", handled)
+	}
+	return err
+}
+
+func (s *ConsumeAuthEvents) handle(ctx context.Context, event domain.Event) error {
+	if event.Type != domain.UserCreated {
+		return nil
+	}
+
+	if event.SchemaVersion > 1 {
+		return fmt.Errorf("event %s has unsupported schema version %d", event.Type, event.SchemaVersion)
+	}
+
+	var user domain.UserEventData
+	if err := json.Unmarshal(event.Data, &user); err != nil {
+		return err
+	}
+
+	err := checkUserHasNoCustomer(ctx, s.customerDAO, user.ID)
+	if errors.Is(err, domain.ErrCustomerAlreadyExists) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	customer, err := domain.NewCustomerFromUser(s.nextID(), user.ID, user.Email, user.Username)
+	if err != nil {
+		return err
+	}
+
+	if err := s.customerDAO.Create(ctx, customer); err != nil {
+		return err
+	}
+
+	slog.InfoContext(ctx, "customer provisioned", "customer_id", customer.GetID(), "user_id", user.ID)
+	return s.eventBus.Publish(ctx, customer.PullEvents()...)
+}
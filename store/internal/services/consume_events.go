@@ -0,0 +1,74 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"ichibuy/store/internal/domain"
+	"ichibuy/store/internal/domain/dao"
+	sharedCtx "ichibuy/store/internal/shared/context"
+)
+
+const eventsBatchSize = 100
+
+// eventsSource returns the events of the given types after the event with the given ID, from the first one
+// when it is empty, like the FindEventsAfter of domain.EventsService
+type eventsSource func(ctx context.Context, after string, types []domain.EventType, limit int) ([]domain.Event, error)
+
+// consumeEvents hands the events of the source after the checkpoint of the consumer to handle. Each event
+// is handled in a transaction with its checkpoint, so an event is handled once even if the job stops
+// halfway. The events published by handle are caused by the event handled. It returns the number of events
+// handled.
+func consumeEvents(
+	ctx context.Context,
+	eventCheckpointDAO dao.EventCheckpointDAO,
+	source eventsSource,
+	consumer string,
+	types []domain.EventType,
+	handle func(ctx context.Context, event domain.Event) error,
+) (int, error) {
+	checkpoint, err := eventCheckpointDAO.FindByPk(ctx, consumer)
+	isNew := errors.Is(err, sql.ErrNoRows)
+	if isNew {
+		checkpoint = domain.NewEventCheckpoint(consumer)
+	} else if err != nil {
+		slog.ErrorContext(ctx, "find event checkpoint failed", "consumer", consumer, "error", err.Error())
+		return 0, err
+	}
+
+	handled := 0
+	for {
+		events, err := source(ctx, checkpoint.GetLastEventID(), types, eventsBatchSize)
+		if err != nil {
+			slog.ErrorContext(ctx, "find events failed", "consumer", consumer, "error", err.Error())
+			return handled, err
+		}
+
+		for _, event := range events {
+			err := eventCheckpointDAO.WithTransaction(ctx, func(ctx context.Context) error {
+				if err := handle(sharedCtx.WithCause(ctx, event.ID, event.CorrelationID), event); err != nil {
+					return err
+				}
+
+				checkpoint.Advance(event.ID)
+				if isNew {
+					return eventCheckpointDAO.Create(ctx, checkpoint)
+				}
+				return eventCheckpointDAO.Update(ctx, checkpoint)
+			})
+			if err != nil {
+				slog.ErrorContext(ctx, "handle event failed", "consumer", consumer, "event_id", event.ID, "error", err.Error())
+				return handled, err
+			}
+
+			isNew = false
+			handled++
+		}
+
+		if len(events) < eventsBatchSize {
+			return handled, nil
+		}
+	}
+}
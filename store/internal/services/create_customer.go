@@ -2,6 +2,8 @@ package services
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"log/slog"
 
 	"ichibuy/store/internal/domain"
@@ -13,20 +15,26 @@ type CreateCustomerReq struct {
 	LastName  string
 	Email     *string
 	Phone     *string
-	UserID    string
+	// PhoneRegion of a local phone number, e.g. PE
+	PhoneRegion *string
+	// StoreID is the store the customer comes from, its phone region is used when none is given
+	StoreID *string
+	UserID  string
 }
 
 type CreateCustomerResp = CreateUpdateResponse
 
 type CreateCustomer struct {
 	customerDAO dao.CustomerDAO
+	storeDAO    dao.StoreDAO
 	eventBus    domain.EventBus
 	nextID      domain.NextID
 }
 
-func NewCreateCustomer(customerDAO dao.CustomerDAO, eventBus domain.EventBus, nextID domain.NextID) *CreateCustomer {
+func NewCreateCustomer(customerDAO dao.CustomerDAO, storeDAO dao.StoreDAO, eventBus domain.EventBus, nextID domain.NextID) *CreateCustomer {
 	return &CreateCustomer{
 		customerDAO: customerDAO,
+		storeDAO:    storeDAO,
 		eventBus:    eventBus,
 		nextID:      nextID,
 	}
@@ -35,7 +43,17 @@ func NewCreateCustomer(customerDAO dao.CustomerDAO, eventBus domain.EventBus, ne
 func (s *CreateCustomer) Exec(ctx context.Context, req CreateCustomerReq) (*CreateCustomerResp, error) {
 	slog.InfoContext(ctx, "create customer started", "req", req)
 
-	customer, err := domain.NewCustomer(s.nextID(), req.FirstName, req.LastName, req.Email, req.Phone, req.UserID)
+	if err := checkUserHasNoCustomer(ctx, s.customerDAO, req.UserID); err != nil {
+		return nil, err
+	}
+
+	phone, err := normalizeCustomerPhone(ctx, s.storeDAO, req.Phone, req.PhoneRegion, req.StoreID)
+	if err != nil {
+		slog.ErrorContext(ctx, "normalize phone failed", "error", err.Error())
+		return nil, err
+	}
+
+	customer, err := domain.NewCustomer(s.nextID(), req.FirstName, req.LastName, req.Email, phone, req.UserID)
 	if err != nil {
 		slog.ErrorContext(ctx, "new customer failed", "error", err.Error())
 		return nil, err
@@ -55,3 +73,19 @@ func (s *CreateCustomer) Exec(ctx context.Context, req CreateCustomerReq) (*Crea
 
 	return &CreateCustomerResp{ID: customer.GetID()}, nil
 }
+
+// checkUserHasNoCustomer returns ErrCustomerAlreadyExists when the user has an active customer, the
+// unique index of customers.user_id also rejects concurrent creations
+func checkUserHasNoCustomer(ctx context.Context, customerDAO dao.CustomerDAO, userID string) error {
+	_, err := customerDAO.FindOne(ctx, "user_id = $1 AND deleted_at IS NULL", "", userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "find customer by user id failed", "error", err.Error())
+		return err
+	}
+
+	slog.WarnContext(ctx, "user already has a customer", "user_id", userID)
+	return domain.ErrCustomerAlreadyExists
+}
@@ -0,0 +1,53 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+
+	"ichibuy/store/internal/domain"
+	"ichibuy/store/internal/domain/dao"
+)
+
+type CreateCustomerAddressReq struct {
+	CustomerID string
+	Address    AddressReq
+	UserID     string
+}
+
+type CreateCustomerAddress struct {
+	customerDAO        dao.CustomerDAO
+	customerAddressDAO dao.CustomerAddressDAO
+	eventBus           domain.EventBus
+	nextID             domain.NextID
+}
+
+func NewCreateCustomerAddress(customerDAO dao.CustomerDAO, customerAddressDAO dao.CustomerAddressDAO, eventBus domain.EventBus, nextID domain.NextID) *CreateCustomerAddress {
+	return &CreateCustomerAddress{
+		customerDAO:        customerDAO,
+		customerAddressDAO: customerAddressDAO,
+		eventBus:           eventBus,
+		nextID:             nextID,
+	}
+}
+
+func (s *CreateCustomerAddress) Exec(ctx context.Context, req CreateCustomerAddressReq) (*CustomerAddressResp, error) {
+	slog.InfoContext(ctx, "create customer address started", "req", req)
+	customer, err := findCustomerWithAddresses(ctx, s.customerDAO, s.customerAddressDAO, req.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := customer.AddAddress(s.nextID(), req.Address.toAddressData(), req.Address.IsDefault, req.UserID)
+	if err != nil {
+		slog.ErrorContext(ctx, "add customer address domain failed", "error", err.Error())
+		return nil, err
+	}
+
+	if err := saveCustomerAddresses(ctx, s.customerDAO, s.customerAddressDAO, s.eventBus, customer, address, nil); err != nil {
+		slog.ErrorContext(ctx, "save customer addresses failed", "error", err.Error())
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "create customer address finished", "address_id", address.GetID())
+	return mapCustomerAddressToResp(address), nil
+}
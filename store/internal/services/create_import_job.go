@@ -0,0 +1,74 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+
+	"ichibuy/store/internal/domain"
+	"ichibuy/store/internal/domain/dao"
+)
+
+type CreateImportJobReq struct {
+	StoreID string
+	UserID  string
+	Format  string
+	Payload string
+}
+
+type CreateImportJobResp = CreateUpdateResponse
+
+type CreateImportJob struct {
+	importJobDAO dao.ImportJobDAO
+	storeDAO     dao.StoreDAO
+	nextID       domain.NextID
+}
+
+func NewCreateImportJob(importJobDAO dao.ImportJobDAO, storeDAO dao.StoreDAO, nextID domain.NextID) *CreateImportJob {
+	return &CreateImportJob{
+		importJobDAO: importJobDAO,
+		storeDAO:     storeDAO,
+		nextID:       nextID,
+	}
+}
+
+func (s *CreateImportJob) Exec(ctx context.Context, req CreateImportJobReq) (*CreateImportJobResp, error) {
+	slog.InfoContext(ctx, "create import job started", "store_id", req.StoreID, "format", req.Format)
+
+	store, err := s.storeDAO.FindOne(ctx, "id = $1 AND deleted_at IS NULL", "", req.StoreID)
+	if err != nil {
+		slog.ErrorContext(ctx, "find store failed", "error", err.Error())
+		return nil, err
+	}
+
+	if err := store.CheckOwner(req.UserID); err != nil {
+		slog.ErrorContext(ctx, "check store owner failed", "error", err.Error())
+		return nil, err
+	}
+
+	format, err := domain.NewImportFormat(req.Format)
+	if err != nil {
+		slog.ErrorContext(ctx, "new import format failed", "error", err.Error())
+		return nil, err
+	}
+
+	// parse up-front so unreadable files are rejected before queueing the job
+	rows, rowErrors, err := parseCatalogFile(format, req.Payload)
+	if err != nil {
+		slog.ErrorContext(ctx, "parse catalog file failed", "error", err.Error())
+		return nil, err
+	}
+
+	job, err := domain.NewImportJob(s.nextID(), store.GetID(), req.UserID, format, req.Payload, len(rows)+len(rowErrors))
+	if err != nil {
+		slog.ErrorContext(ctx, "new import job failed", "error", err.Error())
+		return nil, err
+	}
+
+	if err := s.importJobDAO.Create(ctx, job); err != nil {
+		slog.ErrorContext(ctx, "create import job failed", "error", err.Error())
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "create import job finished", "import_job_id", job.GetID(), "total_rows", job.GetTotalRows())
+	return &CreateImportJobResp{ID: job.GetID()}, nil
+}
@@ -21,14 +21,16 @@ type CreateProductResp = CreateUpdateResponse
 
 type CreateProduct struct {
 	productDAO     dao.ProductDAO
+	storeDAO       dao.StoreDAO
 	eventBus       domain.EventBus
 	nextID         domain.NextID
 	productFactory *domain.ProductFactory
 }
 
-func NewCreateProduct(productDAO dao.ProductDAO, eventBus domain.EventBus, nextID domain.NextID, productFactory *domain.ProductFactory) *CreateProduct {
+func NewCreateProduct(productDAO dao.ProductDAO, storeDAO dao.StoreDAO, eventBus domain.EventBus, nextID domain.NextID, productFactory *domain.ProductFactory) *CreateProduct {
 	return &CreateProduct{
 		productDAO:     productDAO,
+		storeDAO:       storeDAO,
 		eventBus:       eventBus,
 		nextID:         nextID,
 		productFactory: productFactory,
@@ -44,6 +46,17 @@ func (s *CreateProduct) Exec(ctx context.Context, req CreateProductReq) (*Create
 		return nil, err
 	}
 
+	store, err := s.storeDAO.FindOne(ctx, "id = $1 AND deleted_at IS NULL", "", req.StoreID)
+	if err != nil {
+		slog.ErrorContext(ctx, "find store failed", "error", err.Error())
+		return nil, err
+	}
+
+	if err := store.CheckPrices(prices); err != nil {
+		slog.ErrorContext(ctx, "check store prices failed", "error", err.Error())
+		return nil, err
+	}
+
 	product, err := s.productFactory.NewProduct(ctx, req.Name, req.Description, req.Active, req.StoreID, s.fileDTOsToUploadFileRequests(req.ImageFiles), prices)
 	if err != nil {
 		slog.ErrorContext(ctx, "new product failed", "error", err.Error())
@@ -12,6 +12,8 @@ type CreateStoreReq struct {
 	Name        string
 	Description *string
 	Location    domain.Location
+	Currencies  []string
+	PhoneRegion string
 	UserID      string
 }
 
@@ -33,7 +35,7 @@ func NewCreateStore(storeDAO dao.StoreDAO, eventBus domain.EventBus, nextID doma
 
 func (s *CreateStore) Exec(ctx context.Context, req CreateStoreReq) (*CreateStoreResp, error) {
 	slog.InfoContext(ctx, "create store started", "req", req)
-	store, err := domain.NewStore(s.nextID(), req.Name, req.Description, req.Location.Lat, req.Location.Lng, req.UserID)
+	store, err := domain.NewStore(s.nextID(), req.Name, req.Description, req.Location.Lat, req.Location.Lng, req.Currencies, req.PhoneRegion, req.UserID)
 	if err != nil {
 		slog.ErrorContext(ctx, "new store failed", "error", err.Error())
 		return nil, err
@@ -0,0 +1,63 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+
+	"ichibuy/store/internal/domain"
+	"ichibuy/store/internal/domain/dao"
+)
+
+type CreateWebhookSubscriptionReq struct {
+	StoreID    string
+	URL        string
+	Secret     string
+	EventTypes []string
+	UserID     string
+}
+
+type CreateWebhookSubscriptionResp struct {
+	WebhookSubscriptionResp
+	// Secret signs the deliveries, it is only returned on creation
+	Secret string `json:"secret"`
+}
+
+type CreateWebhookSubscription struct {
+	storeDAO               dao.StoreDAO
+	webhookSubscriptionDAO dao.WebhookSubscriptionDAO
+	nextID                 domain.NextID
+}
+
+func NewCreateWebhookSubscription(storeDAO dao.StoreDAO, webhookSubscriptionDAO dao.WebhookSubscriptionDAO, nextID domain.NextID) *CreateWebhookSubscription {
+	return &CreateWebhookSubscription{
+		storeDAO:               storeDAO,
+		webhookSubscriptionDAO: webhookSubscriptionDAO,
+		nextID:                 nextID,
+	}
+}
+
+func (s *CreateWebhookSubscription) Exec(ctx context.Context, req CreateWebhookSubscriptionReq) (*CreateWebhookSubscriptionResp, error) {
+	slog.InfoContext(ctx, "create webhook subscription started", "store_id", req.StoreID, "url", req.URL, "event_types", req.EventTypes)
+	store, err := s.storeDAO.FindOne(ctx, "id = $1 AND deleted_at IS NULL", "", req.StoreID)
+	if err != nil {
+		slog.ErrorContext(ctx, "find store failed", "error", err.Error())
+		return nil, err
+	}
+
+	subscription, err := domain.NewWebhookSubscription(s.nextID(), store, req.URL, req.Secret, req.EventTypes, req.UserID)
+	if err != nil {
+		slog.ErrorContext(ctx, "create webhook subscription domain failed", "error", err.Error())
+		return nil, err
+	}
+
+	if err := s.webhookSubscriptionDAO.Create(ctx, subscription); err != nil {
+		slog.ErrorContext(ctx, "create webhook subscription failed", "error", err.Error())
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "create webhook subscription finished", "subscription_id", subscription.GetID())
+	return &CreateWebhookSubscriptionResp{
+		WebhookSubscriptionResp: mapWebhookSubscriptionToResp(subscription),
+		Secret:                  subscription.GetSecret(),
+	}, nil
+}
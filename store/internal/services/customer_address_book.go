@@ -0,0 +1,143 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"ichibuy/store/internal/domain"
+	"ichibuy/store/internal/domain/dao"
+)
+
+type AddressReq struct {
+	Label      string
+	Recipient  *string
+	Line1      string
+	Line2      *string
+	City       string
+	Region     *string
+	PostalCode *string
+	Country    string
+	Location   *domain.Location
+	IsDefault  bool
+}
+
+type CustomerAddressResp struct {
+	ID         string           `json:"id"`
+	CustomerID string           `json:"customer_id"`
+	Label      string           `json:"label"`
+	Recipient  *string          `json:"recipient"`
+	Line1      string           `json:"line1"`
+	Line2      *string          `json:"line2"`
+	City       string           `json:"city"`
+	Region     *string          `json:"region"`
+	PostalCode *string          `json:"postal_code"`
+	Country    string           `json:"country"`
+	Location   *domain.Location `json:"location"`
+	IsDefault  bool             `json:"is_default"`
+	CreatedAt  time.Time        `json:"created_at"`
+	UpdatedAt  time.Time        `json:"updated_at"`
+}
+
+// findCustomerWithAddresses returns the customer with its address book loaded, so its events carry it
+func findCustomerWithAddresses(ctx context.Context, customerDAO dao.CustomerDAO, customerAddressDAO dao.CustomerAddressDAO, customerID string) (*domain.Customer, error) {
+	customer, err := customerDAO.FindOne(ctx, "id = $1 AND deleted_at IS NULL", "", customerID)
+	if err != nil {
+		slog.ErrorContext(ctx, "find customer failed", "error", err.Error())
+		return nil, err
+	}
+
+	if err := loadCustomerAddresses(ctx, customerAddressDAO, customer); err != nil {
+		return nil, err
+	}
+
+	return customer, nil
+}
+
+func loadCustomerAddresses(ctx context.Context, customerAddressDAO dao.CustomerAddressDAO, customer *domain.Customer) error {
+	addresses, err := customerAddressDAO.FindAll(ctx, "customer_id = $1", "created_at ASC", customer.GetID())
+	if err != nil {
+		slog.ErrorContext(ctx, "find customer addresses failed", "error", err.Error())
+		return err
+	}
+
+	customer.LoadAddresses(addresses)
+	return nil
+}
+
+// saveCustomerAddresses saves the customer with its address book, the new address is created and the
+// removed one deleted, then its events are published
+func saveCustomerAddresses(
+	ctx context.Context,
+	customerDAO dao.CustomerDAO,
+	customerAddressDAO dao.CustomerAddressDAO,
+	eventBus domain.EventBus,
+	customer *domain.Customer,
+	created *domain.CustomerAddress,
+	removedID *string,
+) error {
+	return customerDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := customerDAO.Update(ctx, customer); err != nil {
+			return err
+		}
+
+		existing := make([]*domain.CustomerAddress, 0, len(customer.GetAddresses()))
+		for _, address := range customer.GetAddresses() {
+			if created != nil && address.GetID() == created.GetID() {
+				continue
+			}
+			existing = append(existing, address)
+		}
+
+		if created != nil {
+			if err := customerAddressDAO.Create(ctx, created); err != nil {
+				return err
+			}
+		}
+
+		if removedID != nil {
+			if err := customerAddressDAO.DeleteByPk(ctx, *removedID); err != nil {
+				return err
+			}
+		}
+
+		if err := customerAddressDAO.UpdateMany(ctx, existing); err != nil {
+			return err
+		}
+
+		return eventBus.Publish(ctx, customer.PullEvents()...)
+	})
+}
+
+func (r AddressReq) toAddressData() domain.AddressData {
+	return domain.AddressData{
+		Label:      r.Label,
+		Recipient:  r.Recipient,
+		Line1:      r.Line1,
+		Line2:      r.Line2,
+		City:       r.City,
+		Region:     r.Region,
+		PostalCode: r.PostalCode,
+		Country:    r.Country,
+		Location:   r.Location,
+	}
+}
+
+func mapCustomerAddressToResp(address *domain.CustomerAddress) *CustomerAddressResp {
+	return &CustomerAddressResp{
+		ID:         address.GetID(),
+		CustomerID: address.GetCustomerID(),
+		Label:      address.GetLabel(),
+		Recipient:  address.GetRecipient(),
+		Line1:      address.GetLine1(),
+		Line2:      address.GetLine2(),
+		City:       address.GetCity(),
+		Region:     address.GetRegion(),
+		PostalCode: address.GetPostalCode(),
+		Country:    address.GetCountry(),
+		Location:   address.Location(),
+		IsDefault:  address.GetIsDefault(),
+		CreatedAt:  address.GetCreatedAt(),
+		UpdatedAt:  address.GetUpdatedAt(),
+	}
+}
@@ -0,0 +1,37 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+
+	"ichibuy/store/internal/domain"
+	"ichibuy/store/internal/domain/dao"
+)
+
+// normalizeCustomerPhone returns the E.164 form of a customer phone. Local numbers use the given phone
+// region, else the phone region of the store the customer comes from, else the default one.
+func normalizeCustomerPhone(ctx context.Context, storeDAO dao.StoreDAO, phone, phoneRegion, storeID *string) (*string, error) {
+	if phone == nil {
+		return nil, nil
+	}
+
+	region := domain.DefaultPhoneRegion
+	if phoneRegion != nil && *phoneRegion != "" {
+		region = *phoneRegion
+	} else if storeID != nil && *storeID != "" {
+		store, err := storeDAO.FindOne(ctx, "id = $1 AND deleted_at IS NULL", "", *storeID)
+		if err != nil {
+			slog.ErrorContext(ctx, "find store failed", "error", err.Error())
+			return nil, err
+		}
+		region = store.GetPhoneRegion()
+	}
+
+	normalized, err := domain.NormalizePhone(*phone, region)
+	if err != nil {
+		return nil, err
+	}
+
+	value := normalized.String()
+	return &value, nil
+}
@@ -13,16 +13,18 @@ type DeleteCustomerReq struct {
 }
 
 type DeleteCustomer struct {
-	customerDAO dao.CustomerDAO
-	eventBus    domain.EventBus
-	nextID      domain.NextID
+	customerDAO        dao.CustomerDAO
+	customerAddressDAO dao.CustomerAddressDAO
+	eventBus           domain.EventBus
+	nextID             domain.NextID
 }
 
-func NewDeleteCustomer(customerDAO dao.CustomerDAO, eventBus domain.EventBus, nextID domain.NextID) *DeleteCustomer {
+func NewDeleteCustomer(customerDAO dao.CustomerDAO, customerAddressDAO dao.CustomerAddressDAO, eventBus domain.EventBus, nextID domain.NextID) *DeleteCustomer {
 	return &DeleteCustomer{
-		customerDAO: customerDAO,
-		eventBus:    eventBus,
-		nextID:      nextID,
+		customerDAO:        customerDAO,
+		customerAddressDAO: customerAddressDAO,
+		eventBus:           eventBus,
+		nextID:             nextID,
 	}
 }
 
@@ -34,9 +36,16 @@ func (s *DeleteCustomer) Exec(ctx context.Context, req DeleteCustomerReq) error
 		return err
 	}
 
-	customer.PrepareDelete()
+	if err := loadCustomerAddresses(ctx, s.customerAddressDAO, customer); err != nil {
+		return err
+	}
+
+	if err := customer.SoftDelete(); err != nil {
+		slog.ErrorContext(ctx, "soft delete customer failed", "error", err.Error())
+		return err
+	}
 
-	if err := s.customerDAO.DeleteByPk(ctx, req.ID); err != nil {
+	if err := s.customerDAO.Update(ctx, customer); err != nil {
 		slog.ErrorContext(ctx, "delete customer failed", "error", err.Error())
 		return err
 	}
@@ -0,0 +1,50 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+
+	"ichibuy/store/internal/domain"
+	"ichibuy/store/internal/domain/dao"
+)
+
+type DeleteCustomerAddressReq struct {
+	CustomerID string
+	AddressID  string
+	UserID     string
+}
+
+type DeleteCustomerAddress struct {
+	customerDAO        dao.CustomerDAO
+	customerAddressDAO dao.CustomerAddressDAO
+	eventBus           domain.EventBus
+}
+
+func NewDeleteCustomerAddress(customerDAO dao.CustomerDAO, customerAddressDAO dao.CustomerAddressDAO, eventBus domain.EventBus) *DeleteCustomerAddress {
+	return &DeleteCustomerAddress{
+		customerDAO:        customerDAO,
+		customerAddressDAO: customerAddressDAO,
+		eventBus:           eventBus,
+	}
+}
+
+func (s *DeleteCustomerAddress) Exec(ctx context.Context, req DeleteCustomerAddressReq) error {
+	slog.InfoContext(ctx, "delete customer address started", "req", req)
+	customer, err := findCustomerWithAddresses(ctx, s.customerDAO, s.customerAddressDAO, req.CustomerID)
+	if err != nil {
+		return err
+	}
+
+	if err := customer.RemoveAddress(req.AddressID, req.UserID); err != nil {
+		slog.ErrorContext(ctx, "remove customer address domain failed", "error", err.Error())
+		return err
+	}
+
+	if err := saveCustomerAddresses(ctx, s.customerDAO, s.customerAddressDAO, s.eventBus, customer, nil, &req.AddressID); err != nil {
+		slog.ErrorContext(ctx, "save customer addresses failed", "error", err.Error())
+		return err
+	}
+
+	slog.InfoContext(ctx, "delete customer address finished", "address_id", req.AddressID)
+	return nil
+}
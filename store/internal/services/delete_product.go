@@ -16,15 +16,13 @@ type DeleteProduct struct {
 	productDAO dao.ProductDAO
 	eventBus   domain.EventBus
 	nextID     domain.NextID
-	storageSvc domain.StorageService
 }
 
-func NewDeleteProduct(productDAO dao.ProductDAO, eventBus domain.EventBus, nextID domain.NextID, storageSvc domain.StorageService) *DeleteProduct {
+func NewDeleteProduct(productDAO dao.ProductDAO, eventBus domain.EventBus, nextID domain.NextID) *DeleteProduct {
 	return &DeleteProduct{
 		productDAO: productDAO,
 		eventBus:   eventBus,
 		nextID:     nextID,
-		storageSvc: storageSvc,
 	}
 }
 
@@ -36,13 +34,12 @@ func (s *DeleteProduct) Exec(ctx context.Context, req DeleteProductReq) error {
 		return err
 	}
 
-	err = product.PrepareDelete(ctx, s.storageSvc)
-	if err != nil {
-		slog.ErrorContext(ctx, "prepare delete product failed", "error", err.Error())
+	if err := product.SoftDelete(); err != nil {
+		slog.ErrorContext(ctx, "soft delete product failed", "error", err.Error())
 		return err
 	}
 
-	if err := s.productDAO.DeleteByPk(ctx, req.ID); err != nil {
+	if err := s.productDAO.Update(ctx, product); err != nil {
 		slog.ErrorContext(ctx, "delete product failed", "error", err.Error())
 		return err
 	}
@@ -9,20 +9,26 @@ import (
 )
 
 type DeleteStoreReq struct {
-	ID string
+	ID     string
+	UserID string
+	Force  bool
 }
 
 type DeleteStore struct {
-	storeDAO dao.StoreDAO
-	eventBus domain.EventBus
-	nextID   domain.NextID
+	storeDAO     dao.StoreDAO
+	productDAO   dao.ProductDAO
+	eventBus     domain.EventBus
+	nextID       domain.NextID
+	storeDeleter *domain.StoreDeleter
 }
 
-func NewDeleteStore(storeDAO dao.StoreDAO, eventBus domain.EventBus, nextID domain.NextID) *DeleteStore {
+func NewDeleteStore(storeDAO dao.StoreDAO, productDAO dao.ProductDAO, eventBus domain.EventBus, nextID domain.NextID, storeDeleter *domain.StoreDeleter) *DeleteStore {
 	return &DeleteStore{
-		storeDAO: storeDAO,
-		eventBus: eventBus,
-		nextID:   nextID,
+		storeDAO:     storeDAO,
+		productDAO:   productDAO,
+		eventBus:     eventBus,
+		nextID:       nextID,
+		storeDeleter: storeDeleter,
 	}
 }
 
@@ -34,18 +40,45 @@ func (s *DeleteStore) Exec(ctx context.Context, req DeleteStoreReq) error {
 		return err
 	}
 
-	store.PrepareDelete()
+	products, err := s.productDAO.FindAll(ctx, "store_id = $1 AND deleted_at IS NULL", "", store.GetID())
+	if err != nil {
+		slog.ErrorContext(ctx, "find store products failed", "error", err.Error())
+		return err
+	}
 
-	if err := s.storeDAO.DeleteByPk(ctx, req.ID); err != nil {
-		slog.ErrorContext(ctx, "delete store failed", "error", err.Error())
+	if err := s.storeDeleter.Delete(ctx, store, products, req.UserID, req.Force); err != nil {
+		slog.ErrorContext(ctx, "delete store domain failed", "error", err.Error())
 		return err
 	}
 
-	if err := s.eventBus.Publish(ctx, store.PullEvents()...); err != nil {
-		slog.ErrorContext(ctx, "publish events failed", "error", err.Error())
+	events := []domain.Event{}
+	for _, product := range products {
+		events = append(events, product.PullEvents()...)
+	}
+	events = append(events, store.PullEvents()...)
+
+	err = s.storeDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := s.productDAO.UpdateMany(ctx, products); err != nil {
+			slog.ErrorContext(ctx, "update products failed", "error", err.Error())
+			return err
+		}
+
+		if err := s.storeDAO.Update(ctx, store); err != nil {
+			slog.ErrorContext(ctx, "update store failed", "error", err.Error())
+			return err
+		}
+
+		if err := s.eventBus.Publish(ctx, events...); err != nil {
+			slog.ErrorContext(ctx, "publish events failed", "error", err.Error())
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
 		return err
 	}
 
-	slog.InfoContext(ctx, "delete store finished", "store_id", req.ID)
+	slog.InfoContext(ctx, "delete store finished", "store_id", req.ID, "products", len(products))
 	return nil
 }
@@ -0,0 +1,43 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+
+	"ichibuy/store/internal/domain/dao"
+)
+
+type DeleteWebhookSubscriptionReq struct {
+	StoreID        string
+	SubscriptionID string
+	UserID         string
+}
+
+// DeleteWebhookSubscription deletes the subscription with its deliveries and their attempts
+type DeleteWebhookSubscription struct {
+	storeDAO               dao.StoreDAO
+	webhookSubscriptionDAO dao.WebhookSubscriptionDAO
+}
+
+func NewDeleteWebhookSubscription(storeDAO dao.StoreDAO, webhookSubscriptionDAO dao.WebhookSubscriptionDAO) *DeleteWebhookSubscription {
+	return &DeleteWebhookSubscription{
+		storeDAO:               storeDAO,
+		webhookSubscriptionDAO: webhookSubscriptionDAO,
+	}
+}
+
+func (s *DeleteWebhookSubscription) Exec(ctx context.Context, req DeleteWebhookSubscriptionReq) error {
+	slog.InfoContext(ctx, "delete webhook subscription started", "req", req)
+	subscription, err := findStoreWebhookSubscription(ctx, s.storeDAO, s.webhookSubscriptionDAO, req.StoreID, req.SubscriptionID, req.UserID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.webhookSubscriptionDAO.DeleteByPk(ctx, subscription.GetID()); err != nil {
+		slog.ErrorContext(ctx, "delete webhook subscription failed", "error", err.Error())
+		return err
+	}
+
+	slog.InfoContext(ctx, "delete webhook subscription finished", "subscription_id", subscription.GetID())
+	return nil
+}
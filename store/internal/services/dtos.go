@@ -21,15 +21,22 @@ type ImageDTO struct {
 	URL string `json:"url"`
 }
 
+type MoneyDTO struct {
+	Amount   int    `json:"amount"` // minor units
+	Currency string `json:"currency"`
+}
+
 type NewPriceDTO struct {
-	Amount   int    `json:"amount"` // cents
+	Amount   int    `json:"amount"` // minor units
 	Currency string `json:"currency"`
 }
 
 type PriceDTO struct {
 	ID       string `json:"id"`
-	Amount   int    `json:"amount"` // cents
+	Amount   int    `json:"amount"` // minor units
 	Currency string `json:"currency"`
+	// Converted is the price in the requested display currency
+	Converted *MoneyDTO `json:"converted,omitempty"`
 }
 
 type FileDTO struct {
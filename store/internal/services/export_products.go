@@ -0,0 +1,96 @@
+package services
+
+import (
+	"context"
+	"io"
+	"log/slog"
+
+	"ichibuy/store/internal/domain"
+	"ichibuy/store/internal/domain/dao"
+)
+
+const exportProductsPageSize = 100
+
+type ExportProductsReq struct {
+	StoreID string
+	UserID  string
+	Format  string
+}
+
+type ExportProducts struct {
+	productDAO dao.ProductDAO
+	storeDAO   dao.StoreDAO
+}
+
+func NewExportProducts(productDAO dao.ProductDAO, storeDAO dao.StoreDAO) *ExportProducts {
+	return &ExportProducts{
+		productDAO: productDAO,
+		storeDAO:   storeDAO,
+	}
+}
+
+// Validate checks the request before anything is written, so errors can still be reported to the client
+func (s *ExportProducts) Validate(ctx context.Context, req ExportProductsReq) error {
+	store, err := s.storeDAO.FindOne(ctx, "id = $1 AND deleted_at IS NULL", "", req.StoreID)
+	if err != nil {
+		slog.ErrorContext(ctx, "find store failed", "error", err.Error())
+		return err
+	}
+
+	if err := store.CheckOwner(req.UserID); err != nil {
+		slog.ErrorContext(ctx, "check store owner failed", "error", err.Error())
+		return err
+	}
+
+	if _, err := domain.NewImportFormat(req.Format); err != nil {
+		slog.ErrorContext(ctx, "new import format failed", "error", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// Exec streams the store catalog into w page by page
+func (s *ExportProducts) Exec(ctx context.Context, req ExportProductsReq, w io.Writer) error {
+	slog.InfoContext(ctx, "export products started", "req", req)
+
+	if err := s.Validate(ctx, req); err != nil {
+		return err
+	}
+
+	format, _ := domain.NewImportFormat(req.Format)
+	writer, err := newCatalogWriter(format, w)
+	if err != nil {
+		slog.ErrorContext(ctx, "new catalog writer failed", "error", err.Error())
+		return err
+	}
+
+	exported := 0
+	for offset := 0; ; offset += exportProductsPageSize {
+		products, err := s.productDAO.FindPaginated(ctx, exportProductsPageSize, offset, "store_id = $1 AND deleted_at IS NULL", "created_at ASC, id ASC", req.StoreID)
+		if err != nil {
+			slog.ErrorContext(ctx, "find paginated products failed", "error", err.Error())
+			return err
+		}
+
+		for _, product := range products {
+			if err := writer.Write(product); err != nil {
+				slog.ErrorContext(ctx, "write product failed", "product_id", product.GetID(), "error", err.Error())
+				return err
+			}
+		}
+
+		if err := writer.Flush(); err != nil {
+			slog.ErrorContext(ctx, "flush catalog writer failed", "error", err.Error())
+			return err
+		}
+
+		exported += len(products)
+		if len(products) < exportProductsPageSize {
+			break
+		}
+	}
+
+	slog.InfoContext(ctx, "export products finished", "store_id", req.StoreID, "count", exported)
+	return nil
+}
@@ -14,14 +14,16 @@ type GetCustomerReq struct {
 }
 
 type GetCustomerResp struct {
-	ID        string    `json:"id"`
-	FirstName string    `json:"first_name"`
-	LastName  string    `json:"last_name"`
-	Email     *string   `json:"email"`
-	Phone     *string   `json:"phone"`
-	UserID    string    `json:"user_id"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID              string     `json:"id"`
+	FirstName       string     `json:"first_name"`
+	LastName        string     `json:"last_name"`
+	Email           *string    `json:"email"`
+	Phone           *string    `json:"phone"`
+	EmailVerifiedAt *time.Time `json:"email_verified_at"`
+	PhoneVerifiedAt *time.Time `json:"phone_verified_at"`
+	UserID          string     `json:"user_id"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
 }
 
 type GetCustomer struct {
@@ -36,7 +38,7 @@ func NewGetCustomer(customerDAO dao.CustomerDAO) *GetCustomer {
 
 func (s *GetCustomer) Exec(ctx context.Context, req GetCustomerReq) (*GetCustomerResp, error) {
 	slog.InfoContext(ctx, "get customer started", "req", req)
-	customer, err := s.customerDAO.FindByPk(ctx, req.ID)
+	customer, err := s.customerDAO.FindOne(ctx, "id = $1 AND deleted_at IS NULL", "", req.ID)
 	if err != nil {
 		slog.ErrorContext(ctx, "find customer failed", "error", err.Error())
 		return nil, err
@@ -47,13 +49,15 @@ func (s *GetCustomer) Exec(ctx context.Context, req GetCustomerReq) (*GetCustome
 
 func mapCustomerToGetCustomerResp(customer *domain.Customer) *GetCustomerResp {
 	return &GetCustomerResp{
-		ID:        customer.GetID(),
-		FirstName: customer.GetFirstName(),
-		LastName:  customer.GetLastName(),
-		Email:     customer.GetEmailString(),
-		Phone:     customer.GetPhoneString(),
-		UserID:    customer.GetUserID(),
-		CreatedAt: customer.GetCreatedAt(),
-		UpdatedAt: customer.GetUpdatedAt(),
+		ID:              customer.GetID(),
+		FirstName:       customer.GetFirstName(),
+		LastName:        customer.GetLastName(),
+		Email:           customer.GetEmailString(),
+		Phone:           customer.GetPhoneString(),
+		EmailVerifiedAt: customer.GetEmailVerifiedAt(),
+		PhoneVerifiedAt: customer.GetPhoneVerifiedAt(),
+		UserID:          customer.GetUserID(),
+		CreatedAt:       customer.GetCreatedAt(),
+		UpdatedAt:       customer.GetUpdatedAt(),
 	}
 }
@@ -0,0 +1,46 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+
+	"ichibuy/store/internal/domain/dao"
+)
+
+type GetCustomerAddressReq struct {
+	CustomerID string
+	AddressID  string
+	UserID     string
+}
+
+type GetCustomerAddress struct {
+	customerDAO        dao.CustomerDAO
+	customerAddressDAO dao.CustomerAddressDAO
+}
+
+func NewGetCustomerAddress(customerDAO dao.CustomerDAO, customerAddressDAO dao.CustomerAddressDAO) *GetCustomerAddress {
+	return &GetCustomerAddress{
+		customerDAO:        customerDAO,
+		customerAddressDAO: customerAddressDAO,
+	}
+}
+
+func (s *GetCustomerAddress) Exec(ctx context.Context, req GetCustomerAddressReq) (*CustomerAddressResp, error) {
+	slog.InfoContext(ctx, "get customer address started", "req", req)
+	customer, err := findCustomerWithAddresses(ctx, s.customerDAO, s.customerAddressDAO, req.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := customer.CheckOwner(req.UserID); err != nil {
+		return nil, err
+	}
+
+	address, err := customer.FindAddress(req.AddressID)
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "get customer address finished", "address_id", address.GetID())
+	return mapCustomerAddressToResp(address), nil
+}
@@ -14,14 +14,16 @@ type GetCustomerByUserIDReq struct {
 }
 
 type GetCustomerByUserIDResp struct {
-	ID        string    `json:"id"`
-	FirstName string    `json:"first_name"`
-	LastName  string    `json:"last_name"`
-	Email     *string   `json:"email"`
-	Phone     *string   `json:"phone"`
-	UserID    string    `json:"user_id"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID              string     `json:"id"`
+	FirstName       string     `json:"first_name"`
+	LastName        string     `json:"last_name"`
+	Email           *string    `json:"email"`
+	Phone           *string    `json:"phone"`
+	EmailVerifiedAt *time.Time `json:"email_verified_at"`
+	PhoneVerifiedAt *time.Time `json:"phone_verified_at"`
+	UserID          string     `json:"user_id"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
 }
 
 type GetCustomerByUserID struct {
@@ -36,7 +38,7 @@ func NewGetCustomerByUserID(customerDAO dao.CustomerDAO) *GetCustomerByUserID {
 
 func (s *GetCustomerByUserID) Exec(ctx context.Context, req GetCustomerByUserIDReq) (*GetCustomerByUserIDResp, error) {
 	slog.InfoContext(ctx, "get customer by user id started", "req", req)
-	customer, err := s.customerDAO.FindOne(ctx, "user_id = $1", "", req.UserID)
+	customer, err := s.customerDAO.FindOne(ctx, "user_id = $1 AND deleted_at IS NULL", "", req.UserID)
 	if err != nil {
 		slog.ErrorContext(ctx, "find customer by user id failed", "error", err.Error())
 		return nil, err
@@ -47,13 +49,15 @@ func (s *GetCustomerByUserID) Exec(ctx context.Context, req GetCustomerByUserIDR
 
 func mapCustomerToGetCustomerByUserIDResp(customer *domain.Customer) *GetCustomerByUserIDResp {
 	return &GetCustomerByUserIDResp{
-		ID:        customer.GetID(),
-		FirstName: customer.GetFirstName(),
-		LastName:  customer.GetLastName(),
-		Email:     customer.GetEmailString(),
-		Phone:     customer.GetPhoneString(),
-		UserID:    customer.GetUserID(),
-		CreatedAt: customer.GetCreatedAt(),
-		UpdatedAt: customer.GetUpdatedAt(),
+		ID:              customer.GetID(),
+		FirstName:       customer.GetFirstName(),
+		LastName:        customer.GetLastName(),
+		Email:           customer.GetEmailString(),
+		Phone:           customer.GetPhoneString(),
+		EmailVerifiedAt: customer.GetEmailVerifiedAt(),
+		PhoneVerifiedAt: customer.GetPhoneVerifiedAt(),
+		UserID:          customer.GetUserID(),
+		CreatedAt:       customer.GetCreatedAt(),
+		UpdatedAt:       customer.GetUpdatedAt(),
 	}
 }
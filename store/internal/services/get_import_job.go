@@ -0,0 +1,78 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"ichibuy/store/internal/domain/dao"
+)
+
+type GetImportJobReq struct {
+	ID     string
+	UserID string
+}
+
+type ImportRowErrorDTO struct {
+	Row   int    `json:"row"`
+	Error string `json:"error"`
+}
+
+type GetImportJobResp struct {
+	ID          string              `json:"id"`
+	StoreID     string              `json:"store_id"`
+	Format      string              `json:"format"`
+	Status      string              `json:"status"`
+	TotalRows   int                 `json:"total_rows"`
+	CreatedRows int                 `json:"created_rows"`
+	FailedRows  int                 `json:"failed_rows"`
+	RowErrors   []ImportRowErrorDTO `json:"row_errors"`
+	CreatedAt   time.Time           `json:"created_at"`
+	UpdatedAt   time.Time           `json:"updated_at"`
+	FinishedAt  *time.Time          `json:"finished_at"`
+}
+
+type GetImportJob struct {
+	importJobDAO dao.ImportJobDAO
+}
+
+func NewGetImportJob(importJobDAO dao.ImportJobDAO) *GetImportJob {
+	return &GetImportJob{
+		importJobDAO: importJobDAO,
+	}
+}
+
+func (s *GetImportJob) Exec(ctx context.Context, req GetImportJobReq) (*GetImportJobResp, error) {
+	slog.InfoContext(ctx, "get import job started", "req", req)
+	job, err := s.importJobDAO.FindByPk(ctx, req.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "find import job failed", "error", err.Error())
+		return nil, err
+	}
+
+	if job.GetUserID() != req.UserID {
+		slog.ErrorContext(ctx, "import job does not belong to user", "import_job_id", job.GetID())
+		return nil, fmt.Errorf("user id does not match")
+	}
+
+	rowErrors := make([]ImportRowErrorDTO, len(job.GetRowErrors()))
+	for i, rowErr := range job.GetRowErrors() {
+		rowErrors[i] = ImportRowErrorDTO{Row: rowErr.Row, Error: rowErr.Error}
+	}
+
+	slog.InfoContext(ctx, "get import job finished", "import_job_id", job.GetID())
+	return &GetImportJobResp{
+		ID:          job.GetID(),
+		StoreID:     job.GetStoreID(),
+		Format:      string(job.GetFormat()),
+		Status:      string(job.GetStatus()),
+		TotalRows:   job.GetTotalRows(),
+		CreatedRows: job.GetCreatedRows(),
+		FailedRows:  job.GetFailedRows(),
+		RowErrors:   rowErrors,
+		CreatedAt:   job.GetCreatedAt(),
+		UpdatedAt:   job.GetUpdatedAt(),
+		FinishedAt:  job.GetFinishedAt(),
+	}, nil
+}
@@ -4,9 +4,16 @@ import (
 	"context"
 	"log/slog"
 
+	"ichibuy/store/internal/domain"
 	"ichibuy/store/internal/domain/dao"
 )
 
+type GetProductReq struct {
+	ID string
+	// Currency to display the converted prices in, optional
+	Currency string
+}
+
 type GetProductResp struct {
 	ID          string     `json:"id"`
 	Name        string     `json:"name"`
@@ -20,23 +27,33 @@ type GetProductResp struct {
 }
 
 type GetProduct struct {
-	productDAO dao.ProductDAO
+	productDAO   dao.ProductDAO
+	rateProvider domain.ExchangeRateProvider
 }
 
-func NewGetProduct(productDAO dao.ProductDAO) *GetProduct {
+func NewGetProduct(productDAO dao.ProductDAO, rateProvider domain.ExchangeRateProvider) *GetProduct {
 	return &GetProduct{
-		productDAO: productDAO,
+		productDAO:   productDAO,
+		rateProvider: rateProvider,
 	}
 }
 
-func (s *GetProduct) Exec(ctx context.Context, id string) (*GetProductResp, error) {
-	slog.InfoContext(ctx, "get product started", "id", id)
-	product, err := s.productDAO.FindByPk(ctx, id)
+func (s *GetProduct) Exec(ctx context.Context, req GetProductReq) (*GetProductResp, error) {
+	slog.InfoContext(ctx, "get product started", "req", req)
+	product, err := s.productDAO.FindOne(ctx, "id = $1 AND deleted_at IS NULL", "", req.ID)
 	if err != nil {
 		slog.ErrorContext(ctx, "find product failed", "error", err.Error())
 		return nil, err
 	}
 
+	prices := convertDomainPricesToDTOs(product.GetPrices())
+	if req.Currency != "" {
+		if err := convertPriceDTOs(ctx, s.rateProvider, prices, req.Currency); err != nil {
+			slog.ErrorContext(ctx, "convert prices failed", "error", err.Error())
+			return nil, err
+		}
+	}
+
 	slog.InfoContext(ctx, "get product finished", "product_id", product.GetID())
 	return &GetProductResp{
 		ID:          product.GetID(),
@@ -45,7 +62,7 @@ func (s *GetProduct) Exec(ctx context.Context, id string) (*GetProductResp, erro
 		Active:      product.GetActive(),
 		StoreID:     product.GetStoreID(),
 		Images:      convertDomainImagesToDTOs(product.GetImages()),
-		Prices:      convertDomainPricesToDTOs(product.GetPrices()),
+		Prices:      prices,
 		CreatedAt:   product.CreatedAt.Format("2006-01-02T15:04:05Z"),
 		UpdatedAt:   product.UpdatedAt.Format("2006-01-02T15:04:05Z"),
 	}, nil
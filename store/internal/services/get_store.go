@@ -20,9 +20,14 @@ type GetStoreResp struct {
 	Lat         float64   `json:"lat"`
 	Lng         float64   `json:"lng"`
 	Slug        string    `json:"slug"`
+	Currencies  []string  `json:"currencies"`
+	PhoneRegion string    `json:"phone_region"`
 	UserID      string    `json:"user_id"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
+	// Schedule is the opening hours of the store, IsOpenNow is computed from it
+	Schedule  domain.StoreSchedule `json:"schedule"`
+	IsOpenNow bool                 `json:"is_open_now"`
 }
 
 type GetStore struct {
@@ -37,7 +42,7 @@ func NewGetStore(storeDAO dao.StoreDAO) *GetStore {
 
 func (s *GetStore) Exec(ctx context.Context, req GetStoreReq) (*GetStoreResp, error) {
 	slog.InfoContext(ctx, "get store started", "req", req)
-	store, err := s.storeDAO.FindByPk(ctx, req.ID)
+	store, err := s.storeDAO.FindOne(ctx, "id = $1 AND deleted_at IS NULL", "", req.ID)
 	if err != nil {
 		slog.ErrorContext(ctx, "find store failed", "error", err.Error())
 		return nil, err
@@ -54,8 +59,12 @@ func mapStoreToGetStoreResp(store *domain.Store) *GetStoreResp {
 		Lat:         store.GetLat(),
 		Lng:         store.GetLng(),
 		Slug:        store.GetSlug(),
+		Currencies:  store.GetCurrencies(),
+		PhoneRegion: store.GetPhoneRegion(),
 		UserID:      store.GetUserID(),
 		CreatedAt:   store.GetCreatedAt(),
 		UpdatedAt:   store.GetUpdatedAt(),
+		Schedule:    store.Schedule(),
+		IsOpenNow:   store.IsOpenAt(time.Now()),
 	}
 }
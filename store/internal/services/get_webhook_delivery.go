@@ -0,0 +1,83 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"ichibuy/store/internal/domain/dao"
+)
+
+type GetWebhookDeliveryReq struct {
+	StoreID        string
+	SubscriptionID string
+	DeliveryID     string
+	UserID         string
+}
+
+type GetWebhookDeliveryResp struct {
+	WebhookDeliveryResp
+	Payload  json.RawMessage              `json:"payload" swaggertype:"object"`
+	Attempts []WebhookDeliveryAttemptResp `json:"attempt_log"`
+}
+
+// GetWebhookDelivery returns a delivery with the payload sent and every attempt with the response received
+type GetWebhookDelivery struct {
+	storeDAO                  dao.StoreDAO
+	webhookSubscriptionDAO    dao.WebhookSubscriptionDAO
+	webhookDeliveryDAO        dao.WebhookDeliveryDAO
+	webhookDeliveryAttemptDAO dao.WebhookDeliveryAttemptDAO
+}
+
+func NewGetWebhookDelivery(
+	storeDAO dao.StoreDAO,
+	webhookSubscriptionDAO dao.WebhookSubscriptionDAO,
+	webhookDeliveryDAO dao.WebhookDeliveryDAO,
+	webhookDeliveryAttemptDAO dao.WebhookDeliveryAttemptDAO,
+) *GetWebhookDelivery {
+	return &GetWebhookDelivery{
+		storeDAO:                  storeDAO,
+		webhookSubscriptionDAO:    webhookSubscriptionDAO,
+		webhookDeliveryDAO:        webhookDeliveryDAO,
+		webhookDeliveryAttemptDAO: webhookDeliveryAttemptDAO,
+	}
+}
+
+func (s *GetWebhookDelivery) Exec(ctx context.Context, req GetWebhookDeliveryReq) (*GetWebhookDeliveryResp, error) {
+	slog.InfoContext(ctx, "get webhook delivery started", "req", req)
+	subscription, err := findStoreWebhookSubscription(ctx, s.storeDAO, s.webhookSubscriptionDAO, req.StoreID, req.SubscriptionID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, err := s.webhookDeliveryDAO.FindOne(ctx, "id = $1 AND subscription_id = $2", "", req.DeliveryID, subscription.GetID())
+	if err != nil {
+		slog.ErrorContext(ctx, "find webhook delivery failed", "error", err.Error())
+		return nil, err
+	}
+
+	attempts, err := s.webhookDeliveryAttemptDAO.FindAll(ctx, "delivery_id = $1", "attempted_at ASC", delivery.GetID())
+	if err != nil {
+		slog.ErrorContext(ctx, "find webhook delivery attempts failed", "error", err.Error())
+		return nil, err
+	}
+
+	resp := &GetWebhookDeliveryResp{
+		WebhookDeliveryResp: mapWebhookDeliveryToResp(delivery),
+		Payload:             delivery.GetPayload(),
+		Attempts:            make([]WebhookDeliveryAttemptResp, len(attempts)),
+	}
+	for i, attempt := range attempts {
+		resp.Attempts[i] = WebhookDeliveryAttemptResp{
+			ID:           attempt.GetID(),
+			StatusCode:   attempt.GetStatusCode(),
+			ResponseBody: attempt.GetResponseBody(),
+			Error:        attempt.GetError(),
+			DurationMs:   attempt.GetDurationMs(),
+			AttemptedAt:  attempt.GetAttemptedAt(),
+		}
+	}
+
+	slog.InfoContext(ctx, "get webhook delivery finished", "delivery_id", delivery.GetID())
+	return resp, nil
+}
@@ -0,0 +1,41 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+
+	"ichibuy/store/internal/domain"
+)
+
+type CurrencyDTO struct {
+	Code       string `json:"code"`
+	MinorUnits int    `json:"minor_units"`
+	Symbol     string `json:"symbol"`
+}
+
+type ListCurrenciesResp struct {
+	Currencies []CurrencyDTO `json:"currencies"`
+}
+
+type ListCurrencies struct{}
+
+func NewListCurrencies() *ListCurrencies {
+	return &ListCurrencies{}
+}
+
+func (s *ListCurrencies) Exec(ctx context.Context) ListCurrenciesResp {
+	slog.InfoContext(ctx, "list currencies started")
+	currencies := domain.Currencies()
+
+	dtos := make([]CurrencyDTO, len(currencies))
+	for i, currency := range currencies {
+		dtos[i] = CurrencyDTO{
+			Code:       currency.Code,
+			MinorUnits: currency.MinorUnits,
+			Symbol:     currency.Symbol,
+		}
+	}
+
+	slog.InfoContext(ctx, "list currencies finished", "count", len(dtos))
+	return ListCurrenciesResp{Currencies: dtos}
+}
@@ -0,0 +1,49 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+
+	"ichibuy/store/internal/domain/dao"
+)
+
+type ListCustomerAddressesReq struct {
+	CustomerID string
+	UserID     string
+}
+
+type ListCustomerAddressesResp struct {
+	Addresses []CustomerAddressResp `json:"addresses"`
+}
+
+type ListCustomerAddresses struct {
+	customerDAO        dao.CustomerDAO
+	customerAddressDAO dao.CustomerAddressDAO
+}
+
+func NewListCustomerAddresses(customerDAO dao.CustomerDAO, customerAddressDAO dao.CustomerAddressDAO) *ListCustomerAddresses {
+	return &ListCustomerAddresses{
+		customerDAO:        customerDAO,
+		customerAddressDAO: customerAddressDAO,
+	}
+}
+
+func (s *ListCustomerAddresses) Exec(ctx context.Context, req ListCustomerAddressesReq) (*ListCustomerAddressesResp, error) {
+	slog.InfoContext(ctx, "list customer addresses started", "req", req)
+	customer, err := findCustomerWithAddresses(ctx, s.customerDAO, s.customerAddressDAO, req.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := customer.CheckOwner(req.UserID); err != nil {
+		return nil, err
+	}
+
+	addresses := make([]CustomerAddressResp, len(customer.GetAddresses()))
+	for i, address := range customer.GetAddresses() {
+		addresses[i] = *mapCustomerAddressToResp(address)
+	}
+
+	slog.InfoContext(ctx, "list customer addresses finished", "customer_id", customer.GetID(), "count", len(addresses))
+	return &ListCustomerAddressesResp{Addresses: addresses}, nil
+}
@@ -0,0 +1,35 @@
+package services
+
+import (
+	"context"
+
+	"ichibuy/store/internal/domain"
+)
+
+type ListEventSchemasReq struct {
+	// Type filters the schemas of an event type, every type when empty
+	Type string
+}
+
+type ListEventSchemasResp struct {
+	Schemas []*domain.EventSchema `json:"schemas"`
+}
+
+// ListEventSchemas returns the schema registry, every version of the data schema of the published events
+type ListEventSchemas struct {
+	schemas *domain.SchemaRegistry
+}
+
+func NewListEventSchemas(schemas *domain.SchemaRegistry) *ListEventSchemas {
+	return &ListEventSchemas{schemas: schemas}
+}
+
+func (s *ListEventSchemas) Exec(ctx context.Context, req ListEventSchemasReq) (*ListEventSchemasResp, error) {
+	resp := &ListEventSchemasResp{Schemas: []*domain.EventSchema{}}
+	for _, schema := range s.schemas.All() {
+		if req.Type == "" || string(schema.Type) == req.Type {
+			resp.Schemas = append(resp.Schemas, schema)
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,182 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"ichibuy/store/internal/domain"
+	"ichibuy/store/internal/domain/dao"
+)
+
+const (
+	defaultEventsLimit = 100
+	maxEventsLimit     = 500
+)
+
+type ListEventsReq struct {
+	// After is the ID of the last event received, the feed starts from the first event when empty
+	After string
+	Types []string
+	// From and To bound the timestamps of the events, AggregateID filters the events of an aggregate
+	From        *time.Time
+	To          *time.Time
+	AggregateID string
+	Limit       int
+}
+
+type ListEventsResp struct {
+	Events []EventDTO `json:"events"`
+}
+
+type EventDTO struct {
+	ID               string          `json:"id"`
+	Type             string          `json:"type"`
+	SchemaVersion    int             `json:"schema_version"`
+	AggregateType    string          `json:"aggregate_type"`
+	AggregateID      string          `json:"aggregate_id"`
+	AggregateVersion int             `json:"aggregate_version"`
+	Producer         string          `json:"producer"`
+	CorrelationID    *string         `json:"correlation_id"`
+	CausationID      *string         `json:"causation_id"`
+	Data             json.RawMessage `json:"data" swaggertype:"object"`
+	Timestamp        time.Time       `json:"timestamp"`
+}
+
+// ListEvents is the feed of the store events, read by the other services in (transaction_id, position) order
+type ListEvents struct {
+	eventDAO dao.EventDAO
+}
+
+func NewListEvents(eventDAO dao.EventDAO) *ListEvents {
+	return &ListEvents{eventDAO: eventDAO}
+}
+
+func (s *ListEvents) Exec(ctx context.Context, req ListEventsReq) (*ListEventsResp, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultEventsLimit
+	}
+	if limit > maxEventsLimit {
+		limit = maxEventsLimit
+	}
+
+	types := make([]domain.EventType, len(req.Types))
+	for i, eventType := range req.Types {
+		types[i] = domain.EventType(eventType)
+	}
+
+	events, err := findLocalEvents(ctx, s.eventDAO, eventsQuery{
+		After:       req.After,
+		Types:       types,
+		From:        req.From,
+		To:          req.To,
+		AggregateID: req.AggregateID,
+		Limit:       limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ListEventsResp{Events: make([]EventDTO, len(events))}
+	for i, event := range events {
+		resp.Events[i] = EventDTO{
+			ID:               event.ID,
+			Type:             string(event.Type),
+			SchemaVersion:    event.SchemaVersion,
+			AggregateType:    string(event.AggregateType),
+			AggregateID:      event.AggregateID,
+			AggregateVersion: event.AggregateVersion,
+			Producer:         event.Producer,
+			CorrelationID:    event.CorrelationID,
+			CausationID:      event.CausationID,
+			Data:             event.Data,
+			Timestamp:        event.Timestamp,
+		}
+	}
+
+	return resp, nil
+}
+
+// eventsQuery filters the events table, the zero value reads the first events
+type eventsQuery struct {
+	After       string
+	Types       []domain.EventType
+	From        *time.Time
+	To          *time.Time
+	AggregateID string
+	Limit       int
+}
+
+// localEvents reads the events table of the store service in (transaction_id, position) order
+func localEvents(eventDAO dao.EventDAO) eventsSource {
+	return func(ctx context.Context, after string, types []domain.EventType, limit int) ([]domain.Event, error) {
+		return findLocalEvents(ctx, eventDAO, eventsQuery{After: after, Types: types, Limit: limit})
+	}
+}
+
+// visibleEventsWhere keeps the events of the transactions finished before every running transaction
+const visibleEventsWhere = "transaction_id < pg_snapshot_xmin(pg_current_snapshot())"
+
+// findLocalEvents returns the events matching the query in (transaction_id, position) order
+func findLocalEvents(ctx context.Context, eventDAO dao.EventDAO, query eventsQuery) ([]domain.Event, error) {
+	if query.After != "" {
+		_, err := eventDAO.FindByPk(ctx, query.After)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("event %s not found", query.After)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	where, args := eventsWhere(query)
+	events, err := eventDAO.FindPaginated(ctx, query.Limit, 0, where, "transaction_id ASC, position ASC", args...)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.Event, len(events))
+	for i, event := range events {
+		result[i] = *event
+	}
+	return result, nil
+}
+
+// eventsWhere filters the events matching the query after the event in query.After. Events get their position
+// before their transaction commits, so a later position can commit first; only the events of the transactions
+// older than every running one are read, so the cursor never moves past an event that commits later.
+func eventsWhere(query eventsQuery) (string, []any) {
+	var args []any
+	where := visibleEventsWhere
+	if query.After != "" {
+		args = append(args, query.After)
+		where += " AND (transaction_id, position) > (SELECT transaction_id, position FROM events WHERE id = $1)"
+	}
+	if len(query.Types) > 0 {
+		placeholders := make([]string, len(query.Types))
+		for i, eventType := range query.Types {
+			args = append(args, eventType)
+			placeholders[i] = fmt.Sprintf("$%d", len(args))
+		}
+		where += fmt.Sprintf(" AND type IN (%s)", strings.Join(placeholders, ", "))
+	}
+	if query.From != nil {
+		args = append(args, *query.From)
+		where += fmt.Sprintf(` AND "timestamp" >= $%d`, len(args))
+	}
+	if query.To != nil {
+		args = append(args, *query.To)
+		where += fmt.Sprintf(` AND "timestamp" < $%d`, len(args))
+	}
+	if query.AggregateID != "" {
+		args = append(args, query.AggregateID)
+		where += fmt.Sprintf(" AND aggregate_id = $%d", len(args))
+	}
+
+	return where, args
+}
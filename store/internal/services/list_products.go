@@ -15,13 +15,16 @@ type ListProductsReq struct {
 	Filters    ProductFilters
 	Pagination Pagination
 	Sorting    Sorting
+	// Currency to display the converted prices in, optional
+	Currency string
 }
 
 type ProductFilters struct {
-	StoreID     string
-	Name        *string
-	Description *string
-	Active      *bool
+	StoreID        string
+	Name           *string
+	Description    *string
+	Active         *bool
+	IncludeDeleted bool
 }
 
 type ProductListItem struct {
@@ -34,6 +37,7 @@ type ProductListItem struct {
 	Prices      []PriceDTO `json:"prices"`
 	CreatedAt   time.Time  `json:"created_at"`
 	UpdatedAt   time.Time  `json:"updated_at"`
+	DeletedAt   *time.Time `json:"deleted_at"`
 }
 
 type ListProductsResp struct {
@@ -44,12 +48,14 @@ type ListProductsResp struct {
 }
 
 type ListProducts struct {
-	productDAO dao.ProductDAO
+	productDAO   dao.ProductDAO
+	rateProvider domain.ExchangeRateProvider
 }
 
-func NewListProducts(productDAO dao.ProductDAO) *ListProducts {
+func NewListProducts(productDAO dao.ProductDAO, rateProvider domain.ExchangeRateProvider) *ListProducts {
 	return &ListProducts{
-		productDAO: productDAO,
+		productDAO:   productDAO,
+		rateProvider: rateProvider,
 	}
 }
 
@@ -83,6 +89,10 @@ func (s *ListProducts) Exec(ctx context.Context, req ListProductsReq) (ListProdu
 		i++
 	}
 
+	if !req.Filters.IncludeDeleted {
+		whereParts = append(whereParts, "deleted_at IS NULL")
+	}
+
 	where := strings.Join(whereParts, " AND ")
 
 	sort := ""
@@ -113,9 +123,19 @@ func (s *ListProducts) Exec(ctx context.Context, req ListProductsReq) (ListProdu
 		return ListProductsResp{}, err
 	}
 
+	items := mapProductsToListProductsResp(products)
+	if req.Currency != "" {
+		for _, item := range items {
+			if err := convertPriceDTOs(ctx, s.rateProvider, item.Prices, req.Currency); err != nil {
+				slog.ErrorContext(ctx, "convert prices failed", "error", err.Error())
+				return ListProductsResp{}, err
+			}
+		}
+	}
+
 	slog.InfoContext(ctx, "list products finished", "total", total, "count", len(products))
 	return ListProductsResp{
-		Products: mapProductsToListProductsResp(products),
+		Products: items,
 		Total:    total,
 		Limit:    req.Pagination.Limit,
 		Offset:   req.Pagination.Offset,
@@ -135,6 +155,7 @@ func mapProductsToListProductsResp(products []*domain.Product) []ProductListItem
 			Prices:      convertDomainPricesToDTOs(product.GetPrices()),
 			CreatedAt:   product.CreatedAt,
 			UpdatedAt:   product.UpdatedAt,
+			DeletedAt:   product.GetDeletedAt(),
 		}
 	}
 	return response
@@ -153,6 +174,29 @@ func convertDomainImagesToDTOs(images map[string]domain.Image) []ImageDTO {
 	return dtos
 }
 
+// convertPriceDTOs fills the converted price of each dto in the given currency
+func convertPriceDTOs(ctx context.Context, rateProvider domain.ExchangeRateProvider, dtos []PriceDTO, currency string) error {
+	target, err := domain.FindCurrency(currency)
+	if err != nil {
+		return err
+	}
+
+	for i, dto := range dtos {
+		rate, err := rateProvider.GetRate(ctx, dto.Currency, target.Code)
+		if err != nil {
+			return err
+		}
+
+		converted, err := domain.Money{Amount: dto.Amount, Currency: dto.Currency}.Convert(rate)
+		if err != nil {
+			return err
+		}
+
+		dtos[i].Converted = &MoneyDTO{Amount: converted.GetAmount(), Currency: converted.GetCurrency()}
+	}
+	return nil
+}
+
 func convertDomainPricesToDTOs(prices map[string]domain.Price) []PriceDTO {
 	dtos := make([]PriceDTO, len(prices))
 	i := 0
@@ -18,21 +18,28 @@ type ListStoresReq struct {
 }
 
 type StoreFilters struct {
-	UserID      string
-	Name        *string
-	Description *string
+	UserID         string
+	Name           *string
+	Description    *string
+	IncludeDeleted bool
 }
 
 type StoreListItem struct {
-	ID          string    `json:"id"`
-	Name        string    `json:"name"`
-	Description *string   `json:"description"`
-	Lat         float64   `json:"lat"`
-	Lng         float64   `json:"lng"`
-	Slug        string    `json:"slug"`
-	UserID      string    `json:"user_id"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Description *string    `json:"description"`
+	Lat         float64    `json:"lat"`
+	Lng         float64    `json:"lng"`
+	Slug        string     `json:"slug"`
+	Currencies  []string   `json:"currencies"`
+	PhoneRegion string     `json:"phone_region"`
+	UserID      string     `json:"user_id"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	DeletedAt   *time.Time `json:"deleted_at"`
+	// Schedule is the opening hours of the store, IsOpenNow is computed from it
+	Schedule  domain.StoreSchedule `json:"schedule"`
+	IsOpenNow bool                 `json:"is_open_now"`
 }
 
 type ListStoresResp struct {
@@ -76,6 +83,10 @@ func (s *ListStores) Exec(ctx context.Context, req ListStoresReq) (ListStoresRes
 		i++
 	}
 
+	if !req.Filters.IncludeDeleted {
+		whereParts = append(whereParts, "deleted_at IS NULL")
+	}
+
 	where := strings.Join(whereParts, " AND ")
 
 	sort := ""
@@ -116,6 +127,7 @@ func (s *ListStores) Exec(ctx context.Context, req ListStoresReq) (ListStoresRes
 }
 
 func mapStoresToListStoresResp(stores []*domain.Store) []StoreListItem {
+	now := time.Now()
 	response := make([]StoreListItem, len(stores))
 	for i, store := range stores {
 		response[i] = StoreListItem{
@@ -125,9 +137,14 @@ func mapStoresToListStoresResp(stores []*domain.Store) []StoreListItem {
 			Lat:         store.GetLat(),
 			Lng:         store.GetLng(),
 			Slug:        store.GetSlug(),
+			Currencies:  store.GetCurrencies(),
+			PhoneRegion: store.GetPhoneRegion(),
 			UserID:      store.GetUserID(),
 			CreatedAt:   store.GetCreatedAt(),
 			UpdatedAt:   store.GetUpdatedAt(),
+			DeletedAt:   store.GetDeletedAt(),
+			Schedule:    store.Schedule(),
+			IsOpenNow:   store.IsOpenAt(now),
 		}
 	}
 	return response
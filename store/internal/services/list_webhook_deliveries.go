@@ -0,0 +1,84 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"ichibuy/store/internal/domain"
+	"ichibuy/store/internal/domain/dao"
+)
+
+type ListWebhookDeliveriesReq struct {
+	StoreID        string
+	SubscriptionID string
+	Status         *string
+	Pagination     Pagination
+	UserID         string
+}
+
+type ListWebhookDeliveriesResp struct {
+	Deliveries []WebhookDeliveryResp `json:"deliveries"`
+	Total      int64                 `json:"total"`
+	Limit      int                   `json:"limit"`
+	Offset     int                   `json:"offset"`
+}
+
+// ListWebhookDeliveries is the delivery log of a webhook subscription, newest first
+type ListWebhookDeliveries struct {
+	storeDAO               dao.StoreDAO
+	webhookSubscriptionDAO dao.WebhookSubscriptionDAO
+	webhookDeliveryDAO     dao.WebhookDeliveryDAO
+}
+
+func NewListWebhookDeliveries(storeDAO dao.StoreDAO, webhookSubscriptionDAO dao.WebhookSubscriptionDAO, webhookDeliveryDAO dao.WebhookDeliveryDAO) *ListWebhookDeliveries {
+	return &ListWebhookDeliveries{
+		storeDAO:               storeDAO,
+		webhookSubscriptionDAO: webhookSubscriptionDAO,
+		webhookDeliveryDAO:     webhookDeliveryDAO,
+	}
+}
+
+func (s *ListWebhookDeliveries) Exec(ctx context.Context, req ListWebhookDeliveriesReq) (*ListWebhookDeliveriesResp, error) {
+	slog.InfoContext(ctx, "list webhook deliveries started", "req", req)
+	subscription, err := findStoreWebhookSubscription(ctx, s.storeDAO, s.webhookSubscriptionDAO, req.StoreID, req.SubscriptionID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	where := "subscription_id = $1"
+	args := []any{subscription.GetID()}
+	if req.Status != nil {
+		status := domain.WebhookDeliveryStatus(*req.Status)
+		if status != domain.PendingWebhookDeliveryStatus && status != domain.SucceededWebhookDeliveryStatus && status != domain.FailedWebhookDeliveryStatus {
+			return nil, fmt.Errorf("invalid status %s", *req.Status)
+		}
+		where += " AND status = $2"
+		args = append(args, status)
+	}
+
+	total, err := s.webhookDeliveryDAO.Count(ctx, where, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "count webhook deliveries failed", "error", err.Error())
+		return nil, err
+	}
+
+	deliveries, err := s.webhookDeliveryDAO.FindPaginated(ctx, req.Pagination.Limit, req.Pagination.Offset, where, "created_at DESC, id DESC", args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "find paginated webhook deliveries failed", "error", err.Error())
+		return nil, err
+	}
+
+	resp := &ListWebhookDeliveriesResp{
+		Deliveries: make([]WebhookDeliveryResp, len(deliveries)),
+		Total:      total,
+		Limit:      req.Pagination.Limit,
+		Offset:     req.Pagination.Offset,
+	}
+	for i, delivery := range deliveries {
+		resp.Deliveries[i] = mapWebhookDeliveryToResp(delivery)
+	}
+
+	slog.InfoContext(ctx, "list webhook deliveries finished", "total", total, "count", len(deliveries))
+	return resp, nil
+}
@@ -0,0 +1,50 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+
+	"ichibuy/store/internal/domain/dao"
+)
+
+type ListWebhookSubscriptionsReq struct {
+	StoreID string
+	UserID  string
+}
+
+type ListWebhookSubscriptionsResp struct {
+	Webhooks []WebhookSubscriptionResp `json:"webhooks"`
+}
+
+type ListWebhookSubscriptions struct {
+	storeDAO               dao.StoreDAO
+	webhookSubscriptionDAO dao.WebhookSubscriptionDAO
+}
+
+func NewListWebhookSubscriptions(storeDAO dao.StoreDAO, webhookSubscriptionDAO dao.WebhookSubscriptionDAO) *ListWebhookSubscriptions {
+	return &ListWebhookSubscriptions{
+		storeDAO:               storeDAO,
+		webhookSubscriptionDAO: webhookSubscriptionDAO,
+	}
+}
+
+func (s *ListWebhookSubscriptions) Exec(ctx context.Context, req ListWebhookSubscriptionsReq) (*ListWebhookSubscriptionsResp, error) {
+	slog.InfoContext(ctx, "list webhook subscriptions started", "req", req)
+	if _, err := findOwnedStore(ctx, s.storeDAO, req.StoreID, req.UserID); err != nil {
+		return nil, err
+	}
+
+	subscriptions, err := s.webhookSubscriptionDAO.FindAll(ctx, "store_id = $1", "created_at ASC", req.StoreID)
+	if err != nil {
+		slog.ErrorContext(ctx, "find webhook subscriptions failed", "error", err.Error())
+		return nil, err
+	}
+
+	resp := &ListWebhookSubscriptionsResp{Webhooks: make([]WebhookSubscriptionResp, len(subscriptions))}
+	for i, subscription := range subscriptions {
+		resp.Webhooks[i] = mapWebhookSubscriptionToResp(subscription)
+	}
+
+	slog.InfoContext(ctx, "list webhook subscriptions finished", "count", len(subscriptions))
+	return resp, nil
+}
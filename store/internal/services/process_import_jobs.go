@@ -0,0 +1,280 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"ichibuy/store/internal/domain"
+	"ichibuy/store/internal/domain/dao"
+)
+
+const (
+	importBatchSize = 50
+	// importJobLease is how long a worker holds a job without renewing it, the job is renewed while it runs
+	importJobLease = 5 * time.Minute
+)
+
+type ProcessImportJobs struct {
+	importJobDAO   dao.ImportJobDAO
+	productDAO     dao.ProductDAO
+	storeDAO       dao.StoreDAO
+	eventBus       domain.EventBus
+	nextID         domain.NextID
+	productFactory *domain.ProductFactory
+	fileFetcher    domain.FileFetcher
+	storageSvc     domain.StorageService
+}
+
+func NewProcessImportJobs(
+	importJobDAO dao.ImportJobDAO,
+	productDAO dao.ProductDAO,
+	storeDAO dao.StoreDAO,
+	eventBus domain.EventBus,
+	nextID domain.NextID,
+	productFactory *domain.ProductFactory,
+	fileFetcher domain.FileFetcher,
+	storageSvc domain.StorageService,
+) *ProcessImportJobs {
+	return &ProcessImportJobs{
+		importJobDAO:   importJobDAO,
+		productDAO:     productDAO,
+		storeDAO:       storeDAO,
+		eventBus:       eventBus,
+		nextID:         nextID,
+		productFactory: productFactory,
+		fileFetcher:    fileFetcher,
+		storageSvc:     storageSvc,
+	}
+}
+
+// Exec processes every pending import job, oldest first, and the processing jobs whose worker stopped. Jobs
+// are claimed one at a time, so workers running side by side never import the same job.
+func (s *ProcessImportJobs) Exec(ctx context.Context) error {
+	slog.InfoContext(ctx, "process import jobs started")
+	count := 0
+	for {
+		job, err := s.claim(ctx)
+		if errors.Is(err, sql.ErrNoRows) {
+			break
+		}
+		if err != nil {
+			slog.ErrorContext(ctx, "claim import job failed", "error", err.Error())
+			return err
+		}
+
+		if err := s.process(ctx, job); err != nil {
+			slog.ErrorContext(ctx, "process import job failed", "import_job_id", job.GetID(), "error", err.Error())
+			return err
+		}
+		count++
+	}
+
+	slog.InfoContext(ctx, "process import jobs finished", "count", count)
+	return nil
+}
+
+// claim moves the oldest pending job, or processing job whose lease ended, to processing in a single
+// transaction. The row is locked with SKIP LOCKED, so a job being claimed by another worker is skipped
+// instead of waited for.
+func (s *ProcessImportJobs) claim(ctx context.Context) (*domain.ImportJob, error) {
+	var job *domain.ImportJob
+	err := s.importJobDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		now := time.Now().UTC()
+		var err error
+		job, err = s.importJobDAO.FindOne(
+			ctx,
+			"status = $1 OR (status = $2 AND locked_until <= $3)",
+			"created_at ASC LIMIT 1 FOR UPDATE SKIP LOCKED",
+			domain.PendingImportJobStatus, domain.ProcessingImportJobStatus, now,
+		)
+		if err != nil {
+			return err
+		}
+
+		if job.GetLastRow() > 0 {
+			slog.InfoContext(ctx, "resuming import job", "import_job_id", job.GetID(), "last_row", job.GetLastRow())
+		}
+
+		if err := job.Start(now, importJobLease); err != nil {
+			return err
+		}
+
+		return s.importJobDAO.Update(ctx, job)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+func (s *ProcessImportJobs) process(ctx context.Context, job *domain.ImportJob) error {
+	store, err := s.storeDAO.FindOne(ctx, "id = $1 AND deleted_at IS NULL", "", job.GetStoreID())
+	if err != nil {
+		job.Fail(err.Error())
+		return s.importJobDAO.Update(ctx, job)
+	}
+
+	rows, rowErrors, err := parseCatalogFile(job.GetFormat(), job.GetPayload())
+	if err != nil {
+		job.Fail(err.Error())
+		return s.importJobDAO.Update(ctx, job)
+	}
+
+	// a resumed job keeps the products and row errors of the rows it already saved
+	created := job.GetCreatedRows()
+	lastRow := job.GetLastRow()
+	if lastRow > 0 {
+		resumedErrors := []domain.ImportRowError{}
+		for _, rowError := range job.GetRowErrors() {
+			if rowError.Row <= lastRow {
+				resumedErrors = append(resumedErrors, rowError)
+			}
+		}
+		for _, rowError := range rowErrors {
+			if rowError.Row > lastRow {
+				resumedErrors = append(resumedErrors, rowError)
+			}
+		}
+		rowErrors = resumedErrors
+	}
+
+	batch := []*domain.Product{}
+	batchRows := []CatalogRow{}
+
+	// flush saves the batch with the progress of the job up to line, so a resumed job never saves a row twice
+	flush := func(line int) error {
+		defer func() {
+			batch = []*domain.Product{}
+			batchRows = []CatalogRow{}
+		}()
+
+		if err := job.Progress(line, created+len(batch), rowErrors, time.Now().UTC(), importJobLease); err != nil {
+			return err
+		}
+
+		err := s.saveBatch(ctx, job, batch)
+		if err == nil {
+			created += len(batch)
+			return nil
+		}
+
+		slog.ErrorContext(ctx, "save import batch failed", "import_job_id", job.GetID(), "error", err.Error())
+		s.discardImages(ctx, batch)
+		for _, row := range batchRows {
+			rowErrors = append(rowErrors, domain.ImportRowError{Row: row.Line, Error: err.Error()})
+		}
+
+		if err := job.Progress(line, created, rowErrors, time.Now().UTC(), importJobLease); err != nil {
+			return err
+		}
+		return s.importJobDAO.Update(ctx, job)
+	}
+
+	for _, row := range rows {
+		if row.Line <= lastRow {
+			continue
+		}
+
+		if now := time.Now().UTC(); job.NeedsRenewal(now, importJobLease) {
+			job.Renew(now, importJobLease)
+			if err := s.importJobDAO.Update(ctx, job); err != nil {
+				return err
+			}
+		}
+
+		product, err := s.newProduct(ctx, store, row)
+		if err != nil {
+			rowErrors = append(rowErrors, domain.ImportRowError{Row: row.Line, Error: err.Error()})
+			continue
+		}
+
+		batch = append(batch, product)
+		batchRows = append(batchRows, row)
+
+		if len(batch) == importBatchSize {
+			if err := flush(row.Line); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(batch) > 0 {
+		if err := flush(batchRows[len(batchRows)-1].Line); err != nil {
+			return err
+		}
+	}
+
+	sort.Slice(rowErrors, func(i, j int) bool { return rowErrors[i].Row < rowErrors[j].Row })
+
+	if err := job.Finish(created, rowErrors); err != nil {
+		return err
+	}
+
+	slog.InfoContext(ctx, "import job finished", "import_job_id", job.GetID(), "created_rows", created, "failed_rows", len(rowErrors))
+	return s.importJobDAO.Update(ctx, job)
+}
+
+func (s *ProcessImportJobs) newProduct(ctx context.Context, store *domain.Store, row CatalogRow) (*domain.Product, error) {
+	if len(row.ImageURLs) == 0 {
+		return nil, fmt.Errorf("at least one image is required")
+	}
+
+	prices, err := convertNewPriceDTOsToDomain(row.Prices, s.nextID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := store.CheckPrices(prices); err != nil {
+		return nil, err
+	}
+
+	fileRequests := make([]domain.UploadFileRequest, 0, len(row.ImageURLs))
+	for _, url := range row.ImageURLs {
+		file, err := s.fileFetcher.FetchFile(ctx, url)
+		if err != nil {
+			return nil, err
+		}
+		fileRequests = append(fileRequests, *file)
+	}
+
+	return s.productFactory.NewProduct(ctx, row.Name, row.Description, row.Active, store.GetID(), fileRequests, prices)
+}
+
+// saveBatch creates the products with their events and the progress of the job in a single transaction
+func (s *ProcessImportJobs) saveBatch(ctx context.Context, job *domain.ImportJob, products []*domain.Product) error {
+	events := []domain.Event{}
+	for _, product := range products {
+		events = append(events, product.PullEvents()...)
+	}
+
+	return s.productDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := s.productDAO.CreateMany(ctx, products); err != nil {
+			return err
+		}
+
+		if err := s.eventBus.Publish(ctx, events...); err != nil {
+			return err
+		}
+
+		return s.importJobDAO.Update(ctx, job)
+	})
+}
+
+// discardImages removes the already uploaded images of products that could not be saved
+func (s *ProcessImportJobs) discardImages(ctx context.Context, products []*domain.Product) {
+	imageIDs := []string{}
+	for _, product := range products {
+		for id := range product.GetImages() {
+			imageIDs = append(imageIDs, id)
+		}
+	}
+
+	if err := s.storageSvc.DeleteFiles(ctx, imageIDs); err != nil {
+		slog.ErrorContext(ctx, "delete files from storage failed", "image_ids", imageIDs, "error", err.Error())
+	}
+}
@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"ichibuy/store/internal/domain"
+	"ichibuy/store/internal/domain/dao"
+)
+
+// PurgeDeleted hard deletes the stores, products and customers soft deleted before the retention window
+type PurgeDeleted struct {
+	storeDAO    dao.StoreDAO
+	productDAO  dao.ProductDAO
+	customerDAO dao.CustomerDAO
+	eventBus    domain.EventBus
+	storageSvc  domain.StorageService
+	retention   time.Duration
+}
+
+func NewPurgeDeleted(
+	storeDAO dao.StoreDAO,
+	productDAO dao.ProductDAO,
+	customerDAO dao.CustomerDAO,
+	eventBus domain.EventBus,
+	storageSvc domain.StorageService,
+	retention time.Duration,
+) *PurgeDeleted {
+	return &PurgeDeleted{
+		storeDAO:    storeDAO,
+		productDAO:  productDAO,
+		customerDAO: customerDAO,
+		eventBus:    eventBus,
+		storageSvc:  storageSvc,
+		retention:   retention,
+	}
+}
+
+func (s *PurgeDeleted) Exec(ctx context.Context) error {
+	cutoff := domain.PurgeCutoff(time.Now().UTC(), s.retention)
+	slog.InfoContext(ctx, "purge deleted started", "cutoff", cutoff)
+
+	products, err := s.productDAO.FindAll(ctx, "deleted_at IS NOT NULL AND deleted_at < $1", "deleted_at ASC", cutoff)
+	if err != nil {
+		slog.ErrorContext(ctx, "find deleted products failed", "error", err.Error())
+		return err
+	}
+
+	for _, product := range products {
+		if err := s.purgeProducts(ctx, []*domain.Product{product}, nil); err != nil {
+			slog.ErrorContext(ctx, "purge product failed", "product_id", product.GetID(), "error", err.Error())
+			return err
+		}
+	}
+
+	stores, err := s.storeDAO.FindAll(ctx, "deleted_at IS NOT NULL AND deleted_at < $1", "deleted_at ASC", cutoff)
+	if err != nil {
+		slog.ErrorContext(ctx, "find deleted stores failed", "error", err.Error())
+		return err
+	}
+
+	for _, store := range stores {
+		storeProducts, err := s.productDAO.FindAll(ctx, "store_id = $1", "", store.GetID())
+		if err != nil {
+			slog.ErrorContext(ctx, "find store products failed", "store_id", store.GetID(), "error", err.Error())
+			return err
+		}
+
+		if err := s.purgeProducts(ctx, storeProducts, store); err != nil {
+			slog.ErrorContext(ctx, "purge store failed", "store_id", store.GetID(), "error", err.Error())
+			return err
+		}
+	}
+
+	customers, err := s.customerDAO.FindAll(ctx, "deleted_at IS NOT NULL AND deleted_at < $1", "deleted_at ASC", cutoff)
+	if err != nil {
+		slog.ErrorContext(ctx, "find deleted customers failed", "error", err.Error())
+		return err
+	}
+
+	customerIDs := make([]string, len(customers))
+	for i, customer := range customers {
+		customerIDs[i] = customer.GetID()
+	}
+
+	if err := s.customerDAO.DeleteManyByPks(ctx, customerIDs); err != nil {
+		slog.ErrorContext(ctx, "delete customers failed", "error", err.Error())
+		return err
+	}
+
+	slog.InfoContext(ctx, "purge deleted finished", "products", len(products), "stores", len(stores), "customers", len(customers))
+	return nil
+}
+
+// purgeProducts removes the products rows, along with their store when given, and then their images
+func (s *PurgeDeleted) purgeProducts(ctx context.Context, products []*domain.Product, store *domain.Store) error {
+	ids := make([]string, len(products))
+	events := []domain.Event{}
+	for i, product := range products {
+		product.PreparePurge()
+		ids[i] = product.GetID()
+		events = append(events, product.PullEvents()...)
+	}
+
+	err := s.productDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := s.productDAO.DeleteManyByPks(ctx, ids); err != nil {
+			return err
+		}
+
+		if store != nil {
+			if err := s.storeDAO.DeleteByPk(ctx, store.GetID()); err != nil {
+				return err
+			}
+		}
+
+		return s.eventBus.Publish(ctx, events...)
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, product := range products {
+		product.PurgeImages(ctx, s.storageSvc)
+	}
+	return nil
+}
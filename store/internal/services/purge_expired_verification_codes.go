@@ -0,0 +1,44 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"ichibuy/store/internal/domain/dao"
+)
+
+// PurgeExpiredVerificationCodes deletes the verification codes past their expiry
+type PurgeExpiredVerificationCodes struct {
+	verificationCodeDAO dao.VerificationCodeDAO
+}
+
+func NewPurgeExpiredVerificationCodes(verificationCodeDAO dao.VerificationCodeDAO) *PurgeExpiredVerificationCodes {
+	return &PurgeExpiredVerificationCodes{
+		verificationCodeDAO: verificationCodeDAO,
+	}
+}
+
+func (s *PurgeExpiredVerificationCodes) Exec(ctx context.Context) error {
+	now := time.Now().UTC()
+	slog.InfoContext(ctx, "purge expired verification codes started", "now", now)
+
+	codes, err := s.verificationCodeDAO.FindAll(ctx, "expires_at < $1", "", now)
+	if err != nil {
+		slog.ErrorContext(ctx, "find expired verification codes failed", "error", err.Error())
+		return err
+	}
+
+	ids := make([]string, len(codes))
+	for i, code := range codes {
+		ids[i] = code.GetID()
+	}
+
+	if err := s.verificationCodeDAO.DeleteManyByPks(ctx, ids); err != nil {
+		slog.ErrorContext(ctx, "delete expired verification codes failed", "error", err.Error())
+		return err
+	}
+
+	slog.InfoContext(ctx, "purge expired verification codes finished", "count", len(ids))
+	return nil
+}
@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+
+	"ichibuy/store/internal/domain"
+	"ichibuy/store/internal/domain/dao"
+)
+
+const (
+	webhookStoreEventsConsumer = "store.webhooks.store_events"
+	webhookOrderEventsConsumer = "store.webhooks.order_events"
+)
+
+// QueueWebhookDeliveries creates a delivery for every event matching an active webhook subscription of its
+// store. Store and product events are read from the events table and order events from the order service
+// feed, each with its own checkpoint.
+type QueueWebhookDeliveries struct {
+	eventCheckpointDAO     dao.EventCheckpointDAO
+	eventDAO               dao.EventDAO
+	webhookSubscriptionDAO dao.WebhookSubscriptionDAO
+	webhookDeliveryDAO     dao.WebhookDeliveryDAO
+	nextID                 domain.NextID
+	// orderEventsSvc is nil when the order events feed is not configured
+	orderEventsSvc domain.EventsService
+}
+
+func NewQueueWebhookDeliveries(
+	eventCheckpointDAO dao.EventCheckpointDAO,
+	eventDAO dao.EventDAO,
+	webhookSubscriptionDAO dao.WebhookSubscriptionDAO,
+	webhookDeliveryDAO dao.WebhookDeliveryDAO,
+	nextID domain.NextID,
+	orderEventsSvc domain.EventsService,
+) *QueueWebhookDeliveries {
+	return &QueueWebhookDeliveries{
+		eventCheckpointDAO:     eventCheckpointDAO,
+		eventDAO:               eventDAO,
+		webhookSubscriptionDAO: webhookSubscriptionDAO,
+		webhookDeliveryDAO:     webhookDeliveryDAO,
+		nextID:                 nextID,
+		orderEventsSvc:         orderEventsSvc,
+	}
+}
+
+func (s *QueueWebhookDeliveries) Exec(ctx context.Context) error {
+	handled, err := consumeEvents(ctx, s.eventCheckpointDAO, localEvents(s.eventDAO), webhookStoreEventsConsumer, domain.StoreWebhookEventTypes, s.Handle)
+	if handled > 0 {
+		slog.InfoContext(ctx, "queue webhook deliveries finished", "consumer", webhookStoreEventsConsumer, "handled", handled)
+	}
+	if err != nil || s.orderEventsSvc == nil {
+		return err
+	}
+
+	handled, err = consumeEvents(ctx, s.eventCheckpointDAO, s.orderEventsSvc.FindEventsAfter, webhookOrderEventsConsumer, domain.OrderWebhookEventTypes, s.Handle)
+	if handled > 0 {
+		slog.InfoContext(ctx, "queue webhook deliveries finished", "consumer", webhookOrderEventsConsumer, "handled", handled)
+	}
+	return err
+}
+
+// Types returns the events replayed into webhook deliveries, the store events of the events table
+func (s *QueueWebhookDeliveries) Types() []domain.EventType {
+	return domain.StoreWebhookEventTypes
+}
+
+// Handle creates the deliveries of the event for the subscriptions of its store without one, so replaying
+// an event only sends it to the subscriptions that missed it
+func (s *QueueWebhookDeliveries) Handle(ctx context.Context, event domain.Event) error {
+	storeID, err := domain.WebhookEventStoreID(event)
+	if err != nil || storeID == "" {
+		slog.WarnContext(ctx, "webhook event without store skipped", "event_id", event.ID, "type", event.Type)
+		return nil
+	}
+
+	subscriptions, err := s.webhookSubscriptionDAO.FindAll(ctx, "store_id = $1 AND active", "", storeID)
+	if err != nil {
+		return err
+	}
+
+	for _, subscription := range subscriptions {
+		if !subscription.Subscribes(event.Type) {
+			continue
+		}
+
+		existing, err := s.webhookDeliveryDAO.Count(ctx, "subscription_id = $1 AND event_id = $2", subscription.GetID(), event.ID)
+		if err != nil {
+			return err
+		}
+		if existing > 0 {
+			continue
+		}
+
+		delivery, err := domain.NewWebhookDelivery(s.nextID(), subscription, event)
+		if err != nil {
+			return err
+		}
+
+		if err := s.webhookDeliveryDAO.Create(ctx, delivery); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
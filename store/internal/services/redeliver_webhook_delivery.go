@@ -0,0 +1,59 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"ichibuy/store/internal/domain/dao"
+)
+
+type RedeliverWebhookDeliveryReq struct {
+	StoreID        string
+	SubscriptionID string
+	DeliveryID     string
+	UserID         string
+}
+
+// RedeliverWebhookDelivery queues a delivery again, the worker sends it on its next run
+type RedeliverWebhookDelivery struct {
+	storeDAO               dao.StoreDAO
+	webhookSubscriptionDAO dao.WebhookSubscriptionDAO
+	webhookDeliveryDAO     dao.WebhookDeliveryDAO
+}
+
+func NewRedeliverWebhookDelivery(storeDAO dao.StoreDAO, webhookSubscriptionDAO dao.WebhookSubscriptionDAO, webhookDeliveryDAO dao.WebhookDeliveryDAO) *RedeliverWebhookDelivery {
+	return &RedeliverWebhookDelivery{
+		storeDAO:               storeDAO,
+		webhookSubscriptionDAO: webhookSubscriptionDAO,
+		webhookDeliveryDAO:     webhookDeliveryDAO,
+	}
+}
+
+func (s *RedeliverWebhookDelivery) Exec(ctx context.Context, req RedeliverWebhookDeliveryReq) (*WebhookDeliveryResp, error) {
+	slog.InfoContext(ctx, "redeliver webhook delivery started", "req", req)
+	subscription, err := findStoreWebhookSubscription(ctx, s.storeDAO, s.webhookSubscriptionDAO, req.StoreID, req.SubscriptionID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, err := s.webhookDeliveryDAO.FindOne(ctx, "id = $1 AND subscription_id = $2", "", req.DeliveryID, subscription.GetID())
+	if err != nil {
+		slog.ErrorContext(ctx, "find webhook delivery failed", "error", err.Error())
+		return nil, err
+	}
+
+	if err := delivery.Redeliver(time.Now().UTC()); err != nil {
+		slog.ErrorContext(ctx, "redeliver webhook delivery domain failed", "error", err.Error())
+		return nil, err
+	}
+
+	if err := s.webhookDeliveryDAO.Update(ctx, delivery); err != nil {
+		slog.ErrorContext(ctx, "update webhook delivery failed", "error", err.Error())
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "redeliver webhook delivery finished", "delivery_id", delivery.GetID())
+	resp := mapWebhookDeliveryToResp(delivery)
+	return &resp, nil
+}
@@ -0,0 +1,129 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"ichibuy/store/internal/domain"
+	"ichibuy/store/internal/domain/dao"
+)
+
+// verificationResendInterval is the time to wait before requesting another code for the same channel
+const verificationResendInterval = time.Minute
+
+type RequestCustomerVerificationReq struct {
+	CustomerID string
+	Channel    string
+	UserID     string
+}
+
+type RequestCustomerVerificationResp struct {
+	Channel   string    `json:"channel"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// RequestCustomerVerification sends a one-time code to the email or phone of the customer, replacing the
+// codes sent before for the same channel
+type RequestCustomerVerification struct {
+	customerDAO         dao.CustomerDAO
+	verificationCodeDAO dao.VerificationCodeDAO
+	notifier            domain.Notifier
+	nextID              domain.NextID
+	ttl                 time.Duration
+}
+
+func NewRequestCustomerVerification(
+	customerDAO dao.CustomerDAO,
+	verificationCodeDAO dao.VerificationCodeDAO,
+	notifier domain.Notifier,
+	nextID domain.NextID,
+	ttl time.Duration,
+) *RequestCustomerVerification {
+	return &RequestCustomerVerification{
+		customerDAO:         customerDAO,
+		verificationCodeDAO: verificationCodeDAO,
+		notifier:            notifier,
+		nextID:              nextID,
+		ttl:                 ttl,
+	}
+}
+
+func (s *RequestCustomerVerification) Exec(ctx context.Context, req RequestCustomerVerificationReq) (*RequestCustomerVerificationResp, error) {
+	slog.InfoContext(ctx, "request customer verification started", "req", req)
+
+	channel, err := domain.ParseVerificationChannel(req.Channel)
+	if err != nil {
+		return nil, err
+	}
+
+	customer, err := s.customerDAO.FindOne(ctx, "id = $1 AND deleted_at IS NULL", "", req.CustomerID)
+	if err != nil {
+		slog.ErrorContext(ctx, "find customer failed", "error", err.Error())
+		return nil, err
+	}
+
+	if err := customer.CheckOwner(req.UserID); err != nil {
+		return nil, err
+	}
+
+	previous, err := s.verificationCodeDAO.FindAll(ctx, "customer_id = $1 AND channel = $2", "created_at DESC", customer.GetID(), channel)
+	if err != nil {
+		slog.ErrorContext(ctx, "find verification codes failed", "error", err.Error())
+		return nil, err
+	}
+
+	if len(previous) > 0 && time.Since(previous[0].GetCreatedAt()) < verificationResendInterval {
+		return nil, fmt.Errorf("wait %s before requesting another code", verificationResendInterval)
+	}
+
+	verification, code, err := domain.NewVerificationCode(s.nextID(), customer, channel, s.ttl)
+	if err != nil {
+		slog.ErrorContext(ctx, "new verification code failed", "error", err.Error())
+		return nil, err
+	}
+
+	previousIDs := make([]string, len(previous))
+	for i, p := range previous {
+		previousIDs[i] = p.GetID()
+	}
+
+	err = s.verificationCodeDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := s.verificationCodeDAO.DeleteManyByPks(ctx, previousIDs); err != nil {
+			return err
+		}
+
+		return s.verificationCodeDAO.Create(ctx, verification)
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "save verification code failed", "error", err.Error())
+		return nil, err
+	}
+
+	if err := s.notifier.Notify(ctx, verificationNotification(verification, code, s.ttl)); err != nil {
+		slog.ErrorContext(ctx, "notify verification code failed", "error", err.Error())
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "request customer verification finished", "verification_id", verification.GetID())
+	return &RequestCustomerVerificationResp{
+		Channel:   string(verification.GetChannel()),
+		ExpiresAt: verification.GetExpiresAt(),
+	}, nil
+}
+
+func verificationNotification(verification *domain.VerificationCode, code string, ttl time.Duration) domain.Notification {
+	body := fmt.Sprintf("Your ichibuy verification code is %s, it expires in %s.", code, ttl)
+
+	if verification.GetChannel() == domain.PhoneVerificationChannel {
+		return domain.Notification{Channel: domain.SMSNotificationChannel, To: verification.GetTarget(), Body: body}
+	}
+
+	return domain.Notification{
+		Channel: domain.EmailNotificationChannel,
+		To:      verification.GetTarget(),
+		Subject: "Verify your email",
+		Body:    body,
+	}
+}
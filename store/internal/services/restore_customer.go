@@ -0,0 +1,72 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"ichibuy/store/internal/domain"
+	"ichibuy/store/internal/domain/dao"
+)
+
+type RestoreCustomerReq struct {
+	ID     string
+	UserID string
+}
+
+type RestoreCustomer struct {
+	customerDAO        dao.CustomerDAO
+	customerAddressDAO dao.CustomerAddressDAO
+	eventBus           domain.EventBus
+	retention          time.Duration
+}
+
+func NewRestoreCustomer(customerDAO dao.CustomerDAO, customerAddressDAO dao.CustomerAddressDAO, eventBus domain.EventBus, retention time.Duration) *RestoreCustomer {
+	return &RestoreCustomer{
+		customerDAO:        customerDAO,
+		customerAddressDAO: customerAddressDAO,
+		eventBus:           eventBus,
+		retention:          retention,
+	}
+}
+
+func (s *RestoreCustomer) Exec(ctx context.Context, req RestoreCustomerReq) error {
+	slog.InfoContext(ctx, "restore customer started", "req", req)
+	customer, err := s.customerDAO.FindByPk(ctx, req.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "find customer failed", "error", err.Error())
+		return err
+	}
+
+	if err := loadCustomerAddresses(ctx, s.customerAddressDAO, customer); err != nil {
+		return err
+	}
+
+	if customer.GetUserID() != req.UserID {
+		slog.ErrorContext(ctx, "customer does not belong to user", "customer_id", customer.GetID())
+		return fmt.Errorf("user id does not match")
+	}
+
+	if err := checkUserHasNoCustomer(ctx, s.customerDAO, customer.GetUserID()); err != nil {
+		return err
+	}
+
+	if err := customer.Restore(s.retention); err != nil {
+		slog.ErrorContext(ctx, "restore customer domain failed", "error", err.Error())
+		return err
+	}
+
+	if err := s.customerDAO.Update(ctx, customer); err != nil {
+		slog.ErrorContext(ctx, "update customer failed", "error", err.Error())
+		return err
+	}
+
+	if err := s.eventBus.Publish(ctx, customer.PullEvents()...); err != nil {
+		slog.ErrorContext(ctx, "publish events failed", "error", err.Error())
+		return err
+	}
+
+	slog.InfoContext(ctx, "restore customer finished", "customer_id", customer.GetID())
+	return nil
+}
@@ -0,0 +1,75 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"ichibuy/store/internal/domain"
+	"ichibuy/store/internal/domain/dao"
+)
+
+type RestoreProductReq struct {
+	ID     string
+	UserID string
+}
+
+type RestoreProduct struct {
+	productDAO dao.ProductDAO
+	storeDAO   dao.StoreDAO
+	eventBus   domain.EventBus
+	retention  time.Duration
+}
+
+func NewRestoreProduct(productDAO dao.ProductDAO, storeDAO dao.StoreDAO, eventBus domain.EventBus, retention time.Duration) *RestoreProduct {
+	return &RestoreProduct{
+		productDAO: productDAO,
+		storeDAO:   storeDAO,
+		eventBus:   eventBus,
+		retention:  retention,
+	}
+}
+
+func (s *RestoreProduct) Exec(ctx context.Context, req RestoreProductReq) error {
+	slog.InfoContext(ctx, "restore product started", "req", req)
+	product, err := s.productDAO.FindByPk(ctx, req.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "find product failed", "error", err.Error())
+		return err
+	}
+
+	store, err := s.storeDAO.FindByPk(ctx, product.GetStoreID())
+	if err != nil {
+		slog.ErrorContext(ctx, "find store failed", "error", err.Error())
+		return err
+	}
+
+	if err := store.CheckOwner(req.UserID); err != nil {
+		slog.ErrorContext(ctx, "check store owner failed", "error", err.Error())
+		return err
+	}
+
+	if store.IsDeleted() {
+		slog.ErrorContext(ctx, "store is deleted", "store_id", store.GetID())
+		return fmt.Errorf("store is deleted, restore the store first")
+	}
+
+	if err := product.Restore(s.retention); err != nil {
+		slog.ErrorContext(ctx, "restore product domain failed", "error", err.Error())
+		return err
+	}
+
+	if err := s.productDAO.Update(ctx, product); err != nil {
+		slog.ErrorContext(ctx, "update product failed", "error", err.Error())
+		return err
+	}
+
+	if err := s.eventBus.Publish(ctx, product.PullEvents()...); err != nil {
+		slog.ErrorContext(ctx, "publish events failed", "error", err.Error())
+		return err
+	}
+
+	slog.InfoContext(ctx, "restore product finished", "product_id", product.GetID())
+	return nil
+}
@@ -0,0 +1,94 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"ichibuy/store/internal/domain"
+	"ichibuy/store/internal/domain/dao"
+)
+
+type RestoreStoreReq struct {
+	ID     string
+	UserID string
+}
+
+type RestoreStore struct {
+	storeDAO   dao.StoreDAO
+	productDAO dao.ProductDAO
+	eventBus   domain.EventBus
+	retention  time.Duration
+}
+
+func NewRestoreStore(storeDAO dao.StoreDAO, productDAO dao.ProductDAO, eventBus domain.EventBus, retention time.Duration) *RestoreStore {
+	return &RestoreStore{
+		storeDAO:   storeDAO,
+		productDAO: productDAO,
+		eventBus:   eventBus,
+		retention:  retention,
+	}
+}
+
+func (s *RestoreStore) Exec(ctx context.Context, req RestoreStoreReq) error {
+	slog.InfoContext(ctx, "restore store started", "req", req)
+	store, err := s.storeDAO.FindByPk(ctx, req.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "find store failed", "error", err.Error())
+		return err
+	}
+
+	if err := store.CheckOwner(req.UserID); err != nil {
+		slog.ErrorContext(ctx, "check store owner failed", "error", err.Error())
+		return err
+	}
+
+	// products deleted along with the store share its deletion time
+	products := []*domain.Product{}
+	if store.IsDeleted() {
+		products, err = s.productDAO.FindAll(ctx, "store_id = $1 AND deleted_at = $2", "", store.GetID(), *store.GetDeletedAt())
+		if err != nil {
+			slog.ErrorContext(ctx, "find store products failed", "error", err.Error())
+			return err
+		}
+	}
+
+	if err := store.Restore(s.retention); err != nil {
+		slog.ErrorContext(ctx, "restore store domain failed", "error", err.Error())
+		return err
+	}
+
+	events := store.PullEvents()
+	for _, product := range products {
+		if err := product.Restore(s.retention); err != nil {
+			slog.ErrorContext(ctx, "restore product domain failed", "product_id", product.GetID(), "error", err.Error())
+			return err
+		}
+		events = append(events, product.PullEvents()...)
+	}
+
+	err = s.storeDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := s.storeDAO.Update(ctx, store); err != nil {
+			slog.ErrorContext(ctx, "update store failed", "error", err.Error())
+			return err
+		}
+
+		if err := s.productDAO.UpdateMany(ctx, products); err != nil {
+			slog.ErrorContext(ctx, "update products failed", "error", err.Error())
+			return err
+		}
+
+		if err := s.eventBus.Publish(ctx, events...); err != nil {
+			slog.ErrorContext(ctx, "publish events failed", "error", err.Error())
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	slog.InfoContext(ctx, "restore store finished", "store_id", store.GetID(), "products", len(products))
+	return nil
+}
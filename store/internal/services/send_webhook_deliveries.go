@@ -0,0 +1,102 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"ichibuy/store/internal/domain"
+	"ichibuy/store/internal/domain/dao"
+)
+
+const webhookDeliveriesBatchSize = 100
+
+// SendWebhookDeliveries posts the pending deliveries that are due, signed with the secret of their
+// subscription. Each send is recorded as an attempt and failed sends are retried with backoff.
+type SendWebhookDeliveries struct {
+	webhookSubscriptionDAO    dao.WebhookSubscriptionDAO
+	webhookDeliveryDAO        dao.WebhookDeliveryDAO
+	webhookDeliveryAttemptDAO dao.WebhookDeliveryAttemptDAO
+	webhookClient             domain.WebhookClient
+	nextID                    domain.NextID
+}
+
+func NewSendWebhookDeliveries(
+	webhookSubscriptionDAO dao.WebhookSubscriptionDAO,
+	webhookDeliveryDAO dao.WebhookDeliveryDAO,
+	webhookDeliveryAttemptDAO dao.WebhookDeliveryAttemptDAO,
+	webhookClient domain.WebhookClient,
+	nextID domain.NextID,
+) *SendWebhookDeliveries {
+	return &SendWebhookDeliveries{
+		webhookSubscriptionDAO:    webhookSubscriptionDAO,
+		webhookDeliveryDAO:        webhookDeliveryDAO,
+		webhookDeliveryAttemptDAO: webhookDeliveryAttemptDAO,
+		webhookClient:             webhookClient,
+		nextID:                    nextID,
+	}
+}
+
+func (s *SendWebhookDeliveries) Exec(ctx context.Context) error {
+	now := time.Now().UTC()
+	deliveries, err := s.webhookDeliveryDAO.FindPaginated(
+		ctx,
+		webhookDeliveriesBatchSize,
+		0,
+		"status = $1 AND next_attempt_at <= $2 AND subscription_id IN (SELECT id FROM webhook_subscriptions WHERE active)",
+		"next_attempt_at ASC",
+		domain.PendingWebhookDeliveryStatus,
+		now,
+	)
+	if err != nil {
+		slog.ErrorContext(ctx, "find pending webhook deliveries failed", "error", err.Error())
+		return err
+	}
+
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	slog.InfoContext(ctx, "send webhook deliveries started", "count", len(deliveries))
+	succeeded := 0
+	for _, delivery := range deliveries {
+		ok, err := s.send(ctx, delivery)
+		if err != nil {
+			slog.ErrorContext(ctx, "send webhook delivery failed", "delivery_id", delivery.GetID(), "error", err.Error())
+			return err
+		}
+		if ok {
+			succeeded++
+		}
+	}
+
+	slog.InfoContext(ctx, "send webhook deliveries finished", "count", len(deliveries), "succeeded", succeeded)
+	return nil
+}
+
+// send posts the delivery and saves the attempt, an error is only returned when they cannot be saved
+func (s *SendWebhookDeliveries) send(ctx context.Context, delivery *domain.WebhookDelivery) (bool, error) {
+	subscription, err := s.webhookSubscriptionDAO.FindByPk(ctx, delivery.GetSubscriptionID())
+	if err != nil {
+		return false, err
+	}
+
+	start := time.Now().UTC()
+	resp, sendErr := s.webhookClient.Post(ctx, delivery.Request(subscription, start))
+	attempt := delivery.RecordAttempt(s.nextID(), resp, sendErr, time.Since(start), start)
+	if delivery.GetStatus() != domain.SucceededWebhookDeliveryStatus {
+		slog.WarnContext(ctx, "webhook delivery attempt failed", "delivery_id", delivery.GetID(), "attempts", delivery.GetAttempts(), "status", delivery.GetStatus())
+	}
+
+	err = s.webhookDeliveryDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := s.webhookDeliveryAttemptDAO.Create(ctx, attempt); err != nil {
+			return err
+		}
+		return s.webhookDeliveryDAO.Update(ctx, delivery)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return delivery.GetStatus() == domain.SucceededWebhookDeliveryStatus, nil
+}
@@ -0,0 +1,54 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+
+	"ichibuy/store/internal/domain"
+	"ichibuy/store/internal/domain/dao"
+)
+
+type SetStoreScheduleReq struct {
+	ID       string
+	Schedule domain.StoreSchedule
+	UserID   string
+}
+
+type SetStoreSchedule struct {
+	storeDAO dao.StoreDAO
+	eventBus domain.EventBus
+}
+
+func NewSetStoreSchedule(storeDAO dao.StoreDAO, eventBus domain.EventBus) *SetStoreSchedule {
+	return &SetStoreSchedule{
+		storeDAO: storeDAO,
+		eventBus: eventBus,
+	}
+}
+
+func (s *SetStoreSchedule) Exec(ctx context.Context, req SetStoreScheduleReq) error {
+	slog.InfoContext(ctx, "set store schedule started", "req", req)
+	store, err := s.storeDAO.FindOne(ctx, "id = $1 AND deleted_at IS NULL", "", req.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "find store failed", "error", err.Error())
+		return err
+	}
+
+	if err := store.SetSchedule(req.Schedule, req.UserID); err != nil {
+		slog.ErrorContext(ctx, "set store schedule domain failed", "error", err.Error())
+		return err
+	}
+
+	if err := s.storeDAO.Update(ctx, store); err != nil {
+		slog.ErrorContext(ctx, "update store failed", "error", err.Error())
+		return err
+	}
+
+	if err := s.eventBus.Publish(ctx, store.PullEvents()...); err != nil {
+		slog.ErrorContext(ctx, "publish events failed", "error", err.Error())
+		return err
+	}
+
+	slog.InfoContext(ctx, "set store schedule finished", "store_id", store.GetID())
+	return nil
+}
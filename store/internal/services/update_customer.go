@@ -14,32 +14,50 @@ type UpdateCustomerReq struct {
 	LastName  string
 	Email     *string
 	Phone     *string
-	UserID    string
+	// PhoneRegion of a local phone number, e.g. PE
+	PhoneRegion *string
+	// StoreID is the store the customer comes from, its phone region is used when none is given
+	StoreID *string
+	UserID  string
 }
 
 type UpdateCustomer struct {
-	customerDAO dao.CustomerDAO
-	eventBus    domain.EventBus
-	nextID      domain.NextID
+	customerDAO        dao.CustomerDAO
+	customerAddressDAO dao.CustomerAddressDAO
+	storeDAO           dao.StoreDAO
+	eventBus           domain.EventBus
+	nextID             domain.NextID
 }
 
-func NewUpdateCustomer(customerDAO dao.CustomerDAO, eventBus domain.EventBus, nextID domain.NextID) *UpdateCustomer {
+func NewUpdateCustomer(customerDAO dao.CustomerDAO, customerAddressDAO dao.CustomerAddressDAO, storeDAO dao.StoreDAO, eventBus domain.EventBus, nextID domain.NextID) *UpdateCustomer {
 	return &UpdateCustomer{
-		customerDAO: customerDAO,
-		eventBus:    eventBus,
-		nextID:      nextID,
+		customerDAO:        customerDAO,
+		customerAddressDAO: customerAddressDAO,
+		storeDAO:           storeDAO,
+		eventBus:           eventBus,
+		nextID:             nextID,
 	}
 }
 
 func (s *UpdateCustomer) Exec(ctx context.Context, req UpdateCustomerReq) error {
 	slog.InfoContext(ctx, "update customer started", "req", req)
-	customer, err := s.customerDAO.FindByPk(ctx, req.ID)
+	customer, err := s.customerDAO.FindOne(ctx, "id = $1 AND deleted_at IS NULL", "", req.ID)
 	if err != nil {
 		slog.ErrorContext(ctx, "find customer failed", "error", err.Error())
 		return err
 	}
 
-	if err := customer.Update(req.FirstName, req.LastName, req.Email, req.Phone, req.UserID); err != nil {
+	if err := loadCustomerAddresses(ctx, s.customerAddressDAO, customer); err != nil {
+		return err
+	}
+
+	phone, err := normalizeCustomerPhone(ctx, s.storeDAO, req.Phone, req.PhoneRegion, req.StoreID)
+	if err != nil {
+		slog.ErrorContext(ctx, "normalize phone failed", "error", err.Error())
+		return err
+	}
+
+	if err := customer.Update(req.FirstName, req.LastName, req.Email, phone, req.UserID); err != nil {
 		slog.ErrorContext(ctx, "update customer domain failed", "error", err.Error())
 		return err
 	}
@@ -0,0 +1,52 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+
+	"ichibuy/store/internal/domain"
+	"ichibuy/store/internal/domain/dao"
+)
+
+type UpdateCustomerAddressReq struct {
+	CustomerID string
+	AddressID  string
+	Address    AddressReq
+	UserID     string
+}
+
+type UpdateCustomerAddress struct {
+	customerDAO        dao.CustomerDAO
+	customerAddressDAO dao.CustomerAddressDAO
+	eventBus           domain.EventBus
+}
+
+func NewUpdateCustomerAddress(customerDAO dao.CustomerDAO, customerAddressDAO dao.CustomerAddressDAO, eventBus domain.EventBus) *UpdateCustomerAddress {
+	return &UpdateCustomerAddress{
+		customerDAO:        customerDAO,
+		customerAddressDAO: customerAddressDAO,
+		eventBus:           eventBus,
+	}
+}
+
+func (s *UpdateCustomerAddress) Exec(ctx context.Context, req UpdateCustomerAddressReq) (*CustomerAddressResp, error) {
+	slog.InfoContext(ctx, "update customer address started", "req", req)
+	customer, err := findCustomerWithAddresses(ctx, s.customerDAO, s.customerAddressDAO, req.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := customer.UpdateAddress(req.AddressID, req.Address.toAddressData(), req.Address.IsDefault, req.UserID)
+	if err != nil {
+		slog.ErrorContext(ctx, "update customer address domain failed", "error", err.Error())
+		return nil, err
+	}
+
+	if err := saveCustomerAddresses(ctx, s.customerDAO, s.customerAddressDAO, s.eventBus, customer, nil, nil); err != nil {
+		slog.ErrorContext(ctx, "save customer addresses failed", "error", err.Error())
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "update customer address finished", "address_id", address.GetID())
+	return mapCustomerAddressToResp(address), nil
+}
@@ -21,14 +21,16 @@ type UpdateProductReq struct {
 
 type UpdateProduct struct {
 	productDAO dao.ProductDAO
+	storeDAO   dao.StoreDAO
 	eventBus   domain.EventBus
 	nextID     domain.NextID
 	storageSvc domain.StorageService
 }
 
-func NewUpdateProduct(productDAO dao.ProductDAO, eventBus domain.EventBus, nextID domain.NextID, storageSvc domain.StorageService) *UpdateProduct {
+func NewUpdateProduct(productDAO dao.ProductDAO, storeDAO dao.StoreDAO, eventBus domain.EventBus, nextID domain.NextID, storageSvc domain.StorageService) *UpdateProduct {
 	return &UpdateProduct{
 		productDAO: productDAO,
+		storeDAO:   storeDAO,
 		eventBus:   eventBus,
 		nextID:     nextID,
 		storageSvc: storageSvc,
@@ -37,12 +39,29 @@ func NewUpdateProduct(productDAO dao.ProductDAO, eventBus domain.EventBus, nextI
 
 func (s *UpdateProduct) Exec(ctx context.Context, req UpdateProductReq) error {
 	slog.InfoContext(ctx, "update product started", "req", req)
-	product, err := s.productDAO.FindByPk(ctx, req.ID)
+	product, err := s.productDAO.FindOne(ctx, "id = $1 AND deleted_at IS NULL", "", req.ID)
 	if err != nil {
 		slog.ErrorContext(ctx, "find product failed", "error", err.Error())
 		return err
 	}
 
+	prices, err := convertNewPriceDTOsToDomain(req.NewPrices, s.nextID)
+	if err != nil {
+		slog.ErrorContext(ctx, "convert new price dtos to domain failed", "error", err.Error())
+		return err
+	}
+
+	store, err := s.storeDAO.FindByPk(ctx, product.GetStoreID())
+	if err != nil {
+		slog.ErrorContext(ctx, "find store failed", "error", err.Error())
+		return err
+	}
+
+	if err := store.CheckPrices(prices); err != nil {
+		slog.ErrorContext(ctx, "check store prices failed", "error", err.Error())
+		return err
+	}
+
 	// Upload new images to storage
 	images, err := s.uploadImages(ctx, req.NewImageFiles)
 	if err != nil {
@@ -55,12 +74,6 @@ func (s *UpdateProduct) Exec(ctx context.Context, req UpdateProductReq) error {
 		return err
 	}
 
-	prices, err := convertNewPriceDTOsToDomain(req.NewPrices, s.nextID)
-	if err != nil {
-		slog.ErrorContext(ctx, "convert new price dtos to domain failed", "error", err.Error())
-		return err
-	}
-
 	err = product.Update(
 		req.Name,
 		req.Description,
@@ -13,6 +13,8 @@ type UpdateStoreReq struct {
 	Name        string
 	Description *string
 	Location    domain.Location
+	Currencies  []string
+	PhoneRegion string
 	UserID      string
 }
 
@@ -32,13 +34,13 @@ func NewUpdateStore(storeDAO dao.StoreDAO, eventBus domain.EventBus, nextID doma
 
 func (s *UpdateStore) Exec(ctx context.Context, req UpdateStoreReq) error {
 	slog.InfoContext(ctx, "update store started", "req", req)
-	store, err := s.storeDAO.FindByPk(ctx, req.ID)
+	store, err := s.storeDAO.FindOne(ctx, "id = $1 AND deleted_at IS NULL", "", req.ID)
 	if err != nil {
 		slog.ErrorContext(ctx, "find store failed", "error", err.Error())
 		return err
 	}
 
-	if err := store.Update(req.Name, req.Description, req.Location.Lat, req.Location.Lng, req.UserID); err != nil {
+	if err := store.Update(req.Name, req.Description, req.Location.Lat, req.Location.Lng, req.Currencies, req.PhoneRegion, req.UserID); err != nil {
 		slog.ErrorContext(ctx, "update store domain failed", "error", err.Error())
 		return err
 	}
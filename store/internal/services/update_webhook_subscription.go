@@ -0,0 +1,51 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+
+	"ichibuy/store/internal/domain/dao"
+)
+
+type UpdateWebhookSubscriptionReq struct {
+	StoreID        string
+	SubscriptionID string
+	URL            string
+	EventTypes     []string
+	Active         bool
+	UserID         string
+}
+
+type UpdateWebhookSubscription struct {
+	storeDAO               dao.StoreDAO
+	webhookSubscriptionDAO dao.WebhookSubscriptionDAO
+}
+
+func NewUpdateWebhookSubscription(storeDAO dao.StoreDAO, webhookSubscriptionDAO dao.WebhookSubscriptionDAO) *UpdateWebhookSubscription {
+	return &UpdateWebhookSubscription{
+		storeDAO:               storeDAO,
+		webhookSubscriptionDAO: webhookSubscriptionDAO,
+	}
+}
+
+func (s *UpdateWebhookSubscription) Exec(ctx context.Context, req UpdateWebhookSubscriptionReq) (*WebhookSubscriptionResp, error) {
+	slog.InfoContext(ctx, "update webhook subscription started", "req", req)
+	subscription, err := findStoreWebhookSubscription(ctx, s.storeDAO, s.webhookSubscriptionDAO, req.StoreID, req.SubscriptionID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := subscription.Update(req.URL, req.EventTypes, req.Active); err != nil {
+		slog.ErrorContext(ctx, "update webhook subscription domain failed", "error", err.Error())
+		return nil, err
+	}
+
+	if err := s.webhookSubscriptionDAO.Update(ctx, subscription); err != nil {
+		slog.ErrorContext(ctx, "update webhook subscription failed", "error", err.Error())
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "update webhook subscription finished", "subscription_id", subscription.GetID())
+	resp := mapWebhookSubscriptionToResp(subscription)
+	return &resp, nil
+}
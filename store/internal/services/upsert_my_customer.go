@@ -0,0 +1,130 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"github.com/lib/pq"
+
+	"ichibuy/store/internal/domain"
+	"ichibuy/store/internal/domain/dao"
+)
+
+type UpsertMyCustomerReq struct {
+	FirstName string
+	LastName  string
+	Email     *string
+	Phone     *string
+	// PhoneRegion of a local phone number, e.g. PE
+	PhoneRegion *string
+	// StoreID is the store the customer comes from, its phone region is used when none is given
+	StoreID *string
+	UserID  string
+}
+
+type UpsertMyCustomerResp struct {
+	GetCustomerByUserIDResp
+	// Created is true when the customer did not exist
+	Created bool `json:"created"`
+}
+
+// UpsertMyCustomer creates the customer of the user or updates it when it already exists
+type UpsertMyCustomer struct {
+	customerDAO        dao.CustomerDAO
+	customerAddressDAO dao.CustomerAddressDAO
+	storeDAO           dao.StoreDAO
+	eventBus           domain.EventBus
+	nextID             domain.NextID
+}
+
+func NewUpsertMyCustomer(customerDAO dao.CustomerDAO, customerAddressDAO dao.CustomerAddressDAO, storeDAO dao.StoreDAO, eventBus domain.EventBus, nextID domain.NextID) *UpsertMyCustomer {
+	return &UpsertMyCustomer{
+		customerDAO:        customerDAO,
+		customerAddressDAO: customerAddressDAO,
+		storeDAO:           storeDAO,
+		eventBus:           eventBus,
+		nextID:             nextID,
+	}
+}
+
+func (s *UpsertMyCustomer) Exec(ctx context.Context, req UpsertMyCustomerReq) (*UpsertMyCustomerResp, error) {
+	slog.InfoContext(ctx, "upsert my customer started", "req", req)
+
+	phone, err := normalizeCustomerPhone(ctx, s.storeDAO, req.Phone, req.PhoneRegion, req.StoreID)
+	if err != nil {
+		slog.ErrorContext(ctx, "normalize phone failed", "error", err.Error())
+		return nil, err
+	}
+
+	customer, created, err := s.upsert(ctx, req, phone)
+	if isActiveCustomerTaken(err) {
+		// a concurrent request created the customer of the user after it was looked up
+		slog.WarnContext(ctx, "customer created concurrently, updating it", "user_id", req.UserID)
+		customer, created, err = s.upsert(ctx, req, phone)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "upsert my customer finished", "customer_id", customer.GetID(), "created", created)
+	return &UpsertMyCustomerResp{
+		GetCustomerByUserIDResp: *mapCustomerToGetCustomerByUserIDResp(customer),
+		Created:                 created,
+	}, nil
+}
+
+// upsert creates the customer of the user when there is no active one, or updates it. The creation fails
+// with a unique violation when another request created the customer meanwhile.
+func (s *UpsertMyCustomer) upsert(ctx context.Context, req UpsertMyCustomerReq, phone *string) (*domain.Customer, bool, error) {
+	customer, err := s.customerDAO.FindOne(ctx, "user_id = $1 AND deleted_at IS NULL", "", req.UserID)
+	created := errors.Is(err, sql.ErrNoRows)
+	if err != nil && !created {
+		slog.ErrorContext(ctx, "find customer by user id failed", "error", err.Error())
+		return nil, false, err
+	}
+
+	if created {
+		customer, err = domain.NewCustomer(s.nextID(), req.FirstName, req.LastName, req.Email, phone, req.UserID)
+		if err != nil {
+			slog.ErrorContext(ctx, "new customer failed", "error", err.Error())
+			return nil, false, err
+		}
+	} else {
+		if err := loadCustomerAddresses(ctx, s.customerAddressDAO, customer); err != nil {
+			return nil, false, err
+		}
+
+		if err := customer.Update(req.FirstName, req.LastName, req.Email, phone, req.UserID); err != nil {
+			slog.ErrorContext(ctx, "update customer domain failed", "error", err.Error())
+			return nil, false, err
+		}
+	}
+
+	err = s.customerDAO.WithTransaction(ctx, func(ctx context.Context) error {
+		if created {
+			if err := s.customerDAO.Create(ctx, customer); err != nil {
+				return err
+			}
+		} else {
+			if err := s.customerDAO.Update(ctx, customer); err != nil {
+				return err
+			}
+		}
+
+		return s.eventBus.Publish(ctx, customer.PullEvents()...)
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "save customer failed", "error", err.Error())
+		return nil, false, err
+	}
+
+	return customer, created, nil
+}
+
+// isActiveCustomerTaken reports whether a customer was not created because the user has an active one
+func isActiveCustomerTaken(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code.Name() == "unique_violation" && pqErr.Constraint == "idx_customers_user_id_active"
+}
@@ -0,0 +1,103 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"ichibuy/store/internal/domain"
+	"ichibuy/store/internal/domain/dao"
+)
+
+type WebhookSubscriptionResp struct {
+	ID         string             `json:"id"`
+	StoreID    string             `json:"store_id"`
+	URL        string             `json:"url"`
+	EventTypes []domain.EventType `json:"event_types"`
+	Active     bool               `json:"active"`
+	CreatedAt  time.Time          `json:"created_at"`
+	UpdatedAt  time.Time          `json:"updated_at"`
+}
+
+type WebhookDeliveryResp struct {
+	ID             string                       `json:"id"`
+	SubscriptionID string                       `json:"subscription_id"`
+	EventID        string                       `json:"event_id"`
+	EventType      domain.EventType             `json:"event_type"`
+	Status         domain.WebhookDeliveryStatus `json:"status"`
+	Attempts       int                          `json:"attempts"`
+	NextAttemptAt  *time.Time                   `json:"next_attempt_at"`
+	LastStatusCode *int                         `json:"last_status_code"`
+	LastError      *string                      `json:"last_error"`
+	DeliveredAt    *time.Time                   `json:"delivered_at"`
+	CreatedAt      time.Time                    `json:"created_at"`
+	UpdatedAt      time.Time                    `json:"updated_at"`
+}
+
+type WebhookDeliveryAttemptResp struct {
+	ID           string    `json:"id"`
+	StatusCode   *int      `json:"status_code"`
+	ResponseBody *string   `json:"response_body"`
+	Error        *string   `json:"error"`
+	DurationMs   int       `json:"duration_ms"`
+	AttemptedAt  time.Time `json:"attempted_at"`
+}
+
+// findOwnedStore returns the store when the user owns it
+func findOwnedStore(ctx context.Context, storeDAO dao.StoreDAO, storeID, userID string) (*domain.Store, error) {
+	store, err := storeDAO.FindOne(ctx, "id = $1 AND deleted_at IS NULL", "", storeID)
+	if err != nil {
+		slog.ErrorContext(ctx, "find store failed", "error", err.Error())
+		return nil, err
+	}
+
+	if err := store.CheckOwner(userID); err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
+
+// findStoreWebhookSubscription returns the webhook subscription of a store owned by the user
+func findStoreWebhookSubscription(ctx context.Context, storeDAO dao.StoreDAO, webhookSubscriptionDAO dao.WebhookSubscriptionDAO, storeID, subscriptionID, userID string) (*domain.WebhookSubscription, error) {
+	if _, err := findOwnedStore(ctx, storeDAO, storeID, userID); err != nil {
+		return nil, err
+	}
+
+	subscription, err := webhookSubscriptionDAO.FindOne(ctx, "id = $1 AND store_id = $2", "", subscriptionID, storeID)
+	if err != nil {
+		slog.ErrorContext(ctx, "find webhook subscription failed", "error", err.Error())
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+func mapWebhookSubscriptionToResp(subscription *domain.WebhookSubscription) WebhookSubscriptionResp {
+	return WebhookSubscriptionResp{
+		ID:         subscription.GetID(),
+		StoreID:    subscription.GetStoreID(),
+		URL:        subscription.GetURL(),
+		EventTypes: subscription.GetEventTypes(),
+		Active:     subscription.IsActive(),
+		CreatedAt:  subscription.GetCreatedAt(),
+		UpdatedAt:  subscription.GetUpdatedAt(),
+	}
+}
+
+func mapWebhookDeliveryToResp(delivery *domain.WebhookDelivery) WebhookDeliveryResp {
+	return WebhookDeliveryResp{
+		ID:             delivery.GetID(),
+		SubscriptionID: delivery.GetSubscriptionID(),
+		EventID:        delivery.GetEventID(),
+		EventType:      delivery.GetEventType(),
+		Status:         delivery.GetStatus(),
+		Attempts:       delivery.GetAttempts(),
+		NextAttemptAt:  delivery.GetNextAttemptAt(),
+		LastStatusCode: delivery.GetLastStatusCode(),
+		LastError:      delivery.GetLastError(),
+		DeliveredAt:    delivery.GetDeliveredAt(),
+		CreatedAt:      delivery.GetCreatedAt(),
+		UpdatedAt:      delivery.GetUpdatedAt(),
+	}
+}
@@ -25,7 +25,7 @@ import (
 
 func New(cfg config.Config, db *sql.DB) *gin.Engine {
 	router := gin.Default()
-	router.Use(middlewares.UseCORS())
+	router.Use(middlewares.UseCORS(), middlewares.CorrelationID())
 
 	httpClient := &http.Client{
 		Timeout: 10 * time.Second,
@@ -46,39 +46,84 @@ func New(cfg config.Config, db *sql.DB) *gin.Engine {
 	eventDAO := postgres.NewEventDAO(db)
 	storeDAO := postgres.NewStoreDAO(db)
 	customerDAO := postgres.NewCustomerDAO(db)
+	customerAddressDAO := postgres.NewCustomerAddressDAO(db)
 	productDAO := postgres.NewProductDAO(db)
+	importJobDAO := postgres.NewImportJobDAO(db)
+	idempotencyKeyDAO := postgres.NewIdempotencyKeyDAO(db)
+	verificationCodeDAO := postgres.NewVerificationCodeDAO(db)
+	webhookSubscriptionDAO := postgres.NewWebhookSubscriptionDAO(db)
+	webhookDeliveryDAO := postgres.NewWebhookDeliveryDAO(db)
+	webhookDeliveryAttemptDAO := postgres.NewWebhookDeliveryAttemptDAO(db)
 
 	eventBus := events.NewBus(eventDAO)
+	idempotencyMiddleware := middlewares.NewIdempotencyMiddleware(idempotencyKeyDAO, cfg.GetIdempotencyKeyTTL(), cfg.GetIdempotencyKeyLease())
 	nextIDFunc := uuid.NewString
+	retention := cfg.GetSoftDeleteRetention()
 
 	// Domain ports
 	storageSvc := infraServices.NewStorageService(fstorageClient)
+	orderSvc := infraServices.NewOrderService(httpClient, cfg.OrderBaseURL)
+	notifier := infraServices.NewLogNotifier(cfg.NotificationsFile)
+	rateProvider, err := infraServices.NewFileExchangeRateProvider(cfg.ExchangeRatesFile)
+	if err != nil {
+		panic(err)
+	}
 
 	// Factories
 	productFactory := domain.NewProductFactory(storageSvc, nextIDFunc)
+	storeDeleter := domain.NewStoreDeleter(orderSvc)
 
 	// Use-Cases
 	createStoreService := services.NewCreateStore(storeDAO, eventBus, nextIDFunc)
 	getStoreService := services.NewGetStore(storeDAO)
 	updateStoreService := services.NewUpdateStore(storeDAO, eventBus, nextIDFunc)
-	deleteStoreService := services.NewDeleteStore(storeDAO, eventBus, nextIDFunc)
+	deleteStoreService := services.NewDeleteStore(storeDAO, productDAO, eventBus, nextIDFunc, storeDeleter)
+	restoreStoreService := services.NewRestoreStore(storeDAO, productDAO, eventBus, retention)
 	listStoresService := services.NewListStores(storeDAO)
+	setStoreScheduleService := services.NewSetStoreSchedule(storeDAO, eventBus)
 
-	createCustomerService := services.NewCreateCustomer(customerDAO, eventBus, nextIDFunc)
+	createCustomerService := services.NewCreateCustomer(customerDAO, storeDAO, eventBus, nextIDFunc)
 	getCustomerService := services.NewGetCustomer(customerDAO)
-	updateCustomerService := services.NewUpdateCustomer(customerDAO, eventBus, nextIDFunc)
-	deleteCustomerService := services.NewDeleteCustomer(customerDAO, eventBus, nextIDFunc)
+	updateCustomerService := services.NewUpdateCustomer(customerDAO, customerAddressDAO, storeDAO, eventBus, nextIDFunc)
+	deleteCustomerService := services.NewDeleteCustomer(customerDAO, customerAddressDAO, eventBus, nextIDFunc)
+	restoreCustomerService := services.NewRestoreCustomer(customerDAO, customerAddressDAO, eventBus, retention)
 	getCustomerByUserIDService := services.NewGetCustomerByUserID(customerDAO)
-
-	createProductService := services.NewCreateProduct(productDAO, eventBus, nextIDFunc, productFactory)
-	getProductService := services.NewGetProduct(productDAO)
-	updateProductService := services.NewUpdateProduct(productDAO, eventBus, nextIDFunc, storageSvc)
-	deleteProductService := services.NewDeleteProduct(productDAO, eventBus, nextIDFunc, storageSvc)
-	listProductsService := services.NewListProducts(productDAO)
+	upsertMyCustomerService := services.NewUpsertMyCustomer(customerDAO, customerAddressDAO, storeDAO, eventBus, nextIDFunc)
+	listCustomerAddressesService := services.NewListCustomerAddresses(customerDAO, customerAddressDAO)
+	createCustomerAddressService := services.NewCreateCustomerAddress(customerDAO, customerAddressDAO, eventBus, nextIDFunc)
+	getCustomerAddressService := services.NewGetCustomerAddress(customerDAO, customerAddressDAO)
+	updateCustomerAddressService := services.NewUpdateCustomerAddress(customerDAO, customerAddressDAO, eventBus)
+	deleteCustomerAddressService := services.NewDeleteCustomerAddress(customerDAO, customerAddressDAO, eventBus)
+	requestCustomerVerificationService := services.NewRequestCustomerVerification(customerDAO, verificationCodeDAO, notifier, nextIDFunc, cfg.GetVerificationCodeTTL())
+	confirmCustomerVerificationService := services.NewConfirmCustomerVerification(customerDAO, customerAddressDAO, verificationCodeDAO, eventBus)
+
+	createProductService := services.NewCreateProduct(productDAO, storeDAO, eventBus, nextIDFunc, productFactory)
+	getProductService := services.NewGetProduct(productDAO, rateProvider)
+	updateProductService := services.NewUpdateProduct(productDAO, storeDAO, eventBus, nextIDFunc, storageSvc)
+	deleteProductService := services.NewDeleteProduct(productDAO, eventBus, nextIDFunc)
+	restoreProductService := services.NewRestoreProduct(productDAO, storeDAO, eventBus, retention)
+	listProductsService := services.NewListProducts(productDAO, rateProvider)
+	createImportJobService := services.NewCreateImportJob(importJobDAO, storeDAO, nextIDFunc)
+	getImportJobService := services.NewGetImportJob(importJobDAO)
+	exportProductsService := services.NewExportProducts(productDAO, storeDAO)
+	listCurrenciesService := services.NewListCurrencies()
+	listEventsService := services.NewListEvents(eventDAO)
+	listEventSchemasService := services.NewListEventSchemas(domain.EventSchemas)
+	createWebhookSubscriptionService := services.NewCreateWebhookSubscription(storeDAO, webhookSubscriptionDAO, nextIDFunc)
+	listWebhookSubscriptionsService := services.NewListWebhookSubscriptions(storeDAO, webhookSubscriptionDAO)
+	updateWebhookSubscriptionService := services.NewUpdateWebhookSubscription(storeDAO, webhookSubscriptionDAO)
+	deleteWebhookSubscriptionService := services.NewDeleteWebhookSubscription(storeDAO, webhookSubscriptionDAO)
+	listWebhookDeliveriesService := services.NewListWebhookDeliveries(storeDAO, webhookSubscriptionDAO, webhookDeliveryDAO)
+	getWebhookDeliveryService := services.NewGetWebhookDelivery(storeDAO, webhookSubscriptionDAO, webhookDeliveryDAO, webhookDeliveryAttemptDAO)
+	redeliverWebhookDeliveryService := services.NewRedeliverWebhookDelivery(storeDAO, webhookSubscriptionDAO, webhookDeliveryDAO)
 
 	// Routes
+	// the events feed is read by the other services with a shared token
+	router.GET("/api/v1/events", middlewares.RequireEventsToken(cfg.EventsAPIToken), handlers.ListEvents(listEventsService))
+	router.GET("/api/v1/events/schemas", middlewares.RequireEventsToken(cfg.EventsAPIToken), handlers.ListEventSchemas(listEventSchemasService))
+
 	api := router.Group("/api/v1")
-	api.Use(jwtMiddleware.ValidateToken())
+	api.Use(jwtMiddleware.ValidateToken(), idempotencyMiddleware.Handle())
 	{
 		stores := api.Group("/stores")
 		{
@@ -86,15 +131,35 @@ func New(cfg config.Config, db *sql.DB) *gin.Engine {
 			stores.GET("/:id", handlers.GetStore(getStoreService))
 			stores.PUT("/:id", handlers.UpdateStore(updateStoreService))
 			stores.DELETE("/:id", handlers.DeleteStore(deleteStoreService))
+			stores.POST("/:id/restore", handlers.RestoreStore(restoreStoreService))
+			stores.PUT("/:id/schedule", handlers.SetStoreSchedule(setStoreScheduleService))
 			stores.GET("", handlers.ListStores(listStoresService))
+			stores.POST("/:id/products/import", handlers.ImportProducts(createImportJobService))
+			stores.GET("/:id/products/export", handlers.ExportProducts(exportProductsService))
+			stores.POST("/:id/webhooks", handlers.CreateWebhookSubscription(createWebhookSubscriptionService))
+			stores.GET("/:id/webhooks", handlers.ListWebhookSubscriptions(listWebhookSubscriptionsService))
+			stores.PUT("/:id/webhooks/:webhookId", handlers.UpdateWebhookSubscription(updateWebhookSubscriptionService))
+			stores.DELETE("/:id/webhooks/:webhookId", handlers.DeleteWebhookSubscription(deleteWebhookSubscriptionService))
+			stores.GET("/:id/webhooks/:webhookId/deliveries", handlers.ListWebhookDeliveries(listWebhookDeliveriesService))
+			stores.GET("/:id/webhooks/:webhookId/deliveries/:deliveryId", handlers.GetWebhookDelivery(getWebhookDeliveryService))
+			stores.POST("/:id/webhooks/:webhookId/deliveries/:deliveryId/redeliver", handlers.RedeliverWebhookDelivery(redeliverWebhookDeliveryService))
 		}
 
 		customers := api.Group("/customers")
 		{
 			customers.POST("", handlers.CreateCustomer(createCustomerService))
+			customers.PUT("/me", handlers.UpsertMyCustomer(upsertMyCustomerService))
 			customers.GET("/:id", handlers.GetCustomer(getCustomerService))
 			customers.PUT("/:id", handlers.UpdateCustomer(updateCustomerService))
 			customers.DELETE("/:id", handlers.DeleteCustomer(deleteCustomerService))
+			customers.POST("/:id/restore", handlers.RestoreCustomer(restoreCustomerService))
+			customers.GET("/:id/addresses", handlers.ListCustomerAddresses(listCustomerAddressesService))
+			customers.POST("/:id/addresses", handlers.CreateCustomerAddress(createCustomerAddressService))
+			customers.GET("/:id/addresses/:addressId", handlers.GetCustomerAddress(getCustomerAddressService))
+			customers.PUT("/:id/addresses/:addressId", handlers.UpdateCustomerAddress(updateCustomerAddressService))
+			customers.DELETE("/:id/addresses/:addressId", handlers.DeleteCustomerAddress(deleteCustomerAddressService))
+			customers.POST("/:id/verifications", handlers.RequestCustomerVerification(requestCustomerVerificationService))
+			customers.POST("/:id/verifications/confirm", handlers.ConfirmCustomerVerification(confirmCustomerVerificationService))
 			customers.GET("/user/:userId", handlers.GetCustomerByUserID(getCustomerByUserIDService))
 		}
 
@@ -104,9 +169,13 @@ func New(cfg config.Config, db *sql.DB) *gin.Engine {
 			products.GET("/:id", handlers.GetProduct(getProductService))
 			products.PUT("/:id", handlers.UpdateProduct(updateProductService))
 			products.DELETE("/:id", handlers.DeleteProduct(deleteProductService))
+			products.POST("/:id/restore", handlers.RestoreProduct(restoreProductService))
 			products.GET("", handlers.ListProducts(listProductsService))
 		}
 
+		api.GET("/imports/:id", handlers.GetImportJob(getImportJobService))
+		api.GET("/currencies", handlers.ListCurrencies(listCurrenciesService))
+
 		api.POST("/graphql", handlers.GraphQLStores(listStoresService))
 	}
 